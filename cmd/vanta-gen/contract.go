@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// renderContractCases renders the table driving the contract test in
+// v1/contract_test.go: for every method, the params built from the source's
+// request example, the request that example describes, and each 2xx example
+// response.
+func renderContractCases(a *api, _ map[string]bool) string {
+	var b bytes.Buffer
+	b.WriteString(generatedHeader + "// Contract cases from the API source's request and response examples.\n\npackage v1\n\n")
+	b.WriteString("var contractCases = []contractCase{\n")
+	for _, svc := range a.Services {
+		for _, m := range svc.Methods {
+			renderContractCase(&b, m)
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func renderContractCase(b *bytes.Buffer, m *method) {
+	ep := m.Endpoint
+	params, path, query := contractRequest(m)
+	responses := contractResponses(ep)
+	for _, resp := range responses {
+		b.WriteString("\t{\n")
+		fmt.Fprintf(b, "\t\tOperation: %q,\n", m.Service+"."+m.Name)
+		fmt.Fprintf(b, "\t\tMethod: %q,\n\t\tPath: %q,\n", ep.Method, path)
+		if query != "" {
+			fmt.Fprintf(b, "\t\tQuery: %q,\n", query)
+		}
+		if params != "" {
+			fmt.Fprintf(b, "\t\tParams: %s,\n", goString(params))
+		}
+		fmt.Fprintf(b, "\t\tStatus: %d,\n", resp.Code)
+		if resp.Body != "" {
+			fmt.Fprintf(b, "\t\tResponse: %s,\n", goString(resp.Body))
+		}
+		b.WriteString("\t},\n")
+	}
+}
+
+// contractRequest returns the params JSON, keyed by params field name, that
+// the request example describes, and the path and encoded query the
+// generated method should build from it. Query examples that do not fit the
+// param's type are left out.
+func contractRequest(m *method) (params, path, query string) {
+	ep := m.Endpoint
+	var fields []string
+	add := func(name string, v any) {
+		encoded, _ := json.Marshal(v)
+		fields = append(fields, strconv.Quote(name)+":"+string(encoded))
+	}
+	path = ep.Path
+	values := url.Values{}
+	for _, f := range m.Params {
+		switch f.Kind {
+		case pathField:
+			example := f.Example
+			if example == "" || strings.Contains(example, "{{") {
+				example = "example-" + f.JSON
+			}
+			add(f.Name, example)
+			path = strings.Replace(path, ":"+f.JSON, example, 1)
+		case queryField:
+			var examples []string
+			for _, q := range ep.Query {
+				if q.Name == f.JSON && q.Example != "" {
+					examples = append(examples, q.Example)
+				}
+			}
+			if len(examples) == 0 {
+				continue
+			}
+			v, ok := queryValue(f.Type, examples)
+			if !ok {
+				continue
+			}
+			add(f.Name, v)
+			if !strings.HasPrefix(f.Type, "[]") {
+				examples = examples[:1]
+			}
+			values[f.JSON] = examples
+		case bodyField:
+			var body any
+			if json.Unmarshal(ep.Body, &body) == nil {
+				add(f.Name, body)
+			}
+		}
+	}
+	if len(fields) > 0 {
+		params = "{" + strings.Join(fields, ",") + "}"
+	}
+	return params, path, values.Encode()
+}
+
+// queryValue converts query examples to the JSON value of a params field of
+// type typ.
+func queryValue(typ string, examples []string) (any, bool) {
+	if strings.HasPrefix(typ, "[]") {
+		return examples, true
+	}
+	switch typ {
+	case "*int":
+		n, err := strconv.Atoi(examples[0])
+		return n, err == nil
+	case "*float64":
+		n, err := strconv.ParseFloat(examples[0], 64)
+		return n, err == nil
+	case "*bool":
+		v, err := strconv.ParseBool(examples[0])
+		return v, err == nil
+	}
+	return examples[0], true
+}
+
+// contractResponses returns the 2xx example responses of ep, or a single
+// empty 200 response when it has none, so the request is still checked.
+func contractResponses(ep *endpoint) []exampleResponse {
+	var out []exampleResponse
+	for _, r := range ep.Responses {
+		if r.Code < 200 || r.Code > 299 {
+			continue
+		}
+		body := strings.TrimSpace(r.Body)
+		var indented bytes.Buffer
+		if json.Indent(&indented, []byte(body), "", "  ") == nil {
+			body = indented.String()
+		}
+		out = append(out, exampleResponse{Code: r.Code, Body: body})
+	}
+	if len(out) == 0 {
+		out = append(out, exampleResponse{Code: 200})
+	}
+	return out
+}
+
+// goString quotes s as a raw string literal when it can be one.
+func goString(s string) string {
+	if strings.ContainsAny(s, "`\r") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
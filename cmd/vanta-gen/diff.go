@@ -0,0 +1,180 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+)
+
+// diffSources reports where an OpenAPI document and a Postman collection
+// disagree: endpoints only one of them has, query params, request
+// body fields, and example values that do not match the schema's type or
+// enum. Each line is prefixed with the endpoint key.
+func diffSources(spec, collection []*endpoint) []string {
+	specByKey := endpointsByKey(spec)
+	collectionByKey := endpointsByKey(collection)
+	var out []string
+	for _, key := range slices.Sorted(maps.Keys(specByKey)) {
+		if _, ok := collectionByKey[key]; !ok {
+			out = append(out, key+": only in OpenAPI")
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(collectionByKey)) {
+		pm := collectionByKey[key]
+		ep, ok := specByKey[key]
+		if !ok {
+			out = append(out, key+": only in Postman")
+			continue
+		}
+		for _, line := range diffEndpoint(ep, pm) {
+			out = append(out, key+": "+line)
+		}
+	}
+	return out
+}
+
+func endpointsByKey(endpoints []*endpoint) map[string]*endpoint {
+	out := map[string]*endpoint{}
+	for _, ep := range endpoints {
+		if _, ok := out[ep.key()]; !ok {
+			out[ep.key()] = ep
+		}
+	}
+	return out
+}
+
+func diffEndpoint(spec, pm *endpoint) []string {
+	var out []string
+	out = append(out, diffParams("query param", spec.Query, pm.Query)...)
+	if spec.Multipart != pm.Multipart {
+		out = append(out, fmt.Sprintf("multipart body: OpenAPI %t, Postman %t", spec.Multipart, pm.Multipart))
+	}
+	if spec.BodySchema != nil && len(pm.Body) > 0 {
+		var example any
+		if err := json.Unmarshal(pm.Body, &example); err == nil {
+			out = append(out, diffValue("body", spec.BodySchema, example)...)
+		}
+	}
+	if spec.ResponseSchema != nil {
+		if example, ok := responseExample(pm); ok {
+			out = append(out, diffValue("response", spec.ResponseSchema, example)...)
+		}
+	}
+	return out
+}
+
+func diffParams(what string, spec, pm []param) []string {
+	specNames := map[string]param{}
+	for _, p := range spec {
+		specNames[p.Name] = p
+	}
+	pmNames := map[string]bool{}
+	for _, p := range pm {
+		pmNames[p.Name] = true
+	}
+	var out []string
+	for _, p := range spec {
+		if !pmNames[p.Name] {
+			out = append(out, fmt.Sprintf("%s %s: only in OpenAPI", what, p.Name))
+		}
+	}
+	seen := map[string]bool{}
+	for _, p := range pm {
+		if seen[p.Name] {
+			continue
+		}
+		seen[p.Name] = true
+		sp, ok := specNames[p.Name]
+		if !ok {
+			out = append(out, fmt.Sprintf("%s %s: only in Postman", what, p.Name))
+			continue
+		}
+		if sp.Schema != nil && p.Example != "" {
+			ps := sp.Schema.flatten()
+			if ps.kind() == "array" && ps.Items != nil {
+				ps = ps.Items.flatten()
+			}
+			if values := ps.enumValues(); len(values) > 0 && !slices.Contains(values, p.Example) {
+				out = append(out, fmt.Sprintf("%s %s: Postman example %q is not one of %v", what, p.Name, p.Example, values))
+			}
+		}
+	}
+	return out
+}
+
+// diffValue compares an example value with a schema, recursing into object
+// properties and the first array element.
+func diffValue(path string, s *schema, v any) []string {
+	s = s.flatten()
+	if s == nil || len(s.OneOf) > 0 || len(s.AnyOf) > 0 {
+		return nil
+	}
+	if v == nil {
+		if s.nullable() || s.kind() == "" {
+			return nil
+		}
+		return []string{fmt.Sprintf("%s: Postman example is null, OpenAPI does not allow null", path)}
+	}
+	want := s.kind()
+	if got := jsonKind(v); want != "" && got != want && !(want == "integer" && got == "number" && isWhole(v)) {
+		return []string{fmt.Sprintf("%s: OpenAPI type %s, Postman example is %s", path, want, got)}
+	}
+	var out []string
+	switch v := v.(type) {
+	case string:
+		if values := s.enumValues(); len(values) > 0 && !slices.Contains(values, v) {
+			out = append(out, fmt.Sprintf("%s: Postman example %q is not one of %v", path, v, values))
+		}
+	case []any:
+		if len(v) > 0 && s.Items != nil {
+			out = append(out, diffValue(path+"[0]", s.Items, v[0])...)
+		}
+	case map[string]any:
+		if len(s.Properties) == 0 {
+			return nil
+		}
+		keys := slices.Sorted(maps.Keys(v))
+		for _, k := range keys {
+			prop, ok := s.Properties[k]
+			if !ok {
+				out = append(out, fmt.Sprintf("%s.%s: only in Postman", path, k))
+				continue
+			}
+			out = append(out, diffValue(path+"."+k, prop, v[k])...)
+		}
+		var missing []string
+		for _, k := range s.Required {
+			if _, ok := v[k]; !ok {
+				missing = append(missing, k)
+			}
+		}
+		sort.Strings(missing)
+		for _, k := range missing {
+			out = append(out, fmt.Sprintf("%s.%s: required in OpenAPI, missing from Postman example", path, k))
+		}
+	}
+	return out
+}
+
+func jsonKind(v any) string {
+	switch v.(type) {
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return "null"
+}
+
+func isWhole(v any) bool {
+	f, ok := v.(float64)
+	return ok && f == float64(int64(f))
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRunDiff(t *testing.T) {
+	var out bytes.Buffer
+	n, err := runDiff(&out, "testdata/openapi.json", "testdata/collection.json")
+	if err != nil {
+		t.Fatalf("runDiff returned error: %v", err)
+	}
+	want := []string{
+		"GET /widgets/:widgetId: only in OpenAPI",
+		"POST /widgets/:widgetId/attachments: only in OpenAPI",
+		"GET /gadgets: only in Postman",
+		"GET /widgets: query param pageCursor: only in OpenAPI",
+		"GET /widgets: query param updatedAfter: only in OpenAPI",
+		`GET /widgets: query param statusMatchesAny: Postman example "BROKEN" is not one of [ACTIVE RETIRED]`,
+		"GET /widgets: query param ownerId: only in Postman",
+		"GET /widgets: response.results.data[0].color: only in Postman",
+		"GET /widgets: response.results.data[0].tags: OpenAPI type array, Postman example is string",
+		`POST /widgets: body.kind: Postman example "HUGE" is not one of [SMALL LARGE]`,
+		"POST /widgets: body.name: required in OpenAPI, missing from Postman example",
+	}
+	if got := strings.TrimSpace(out.String()); got != strings.Join(want, "\n") {
+		t.Fatalf("diff output:\n%s\nwant:\n%s", got, strings.Join(want, "\n"))
+	}
+	if n != len(want) {
+		t.Fatalf("n = %d, want %d", n, len(want))
+	}
+}
+
+func TestRunDiffNeedsBothSources(t *testing.T) {
+	if _, err := runDiff(&bytes.Buffer{}, "", "testdata/collection.json"); err == nil {
+		t.Fatal("runDiff without -openapi returned nil error")
+	}
+}
@@ -0,0 +1,312 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// catalogOperation is an entry of the operation catalog, read back from the
+// committed generated_operations.go.
+type catalogOperation struct {
+	ID          string
+	HTTPMethod  string
+	Path        string
+	PathParams  []catalogParam
+	QueryParams []catalogParam
+}
+
+// catalogParam is a path or query param of a catalogOperation.
+type catalogParam struct {
+	Name string
+	Type string
+}
+
+// runDrift writes where the operation catalog in dir has drifted from the
+// API source to w and returns how many findings there were.
+func runDrift(w io.Writer, src sources, dir string) (int, error) {
+	endpoints, err := src.endpoints()
+	if err != nil {
+		return 0, err
+	}
+	basePath, err := loadBasePath(dir)
+	if err != nil {
+		return 0, err
+	}
+	catalog, err := loadCatalog(filepath.Join(dir, "generated_operations.go"))
+	if err != nil {
+		return 0, err
+	}
+	lines := driftReport(endpoints, catalog, basePath)
+	for _, line := range lines {
+		fmt.Fprintln(w, line)
+	}
+	return len(lines), nil
+}
+
+// driftReport compares every endpoint of the source, as written there and
+// including repeated requests, with the catalog: endpoints the SDK is
+// missing, operations the source no longer has, and, for each endpoint the
+// catalog has, its HTTP method and the names and Go types of its params.
+// Endpoints are paired with operations by method and path, with basePath,
+// the path of the SDK's default base URL, stripped from source paths that
+// spell it out; catalog paths that still start with it are reported as
+// doubling it. Endpoints that pair with nothing are paired by the ID the
+// generator would give them instead and their method and path compared.
+func driftReport(endpoints []*endpoint, catalog []catalogOperation, basePath string) []string {
+	var out []string
+	seen := map[string]bool{}
+	report := func(format string, args ...any) {
+		line := fmt.Sprintf(format, args...)
+		if !seen[line] {
+			seen[line] = true
+			out = append(out, line)
+		}
+	}
+
+	trimmed := make([]*endpoint, len(endpoints))
+	for i, ep := range endpoints {
+		cp := *ep
+		trimmed[i] = &cp
+	}
+	trimBasePath(trimmed, basePath)
+
+	byKey := map[string]int{}
+	for i, op := range catalog {
+		byKey[op.HTTPMethod+" "+op.Path] = i
+	}
+	matched := make([]bool, len(catalog))
+	ops := make([]int, len(trimmed))
+	for i, ep := range trimmed {
+		ops[i] = -1
+		if j, ok := byKey[ep.key()]; ok {
+			ops[i] = j
+			matched[j] = true
+		}
+	}
+	for i, ep := range trimmed {
+		if ops[i] >= 0 {
+			continue
+		}
+		id := ep.Name
+		if m, err := buildMethod(ep); err == nil {
+			id = m.Service + "." + m.Name
+		}
+		j := slices.IndexFunc(catalog, func(op catalogOperation) bool { return op.ID == id })
+		if j < 0 {
+			report("%s: missing from SDK (expected %s)", endpoints[i].key(), id)
+			continue
+		}
+		if catalog[j].HTTPMethod != ep.Method {
+			report("%s: method %s, source %s", id, catalog[j].HTTPMethod, ep.Method)
+		}
+		if catalog[j].Path != ep.Path {
+			report("%s: path %s, source %s", id, catalog[j].Path, ep.Path)
+		}
+		ops[i] = j
+		matched[j] = true
+	}
+
+	for i, ep := range trimmed {
+		if ops[i] < 0 {
+			continue
+		}
+		op := catalog[ops[i]]
+		var pathParams, queryParams []*field
+		for _, f := range paramFields(ep, overrides[ep.key()]) {
+			if f.Kind == pathField {
+				pathParams = append(pathParams, f)
+			} else {
+				queryParams = append(queryParams, f)
+			}
+		}
+		for _, line := range driftParams(op.ID, "path param", op.PathParams, pathParams) {
+			report("%s", line)
+		}
+		for _, line := range driftParams(op.ID, "query param", op.QueryParams, queryParams) {
+			report("%s", line)
+		}
+	}
+
+	for i, op := range catalog {
+		if !matched[i] {
+			report("%s: in SDK (%s %s), not in source", op.ID, op.HTTPMethod, op.Path)
+		}
+		if basePath != "" && strings.HasPrefix(op.Path, basePath+"/") {
+			report("%s: path %s repeats the base URL path %s", op.ID, op.Path, basePath)
+		}
+	}
+	return out
+}
+
+func driftParams(id, what string, sdk []catalogParam, source []*field) []string {
+	var out []string
+	for _, p := range sdk {
+		if !slices.ContainsFunc(source, func(f *field) bool { return f.JSON == p.Name }) {
+			out = append(out, fmt.Sprintf("%s: %s %s: only in SDK", id, what, p.Name))
+		}
+	}
+	for _, f := range source {
+		i := slices.IndexFunc(sdk, func(p catalogParam) bool { return p.Name == f.JSON })
+		switch {
+		case i < 0:
+			out = append(out, fmt.Sprintf("%s: %s %s: only in source", id, what, f.JSON))
+		case sdk[i].Type != f.Type:
+			out = append(out, fmt.Sprintf("%s: %s %s: type %s, source %s", id, what, f.JSON, sdk[i].Type, f.Type))
+		}
+	}
+	return out
+}
+
+// trimBasePath strips basePath from endpoint paths that start with it. Some
+// collection requests spell out /v1 although {{baseUrl}} already ends in it,
+// which would make the SDK request /v1/v1/....
+func trimBasePath(endpoints []*endpoint, basePath string) {
+	if basePath == "" {
+		return
+	}
+	for _, ep := range endpoints {
+		if rest, ok := strings.CutPrefix(ep.Path, basePath+"/"); ok {
+			ep.Path = "/" + rest
+		}
+	}
+}
+
+// loadBasePath returns the path of the default API base URL declared by the
+// hand-written files in dir (the defaultAPIBaseURL constant), or "" if there
+// is none.
+func loadBasePath(dir string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+	fset := token.NewFileSet()
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || strings.HasPrefix(name, "generated_") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return "", err
+		}
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec)
+				for i, ident := range vs.Names {
+					if ident.Name != "defaultAPIBaseURL" || i >= len(vs.Values) {
+						continue
+					}
+					raw, ok := stringLit(vs.Values[i])
+					if !ok {
+						return "", fmt.Errorf("%s: defaultAPIBaseURL is not a string literal", name)
+					}
+					u, err := url.Parse(raw)
+					if err != nil {
+						return "", fmt.Errorf("%s: defaultAPIBaseURL: %w", name, err)
+					}
+					return strings.TrimSuffix(u.Path, "/"), nil
+				}
+			}
+		}
+	}
+	return "", nil
+}
+
+// loadCatalog reads the operations variable of a generated_operations.go.
+func loadCatalog(path string) ([]catalogOperation, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	var list *ast.CompositeLit
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if len(vs.Names) == 1 && vs.Names[0].Name == "operations" && len(vs.Values) == 1 {
+				list, _ = vs.Values[0].(*ast.CompositeLit)
+			}
+		}
+	}
+	if list == nil {
+		return nil, fmt.Errorf("%s: no operations catalog", path)
+	}
+	var out []catalogOperation
+	for _, elt := range list.Elts {
+		fields := compositeFields(elt)
+		var op catalogOperation
+		op.ID, _ = stringLit(fields["ID"])
+		op.HTTPMethod, _ = stringLit(fields["HTTPMethod"])
+		op.Path, _ = stringLit(fields["Path"])
+		op.PathParams = catalogParams(fields["PathParams"])
+		op.QueryParams = catalogParams(fields["QueryParams"])
+		if op.ID == "" {
+			return nil, fmt.Errorf("%s: operation without an ID", path)
+		}
+		out = append(out, op)
+	}
+	slices.SortFunc(out, func(a, b catalogOperation) int { return strings.Compare(a.ID, b.ID) })
+	return out, nil
+}
+
+// compositeFields returns the keyed fields of a composite literal.
+func compositeFields(e ast.Expr) map[string]ast.Expr {
+	out := map[string]ast.Expr{}
+	lit, ok := e.(*ast.CompositeLit)
+	if !ok {
+		return out
+	}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if key, ok := kv.Key.(*ast.Ident); ok {
+			out[key.Name] = kv.Value
+		}
+	}
+	return out
+}
+
+func catalogParams(e ast.Expr) []catalogParam {
+	lit, ok := e.(*ast.CompositeLit)
+	if !ok {
+		return nil
+	}
+	var out []catalogParam
+	for _, elt := range lit.Elts {
+		fields := compositeFields(elt)
+		name, ok := stringLit(fields["Name"])
+		if !ok {
+			continue
+		}
+		typ, _ := stringLit(fields["Type"])
+		out = append(out, catalogParam{Name: name, Type: typ})
+	}
+	return out
+}
+
+func stringLit(e ast.Expr) (string, bool) {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestCatalogHasNotDrifted(t *testing.T) {
+	var out bytes.Buffer
+	n, err := runDrift(&out, sources{Collection: testCollection}, "../../v1")
+	if err != nil {
+		t.Fatalf("runDrift returned error: %v", err)
+	}
+	if n != 0 {
+		t.Fatalf("operation catalog has drifted from the collection:\n%s", out.String())
+	}
+}
+
+func TestDriftReport(t *testing.T) {
+	endpoints, err := loadPostman("testdata/collection.json")
+	if err != nil {
+		t.Fatalf("loadPostman returned error: %v", err)
+	}
+	// A repeat of the listing that spells out the base path and takes
+	// another filter; generation keeps only the first.
+	endpoints = append(endpoints, &endpoint{
+		Name:   "List all widgets",
+		Method: "GET",
+		Path:   "/v1/widgets",
+		Query:  []param{{Name: "pageSize", Example: "10"}, {Name: "color", Example: "red"}},
+	})
+	catalog := []catalogOperation{
+		{ID: "Widgets.CreateWidget", HTTPMethod: "PUT", Path: "/widgets"},
+		{ID: "Widgets.DeleteWidget", HTTPMethod: "DELETE", Path: "/widgets/:widgetId", PathParams: []catalogParam{{Name: "widgetId", Type: "string"}}},
+		{ID: "Widgets.ListAllWidgets", HTTPMethod: "GET", Path: "/v1/widgets", QueryParams: []catalogParam{
+			{Name: "pageSize", Type: "*int"},
+			{Name: "pageCursor", Type: "*string"},
+			{Name: "statusMatchesAny", Type: "[]WidgetStatus"},
+		}},
+	}
+	want := []string{
+		"Widgets.ListAllWidgets: path /v1/widgets, source /widgets",
+		"Widgets.CreateWidget: method PUT, source POST",
+		"GET /gadgets: missing from SDK (expected Gadgets.ListGadgets)",
+		"Widgets.ListAllWidgets: query param pageCursor: only in SDK",
+		"Widgets.ListAllWidgets: query param statusMatchesAny: type []WidgetStatus, source *string",
+		"Widgets.ListAllWidgets: query param ownerId: only in source",
+		"Widgets.ListAllWidgets: query param statusMatchesAny: only in SDK",
+		"Widgets.ListAllWidgets: query param color: only in source",
+		"Widgets.DeleteWidget: in SDK (DELETE /widgets/:widgetId), not in source",
+		"Widgets.ListAllWidgets: path /v1/widgets repeats the base URL path /v1",
+	}
+	got := driftReport(endpoints, catalog, "/v1")
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("drift report:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestTrimBasePath(t *testing.T) {
+	endpoints := []*endpoint{{Path: "/v1/resources/user_account"}, {Path: "/v1beta/things"}, {Path: "/oauth/token"}}
+	trimBasePath(endpoints, "/v1")
+	var got []string
+	for _, ep := range endpoints {
+		got = append(got, ep.Path)
+	}
+	if want := "/resources/user_account,/v1beta/things,/oauth/token"; strings.Join(got, ",") != want {
+		t.Fatalf("paths = %s, want %s", strings.Join(got, ","), want)
+	}
+}
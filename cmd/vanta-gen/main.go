@@ -0,0 +1,254 @@
+// Command vanta-gen generates the Vanta service bindings in v1 from the
+// bundled Postman collection or from an OpenAPI document.
+//
+// It writes generated_services.go, generated_iterators.go,
+// generated_validation.go and the other generated_*.go files, including
+// generated_contract_test.go, the table of request and response examples
+// that v1/contract_test.go checks the generated methods against. Response
+// types are the hand-written models whose JSON fields match each example
+// response (the first item of results.data for lists). Shapes that cannot be
+// inferred from the source (entity ID types, enums, wrong examples and
+// helper-backed methods) come from the tables in overrides.go. Enum types are
+// discovered by scanning the output package for string types with a Valid
+// method.
+//
+// With -openapi, types, required fields, enums, nullability and field
+// descriptions come from the document's schemas. The collection, unless
+// -collection is empty, supplies request names (keeping method names stable)
+// and examples for operations the document has none for. Only JSON
+// documents are read.
+//
+// With -diff, nothing is written. vanta-gen prints every disagreement
+// between the OpenAPI document and the collection and exits with status 1
+// if there are any.
+//
+// With -drift, nothing is written either. vanta-gen compares every request
+// of the source (-collection, or -openapi) as written there, repeats
+// included, with the operation catalog committed in -out and prints
+// endpoints the SDK is missing, operations the source no longer has, and
+// HTTP method, path, param name and param type mismatches, including paths
+// that repeat the base URL's /v1. It exits with status 1 if there are any.
+//
+// Usage:
+//
+//	go run ./cmd/vanta-gen -collection "Vanta Postman Env & Collection/Vanta API.postman_collection.json" -out v1
+//	go run ./cmd/vanta-gen -openapi openapi.json -out v1
+//	go run ./cmd/vanta-gen -openapi openapi.json -diff
+//	go run ./cmd/vanta-gen -drift
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	collection := flag.String("collection", "Vanta Postman Env & Collection/Vanta API.postman_collection.json", "Postman collection to generate from")
+	spec := flag.String("openapi", "", "OpenAPI JSON document to generate from instead of the collection")
+	out := flag.String("out", "v1", "package directory to write generated files to")
+	diff := flag.Bool("diff", false, "report where -openapi and -collection disagree instead of generating")
+	drift := flag.Bool("drift", false, "report where the operation catalog in -out has drifted from the source instead of generating")
+	flag.Parse()
+
+	if *drift {
+		n, err := runDrift(os.Stdout, sources{Collection: *collection, OpenAPI: *spec}, *out)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "vanta-gen:", err)
+			os.Exit(1)
+		}
+		if n > 0 {
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *diff {
+		n, err := runDiff(os.Stdout, *spec, *collection)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "vanta-gen:", err)
+			os.Exit(1)
+		}
+		if n > 0 {
+			os.Exit(1)
+		}
+		return
+	}
+	if err := run(sources{Collection: *collection, OpenAPI: *spec}, *out); err != nil {
+		fmt.Fprintln(os.Stderr, "vanta-gen:", err)
+		os.Exit(1)
+	}
+}
+
+// sources names the API descriptions to generate from. OpenAPI takes
+// precedence; Collection is then only used to fill in examples.
+type sources struct {
+	Collection string
+	OpenAPI    string
+}
+
+func (s sources) endpoints() ([]*endpoint, error) {
+	var collection []*endpoint
+	if s.Collection != "" {
+		var err error
+		collection, err = loadPostman(s.Collection)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if s.OpenAPI == "" {
+		if s.Collection == "" {
+			return nil, fmt.Errorf("no -collection or -openapi source given")
+		}
+		return collection, nil
+	}
+	spec, err := loadOpenAPI(s.OpenAPI)
+	if err != nil {
+		return nil, err
+	}
+	mergeExamples(spec, collection)
+	return spec, nil
+}
+
+func run(src sources, out string) error {
+	files, err := generateFiles(src, out)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if err := os.WriteFile(filepath.Join(out, f.Name), f.Content, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runDiff writes the disagreements between the two sources to w and returns
+// how many there were.
+func runDiff(w io.Writer, spec, collection string) (int, error) {
+	if spec == "" || collection == "" {
+		return 0, fmt.Errorf("-diff needs both -openapi and -collection")
+	}
+	specEndpoints, err := loadOpenAPI(spec)
+	if err != nil {
+		return 0, err
+	}
+	collectionEndpoints, err := loadPostman(collection)
+	if err != nil {
+		return 0, err
+	}
+	lines := diffSources(specEndpoints, collectionEndpoints)
+	for _, line := range lines {
+		fmt.Fprintln(w, line)
+	}
+	return len(lines), nil
+}
+
+// generateFiles renders the generated files for the package in dir without
+// writing them.
+func generateFiles(src sources, dir string) ([]file, error) {
+	endpoints, err := src.endpoints()
+	if err != nil {
+		return nil, err
+	}
+	known, enums, models, err := loadTypes(dir)
+	if err != nil {
+		return nil, err
+	}
+	basePath, err := loadBasePath(dir)
+	if err != nil {
+		return nil, err
+	}
+	trimBasePath(endpoints, basePath)
+	a, err := buildAPI(endpoints, known, models)
+	if err != nil {
+		return nil, err
+	}
+	return generate(a, enums)
+}
+
+// loadTypes returns the types declared by the hand-written files in dir, the
+// subset that have a Valid method, which is how the package marks string
+// enums, and the JSON keys of each struct type.
+func loadTypes(dir string) (known, enums map[string]bool, models map[string][]string, err error) {
+	fset := token.NewFileSet()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	known = map[string]bool{}
+	enums = map[string]bool{}
+	models = map[string][]string{}
+	embeds := map[string][]string{}
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || strings.HasPrefix(name, "generated_") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		for _, decl := range f.Decls {
+			if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.TYPE {
+				for _, spec := range gen.Specs {
+					ts := spec.(*ast.TypeSpec)
+					known[ts.Name.Name] = true
+					if st, ok := ts.Type.(*ast.StructType); ok && ts.TypeParams == nil {
+						models[ts.Name.Name], embeds[ts.Name.Name] = jsonKeys(st)
+					}
+				}
+				continue
+			}
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || fn.Name.Name != "Valid" || len(fn.Recv.List) != 1 {
+				continue
+			}
+			if ident, ok := fn.Recv.List[0].Type.(*ast.Ident); ok {
+				enums[ident.Name] = true
+			}
+		}
+	}
+	for name, embedded := range embeds {
+		for _, e := range embedded {
+			models[name] = append(models[name], models[e]...)
+		}
+	}
+	for name, keys := range models {
+		if len(keys) == 0 {
+			delete(models, name)
+		}
+	}
+	return known, enums, models, nil
+}
+
+// jsonKeys returns the JSON names of the tagged fields of st and the names of
+// the types it embeds.
+func jsonKeys(st *ast.StructType) (keys, embedded []string) {
+	for _, f := range st.Fields.List {
+		if ident, ok := f.Type.(*ast.Ident); ok && len(f.Names) == 0 {
+			embedded = append(embedded, ident.Name)
+			continue
+		}
+		if f.Tag == nil {
+			continue
+		}
+		tag, err := strconv.Unquote(f.Tag.Value)
+		if err != nil {
+			continue
+		}
+		name, _, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+		if name != "" && name != "-" {
+			keys = append(keys, name)
+		}
+	}
+	return keys, embedded
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testCollection = "../../Vanta Postman Env & Collection/Vanta API.postman_collection.json"
+
+func TestGeneratedFilesAreUpToDate(t *testing.T) {
+	files, err := generateFiles(sources{Collection: testCollection}, "../../v1")
+	if err != nil {
+		t.Fatalf("generateFiles returned error: %v", err)
+	}
+	for _, f := range files {
+		got, err := os.ReadFile(filepath.Join("../../v1", f.Name))
+		if err != nil {
+			t.Fatalf("read %s: %v", f.Name, err)
+		}
+		if !bytes.Equal(got, f.Content) {
+			t.Errorf("v1/%s is stale; run go generate ./v1", f.Name)
+		}
+	}
+}
+
+func TestMethodName(t *testing.T) {
+	tests := map[string]string{
+		"List controls":                    "ListControls",
+		"Get a control by ID":              "GetControlByID",
+		"Add a document to a control":      "AddDocumentToControl",
+		"Get the vendor's security review": "GetVendorsSecurityReview",
+	}
+	for in, want := range tests {
+		if got := methodName(in); got != want {
+			t.Errorf("methodName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestIteratorName(t *testing.T) {
+	tests := map[string]string{
+		"ListControls":      "AllControls",
+		"ListOfPeople":      "AllPeople",
+		"GetVendorFindings": "AllVendorFindings",
+	}
+	for in, want := range tests {
+		if got := iteratorName(in); got != want {
+			t.Errorf("iteratorName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestModelMatchesExampleKeys(t *testing.T) {
+	typer := &typer{models: map[string][]string{
+		"Ref":     {"id", "name"},
+		"Widget":  {"id", "name", "color"},
+		"Gadget":  {"id", "name", "size"},
+		"Details": {"id", "name", "color", "count"},
+	}}
+	tests := []struct {
+		example any
+		want    string
+	}{
+		{map[string]any{"id": "1", "name": "a"}, "Ref"},
+		{map[string]any{"id": "1", "color": "red"}, "Widget"},
+		{map[string]any{"id": "1", "name": "a", "count": 2.0}, "Details"},
+		{map[string]any{"id": "1", "weight": 3.0}, ""},
+		{map[string]any{"id": "1"}, ""},
+		{"id", ""},
+	}
+	for _, tt := range tests {
+		got, ok := typer.model(tt.example)
+		if got != tt.want || ok != (tt.want != "") {
+			t.Errorf("model(%v) = %q, %t, want %q", tt.example, got, ok, tt.want)
+		}
+	}
+
+	typer.models["Gizmo"] = []string{"id", "name", "color"}
+	if got, ok := typer.model(map[string]any{"id": "1", "color": "red"}); ok {
+		t.Errorf("model matched %q, want no match when two models fit equally", got)
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+func renderInterfaces(a *api, _ map[string]bool) string {
+	var b bytes.Buffer
+	b.WriteString(generatedHeader + "// Vanta service interfaces.\n\npackage v1\n\n")
+	writeImports(&b, a)
+	for _, svc := range a.Services {
+		fmt.Fprintf(&b, "\n// %sAPI is the method set of %sService, implemented for tests by\n// vantamock.%sAPI.\n", svc.Name, svc.Name, svc.Name)
+		fmt.Fprintf(&b, "type %sAPI interface {\n", svc.Name)
+		for _, m := range svc.Methods {
+			fmt.Fprintf(&b, "\t%s(ctx context.Context, params *%s) (%s, error)\n", m.Name, m.paramsType(), m.Returns)
+			if name, item, ok := m.iterator(); ok {
+				fmt.Fprintf(&b, "\t%s(ctx context.Context, params *%s) iter.Seq2[%s, error]\n", name, m.paramsType(), item)
+			}
+		}
+		b.WriteString("}\n")
+		fmt.Fprintf(&b, "\nvar _ %sAPI = (*%sService)(nil)\n", svc.Name, svc.Name)
+	}
+	return b.String()
+}
+
+func renderMocks(a *api, _ map[string]bool) string {
+	var b bytes.Buffer
+	b.WriteString(generatedHeader + "// Mock Vanta services.\n\npackage vantamock\n\n")
+	writeImports(&b, a, `vanta "github.com/richardoc/vanta-sdk-go/v1"`)
+
+	b.WriteString("\n// Services holds one mock per Vanta service.\ntype Services struct {\n")
+	for _, svc := range a.Services {
+		fmt.Fprintf(&b, "\t%s *%sAPI\n", svc.Name, svc.Name)
+	}
+	b.WriteString("}\n\n// NewServices returns a mock for every service.\nfunc NewServices() *Services {\n\treturn &Services{\n")
+	for _, svc := range a.Services {
+		fmt.Fprintf(&b, "\t\t%s: &%sAPI{},\n", svc.Name, svc.Name)
+	}
+	b.WriteString("\t}\n}\n\n// API returns a *vanta.Services backed by the mocks.\nfunc (s *Services) API() *vanta.Services {\n\treturn &vanta.Services{\n")
+	for _, svc := range a.Services {
+		fmt.Fprintf(&b, "\t\t%s: s.%s,\n", svc.Name, svc.Name)
+	}
+	b.WriteString("\t}\n}\n")
+
+	for _, svc := range a.Services {
+		mock := svc.Name + "API"
+		fmt.Fprintf(&b, "\n// %s is a mock vanta.%s.\ntype %s struct {\n\tRecorder\n\n", mock, mock, mock)
+		for _, m := range svc.Methods {
+			fmt.Fprintf(&b, "\t%sFunc func(ctx context.Context, params *vanta.%s) (%s, error)\n", m.Name, m.paramsType(), qualify(m.Returns))
+		}
+		b.WriteString("}\n")
+		fmt.Fprintf(&b, "\nvar _ vanta.%s = (*%s)(nil)\n", mock, mock)
+		for _, m := range svc.Methods {
+			params := "vanta." + m.paramsType()
+			returns := qualify(m.Returns)
+			fmt.Fprintf(&b, "\n// %s calls %sFunc.\n", m.Name, m.Name)
+			fmt.Fprintf(&b, "func (m *%s) %s(ctx context.Context, params *%s) (%s, error) {\n", mock, m.Name, params, returns)
+			fmt.Fprintf(&b, "\tm.record(%q, params)\n", m.Name)
+			fmt.Fprintf(&b, "\tif m.%sFunc == nil {\n\t\treturn nil, notConfigured(%q, %q)\n\t}\n", m.Name, mock, m.Name)
+			fmt.Fprintf(&b, "\treturn m.%sFunc(ctx, params)\n}\n", m.Name)
+			if name, item, ok := m.iterator(); ok {
+				fmt.Fprintf(&b, "\n// %s pages through %s.\n", name, m.Name)
+				fmt.Fprintf(&b, "func (m *%s) %s(ctx context.Context, params *%s) iter.Seq2[%s, error] {\n", mock, name, params, qualify(item))
+				fmt.Fprintf(&b, "\treturn allItems(ctx, params, func(p *%s) **string { return &p.PageCursor }, m.%s)\n}\n", params, m.Name)
+			}
+		}
+	}
+	return b.String()
+}
+
+// writeImports writes the import block for files declaring every method of
+// a: context always, encoding/json and iter when a method signature needs
+// them, then extra.
+func writeImports(b *bytes.Buffer, a *api, extra ...string) {
+	var needJSON, needIter bool
+	for _, svc := range a.Services {
+		for _, m := range svc.Methods {
+			needJSON = needJSON || strings.Contains(m.Returns, "json.")
+			_, _, ok := m.iterator()
+			needIter = needIter || ok
+		}
+	}
+	b.WriteString("import (\n\t\"context\"\n")
+	if needJSON {
+		b.WriteString("\t\"encoding/json\"\n")
+	}
+	if needIter {
+		b.WriteString("\t\"iter\"\n")
+	}
+	if len(extra) > 0 {
+		b.WriteString("\n")
+	}
+	for _, imp := range extra {
+		fmt.Fprintf(b, "\t%s\n", imp)
+	}
+	b.WriteString(")\n")
+}
+
+var exportedIdent = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?`)
+
+// qualify prefixes the v1 package's exported type names in typ with
+// "vanta.", leaving builtins and other packages' types alone.
+func qualify(typ string) string {
+	return exportedIdent.ReplaceAllStringFunc(typ, func(ident string) string {
+		if strings.Contains(ident, ".") || ident[0] < 'A' || ident[0] > 'Z' {
+			return ident
+		}
+		return "vanta." + ident
+	})
+}
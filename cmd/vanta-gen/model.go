@@ -0,0 +1,587 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// endpoint is one request from an API description, independent of the
+// format it was read from.
+type endpoint struct {
+	Name        string
+	Method      string
+	Host        string
+	Path        string
+	Description string
+	PathParams  []param
+	Query       []param
+	Body        json.RawMessage
+	Multipart   bool
+	FormFields  []string
+	Responses   []exampleResponse
+	// BodySchema and ResponseSchema are set when the source has schemas;
+	// examples are only used for shapes they do not cover.
+	BodySchema     *schema
+	ResponseSchema *schema
+}
+
+type param struct {
+	Name        string
+	Example     string
+	Description string
+	Required    bool
+	Schema      *schema
+}
+
+type exampleResponse struct {
+	Code int
+	Body string
+}
+
+// key identifies an endpoint in the override tables.
+func (e *endpoint) key() string {
+	return e.Method + " " + e.Path
+}
+
+type api struct {
+	Services []*service
+	// Types are the component schemas generated as named structs.
+	Types []*structType
+}
+
+type service struct {
+	Name    string
+	Methods []*method
+}
+
+type method struct {
+	Service  string
+	Name     string
+	Doc      string
+	Endpoint *endpoint
+	Params   []*field
+	Body     *structType
+	// BodyExample is the example request body used for validation rules.
+	BodyExample map[string]any
+	Multipart   bool
+	Response    *structType
+	Returns     string
+	Delegate    string
+	// Unauthenticated methods are called without a bearer token.
+	Unauthenticated bool
+	// Types are inline schema objects generated for this method.
+	Types []*structType
+}
+
+// field is a Params field or a JSON struct field. Required, Format, Enum
+// and OmitEmpty only come from schemas.
+type field struct {
+	Name      string
+	Type      string
+	JSON      string
+	Kind      fieldKind
+	Example   string
+	Desc      string
+	Required  bool
+	Format    string
+	Enum      []string
+	OmitEmpty bool
+}
+
+type fieldKind int
+
+const (
+	pathField fieldKind = iota
+	queryField
+	bodyField
+	formField
+	jsonField
+)
+
+type structType struct {
+	Name        string
+	Doc         string
+	Fields      []*field
+	Handwritten bool
+}
+
+func (m *method) paramsType() string { return m.Service + m.Name + "Params" }
+
+// listItem returns T when the method returns *ResultsPage[T].
+func (m *method) listItem() (string, bool) {
+	item, ok := strings.CutPrefix(m.Returns, "*ResultsPage[")
+	if !ok {
+		return "", false
+	}
+	return strings.TrimSuffix(item, "]"), true
+}
+
+// iterator returns the name and item type of the All* iterator generated for
+// a cursor-paginated list method.
+func (m *method) iterator() (name, item string, ok bool) {
+	item, ok = m.listItem()
+	if !ok || m.param("PageCursor") == nil {
+		return "", "", false
+	}
+	return iteratorName(m.Name), item, true
+}
+
+func (m *method) param(name string) *field {
+	for _, f := range m.Params {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// buildAPI shapes endpoints into services and methods, applying the override
+// tables. Requests repeated in the collection (same method and path) are
+// generated once. known lists the types already declared in the output
+// package; schemas naming one of them reuse it instead of generating a struct.
+// models holds the JSON keys of the hand-written structs among them, which
+// example responses are matched against.
+func buildAPI(endpoints []*endpoint, known map[string]bool, models map[string][]string) (*api, error) {
+	t := &typer{known: known, models: models, components: map[string]*structType{}}
+	seen := map[string]bool{}
+	byService := map[string]*service{}
+	for _, ep := range endpoints {
+		key := ep.Host + " " + ep.key()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		m, err := buildMethod(ep)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", ep.key(), err)
+		}
+		svc := byService[m.Service]
+		if svc == nil {
+			svc = &service{Name: m.Service}
+			byService[m.Service] = svc
+		}
+		svc.Methods = append(svc.Methods, m)
+	}
+	out := &api{}
+	for _, svc := range byService {
+		if err := disambiguate(svc); err != nil {
+			return nil, err
+		}
+		sort.Slice(svc.Methods, func(i, j int) bool { return svc.Methods[i].Name < svc.Methods[j].Name })
+		for _, m := range svc.Methods {
+			if err := shapeMethod(m, t); err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", m.Service, m.Name, err)
+			}
+		}
+		out.Services = append(out.Services, svc)
+	}
+	sort.Slice(out.Services, func(i, j int) bool { return out.Services[i].Name < out.Services[j].Name })
+	out.Types = t.sortedComponents()
+	return out, nil
+}
+
+func buildMethod(ep *endpoint) (*method, error) {
+	svc := serviceName(ep.Path)
+	if svc == "" {
+		return nil, fmt.Errorf("cannot derive a service name")
+	}
+	m := &method{Service: svc, Name: methodName(ep.Name), Endpoint: ep}
+	if o, ok := overrides[ep.key()]; ok && o.Name != "" {
+		m.Name = o.Name
+	}
+	return m, nil
+}
+
+// disambiguate renames methods whose request names collide within a service.
+// The endpoint with the longer path gets a "For<segments>" suffix built from
+// its static path segments after the service segment.
+func disambiguate(svc *service) error {
+	byName := map[string][]*method{}
+	for _, m := range svc.Methods {
+		byName[m.Name] = append(byName[m.Name], m)
+	}
+	for name, ms := range byName {
+		if len(ms) < 2 {
+			continue
+		}
+		sort.SliceStable(ms, func(i, j int) bool { return len(ms[i].Endpoint.Path) < len(ms[j].Endpoint.Path) })
+		for _, m := range ms[1:] {
+			var b strings.Builder
+			b.WriteString("For")
+			for i, seg := range staticSegments(m.Endpoint.Path) {
+				if i > 0 {
+					b.WriteString(camel(seg))
+				}
+			}
+			m.Name = name + b.String()
+		}
+	}
+	names := map[string]bool{}
+	for _, m := range svc.Methods {
+		if names[m.Name] {
+			return fmt.Errorf("%s: duplicate method %s; add a name override", svc.Name, m.Name)
+		}
+		names[m.Name] = true
+	}
+	return nil
+}
+
+func shapeMethod(m *method, t *typer) error {
+	ep := m.Endpoint
+	o := overrides[ep.key()]
+	prefix := m.Service + m.Name
+
+	m.Doc = strings.Join(strings.Fields(ep.Description), " ")
+	if m.Doc == "" {
+		m.Doc = fmt.Sprintf("%s performs %s %s", m.Name, ep.Method, ep.Path)
+		if o.DocSuffix != "" {
+			m.Doc += " " + o.DocSuffix
+		}
+		m.Doc += "."
+	}
+	m.Delegate = o.Delegate
+	m.Unauthenticated = o.Unauthenticated
+
+	m.Params = paramFields(ep, o)
+
+	if ep.Multipart {
+		m.Multipart = true
+		m.Params = append(m.Params, &field{Name: "FormData", Type: "map[string]string", Kind: formField})
+	} else if ep.BodySchema != nil {
+		m.Body = t.structOf(prefix+"RequestBody", ep.BodySchema, &m.Types)
+		applyBodyOverrides(m, o)
+	} else if len(ep.Body) > 0 {
+		var example map[string]any
+		if err := json.Unmarshal(ep.Body, &example); err != nil {
+			return fmt.Errorf("request body example is not a JSON object: %w", err)
+		}
+		m.BodyExample = example
+		m.Body = inferStruct(prefix+"RequestBody", example, o.Fields, "Body.")
+		applyBodyOverrides(m, o)
+	}
+
+	switch {
+	case o.Returns != "":
+		m.Returns = o.Returns
+	case ep.ResponseSchema != nil:
+		m.Returns, m.Response = t.response(prefix, ep.ResponseSchema, o.Fields, &m.Types)
+	default:
+		example, ok := responseExample(ep)
+		if !ok {
+			m.Returns = "json.RawMessage"
+			break
+		}
+		if items, ok := example.([]any); ok {
+			m.Returns = "json.RawMessage"
+			if model, ok := t.model(first(items)); ok {
+				m.Returns = "[]" + model
+			}
+			break
+		}
+		obj, isObject := example.(map[string]any)
+		if !isObject {
+			m.Returns = "json.RawMessage"
+			break
+		}
+		if isPaginated(obj) {
+			data, _ := obj["results"].(map[string]any)["data"].([]any)
+			item, ok := t.model(first(data))
+			if !ok {
+				item = "map[string]any"
+			}
+			m.Returns = "*ResultsPage[" + item + "]"
+			break
+		}
+		if model, ok := t.model(obj); ok {
+			m.Returns = "*" + model
+			break
+		}
+		m.Response = inferStruct(prefix+"Response", obj, o.Fields, "Response.")
+		m.Returns = "*" + m.Response.Name
+	}
+	return nil
+}
+
+// paramFields returns the path and query params of ep, with the types the
+// ID type tables and o give them.
+func paramFields(ep *endpoint, o override) []*field {
+	var params []*field
+	for _, p := range ep.PathParams {
+		typ := "string"
+		if t, ok := pathParamTypes[p.Name]; ok {
+			typ = t
+		}
+		params = append(params, &field{Name: goName(p.Name), Type: typ, JSON: p.Name, Kind: pathField, Example: p.Example, Desc: p.Description, Required: true})
+	}
+	var queryOrder []string
+	queryParams := map[string]*field{}
+	for _, q := range ep.Query {
+		if f, ok := queryParams[q.Name]; ok {
+			f.Type = "[]string"
+			continue
+		}
+		f := &field{Name: goName(q.Name), Type: queryType(q.Example), JSON: q.Name, Kind: queryField, Example: q.Example, Desc: q.Description, Required: q.Required}
+		if q.Schema != nil {
+			applyQuerySchema(f, q.Schema)
+		}
+		queryParams[q.Name] = f
+		queryOrder = append(queryOrder, q.Name)
+	}
+	for _, name := range queryOrder {
+		f := queryParams[name]
+		if t, ok := queryParamTypes[name]; ok {
+			switch f.Type {
+			case "*string":
+				f.Type = "*" + t
+			case "[]string":
+				f.Type = "[]" + t
+			}
+		}
+		params = append(params, f)
+	}
+	for _, f := range params {
+		if t, ok := o.Fields[f.Name]; ok {
+			f.Type = t
+		}
+	}
+	return params
+}
+
+// applyBodyOverrides applies the ID type and field override tables to a
+// request body and adds the Body params field.
+func applyBodyOverrides(m *method, o override) {
+	for _, f := range m.Body.Fields {
+		if t, ok := bodyFieldTypes[f.JSON]; ok {
+			switch f.Type {
+			case "string":
+				f.Type = t
+			case "[]string":
+				f.Type = "[]" + t
+			}
+		}
+		if t, ok := o.Fields["Body."+f.Name]; ok {
+			f.Type = t
+		}
+	}
+	m.Body.Handwritten = handwrittenTypes[m.Body.Name]
+	m.Params = append(m.Params, &field{Name: "Body", Type: "*" + m.Body.Name, Kind: bodyField})
+}
+
+// responseExample returns the first 2xx example response with a JSON body.
+func responseExample(ep *endpoint) (any, bool) {
+	for _, r := range ep.Responses {
+		if r.Code < 200 || r.Code > 299 || strings.TrimSpace(r.Body) == "" {
+			continue
+		}
+		var v any
+		if err := json.Unmarshal([]byte(r.Body), &v); err != nil {
+			continue
+		}
+		return v, true
+	}
+	return nil, false
+}
+
+// isPaginated reports whether a response has Vanta's
+// {"results": {"data": [...], "pageInfo": {...}}} envelope.
+func isPaginated(obj map[string]any) bool {
+	results, ok := obj["results"].(map[string]any)
+	if !ok {
+		return false
+	}
+	_, hasData := results["data"]
+	_, hasPageInfo := results["pageInfo"]
+	return hasData && hasPageInfo
+}
+
+// model returns the hand-written model an example object is an instance of:
+// the struct with a field for every key of the example and, among those, the
+// fewest fields the example leaves out. Examples with fewer than two keys, or
+// that two models fit equally well, match nothing.
+func (t *typer) model(example any) (string, bool) {
+	obj, ok := example.(map[string]any)
+	if !ok || len(obj) < 2 {
+		return "", false
+	}
+	best, bestMissing, tied := "", -1, false
+	for _, name := range slices.Sorted(maps.Keys(t.models)) {
+		keys := t.models[name]
+		covered := 0
+		for _, k := range keys {
+			if _, ok := obj[k]; ok {
+				covered++
+			}
+		}
+		if covered != len(obj) {
+			continue
+		}
+		switch missing := len(keys) - covered; {
+		case bestMissing < 0 || missing < bestMissing:
+			best, bestMissing, tied = name, missing, false
+		case missing == bestMissing:
+			tied = true
+		}
+	}
+	return best, best != "" && !tied
+}
+
+func first(items []any) any {
+	if len(items) == 0 {
+		return nil
+	}
+	return items[0]
+}
+
+func inferStruct(name string, example map[string]any, fieldTypes map[string]string, prefix string) *structType {
+	st := &structType{Name: name}
+	keys := make([]string, 0, len(example))
+	for k := range example {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		f := &field{Name: goName(k), Type: inferType(example[k]), JSON: k, Kind: jsonField}
+		if s, ok := example[k].(string); ok {
+			f.Example = s
+		}
+		if t, ok := fieldTypes[prefix+f.Name]; ok {
+			f.Type = t
+		}
+		st.Fields = append(st.Fields, f)
+	}
+	return st
+}
+
+func inferType(v any) string {
+	switch v := v.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "bool"
+	case float64:
+		return "float64"
+	case map[string]any:
+		return "map[string]any"
+	case []any:
+		if len(v) == 0 {
+			return "[]any"
+		}
+		switch v[0].(type) {
+		case string:
+			return "[]string"
+		case map[string]any:
+			return "[]map[string]any"
+		case float64:
+			return "[]float64"
+		case bool:
+			return "[]bool"
+		}
+		return "[]any"
+	}
+	return "any"
+}
+
+func queryType(example string) string {
+	if _, err := strconv.Atoi(example); err == nil {
+		return "*int"
+	}
+	if example == "true" || example == "false" {
+		return "*bool"
+	}
+	return "*string"
+}
+
+// serviceName derives the Go service name from the first static path
+// segment, skipping a leading API version segment.
+func serviceName(path string) string {
+	segs := staticSegments(path)
+	if len(segs) > 1 && segs[0] == "v1" {
+		return serviceName("/" + strings.Join(segs[1:], "/"))
+	}
+	if len(segs) == 0 {
+		return ""
+	}
+	if name, ok := serviceNames[segs[0]]; ok {
+		return name
+	}
+	return camel(segs[0])
+}
+
+func staticSegments(path string) []string {
+	var out []string
+	for seg := range strings.SplitSeq(strings.Trim(path, "/"), "/") {
+		if seg != "" && !strings.HasPrefix(seg, ":") {
+			out = append(out, seg)
+		}
+	}
+	return out
+}
+
+// camel turns a kebab- or snake-case path segment into CamelCase.
+func camel(s string) string {
+	var b strings.Builder
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool { return r == '-' || r == '_' }) {
+		b.WriteString(upperFirst(part))
+	}
+	return b.String()
+}
+
+// methodName turns a request name such as "Get control by an ID" into
+// "GetControlByID". Articles and punctuation are dropped and every word is
+// title-cased, so acronyms other than ID lose their capitals ("FAQ" becomes
+// "Faq").
+func methodName(name string) string {
+	name = strings.ReplaceAll(name, "'", "")
+	var b strings.Builder
+	for _, word := range strings.FieldsFunc(name, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }) {
+		if slices.Contains([]string{"a", "an", "the"}, strings.ToLower(word)) {
+			continue
+		}
+		for _, part := range splitCamel(word) {
+			part = strings.ToLower(part)
+			if part == "id" {
+				b.WriteString("ID")
+				continue
+			}
+			b.WriteString(upperFirst(part))
+		}
+	}
+	return b.String()
+}
+
+// splitCamel splits "MacOS" into "Mac" and "OS".
+func splitCamel(word string) []string {
+	var parts []string
+	runes := []rune(word)
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		if unicode.IsUpper(runes[i]) && unicode.IsLower(runes[i-1]) {
+			parts = append(parts, string(runes[start:i]))
+			start = i
+		}
+	}
+	return append(parts, string(runes[start:]))
+}
+
+// goName turns a JSON key or parameter name into an exported Go identifier.
+func goName(key string) string {
+	name := camel(key)
+	name = strings.ReplaceAll(name, "Id", "ID")
+	name = strings.ReplaceAll(name, "Url", "URL")
+	return name
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
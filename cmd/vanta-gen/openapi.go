@@ -0,0 +1,491 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// openAPIDoc is the subset of an OpenAPI 3.x document the generator reads.
+type openAPIDoc struct {
+	Servers    []openAPIServer                       `json:"servers"`
+	Paths      map[string]map[string]json.RawMessage `json:"paths"`
+	Components struct {
+		Schemas       map[string]*schema             `json:"schemas"`
+		Parameters    map[string]*openAPIParameter   `json:"parameters"`
+		RequestBodies map[string]*openAPIRequestBody `json:"requestBodies"`
+		Responses     map[string]*openAPIResponse    `json:"responses"`
+	} `json:"components"`
+}
+
+type openAPIServer struct {
+	URL string `json:"url"`
+}
+
+type openAPIOperation struct {
+	OperationID string                      `json:"operationId"`
+	Summary     string                      `json:"summary"`
+	Description string                      `json:"description"`
+	Servers     []openAPIServer             `json:"servers"`
+	Parameters  []*openAPIParameter         `json:"parameters"`
+	RequestBody *openAPIRequestBody         `json:"requestBody"`
+	Responses   map[string]*openAPIResponse `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Ref         string  `json:"$ref"`
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description"`
+	Required    bool    `json:"required"`
+	Schema      *schema `json:"schema"`
+	Example     any     `json:"example"`
+}
+
+type openAPIRequestBody struct {
+	Ref     string                   `json:"$ref"`
+	Content map[string]*openAPIMedia `json:"content"`
+}
+
+type openAPIResponse struct {
+	Ref     string                   `json:"$ref"`
+	Content map[string]*openAPIMedia `json:"content"`
+}
+
+type openAPIMedia struct {
+	Schema   *schema                    `json:"schema"`
+	Example  any                        `json:"example"`
+	Examples map[string]*openAPIExample `json:"examples"`
+}
+
+type openAPIExample struct {
+	Value any `json:"value"`
+}
+
+// schema is a JSON Schema object as used by OpenAPI. After loading, Target
+// points at the component a $ref names.
+type schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 schemaTypes        `json:"type"`
+	Format               string             `json:"format"`
+	Description          string             `json:"description"`
+	Enum                 []any              `json:"enum"`
+	Nullable             bool               `json:"nullable"`
+	Required             []string           `json:"required"`
+	Properties           map[string]*schema `json:"properties"`
+	Items                *schema            `json:"items"`
+	AdditionalProperties *schema            `json:"additionalProperties"`
+	AllOf                []*schema          `json:"allOf"`
+	OneOf                []*schema          `json:"oneOf"`
+	AnyOf                []*schema          `json:"anyOf"`
+	Example              any                `json:"example"`
+
+	Name   string  `json:"-"`
+	Target *schema `json:"-"`
+}
+
+// schemaTypes accepts both the OpenAPI 3.0 string form of "type" and the 3.1
+// list form, where "null" marks the schema nullable.
+type schemaTypes []string
+
+func (t *schemaTypes) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*t = schemaTypes{s}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*t = list
+	return nil
+}
+
+// UnmarshalJSON also accepts the boolean form additionalProperties allows,
+// which is read as an empty schema.
+func (s *schema) UnmarshalJSON(data []byte) error {
+	var b bool
+	if err := json.Unmarshal(data, &b); err == nil {
+		*s = schema{}
+		return nil
+	}
+	type plain schema
+	return json.Unmarshal(data, (*plain)(s))
+}
+
+// resolved follows $ref links to the schema that defines the shape.
+func (s *schema) resolved() *schema {
+	for s != nil && s.Target != nil {
+		s = s.Target
+	}
+	return s
+}
+
+// kind returns the schema's non-null type, or "" when it has none.
+func (s *schema) kind() string {
+	for _, t := range s.Type {
+		if t != "null" {
+			return t
+		}
+	}
+	if len(s.Properties) > 0 {
+		return "object"
+	}
+	return ""
+}
+
+// nullable reports whether null is an allowed value.
+func (s *schema) nullable() bool {
+	return s.Nullable || slices.Contains(s.Type, "null")
+}
+
+// flatten merges allOf members into one object schema.
+func (s *schema) flatten() *schema {
+	s = s.resolved()
+	if s == nil || len(s.AllOf) == 0 {
+		return s
+	}
+	out := &schema{Type: schemaTypes{"object"}, Description: s.Description, Nullable: s.Nullable, Properties: map[string]*schema{}}
+	parts := append([]*schema{{Properties: s.Properties, Required: s.Required}}, s.AllOf...)
+	for _, part := range parts {
+		part = part.flatten()
+		if part == nil {
+			continue
+		}
+		maps.Copy(out.Properties, part.Properties)
+		out.Required = append(out.Required, part.Required...)
+	}
+	return out
+}
+
+// enumValues returns the string values of an enum schema.
+func (s *schema) enumValues() []string {
+	var out []string
+	for _, v := range s.Enum {
+		if str, ok := v.(string); ok {
+			out = append(out, str)
+		}
+	}
+	return out
+}
+
+var openAPIMethods = []string{"get", "put", "post", "patch", "delete"}
+
+// loadOpenAPI reads an OpenAPI 3.x JSON document and returns its operations
+// sorted by path and method. Paths are converted to the ":name" form the
+// Postman collection uses so both sources share override keys.
+func loadOpenAPI(path string) ([]*endpoint, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc openAPIDoc
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	for name, s := range doc.Components.Schemas {
+		s.Name = name
+	}
+	if err := doc.link(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	var out []*endpoint
+	for _, p := range slices.Sorted(maps.Keys(doc.Paths)) {
+		item := doc.Paths[p]
+		var shared []*openAPIParameter
+		if raw, ok := item["parameters"]; ok {
+			if err := json.Unmarshal(raw, &shared); err != nil {
+				return nil, fmt.Errorf("%s parameters: %w", p, err)
+			}
+		}
+		for _, verb := range openAPIMethods {
+			raw, ok := item[verb]
+			if !ok {
+				continue
+			}
+			var op openAPIOperation
+			if err := json.Unmarshal(raw, &op); err != nil {
+				return nil, fmt.Errorf("%s %s: %w", strings.ToUpper(verb), p, err)
+			}
+			ep, err := doc.endpoint(strings.ToUpper(verb), p, &op, shared)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", strings.ToUpper(verb), p, err)
+			}
+			out = append(out, ep)
+		}
+	}
+	return out, nil
+}
+
+// link resolves every schema $ref in the components. Operation schemas are
+// linked as each operation is read.
+func (d *openAPIDoc) link() error {
+	for _, s := range d.Components.Schemas {
+		if err := d.linkSchema(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *openAPIDoc) linkSchema(s *schema) error {
+	if s == nil {
+		return nil
+	}
+	if s.Ref != "" && s.Target == nil {
+		name, ok := strings.CutPrefix(s.Ref, "#/components/schemas/")
+		target := d.Components.Schemas[name]
+		if !ok || target == nil {
+			return fmt.Errorf("unresolved schema reference %q", s.Ref)
+		}
+		s.Target = target
+	}
+	children := []*schema{s.Items, s.AdditionalProperties}
+	children = append(children, s.AllOf...)
+	children = append(children, s.OneOf...)
+	children = append(children, s.AnyOf...)
+	for _, prop := range s.Properties {
+		children = append(children, prop)
+	}
+	for _, c := range children {
+		if err := d.linkSchema(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *openAPIDoc) endpoint(verb, path string, op *openAPIOperation, shared []*openAPIParameter) (*endpoint, error) {
+	ep := &endpoint{
+		Name:        op.Summary,
+		Method:      verb,
+		Path:        openAPIPath(path),
+		Description: op.Description,
+	}
+	if ep.Name == "" {
+		ep.Name = op.OperationID
+	}
+	servers := op.Servers
+	if len(servers) == 0 {
+		servers = d.Servers
+	}
+	if len(servers) > 0 {
+		ep.Host = servers[0].URL
+	}
+
+	params := map[string]*openAPIParameter{}
+	var order []string
+	for _, p := range append(slices.Clone(shared), op.Parameters...) {
+		p, err := d.parameter(p)
+		if err != nil {
+			return nil, err
+		}
+		key := p.In + " " + p.Name
+		if _, ok := params[key]; !ok {
+			order = append(order, key)
+		}
+		params[key] = p
+	}
+	for _, key := range order {
+		p := params[key]
+		pp := param{Name: p.Name, Example: exampleString(p.Example), Description: p.Description, Required: p.Required, Schema: p.Schema}
+		if pp.Example == "" && p.Schema != nil {
+			pp.Example = exampleString(p.Schema.resolved().Example)
+		}
+		switch p.In {
+		case "path":
+			ep.PathParams = append(ep.PathParams, pp)
+		case "query":
+			ep.Query = append(ep.Query, pp)
+		}
+	}
+	ep.PathParams = sortPathParams(ep.Path, ep.PathParams)
+
+	if op.RequestBody != nil {
+		body, err := d.requestBody(op.RequestBody)
+		if err != nil {
+			return nil, err
+		}
+		if media, ok := body.Content["multipart/form-data"]; ok {
+			ep.Multipart = true
+			if s := media.Schema.flatten(); s != nil {
+				ep.FormFields = slices.Sorted(maps.Keys(s.Properties))
+			}
+		} else if media, ok := body.Content["application/json"]; ok {
+			if err := d.linkSchema(media.Schema); err != nil {
+				return nil, err
+			}
+			ep.BodySchema = media.Schema
+			if ex, ok := media.example(); ok {
+				ep.Body = ex
+			}
+		}
+	}
+
+	for _, code := range slices.Sorted(maps.Keys(op.Responses)) {
+		resp, err := d.response(op.Responses[code])
+		if err != nil {
+			return nil, err
+		}
+		var status int
+		if _, err := fmt.Sscanf(code, "%d", &status); err != nil {
+			continue
+		}
+		media, ok := resp.Content["application/json"]
+		if !ok {
+			continue
+		}
+		if err := d.linkSchema(media.Schema); err != nil {
+			return nil, err
+		}
+		if status >= 200 && status <= 299 && ep.ResponseSchema == nil {
+			ep.ResponseSchema = media.Schema
+		}
+		if ex, ok := media.example(); ok {
+			ep.Responses = append(ep.Responses, exampleResponse{Code: status, Body: string(ex)})
+		}
+	}
+	return ep, nil
+}
+
+func (d *openAPIDoc) parameter(p *openAPIParameter) (*openAPIParameter, error) {
+	if p.Ref == "" {
+		return p, d.linkSchema(p.Schema)
+	}
+	name, _ := strings.CutPrefix(p.Ref, "#/components/parameters/")
+	target := d.Components.Parameters[name]
+	if target == nil {
+		return nil, fmt.Errorf("unresolved parameter reference %q", p.Ref)
+	}
+	return target, d.linkSchema(target.Schema)
+}
+
+func (d *openAPIDoc) requestBody(b *openAPIRequestBody) (*openAPIRequestBody, error) {
+	if b.Ref == "" {
+		return b, nil
+	}
+	name, _ := strings.CutPrefix(b.Ref, "#/components/requestBodies/")
+	target := d.Components.RequestBodies[name]
+	if target == nil {
+		return nil, fmt.Errorf("unresolved request body reference %q", b.Ref)
+	}
+	return target, nil
+}
+
+func (d *openAPIDoc) response(r *openAPIResponse) (*openAPIResponse, error) {
+	if r.Ref == "" {
+		return r, nil
+	}
+	name, _ := strings.CutPrefix(r.Ref, "#/components/responses/")
+	target := d.Components.Responses[name]
+	if target == nil {
+		return nil, fmt.Errorf("unresolved response reference %q", r.Ref)
+	}
+	return target, nil
+}
+
+// example returns the media type's example, preferring the single example
+// over the first named one.
+func (m *openAPIMedia) example() (json.RawMessage, bool) {
+	v := m.Example
+	if v == nil && len(m.Examples) > 0 {
+		first := slices.Sorted(maps.Keys(m.Examples))[0]
+		v = m.Examples[first].Value
+	}
+	if v == nil {
+		return nil, false
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// openAPIPath turns "/controls/{controlId}" into "/controls/:controlId".
+func openAPIPath(p string) string {
+	segs := strings.Split(p, "/")
+	for i, seg := range segs {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			segs[i] = ":" + strings.Trim(seg, "{}")
+		}
+	}
+	return strings.Join(segs, "/")
+}
+
+// sortPathParams orders path params by their position in the path.
+func sortPathParams(path string, params []param) []param {
+	pos := map[string]int{}
+	for i, seg := range strings.Split(path, "/") {
+		if name, ok := strings.CutPrefix(seg, ":"); ok {
+			pos[name] = i
+		}
+	}
+	sort.SliceStable(params, func(i, j int) bool { return pos[params[i].Name] < pos[params[j].Name] })
+	return params
+}
+
+func exampleString(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return string(data)
+	}
+}
+
+// mergeExamples fills gaps in spec endpoints from the Postman collection.
+// Request names come from the collection so method names stay stable when
+// switching sources; examples are only borrowed where the spec has none.
+func mergeExamples(spec, collection []*endpoint) {
+	byKey := map[string]*endpoint{}
+	for _, ep := range collection {
+		if _, ok := byKey[ep.key()]; !ok {
+			byKey[ep.key()] = ep
+		}
+	}
+	for _, ep := range spec {
+		pm := byKey[ep.key()]
+		if pm == nil {
+			continue
+		}
+		ep.Name = pm.Name
+		if ep.Description == "" {
+			ep.Description = pm.Description
+		}
+		if len(ep.Body) == 0 {
+			ep.Body = pm.Body
+		}
+		if len(ep.Responses) == 0 {
+			ep.Responses = pm.Responses
+		}
+		fillExamples(ep.PathParams, pm.PathParams)
+		fillExamples(ep.Query, pm.Query)
+	}
+}
+
+func fillExamples(dst, src []param) {
+	for i := range dst {
+		if dst[i].Example != "" {
+			continue
+		}
+		for _, p := range src {
+			if p.Name == dst[i].Name {
+				dst[i].Example = p.Example
+				break
+			}
+		}
+	}
+}
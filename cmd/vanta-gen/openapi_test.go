@@ -0,0 +1,119 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadOpenAPI(t *testing.T) {
+	endpoints, err := loadOpenAPI("testdata/openapi.json")
+	if err != nil {
+		t.Fatalf("loadOpenAPI returned error: %v", err)
+	}
+	var keys []string
+	for _, ep := range endpoints {
+		keys = append(keys, ep.key())
+	}
+	want := "GET /widgets,POST /widgets,GET /widgets/:widgetId,POST /widgets/:widgetId/attachments"
+	if got := strings.Join(keys, ","); got != want {
+		t.Fatalf("endpoints = %s, want %s", got, want)
+	}
+
+	get := endpoints[2]
+	if len(get.PathParams) != 1 || get.PathParams[0].Name != "widgetId" {
+		t.Fatalf("path params = %+v, want shared widgetId", get.PathParams)
+	}
+	if get.Host != "https://api.vanta.com/v1" {
+		t.Fatalf("host = %q", get.Host)
+	}
+	if got := get.ResponseSchema.resolved().Name; got != "Widget" {
+		t.Fatalf("response schema = %q, want Widget", got)
+	}
+	list := endpoints[0]
+	if list.Query[0].Name != "pageSize" || list.Query[0].Schema.kind() != "integer" {
+		t.Fatalf("first query param = %+v, want referenced pageSize", list.Query[0])
+	}
+	upload := endpoints[3]
+	if !upload.Multipart || strings.Join(upload.FormFields, ",") != "description,file" {
+		t.Fatalf("upload = multipart %t fields %v", upload.Multipart, upload.FormFields)
+	}
+}
+
+func TestGenerateFromOpenAPI(t *testing.T) {
+	endpoints, err := loadOpenAPI("testdata/openapi.json")
+	if err != nil {
+		t.Fatalf("loadOpenAPI returned error: %v", err)
+	}
+	a, err := buildAPI(endpoints, map[string]bool{"Person": true}, nil)
+	if err != nil {
+		t.Fatalf("buildAPI returned error: %v", err)
+	}
+	files, err := generate(a, map[string]bool{})
+	if err != nil {
+		t.Fatalf("generate returned error: %v", err)
+	}
+	src := map[string]string{}
+	for _, f := range files {
+		src[f.Name] = string(f.Content)
+	}
+	for _, want := range []string{
+		"// Widget is a widget tracked in Vanta.\ntype Widget struct {",
+		"Parent    *WidgetRef        `json:\"parent,omitempty\"`",
+		"RetiredAt *string           `json:\"retiredAt,omitempty\"`",
+		"type WidgetRef struct {",
+		"\t// Display name.\n\tName  string  `json:\"name\"`",
+		"Owner *Person `json:\"owner,omitempty\"`",
+		"Dimensions *WidgetsCreateWidgetRequestBodyDimensions `json:\"dimensions,omitempty\"`",
+		"func (s *WidgetsService) ListWidgets(ctx context.Context, params *WidgetsListWidgetsParams) (*ResultsPage[Widget], error) {",
+		"func (s *WidgetsService) CreateWidget(ctx context.Context, params *WidgetsCreateWidgetParams) (*Widget, error) {",
+		"\tPageSize         *int\n",
+	} {
+		if !strings.Contains(src["generated_services.go"], want) {
+			t.Errorf("generated_services.go is missing %q", want)
+		}
+	}
+	if strings.Contains(src["generated_services.go"], "type Person struct") {
+		t.Error("known type Person was generated again")
+	}
+	for _, want := range []string{
+		"if p.Body == nil {\n\t\tv.add(\"body\", \"is required\")",
+		"v.required(\"name\", b.Name)",
+		"v.oneOf(\"kind\", b.Kind, \"SMALL\", \"LARGE\")",
+		"v.dateTime(\"dueDate\", b.DueDate)",
+		"v.dateTimePtr(\"updatedAfter\", p.UpdatedAfter)",
+		"for _, s := range p.StatusMatchesAny {\n\t\tv.oneOf(\"statusMatchesAny\", s, \"ACTIVE\", \"RETIRED\")",
+	} {
+		if !strings.Contains(src["generated_validation.go"], want) {
+			t.Errorf("generated_validation.go is missing %q", want)
+		}
+	}
+	if !strings.Contains(src["generated_iterators.go"], "func (s *WidgetsService) AllWidgets(") {
+		t.Error("generated_iterators.go is missing AllWidgets")
+	}
+}
+
+func TestMergeExamplesKeepsCollectionNames(t *testing.T) {
+	spec, err := loadOpenAPI("testdata/openapi.json")
+	if err != nil {
+		t.Fatalf("loadOpenAPI returned error: %v", err)
+	}
+	collection, err := loadPostman("testdata/collection.json")
+	if err != nil {
+		t.Fatalf("loadPostman returned error: %v", err)
+	}
+	mergeExamples(spec, collection)
+
+	list, create := spec[0], spec[1]
+	if list.Name != "List all widgets" {
+		t.Fatalf("name = %q, want the collection's request name", list.Name)
+	}
+	if list.Query[0].Example != "10" {
+		t.Fatalf("pageSize example = %q, want 10 from the collection", list.Query[0].Example)
+	}
+	if list.Description != "Returns a page of widgets." {
+		t.Fatalf("description = %q, want the spec's", list.Description)
+	}
+	if len(create.Body) == 0 || len(create.Responses) != 1 {
+		t.Fatalf("create body %s responses %v, want collection examples", create.Body, create.Responses)
+	}
+}
@@ -0,0 +1,127 @@
+package main
+
+// override adjusts what the generator infers from the collection for one
+// endpoint, keyed by "METHOD /path".
+type override struct {
+	// Name replaces the method name derived from the request name.
+	Name string
+	// Returns is the response type. Without it, example responses are matched
+	// against the hand-written models, so it is only needed where the example
+	// is wrong or the method is delegated.
+	Returns string
+	// Fields replaces inferred field types. Params fields are keyed by Go
+	// name ("StatusFilter"), body and response fields by "Body.<Name>" and
+	// "Response.<Name>".
+	Fields map[string]string
+	// DocSuffix is appended to the fallback doc comment of requests without a
+	// description.
+	DocSuffix string
+	// Delegate is the body of a method implemented by a hand-written helper.
+	Delegate string
+	// Unauthenticated marks endpoints called without a bearer token, which
+	// therefore need no OAuth scopes.
+	Unauthenticated bool
+}
+
+// serviceNames overrides the service name derived from the first path segment.
+var serviceNames = map[string]string{
+	"oauth": "OAuth",
+}
+
+// pathParamTypes maps path parameter names to entity ID types.
+var pathParamTypes = map[string]string{
+	"accessRequestId":    "TrustCenterAccessRequestID",
+	"categoryId":         "TrustCenterControlCategoryID",
+	"computerId":         "MonitoredComputerID",
+	"controlId":          "ControlID",
+	"discoveredVendorId": "DiscoveredVendorID",
+	"documentId":         "DocumentID",
+	"entityId":           "TestEntityID",
+	"faqId":              "TrustCenterFAQID",
+	"findingId":          "VendorFindingID",
+	"frameworkId":        "FrameworkID",
+	"groupId":            "GroupID",
+	"integrationId":      "IntegrationID",
+	"linkId":             "DocumentLinkID",
+	"personId":           "PersonID",
+	"policyId":           "PolicyID",
+	"riskScenarioId":     "RiskScenarioID",
+	"securityReviewId":   "VendorSecurityReviewID",
+	"slugId":             "TrustCenterSlug",
+	"subprocessorId":     "TrustCenterSubprocessorID",
+	"subscriberGroupId":  "TrustCenterSubscriberGroupID",
+	"subscriberId":       "TrustCenterSubscriberID",
+	"testId":             "TestID",
+	"updateId":           "TrustCenterUpdateID",
+	"uploadedFileId":     "DocumentUploadID",
+	"viewerId":           "TrustCenterViewerID",
+	"vendorId":           "VendorID",
+	"vulnerabilityId":    "VulnerabilityID",
+	"vulnerableAssetId":  "VulnerableAssetID",
+}
+
+// queryParamTypes maps query parameter names to entity ID types. Filters
+// that take several values get a slice of the type.
+var queryParamTypes = map[string]string{
+	"controlFilter":     "ControlID",
+	"documentId":        "DocumentID",
+	"frameworkFilter":   "FrameworkID",
+	"integrationFilter": "IntegrationID",
+	"integrationId":     "IntegrationID",
+	"securityReviewId":  "VendorSecurityReviewID",
+	"vulnerableAssetId": "VulnerableAssetID",
+}
+
+// bodyFieldTypes maps request body JSON keys to entity ID types. Keys that
+// hold a list of IDs get a slice of the type.
+var bodyFieldTypes = map[string]string{
+	"categoryIds":        "TrustCenterControlCategoryID",
+	"controlId":          "ControlID",
+	"documentId":         "DocumentID",
+	"securityReviewId":   "VendorSecurityReviewID",
+	"subscriberGroupIds": "TrustCenterSubscriberGroupID",
+	"subscriberIds":      "TrustCenterSubscriberID",
+	"testId":             "TestID",
+}
+
+// handwrittenTypes are request bodies declared by hand in the package. The
+// generator references them but does not emit them.
+var handwrittenTypes = map[string]bool{
+	"OAuthCreateTokenRequestBody":           true,
+	"PeopleUpdatePersonMetadataRequestBody": true,
+}
+
+// requiredBodyFields lists request body fields Vanta rejects when empty. The
+// collection does not mark required fields, so these come from the API docs.
+var requiredBodyFields = map[string][]string{
+	"ControlsCreateCustomControlRequestBody": {"name"},
+}
+
+var overrides = map[string]override{
+	// The collection's first example for this request is a document.
+	"GET /documents/:documentId/controls":                                                 {Returns: "*ResultsPage[Control]"},
+	"GET /integrations/:integrationId/resource-kinds/:resourceKind/resources/:resourceId": {Returns: "*GenericResource", Delegate: `return getIntegrationResource[GenericResource](ctx, s.client, params)`},
+	"GET /integrations/:integrationId/resource-kinds/:resourceKind/resources":             {Returns: "*ResultsPage[GenericResource]", Delegate: `return listIntegrationResources[GenericResource](ctx, s.client, params)`},
+	"POST /oauth/token": {Returns: "*OAuthTokenResponse", Unauthenticated: true, DocSuffix: "against the client's auth URL", Delegate: `if params == nil {
+	params = &OAuthCreateTokenParams{}
+}
+if err := params.Validate(); err != nil {
+	return nil, err
+}
+return s.client.createToken(ctx, params.Body)`},
+	"GET /people":                           {Fields: map[string]string{"TaskTypeMatchesAny": "[]PersonTaskType"}},
+	"POST /people/mark-as-not-people":       {Fields: map[string]string{"Body.Updates": "[]PeopleMarkAsNotPeopleUpdate", "Response.Results": "[]PeopleBulkUpdateResult"}},
+	"POST /people/mark-as-people":           {Fields: map[string]string{"Body.Updates": "[]PeopleMarkAsPeopleUpdate", "Response.Results": "[]PeopleBulkUpdateResult"}},
+	"POST /people/offboard":                 {Fields: map[string]string{"Body.Updates": "[]PeopleOffboardPeopleUpdate", "Response.Results": "[]PeopleBulkUpdateResult"}},
+	"POST /risk-scenarios":                  {Fields: map[string]string{"Body.CustomFields": "CustomFields", "Body.Treatment": "RiskTreatment"}},
+	"PATCH /risk-scenarios/:riskScenarioId": {Fields: map[string]string{"Body.CustomFields": "CustomFields", "Body.Treatment": "RiskTreatment"}},
+	"GET /tests/:testId/entities":           {Fields: map[string]string{"EntityStatus": "*TestEntityStatus"}},
+	"GET /tests":                            {Fields: map[string]string{"StatusFilter": "*TestStatus"}},
+	"PUT /trust-centers/:slugId/subscribers/:subscriberId/groups": {Fields: map[string]string{"Body.GroupIDs": "[]TrustCenterSubscriberGroupID"}},
+	"POST /vendors":                   {Fields: map[string]string{"Body.CustomFields": "CustomFields"}},
+	"GET /vendors":                    {Fields: map[string]string{"StatusMatchesAny": "[]VendorStatus"}},
+	"PATCH /vendors/:vendorId":        {Fields: map[string]string{"Body.CustomFields": "CustomFields"}},
+	"GET /vulnerabilities":            {Fields: map[string]string{"Severity": "*VulnerabilitySeverity"}},
+	"GET /vulnerability-remediations": {Fields: map[string]string{"Severity": "*VulnerabilitySeverity"}},
+	"GET /vulnerable-assets":          {Fields: map[string]string{"AssetType": "*VulnerableAssetType"}},
+}
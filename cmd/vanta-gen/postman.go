@@ -0,0 +1,163 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+type postmanCollection struct {
+	Item []postmanItem `json:"item"`
+}
+
+type postmanItem struct {
+	Name     string            `json:"name"`
+	Item     []postmanItem     `json:"item"`
+	Request  *postmanRequest   `json:"request"`
+	Response []postmanResponse `json:"response"`
+}
+
+type postmanRequest struct {
+	Method      string       `json:"method"`
+	URL         postmanURL   `json:"url"`
+	Body        *postmanBody `json:"body"`
+	Description postmanText  `json:"description"`
+}
+
+type postmanURL struct {
+	Raw      string            `json:"raw"`
+	Host     []string          `json:"host"`
+	Path     []string          `json:"path"`
+	Query    []postmanKeyValue `json:"query"`
+	Variable []postmanKeyValue `json:"variable"`
+}
+
+// UnmarshalJSON accepts both the object form and the plain string form of a
+// Postman URL.
+func (u *postmanURL) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err == nil {
+		*u = postmanURL{Raw: raw}
+		return nil
+	}
+	type plain postmanURL
+	return json.Unmarshal(data, (*plain)(u))
+}
+
+type postmanKeyValue struct {
+	Key         string      `json:"key"`
+	Value       string      `json:"value"`
+	Description postmanText `json:"description"`
+}
+
+type postmanBody struct {
+	Mode     string            `json:"mode"`
+	Raw      string            `json:"raw"`
+	FormData []postmanKeyValue `json:"formdata"`
+}
+
+type postmanResponse struct {
+	Code int    `json:"code"`
+	Body string `json:"body"`
+}
+
+// postmanText is a Postman description, which is either a string or an
+// object with a content field.
+type postmanText string
+
+func (t *postmanText) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*t = postmanText(s)
+		return nil
+	}
+	var obj struct {
+		Content string `json:"content"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	*t = postmanText(obj.Content)
+	return nil
+}
+
+// loadPostman reads a Postman v2.1 collection and returns its requests in
+// collection order.
+func loadPostman(path string) ([]*endpoint, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c postmanCollection
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	var out []*endpoint
+	var walk func(items []postmanItem) error
+	walk = func(items []postmanItem) error {
+		for _, it := range items {
+			if it.Request == nil {
+				if err := walk(it.Item); err != nil {
+					return err
+				}
+				continue
+			}
+			ep, err := postmanEndpoint(it)
+			if err != nil {
+				return fmt.Errorf("%s: %w", it.Name, err)
+			}
+			out = append(out, ep)
+		}
+		return nil
+	}
+	if err := walk(c.Item); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func postmanEndpoint(it postmanItem) (*endpoint, error) {
+	req := it.Request
+	u := req.URL
+	if len(u.Path) == 0 {
+		return nil, fmt.Errorf("request URL %q has no path", u.Raw)
+	}
+	ep := &endpoint{
+		Name:        it.Name,
+		Method:      strings.ToUpper(req.Method),
+		Path:        "/" + strings.Join(u.Path, "/"),
+		Description: string(req.Description),
+	}
+	if len(u.Host) > 0 {
+		ep.Host = strings.Trim(u.Host[0], "{}")
+	}
+	vars := map[string]postmanKeyValue{}
+	for _, v := range u.Variable {
+		vars[v.Key] = v
+	}
+	for _, seg := range u.Path {
+		if name, ok := strings.CutPrefix(seg, ":"); ok {
+			v := vars[name]
+			ep.PathParams = append(ep.PathParams, param{Name: name, Example: v.Value, Description: string(v.Description)})
+		}
+	}
+	for _, q := range u.Query {
+		ep.Query = append(ep.Query, param{Name: q.Key, Example: q.Value, Description: string(q.Description)})
+	}
+	if b := req.Body; b != nil {
+		switch b.Mode {
+		case "raw":
+			ep.Body = []byte(b.Raw)
+		case "formdata":
+			ep.Multipart = true
+			for _, f := range b.FormData {
+				ep.FormFields = append(ep.FormFields, f.Key)
+			}
+		}
+	}
+	for _, resp := range it.Response {
+		ep.Responses = append(ep.Responses, exampleResponse{Code: resp.Code, Body: resp.Body})
+	}
+	return ep, nil
+}
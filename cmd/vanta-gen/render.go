@@ -0,0 +1,444 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// generatedHeader marks output files as generated for Go tooling.
+const generatedHeader = "// Code generated by vanta-gen. DO NOT EDIT.\n\n"
+
+// file is one generated output file.
+type file struct {
+	Name    string
+	Content []byte
+}
+
+// generate renders every generated file for a.
+func generate(a *api, enums map[string]bool) ([]file, error) {
+	renderers := []struct {
+		name   string
+		render func(*api, map[string]bool) string
+	}{
+		{"generated_contract_test.go", renderContractCases},
+		{"generated_interfaces.go", renderInterfaces},
+		{"generated_iterators.go", renderIterators},
+		{"generated_operations.go", renderOperations},
+		{"generated_services.go", renderServices},
+		{"generated_validation.go", renderValidation},
+		{"vantamock/generated_mocks.go", renderMocks},
+	}
+	var out []file
+	for _, r := range renderers {
+		src := r.render(a, enums)
+		formatted, err := format.Source([]byte(src))
+		if err != nil {
+			return nil, fmt.Errorf("format %s: %w", r.name, err)
+		}
+		out = append(out, file{Name: r.name, Content: formatted})
+	}
+	return out, nil
+}
+
+func renderServices(a *api, _ map[string]bool) string {
+	var b bytes.Buffer
+	b.WriteString(generatedHeader + "// Vanta service endpoint bindings.\n\npackage v1\n\n")
+	b.WriteString("import (\n\t\"context\"\n\t\"encoding/json\"\n\t\"fmt\"\n\t\"net/url\"\n\t\"strings\"\n)\n\n")
+
+	b.WriteString("// Services is the generated service registry for Vanta APIs.\ntype Services struct {\n")
+	for _, svc := range a.Services {
+		fmt.Fprintf(&b, "\t%s %sAPI\n", svc.Name, svc.Name)
+	}
+	b.WriteString("}\n\nfunc newGeneratedServices(c *Client) *Services {\n\tservices := &Services{}\n")
+	for _, svc := range a.Services {
+		fmt.Fprintf(&b, "\tservices.%s = &%sService{client: c}\n", svc.Name, svc.Name)
+	}
+	b.WriteString("\treturn services\n}\n")
+	for _, st := range a.Types {
+		renderStruct(&b, st)
+	}
+
+	for _, svc := range a.Services {
+		fmt.Fprintf(&b, "\n// %sService groups %d endpoint methods under the %q API segment.\n", svc.Name, len(svc.Methods), svc.Name)
+		fmt.Fprintf(&b, "type %sService struct {\n\tclient *Client\n}\n", svc.Name)
+		for _, m := range svc.Methods {
+			renderMethod(&b, m)
+		}
+	}
+	return b.String()
+}
+
+func renderStruct(b *bytes.Buffer, st *structType) {
+	if st == nil || st.Handwritten {
+		return
+	}
+	b.WriteString("\n")
+	if st.Doc != "" {
+		fmt.Fprintf(b, "// %s %s\n", st.Name, st.Doc)
+	}
+	fmt.Fprintf(b, "type %s struct {\n", st.Name)
+	for _, f := range st.Fields {
+		if f.Desc != "" {
+			fmt.Fprintf(b, "\t// %s\n", f.Desc)
+		}
+		tag := f.JSON
+		if f.OmitEmpty {
+			tag += ",omitempty"
+		}
+		fmt.Fprintf(b, "\t%s %s `json:%q`\n", f.Name, f.Type, tag)
+	}
+	b.WriteString("}\n")
+}
+
+func renderMethod(b *bytes.Buffer, m *method) {
+	params := m.paramsType()
+	renderStruct(b, m.Body)
+	renderStruct(b, m.Response)
+	for _, st := range m.Types {
+		renderStruct(b, st)
+	}
+	fmt.Fprintf(b, "\ntype %s struct {\n", params)
+	for _, f := range m.Params {
+		if f.Kind == formField {
+			b.WriteString("\t// FormData maps multipart field names to values.\n")
+		}
+		fmt.Fprintf(b, "\t%s %s\n", f.Name, f.Type)
+	}
+	b.WriteString("}\n")
+
+	fmt.Fprintf(b, "\n// %s %s\n", m.Name, m.Doc)
+	fmt.Fprintf(b, "func (s *%sService) %s(ctx context.Context, params *%s) (%s, error) {\n", m.Service, m.Name, params, m.Returns)
+	if m.Delegate != "" {
+		for line := range strings.SplitSeq(m.Delegate, "\n") {
+			fmt.Fprintf(b, "\t%s\n", line)
+		}
+		b.WriteString("}\n")
+		return
+	}
+	fmt.Fprintf(b, "\tif params == nil {\n\t\tparams = &%s{}\n\t}\n", params)
+	b.WriteString("\tif err := params.Validate(); err != nil {\n\t\treturn nil, err\n\t}\n")
+	fmt.Fprintf(b, "\tpath := %q\n", m.Endpoint.Path)
+	for _, f := range m.Params {
+		if f.Kind != pathField {
+			continue
+		}
+		value := "params." + f.Name
+		if f.Type != "string" {
+			value = "string(" + value + ")"
+		}
+		fmt.Fprintf(b, "\tpath = strings.ReplaceAll(path, \":%s\", url.PathEscape(%s))\n", f.JSON, value)
+	}
+	b.WriteString("\tquery := url.Values{}\n")
+	for _, f := range m.Params {
+		if f.Kind != queryField {
+			continue
+		}
+		if elem, ok := strings.CutPrefix(f.Type, "[]"); ok {
+			value := "v"
+			if elem != "string" {
+				value = "string(v)"
+			}
+			fmt.Fprintf(b, "\tfor _, v := range params.%s {\n\t\tquery.Add(%q, %s)\n\t}\n", f.Name, f.JSON, value)
+			continue
+		}
+		fmt.Fprintf(b, "\tif params.%s != nil {\n\t\tquery.Set(%q, fmt.Sprint(*params.%s))\n\t}\n", f.Name, f.JSON, f.Name)
+	}
+	switch {
+	case m.Multipart:
+		fmt.Fprintf(b, "\treq, err := s.client.newMultipartRequest(ctx, %q, path, query, params.FormData)\n", m.Endpoint.Method)
+	case m.Body != nil:
+		fmt.Fprintf(b, "\treq, err := s.client.newRequest(ctx, %q, path, query, params.Body)\n", m.Endpoint.Method)
+	default:
+		fmt.Fprintf(b, "\treq, err := s.client.newRequest(ctx, %q, path, query, nil)\n", m.Endpoint.Method)
+	}
+	b.WriteString("\tif err != nil {\n\t\treturn nil, err\n\t}\n")
+	switch {
+	case m.Returns == "json.RawMessage":
+		b.WriteString("\tvar out json.RawMessage\n\tif err := s.client.doJSON(req, &out); err != nil {\n\t\treturn nil, err\n\t}\n")
+	case strings.HasPrefix(m.Returns, "[]"):
+		fmt.Fprintf(b, "\tout := make(%s, 0)\n\tif err := s.client.doJSON(req, &out); err != nil {\n\t\treturn nil, err\n\t}\n", m.Returns)
+	default:
+		fmt.Fprintf(b, "\tout := &%s{}\n\tif err := s.client.doJSON(req, out); err != nil {\n\t\treturn nil, err\n\t}\n", strings.TrimPrefix(m.Returns, "*"))
+	}
+	b.WriteString("\treturn out, nil\n}\n")
+}
+
+func renderIterators(a *api, _ map[string]bool) string {
+	var b bytes.Buffer
+	b.WriteString(generatedHeader + "// Vanta service pagination iterators.\n\npackage v1\n\nimport (\n\t\"context\"\n\t\"iter\"\n)\n")
+	for _, svc := range a.Services {
+		for _, m := range svc.Methods {
+			name, item, ok := m.iterator()
+			if !ok {
+				continue
+			}
+			params := m.paramsType()
+			fmt.Fprintf(&b, "\n// %s iterates every item returned by %s, following page cursors.\n", name, m.Name)
+			fmt.Fprintf(&b, "func (s *%sService) %s(ctx context.Context, params *%s) iter.Seq2[%s, error] {\n", svc.Name, name, params, item)
+			fmt.Fprintf(&b, "\tbase := %s{}\n\tif params != nil {\n\t\tbase = *params\n\t}\n", params)
+			fmt.Fprintf(&b, "\treturn allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[%s], error) {\n", item)
+			fmt.Fprintf(&b, "\t\tp := base\n\t\tp.PageCursor = cursor\n\t\treturn s.%s(ctx, &p)\n\t})\n}\n", m.Name)
+		}
+	}
+	return b.String()
+}
+
+func renderOperations(a *api, _ map[string]bool) string {
+	var b bytes.Buffer
+	b.WriteString(generatedHeader + "// Vanta operation catalog.\n\npackage v1\n\n")
+	b.WriteString("// operations is the catalog returned by Operations, one entry per generated\n// method.\nvar operations = []Operation{\n")
+	for _, svc := range a.Services {
+		for _, m := range svc.Methods {
+			renderOperation(&b, m)
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func renderOperation(b *bytes.Buffer, m *method) {
+	b.WriteString("\t{\n")
+	fmt.Fprintf(b, "\t\tID: %q,\n\t\tService: %q,\n\t\tMethod: %q,\n", m.Service+"."+m.Name, m.Service, m.Name)
+	fmt.Fprintf(b, "\t\tHTTPMethod: %q,\n\t\tPath: %q,\n", m.Endpoint.Method, m.Endpoint.Path)
+	for _, kind := range []fieldKind{pathField, queryField} {
+		var params []*field
+		for _, f := range m.Params {
+			if f.Kind == kind {
+				params = append(params, f)
+			}
+		}
+		if len(params) == 0 {
+			continue
+		}
+		name := "PathParams"
+		if kind == queryField {
+			name = "QueryParams"
+		}
+		fmt.Fprintf(b, "\t\t%s: []OperationParam{\n", name)
+		for _, f := range params {
+			required := ""
+			if f.Kind == pathField || f.Required {
+				required = ", Required: true"
+			}
+			fmt.Fprintf(b, "\t\t\t{Name: %q, Field: %q, Type: %q%s},\n", f.JSON, f.Name, f.Type, required)
+		}
+		b.WriteString("\t\t},\n")
+	}
+	if m.Body != nil {
+		fmt.Fprintf(b, "\t\tBodyType: %q,\n", "*"+m.Body.Name)
+	}
+	if m.Multipart {
+		b.WriteString("\t\tMultipart: true,\n")
+	}
+	if _, ok := m.listItem(); ok {
+		b.WriteString("\t\tPaginated: true,\n")
+	}
+	readOnly := m.Endpoint.Method == "GET"
+	if readOnly {
+		b.WriteString("\t\tReadOnly: true,\n")
+	}
+	switch {
+	case m.Unauthenticated:
+	case readOnly:
+		b.WriteString("\t\tScopes: []string{ScopeAllRead},\n")
+	default:
+		b.WriteString("\t\tScopes: []string{ScopeAllWrite},\n")
+	}
+	fmt.Fprintf(b, "\t\tDoc: %q,\n", m.Doc)
+	fmt.Fprintf(b, "\t\tfn: bind(func(s *Services) %sAPI { return s.%s }, %sAPI.%s),\n", m.Service, m.Service, m.Service, m.Name)
+	b.WriteString("\t},\n")
+}
+
+// iteratorName turns ListControls into AllControls.
+func iteratorName(method string) string {
+	for _, prefix := range []string{"ListOf", "List", "Get"} {
+		if rest, ok := strings.CutPrefix(method, prefix); ok {
+			return "All" + rest
+		}
+	}
+	return "All" + method
+}
+
+var timestampExample = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}`)
+
+var requiresDescription = regexp.MustCompile(`^Requires (\w+)\.`)
+
+// possibleValues matches a description that lists every value of an enum.
+var possibleValues = regexp.MustCompile(`(?m)^Possible values: `)
+
+func renderValidation(a *api, enums map[string]bool) string {
+	var b bytes.Buffer
+	b.WriteString(generatedHeader + "// Vanta request validation.\n\npackage v1\n")
+	seen := map[string]bool{}
+	for _, svc := range a.Services {
+		for _, m := range svc.Methods {
+			if seen[m.paramsType()] {
+				continue
+			}
+			seen[m.paramsType()] = true
+			renderParamsValidation(&b, m, enums)
+			if m.Body != nil && !seen[m.Body.Name] {
+				seen[m.Body.Name] = true
+				renderBodyValidation(&b, m, enums)
+			}
+		}
+	}
+	return b.String()
+}
+
+func renderParamsValidation(b *bytes.Buffer, m *method, enums map[string]bool) {
+	params := m.paramsType()
+	var lines []string
+	for _, f := range m.Params {
+		if f.Kind != pathField {
+			continue
+		}
+		value := "p." + f.Name
+		if f.Type != "string" {
+			value = "string(" + value + ")"
+		}
+		lines = append(lines, fmt.Sprintf("v.required(%q, %s)", f.JSON, value))
+	}
+	for _, f := range m.Params {
+		switch f.Kind {
+		case queryField:
+			switch {
+			case f.Name == "PageSize":
+				lines = append(lines, "v.pageSize(p.PageSize)")
+			case isEnum(f.Type, enums):
+				lines = append(lines, enumCheck(f, "p"))
+			case f.Type == "*string" && isDateTime(f):
+				lines = append(lines, fmt.Sprintf("v.dateTimePtr(%q, p.%s)", f.JSON, f.Name))
+			case len(f.Enum) > 0 && checksOneOf(f):
+				lines = append(lines, oneOf(f, "p"))
+			}
+			if f.Required {
+				lines = append(lines, fmt.Sprintf("if !(%s) {\n\t\tv.add(%q, \"is required\")\n\t}", isSet(f), f.JSON))
+			}
+			if match := requiresDescription.FindStringSubmatch(f.Desc); match != nil {
+				for _, other := range m.Params {
+					if other.Kind == queryField && other.JSON == match[1] {
+						lines = append(lines, fmt.Sprintf("v.requires(%q, %s, %q, %s)", f.JSON, isSet(f), other.JSON, isSet(other)))
+						break
+					}
+				}
+			}
+		case bodyField:
+			if len(requiredBodyFields[m.Body.Name]) > 0 || slices.ContainsFunc(m.Body.Fields, func(f *field) bool { return f.Required }) {
+				lines = append(lines, "if p.Body == nil {\n\t\tv.add(\"body\", \"is required\")\n\t} else {\n\t\tv.nested(\"body\", p.Body.Validate())\n\t}")
+			} else {
+				lines = append(lines, "if p.Body != nil {\n\t\tv.nested(\"body\", p.Body.Validate())\n\t}")
+			}
+		}
+	}
+	fmt.Fprintf(b, "\n// Validate checks params before %sService.%s sends a request.\n", m.Service, m.Name)
+	fmt.Fprintf(b, "func (p *%s) Validate() error {\n", params)
+	if len(lines) == 0 {
+		b.WriteString("\treturn nil\n}\n")
+		return
+	}
+	fmt.Fprintf(b, "\tif p == nil {\n\t\tp = &%s{}\n\t}\n\tvar v validator\n", params)
+	for _, line := range lines {
+		fmt.Fprintf(b, "\t%s\n", line)
+	}
+	b.WriteString("\treturn v.err()\n}\n")
+}
+
+func renderBodyValidation(b *bytes.Buffer, m *method, enums map[string]bool) {
+	st := m.Body
+	var lines []string
+	if !st.Handwritten {
+		required := requiredBodyFields[st.Name]
+		for _, f := range st.Fields {
+			if f.Type == "string" && (f.Required || slices.Contains(required, f.JSON)) {
+				lines = append(lines, fmt.Sprintf("v.required(%q, b.%s)", f.JSON, f.Name))
+			} else if f.Required && strings.HasPrefix(f.Type, "*") {
+				lines = append(lines, fmt.Sprintf("if b.%s == nil {\n\t\tv.add(%q, \"is required\")\n\t}", f.Name, f.JSON))
+			}
+			switch {
+			case isEnum(f.Type, enums):
+				lines = append(lines, enumCheck(f, "b"))
+			case f.Type == "string" && isDateTime(f):
+				lines = append(lines, fmt.Sprintf("v.dateTime(%q, b.%s)", f.JSON, f.Name))
+			case f.Type == "*string" && isDateTime(f):
+				lines = append(lines, fmt.Sprintf("v.dateTimePtr(%q, b.%s)", f.JSON, f.Name))
+			case len(f.Enum) > 0 && checksOneOf(f):
+				lines = append(lines, oneOf(f, "b"))
+			}
+		}
+	}
+	b.WriteString("\n// Validate checks the request body fields that can be verified client-side.\n")
+	fmt.Fprintf(b, "func (b *%s) Validate() error {\n", st.Name)
+	if len(lines) == 0 {
+		b.WriteString("\treturn nil\n}\n")
+		return
+	}
+	b.WriteString("\tif b == nil {\n\t\treturn nil\n\t}\n\tvar v validator\n")
+	for _, line := range lines {
+		fmt.Fprintf(b, "\t%s\n", line)
+	}
+	b.WriteString("\treturn v.err()\n}\n")
+}
+
+func isSet(f *field) string {
+	if strings.HasPrefix(f.Type, "[]") {
+		return fmt.Sprintf("len(p.%s) > 0", f.Name)
+	}
+	return fmt.Sprintf("p.%s != nil", f.Name)
+}
+
+// isDateTime reports whether f holds an RFC 3339 timestamp, going by its
+// schema format or, without a schema, its example value.
+func isDateTime(f *field) bool {
+	return f.Format == "date-time" || timestampExample.MatchString(f.Example)
+}
+
+// checksOneOf reports whether oneOf can render a check for f's type.
+func checksOneOf(f *field) bool {
+	return slices.Contains([]string{"string", "*string", "[]string"}, f.Type)
+}
+
+// oneOf renders the check for an enum that has no named Go type.
+func oneOf(f *field, recv string) string {
+	allowed := make([]string, len(f.Enum))
+	for i, v := range f.Enum {
+		allowed[i] = strconv.Quote(v)
+	}
+	args := strings.Join(allowed, ", ")
+	value := recv + "." + f.Name
+	switch f.Type {
+	case "string":
+		return fmt.Sprintf("v.oneOf(%q, %s, %s)", f.JSON, value, args)
+	case "*string":
+		return fmt.Sprintf("if %s != nil {\n\t\tv.oneOf(%q, *%s, %s)\n\t}", value, f.JSON, value, args)
+	default:
+		return fmt.Sprintf("for _, s := range %s {\n\t\tv.oneOf(%q, s, %s)\n\t}", value, f.JSON, args)
+	}
+}
+
+// enumCheck renders the check for a field of a named enum type. Values are
+// only rejected when the source documents all of them, through a schema enum
+// or a "Possible values:" line in the description; otherwise the enum was
+// built from examples, so unknown values are sent with a warning.
+func enumCheck(f *field, recv string) string {
+	suffix := ""
+	switch {
+	case strings.HasPrefix(f.Type, "[]"):
+		suffix = "s"
+	case strings.HasPrefix(f.Type, "*"):
+		suffix = "Ptr"
+	}
+	if len(f.Enum) > 0 || possibleValues.MatchString(f.Desc) {
+		return fmt.Sprintf("validateEnum%s(&v, %q, %s.%s)", suffix, f.JSON, recv, f.Name)
+	}
+	return fmt.Sprintf("warnEnum%s(%s.%s)", suffix, recv, f.Name)
+}
+
+func isEnum(typ string, enums map[string]bool) bool {
+	return enums[strings.TrimLeft(typ, "*[]")]
+}
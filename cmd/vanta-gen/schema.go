@@ -0,0 +1,214 @@
+package main
+
+import (
+	"maps"
+	"slices"
+	"strings"
+)
+
+// typer maps schemas to Go types. Component schemas become named structs
+// shared by every method; inline objects become structs named after the
+// field that holds them.
+type typer struct {
+	known      map[string]bool
+	models     map[string][]string
+	components map[string]*structType
+}
+
+// goType returns the Go type for s. name is used for an inline object, whose
+// struct is appended to types.
+func (t *typer) goType(s *schema, name string, types *[]*structType) string {
+	if s == nil {
+		return "any"
+	}
+	if len(s.AllOf) == 1 && len(s.Properties) == 0 {
+		// allOf with a single member is how OpenAPI 3.0 attaches nullable
+		// or a description to a $ref.
+		return t.goType(s.AllOf[0], name, types)
+	}
+	if target := s.resolved(); target != s && target.Name != "" {
+		if typ, ok := t.component(target); ok {
+			return typ
+		}
+	}
+	s = s.flatten()
+	switch s.kind() {
+	case "string":
+		return "string"
+	case "integer":
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "array":
+		return "[]" + t.goType(s.Items, name+"Item", types)
+	case "object":
+		if len(s.Properties) > 0 {
+			*types = append(*types, t.structOf(name, s, types))
+			return name
+		}
+		if s.AdditionalProperties != nil {
+			return "map[string]" + t.goType(s.AdditionalProperties, name+"Value", types)
+		}
+		return "map[string]any"
+	}
+	return "any"
+}
+
+// component returns the type name for a named schema. Types the package
+// already declares are reused; other object schemas are generated once.
+// Non-object components are typed structurally.
+func (t *typer) component(s *schema) (string, bool) {
+	name := goName(s.Name)
+	if t.known[name] {
+		return name, true
+	}
+	if _, ok := t.components[name]; ok {
+		return name, true
+	}
+	flat := s.flatten()
+	if flat.kind() != "object" || len(flat.Properties) == 0 {
+		return "", false
+	}
+	st := &structType{Name: name, Doc: typeDoc(name, flat.Description)}
+	t.components[name] = st
+	var nested []*structType
+	st.Fields = t.structOf(name, flat, &nested).Fields
+	for _, n := range nested {
+		t.components[n.Name] = n
+	}
+	return name, true
+}
+
+// structOf builds a struct from an object schema.
+func (t *typer) structOf(name string, s *schema, types *[]*structType) *structType {
+	s = s.flatten()
+	st := &structType{Name: name}
+	for _, key := range slices.Sorted(maps.Keys(s.Properties)) {
+		prop := s.Properties[key]
+		f := &field{Name: goName(key), JSON: key, Kind: jsonField, Required: slices.Contains(s.Required, key)}
+		f.Type = t.goType(prop, name+f.Name, types)
+		flat := prop.flatten()
+		isStruct := flat.kind() == "object" && len(flat.Properties) > 0
+		if (prop.nullable() || isStruct && !f.Required) && !strings.HasPrefix(f.Type, "[]") && !strings.HasPrefix(f.Type, "map[") && f.Type != "any" {
+			f.Type = "*" + f.Type
+		}
+		f.OmitEmpty = !f.Required
+		describe(f, prop)
+		st.Fields = append(st.Fields, f)
+	}
+	return st
+}
+
+// response returns the return type for a response schema, and the response
+// struct when one is generated for the method.
+func (t *typer) response(prefix string, s *schema, fieldTypes map[string]string, types *[]*structType) (string, *structType) {
+	if target := s.resolved(); target != s && target.Name != "" {
+		if typ, ok := t.component(target); ok {
+			return "*" + typ, nil
+		}
+	}
+	flat := s.flatten()
+	if item, ok := pageItem(flat); ok {
+		return "*ResultsPage[" + t.goType(item, prefix+"Item", types) + "]", nil
+	}
+	switch flat.kind() {
+	case "object":
+		if len(flat.Properties) == 0 {
+			return "json.RawMessage", nil
+		}
+		st := t.structOf(prefix+"Response", flat, types)
+		for _, f := range st.Fields {
+			if typ, ok := fieldTypes["Response."+f.Name]; ok {
+				f.Type = typ
+			}
+		}
+		return "*" + st.Name, st
+	case "array":
+		return "[]" + t.goType(flat.Items, prefix+"Item", types), nil
+	}
+	return "json.RawMessage", nil
+}
+
+func (t *typer) sortedComponents() []*structType {
+	var out []*structType
+	for _, name := range slices.Sorted(maps.Keys(t.components)) {
+		out = append(out, t.components[name])
+	}
+	return out
+}
+
+// pageItem returns the item schema of Vanta's
+// {"results": {"data": [...], "pageInfo": {...}}} envelope.
+func pageItem(s *schema) (*schema, bool) {
+	results := s.Properties["results"].flatten()
+	if results == nil {
+		return nil, false
+	}
+	data := results.Properties["data"].flatten()
+	if data == nil || data.kind() != "array" || results.Properties["pageInfo"] == nil {
+		return nil, false
+	}
+	return data.Items, true
+}
+
+// applyQuerySchema types a query param from its schema.
+func applyQuerySchema(f *field, s *schema) {
+	flat := s.flatten()
+	switch flat.kind() {
+	case "integer":
+		f.Type = "*int"
+	case "number":
+		f.Type = "*float64"
+	case "boolean":
+		f.Type = "*bool"
+	case "array":
+		f.Type = "[]string"
+	case "string":
+		f.Type = "*string"
+	}
+	describe(f, s)
+}
+
+// describe copies the description, format, enum values and example of s onto
+// f. For arrays they come from the item schema.
+func describe(f *field, s *schema) {
+	flat := s.flatten()
+	if f.Desc == "" {
+		f.Desc = collapse(s.Description)
+	}
+	if f.Desc == "" {
+		f.Desc = collapse(flat.Description)
+	}
+	if flat.kind() == "array" && flat.Items != nil {
+		flat = flat.Items.flatten()
+	}
+	f.Format = flat.Format
+	f.Enum = flat.enumValues()
+	if f.Example == "" {
+		if ex, ok := flat.Example.(string); ok {
+			f.Example = ex
+		}
+	}
+}
+
+// typeDoc turns a schema description into a doc comment sentence about name.
+// Descriptions starting with an article read as "Name is a ..."; others are
+// kept as they are after a generic first sentence.
+func typeDoc(name, desc string) string {
+	desc = collapse(desc)
+	if desc == "" {
+		return ""
+	}
+	for _, article := range []string{"A ", "An ", "The "} {
+		if rest, ok := strings.CutPrefix(desc, article); ok {
+			return "is " + strings.ToLower(article) + rest
+		}
+	}
+	return "is the " + name + " schema. " + desc
+}
+
+func collapse(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
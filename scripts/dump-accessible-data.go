@@ -9,13 +9,13 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"regexp"
 	"sort"
 	"strings"
 	"time"
 
 	vanta "github.com/richardoc/vanta-sdk-go/v1"
+	"github.com/richardoc/vanta-sdk-go/v1/vantarecord"
 )
 
 type callResult struct {
@@ -81,7 +81,17 @@ func main() {
 		log.Fatalf("create output dir: %v", err)
 	}
 
-	client, recorder, err := newVantaClient(ctx)
+	var transport http.RoundTripper = http.DefaultTransport
+	var cassette *vantarecord.Recorder
+	if path := strings.TrimSpace(os.Getenv("VANTA_CASSETTE")); path != "" {
+		var err error
+		if cassette, err = vantarecord.New(path, vantarecord.ModeRecord); err != nil {
+			log.Fatalf("create cassette recorder: %v", err)
+		}
+		transport = cassette
+	}
+
+	client, recorder, err := newVantaClient(ctx, transport)
 	if err != nil {
 		log.Fatalf("create client: %v", err)
 	}
@@ -90,6 +100,11 @@ func main() {
 	if err != nil {
 		log.Fatalf("dump endpoints: %v", err)
 	}
+	if cassette != nil {
+		if err := cassette.Save(); err != nil {
+			log.Fatalf("save cassette: %v", err)
+		}
+	}
 
 	sort.Slice(results, func(i, j int) bool {
 		if results[i].Service == results[j].Service {
@@ -116,13 +131,14 @@ func main() {
 	fmt.Printf("Output directory: %s\n", outDir)
 }
 
-func newVantaClient(ctx context.Context) (*vanta.Client, *responseRecorder, error) {
+func newVantaClient(ctx context.Context, transport http.RoundTripper) (*vanta.Client, *responseRecorder, error) {
 	opts := make([]vanta.Option, 0, 2)
-	recorder := newResponseRecorder(http.DefaultTransport)
-	opts = append(opts, vanta.WithHTTPClient(&http.Client{
+	recorder := newResponseRecorder(transport)
+	httpClient := &http.Client{
 		Timeout:   30 * time.Second,
 		Transport: recorder,
-	}))
+	}
+	opts = append(opts, vanta.WithHTTPClient(httpClient))
 	if baseURL := strings.TrimSpace(os.Getenv("VANTA_BASE_URL")); baseURL != "" {
 		opts = append(opts, vanta.WithBaseURL(baseURL))
 	}
@@ -148,6 +164,7 @@ func newVantaClient(ctx context.Context) (*vanta.Client, *responseRecorder, erro
 		ClientID:     clientID,
 		ClientSecret: clientSecret,
 		Scope:        scope,
+		HTTPClient:   httpClient,
 	})
 	if err != nil {
 		return nil, nil, err
@@ -159,114 +176,43 @@ func newVantaClient(ctx context.Context) (*vanta.Client, *responseRecorder, erro
 }
 
 func dumpAllCallableEndpoints(ctx context.Context, client *vanta.Client, recorder *responseRecorder, outDir string) ([]callResult, error) {
-	servicesVal := reflect.ValueOf(client.Services).Elem()
-	servicesType := servicesVal.Type()
-	results := make([]callResult, 0, 256)
-
-	for i := 0; i < servicesVal.NumField(); i++ {
-		serviceField := servicesType.Field(i)
-		serviceName := serviceField.Name
-		serviceValue := servicesVal.Field(i)
-		if serviceValue.IsNil() {
-			continue
-		}
-
-		serviceMethods := listCallableMethods(serviceValue)
-		for _, methodName := range serviceMethods {
-			res := callServiceMethod(ctx, serviceName, serviceValue, methodName, recorder, outDir)
-			results = append(results, res)
-		}
-	}
-
-	return results, nil
-}
-
-func listCallableMethods(serviceValue reflect.Value) []string {
-	serviceType := serviceValue.Type()
 	includeMutations := strings.EqualFold(strings.TrimSpace(os.Getenv("VANTA_INCLUDE_MUTATIONS")), "1") ||
 		strings.EqualFold(strings.TrimSpace(os.Getenv("VANTA_INCLUDE_MUTATIONS")), "true")
 
-	methods := make([]string, 0, serviceType.NumMethod())
-	for m := range serviceType.Methods() {
-		if !includeMutations && !isLikelyReadOnlyMethod(m.Name) {
-			continue
-		}
-		// Use the bound method type (receiver omitted) for invocation validation.
-		bound := serviceValue.MethodByName(m.Name)
-		if !bound.IsValid() {
-			continue
-		}
-		mt := bound.Type()
-		if mt.NumIn() != 2 {
-			continue
-		}
-		if mt.In(0) != reflect.TypeFor[context.Context]() {
-			continue
-		}
-		if mt.In(1).Kind() != reflect.Pointer {
-			continue
-		}
-		if mt.NumOut() != 2 {
-			continue
-		}
-		if !mt.Out(1).Implements(reflect.TypeFor[error]()) {
+	results := make([]callResult, 0, 256)
+	for _, op := range vanta.Operations() {
+		if !includeMutations && !op.ReadOnly {
 			continue
 		}
-		methods = append(methods, m.Name)
+		results = append(results, callOperation(ctx, client, op, recorder, outDir))
 	}
-	sort.Strings(methods)
-	return methods
-}
-
-func isLikelyReadOnlyMethod(name string) bool {
-	return strings.HasPrefix(name, "Get") || strings.HasPrefix(name, "List")
+	return results, nil
 }
 
-func callServiceMethod(ctx context.Context, serviceName string, serviceValue reflect.Value, methodName string, recorder *responseRecorder, outDir string) callResult {
-	if strings.HasPrefix(methodName, "List") {
-		return callPaginatedMethod(ctx, serviceName, serviceValue, methodName, recorder, outDir)
+func callOperation(ctx context.Context, client *vanta.Client, op vanta.Operation, recorder *responseRecorder, outDir string) callResult {
+	if op.Paginated {
+		return callPaginatedOperation(ctx, client, op, recorder, outDir)
 	}
 
-	result := callResult{Service: serviceName, Method: methodName, Status: "error"}
-	method := serviceValue.MethodByName(methodName)
-	if !method.IsValid() {
-		result.Error = "method not found"
-		return result
-	}
+	result := callResult{Service: op.Service, Method: op.Method, Status: "error"}
 
 	callCtx, cancel := context.WithTimeout(ctx, 45*time.Second)
 	defer cancel()
 
-	var outs []reflect.Value
-	func() {
-		defer func() {
-			if r := recover(); r != nil {
-				result.Error = fmt.Sprintf("panic: %v", r)
-			}
-		}()
-		outs = method.Call([]reflect.Value{reflect.ValueOf(callCtx), reflect.Zero(method.Type().In(1))})
-	}()
-	if result.Error != "" {
-		return result
-	}
-
-	errVal := outs[1]
-	if !errVal.IsNil() {
-		result.Error = errVal.Interface().(error).Error()
+	resp, err := invoke(callCtx, client, op, nil)
+	if err != nil {
+		result.Error = err.Error()
 		return result
 	}
-
-	respVal := outs[0]
-	if !respVal.IsValid() || (respVal.Kind() == reflect.Pointer && respVal.IsNil()) {
+	if resp == nil {
 		result.Status = "ok"
-		result.File = ""
 		return result
 	}
 
-	fileName := sanitize(fmt.Sprintf("%s_%s.json", serviceName, methodName))
+	fileName := sanitize(fmt.Sprintf("%s_%s.json", op.Service, op.Method))
 	filePath := filepath.Join(outDir, fileName)
 
-	if err := writeResponse(filePath, responsePayload(respVal.Interface(), recorder)); err != nil {
+	if err := writeResponse(filePath, responsePayload(resp, recorder)); err != nil {
 		result.Error = fmt.Sprintf("write response: %v", err)
 		return result
 	}
@@ -276,13 +222,8 @@ func callServiceMethod(ctx context.Context, serviceName string, serviceValue ref
 	return result
 }
 
-func callPaginatedMethod(ctx context.Context, serviceName string, serviceValue reflect.Value, methodName string, recorder *responseRecorder, outDir string) callResult {
-	result := callResult{Service: serviceName, Method: methodName, Status: "error"}
-	method := serviceValue.MethodByName(methodName)
-	if !method.IsValid() {
-		result.Error = "method not found"
-		return result
-	}
+func callPaginatedOperation(ctx context.Context, client *vanta.Client, op vanta.Operation, recorder *responseRecorder, outDir string) callResult {
+	result := callResult{Service: op.Service, Method: op.Method, Status: "error"}
 
 	pageSize := 100
 	if raw := strings.TrimSpace(os.Getenv("VANTA_PAGE_SIZE")); raw != "" {
@@ -301,39 +242,28 @@ func callPaginatedMethod(ctx context.Context, serviceName string, serviceValue r
 
 	for {
 		pageNum++
-		params := buildParams(method.Type().In(1), pageSize, cursor)
-
-		callCtx, cancel := context.WithTimeout(ctx, 45*time.Second)
-		var outs []reflect.Value
-		func() {
-			defer func() {
-				if r := recover(); r != nil {
-					result.Error = fmt.Sprintf("panic: %v", r)
-				}
-			}()
-			outs = method.Call([]reflect.Value{reflect.ValueOf(callCtx), params})
-		}()
-		cancel()
-		if result.Error != "" {
+		params, err := buildParams(op, pageSize, cursor)
+		if err != nil {
+			result.Error = err.Error()
 			return result
 		}
 
-		errVal := outs[1]
-		if !errVal.IsNil() {
-			result.Error = errVal.Interface().(error).Error()
+		callCtx, cancel := context.WithTimeout(ctx, 45*time.Second)
+		resp, err := invoke(callCtx, client, op, params)
+		cancel()
+		if err != nil {
+			result.Error = err.Error()
 			return result
 		}
-
-		respVal := outs[0]
-		if !respVal.IsValid() || (respVal.Kind() == reflect.Pointer && respVal.IsNil()) {
+		if resp == nil {
 			result.Status = "ok"
 			result.Pages = pageNum
 			return result
 		}
 
-		fileName := sanitize(fmt.Sprintf("%s_%s_page_%03d.json", serviceName, methodName, pageNum))
+		fileName := sanitize(fmt.Sprintf("%s_%s_page_%03d.json", op.Service, op.Method, pageNum))
 		filePath := filepath.Join(outDir, fileName)
-		payload := responsePayload(respVal.Interface(), recorder)
+		payload := responsePayload(resp, recorder)
 		if err := writeResponse(filePath, payload); err != nil {
 			result.Error = fmt.Sprintf("write response: %v", err)
 			return result
@@ -353,27 +283,32 @@ func callPaginatedMethod(ctx context.Context, serviceName string, serviceValue r
 	}
 }
 
-func buildParams(paramType reflect.Type, pageSize int, cursor string) reflect.Value {
-	params := reflect.New(paramType.Elem())
-	elem := params.Elem()
-
-	if f := elem.FieldByName("PageSize"); f.IsValid() && f.CanSet() {
-		if f.Kind() == reflect.Pointer && f.Type().Elem().Kind() == reflect.Int {
-			v := reflect.New(f.Type().Elem())
-			v.Elem().SetInt(int64(pageSize))
-			f.Set(v)
+// invoke calls op, turning a panic in the call into an error.
+func invoke(ctx context.Context, client *vanta.Client, op vanta.Operation, params any) (resp any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
 		}
-	}
+	}()
+	return client.Invoke(ctx, op, params)
+}
+
+// buildParams fills the paging params of op. Params structs have no JSON
+// tags, so the wire names match their fields case-insensitively.
+func buildParams(op vanta.Operation, pageSize int, cursor string) (any, error) {
+	page := map[string]any{"pageSize": pageSize}
 	if cursor != "" {
-		if f := elem.FieldByName("PageCursor"); f.IsValid() && f.CanSet() {
-			if f.Kind() == reflect.Pointer && f.Type().Elem().Kind() == reflect.String {
-				v := reflect.New(f.Type().Elem())
-				v.Elem().SetString(cursor)
-				f.Set(v)
-			}
-		}
+		page["pageCursor"] = cursor
+	}
+	raw, err := json.Marshal(page)
+	if err != nil {
+		return nil, err
+	}
+	params := op.NewParams()
+	if err := json.Unmarshal(raw, params); err != nil {
+		return nil, fmt.Errorf("build params: %w", err)
 	}
-	return params
+	return params, nil
 }
 
 func extractPageInfo(payload any) (bool, string, bool) {
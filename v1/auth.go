@@ -4,18 +4,30 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 )
 
+// ErrTokenExpired is returned when a token source only has an expired token
+// and cannot refresh it.
+var ErrTokenExpired = errors.New("token expired")
+
 // Token represents an OAuth access token.
+//
+// When the token endpoint omits expires_in or scope, Expiry, Scopes and
+// Subject are filled from the claims of JWT-shaped access tokens. Those claims
+// are decoded without signature verification.
 type Token struct {
 	AccessToken string
 	TokenType   string
 	Expiry      time.Time
+	Scopes      []string
+	Subject     string
 }
 
 // Valid reports whether the token appears usable for requests.
@@ -34,15 +46,26 @@ type TokenSource interface {
 	Token(ctx context.Context) (Token, error)
 }
 
+// TokenRevoker is implemented by token sources that can discard, and where
+// supported revoke, their cached token. Client.Close uses it on shutdown.
+type TokenRevoker interface {
+	Revoke(ctx context.Context) error
+}
+
 // StaticTokenSource returns a fixed token.
 type StaticTokenSource string
 
-// Token returns the static token value.
+// Token returns the static token value. JWT-shaped tokens report their expiry
+// and scopes from the token claims, and an error once the exp claim has passed.
 func (s StaticTokenSource) Token(context.Context) (Token, error) {
 	if s == "" {
 		return Token{}, fmt.Errorf("static token is empty")
 	}
-	return Token{AccessToken: string(s), TokenType: "Bearer"}, nil
+	tok := Token{AccessToken: string(s), TokenType: "Bearer"}.withJWTClaims()
+	if !tok.Valid() {
+		return Token{}, fmt.Errorf("static token expired at %s: %w", tok.Expiry.UTC().Format(time.RFC3339), ErrTokenExpired)
+	}
+	return tok, nil
 }
 
 // OAuthClientCredentialsConfig configures Vanta OAuth client credentials flow.
@@ -53,6 +76,10 @@ type OAuthClientCredentialsConfig struct {
 	AuthURL      string
 	HTTPClient   *http.Client
 	RefreshSkew  time.Duration
+	// RevokeURL is called by Revoke to invalidate the cached token server-side.
+	// Vanta's public API does not document a revocation endpoint, so it is
+	// empty by default and Revoke only clears the local cache.
+	RevokeURL string
 }
 
 // OAuthClientCredentialsTokenSource fetches and caches OAuth tokens.
@@ -66,6 +93,7 @@ type OAuthClientCredentialsTokenSource struct {
 	clientSecret string
 	scope        string
 	refreshSkew  time.Duration
+	revokeURL    string
 
 	mu    sync.Mutex
 	token Token
@@ -97,6 +125,7 @@ func NewOAuthClientCredentialsTokenSource(cfg OAuthClientCredentialsConfig) (*OA
 		clientSecret: cfg.ClientSecret,
 		scope:        cfg.Scope,
 		refreshSkew:  cfg.RefreshSkew,
+		revokeURL:    cfg.RevokeURL,
 	}, nil
 }
 
@@ -120,62 +149,169 @@ func (s *OAuthClientCredentialsTokenSource) Token(ctx context.Context) (Token, e
 	return tok, nil
 }
 
-type tokenResponse struct {
-	AccessToken string `json:"access_token"`
-	TokenType   string `json:"token_type"`
-	ExpiresIn   int64  `json:"expires_in"`
+// Revoke clears the cached token and, when RevokeURL is configured, asks the
+// server to invalidate it. It is safe to call concurrently and more than once,
+// for example from a signal handler goroutine; later calls are no-ops until a
+// new token is fetched.
+func (s *OAuthClientCredentialsTokenSource) Revoke(ctx context.Context) error {
+	s.mu.Lock()
+	tok := s.token
+	s.token = Token{}
+	s.mu.Unlock()
+
+	if tok.AccessToken == "" || s.revokeURL == "" {
+		return nil
+	}
+	return s.revokeToken(ctx, tok)
 }
 
-func (s *OAuthClientCredentialsTokenSource) fetchToken(ctx context.Context) (Token, error) {
-	payload := map[string]string{
+func (s *OAuthClientCredentialsTokenSource) revokeToken(ctx context.Context, tok Token) error {
+	body, err := json.Marshal(map[string]string{
 		"client_id":     s.clientID,
 		"client_secret": s.clientSecret,
-		"grant_type":    "client_credentials",
-	}
-	if s.scope != "" {
-		payload["scope"] = s.scope
-	}
-
-	body, err := json.Marshal(payload)
+		"token":         tok.AccessToken,
+	})
 	if err != nil {
-		return Token{}, fmt.Errorf("marshal oauth payload: %w", err)
+		return fmt.Errorf("marshal oauth revoke payload: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.authURL, bytes.NewReader(body))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.revokeURL, bytes.NewReader(body))
 	if err != nil {
-		return Token{}, fmt.Errorf("build oauth request: %w", err)
+		return fmt.Errorf("build oauth revoke request: %w", err)
 	}
 	req.Header.Set("Content-Type", contentTypeJSON)
 	req.Header.Set("Accept", contentTypeJSON)
 
 	resp, err := s.httpClient.Do(req)
 	if err != nil {
-		return Token{}, fmt.Errorf("oauth request failed: %w", err)
+		return fmt.Errorf("oauth revoke request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode >= 400 {
-		return Token{}, decodeAPIError(resp)
+		return decodeAPIError(resp)
 	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
 
-	var tr tokenResponse
-	body, readErr := io.ReadAll(resp.Body)
-	if readErr != nil {
-		return Token{}, fmt.Errorf("read oauth token response: %w", readErr)
+// OAuthCreateTokenRequestBody is the client credentials request sent to the
+// OAuth token endpoint.
+type OAuthCreateTokenRequestBody struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	GrantType    string `json:"grant_type"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+// OAuthTokenResponse is the typed response of the OAuth token endpoint.
+type OAuthTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Scope       string `json:"scope"`
+}
+
+// Token converts the response into a Token, resolving expires_in against the
+// current time and falling back to JWT claims for missing fields.
+func (r *OAuthTokenResponse) Token() Token {
+	if r == nil {
+		return Token{}
 	}
-	if err := decodeJSONBytes(body, &tr); err != nil {
-		return Token{}, fmt.Errorf("decode oauth token response: %w", err)
+	tok := Token{AccessToken: r.AccessToken, TokenType: r.TokenType, Scopes: strings.Fields(r.Scope)}
+	if r.ExpiresIn > 0 {
+		tok.Expiry = time.Now().Add(time.Duration(r.ExpiresIn) * time.Second)
 	}
-	if tr.AccessToken == "" {
-		return Token{}, fmt.Errorf("oauth token response missing access_token")
+	if tok.TokenType == "" {
+		tok.TokenType = "Bearer"
 	}
+	return tok.withJWTClaims()
+}
 
-	tok := Token{AccessToken: tr.AccessToken, TokenType: tr.TokenType}
-	if tr.ExpiresIn > 0 {
-		tok.Expiry = time.Now().Add(time.Duration(tr.ExpiresIn) * time.Second)
+func (s *OAuthClientCredentialsTokenSource) fetchToken(ctx context.Context) (Token, error) {
+	tr, err := exchangeToken(ctx, s.httpClient, s.authURL, defaultUserAgent, &OAuthCreateTokenRequestBody{
+		ClientID:     s.clientID,
+		ClientSecret: s.clientSecret,
+		Scope:        s.scope,
+	})
+	if err != nil {
+		return Token{}, err
 	}
-	if tok.TokenType == "" {
-		tok.TokenType = "Bearer"
+	return tr.Token(), nil
+}
+
+// exchangeToken performs the OAuth token request for
+// OAuthClientCredentialsTokenSource, which has no Client to send it through.
+func exchangeToken(ctx context.Context, httpClient *http.Client, authURL, userAgent string, body *OAuthCreateTokenRequestBody) (*OAuthTokenResponse, error) {
+	req, err := newTokenRequest(ctx, authURL, userAgent, body)
+	if err != nil {
+		return nil, err
 	}
-	return tok, nil
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("oauth request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, decodeAPIError(resp)
+	}
+	return readTokenResponse(resp)
+}
+
+// createToken performs the OAuth token request for OAuthService.CreateToken,
+// through the client's HTTP client and request logging but without a bearer
+// token.
+func (c *Client) createToken(ctx context.Context, body *OAuthCreateTokenRequestBody) (*OAuthTokenResponse, error) {
+	req, err := newTokenRequest(c.tagContext(ctx), c.authURL, c.userAgent, body)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return readTokenResponse(resp)
+}
+
+func newTokenRequest(ctx context.Context, authURL, userAgent string, body *OAuthCreateTokenRequestBody) (*http.Request, error) {
+	if body == nil {
+		body = &OAuthCreateTokenRequestBody{}
+	}
+	payload := *body
+	if payload.GrantType == "" {
+		payload.GrantType = "client_credentials"
+	}
+
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshal oauth payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, authURL, bytes.NewReader(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("build oauth request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentTypeJSON)
+	req.Header.Set("Accept", contentTypeJSON)
+	if userAgent != "" {
+		req.Header.Set("User-Agent", userAgent)
+	}
+	return req, nil
+}
+
+func readTokenResponse(resp *http.Response) (*OAuthTokenResponse, error) {
+	tr := &OAuthTokenResponse{}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read oauth token response: %w", err)
+	}
+	if err := decodeJSONBytes(respBody, tr); err != nil {
+		return nil, fmt.Errorf("decode oauth token response: %w", err)
+	}
+	if tr.AccessToken == "" {
+		return nil, fmt.Errorf("oauth token response missing access_token")
+	}
+	return tr, nil
 }
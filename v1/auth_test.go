@@ -2,7 +2,9 @@ package v1
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -123,11 +125,308 @@ func TestOAuthClientCredentialsTokenSourceWarnsOnUnknownFields(t *testing.T) {
 	}
 
 	joined := strings.Join(warnings, "\n")
-	if !strings.Contains(joined, "tokenResponse.new_field") {
-		t.Fatalf("warnings %q do not contain tokenResponse.new_field", joined)
+	if !strings.Contains(joined, "OAuthTokenResponse.new_field") {
+		t.Fatalf("warnings %q do not contain OAuthTokenResponse.new_field", joined)
 	}
 }
 
+func TestStaticTokenSourceReadsJWTClaims(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+	raw := testJWT(t, map[string]any{
+		"exp":   exp.Unix(),
+		"sub":   "app-123",
+		"scope": "vanta-api.all:read vanta-api.all:write",
+	})
+
+	tok, err := StaticTokenSource(raw).Token(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !tok.Expiry.Equal(exp) {
+		t.Fatalf("expiry = %s, want %s", tok.Expiry, exp)
+	}
+	if tok.Subject != "app-123" {
+		t.Fatalf("subject = %q, want app-123", tok.Subject)
+	}
+	if len(tok.Scopes) != 2 || tok.Scopes[1] != "vanta-api.all:write" {
+		t.Fatalf("scopes = %v", tok.Scopes)
+	}
+}
+
+func TestStaticTokenSourceExpiredJWT(t *testing.T) {
+	raw := testJWT(t, map[string]any{"exp": time.Now().Add(-time.Minute).Unix()})
+
+	_, err := StaticTokenSource(raw).Token(context.Background())
+	if !errors.Is(err, ErrTokenExpired) {
+		t.Fatalf("expected ErrTokenExpired, got %v", err)
+	}
+}
+
+func TestOAuthClientCredentialsTokenSourceUsesJWTExpiryWithoutExpiresIn(t *testing.T) {
+	exp := time.Now().Add(30 * time.Minute).Truncate(time.Second)
+	raw := testJWT(t, map[string]any{"exp": exp.Unix(), "scp": []string{"vanta-api.all:read"}})
+	mockClient := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			payload, _ := json.Marshal(map[string]any{"access_token": raw, "token_type": "Bearer"})
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(string(payload))),
+			}, nil
+		}),
+	}
+
+	src, err := NewOAuthClientCredentialsTokenSource(OAuthClientCredentialsConfig{
+		ClientID:     "id",
+		ClientSecret: "secret",
+		HTTPClient:   mockClient,
+	})
+	if err != nil {
+		t.Fatalf("unexpected constructor error: %v", err)
+	}
+
+	tok, err := src.Token(context.Background())
+	if err != nil {
+		t.Fatalf("token error: %v", err)
+	}
+	if !tok.Expiry.Equal(exp) {
+		t.Fatalf("expiry = %s, want %s", tok.Expiry, exp)
+	}
+	if len(tok.Scopes) != 1 || tok.Scopes[0] != "vanta-api.all:read" {
+		t.Fatalf("scopes = %v", tok.Scopes)
+	}
+}
+
+func TestParseJWTClaimsRejectsOpaqueTokens(t *testing.T) {
+	for _, raw := range []string{"opaque-token", "a.b", "a.!!!.c", "a." + base64.RawURLEncoding.EncodeToString([]byte("not-json")) + ".c"} {
+		if _, ok := parseJWTClaims(raw); ok {
+			t.Fatalf("parseJWTClaims(%q) reported a JWT", raw)
+		}
+	}
+}
+
+func TestOAuthClientCredentialsTokenSourceRevoke(t *testing.T) {
+	var tokenCalls, revokeCalls int32
+	mockClient := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			body := `{"access_token":"token-1","token_type":"Bearer","expires_in":3600}`
+			if r.URL.Path == "/oauth/token/revoke" {
+				atomic.AddInt32(&revokeCalls, 1)
+				var payload map[string]string
+				_ = json.NewDecoder(r.Body).Decode(&payload)
+				if payload["token"] != "token-1" {
+					t.Fatalf("revoked token = %q, want token-1", payload["token"])
+				}
+				body = `{}`
+			} else {
+				atomic.AddInt32(&tokenCalls, 1)
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}, nil
+		}),
+	}
+
+	src, err := NewOAuthClientCredentialsTokenSource(OAuthClientCredentialsConfig{
+		ClientID:     "id",
+		ClientSecret: "secret",
+		AuthURL:      "https://api.vanta.com/oauth/token",
+		RevokeURL:    "https://api.vanta.com/oauth/token/revoke",
+		HTTPClient:   mockClient,
+	})
+	if err != nil {
+		t.Fatalf("unexpected constructor error: %v", err)
+	}
+	client, err := NewClient(WithTokenSource(src), WithHTTPClient(mockClient))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	ctx := context.Background()
+	if _, err := src.Token(ctx); err != nil {
+		t.Fatalf("token error: %v", err)
+	}
+	if err := client.Close(ctx); err != nil {
+		t.Fatalf("Close returned error: %v", err)
+	}
+	if err := client.Close(ctx); err != nil {
+		t.Fatalf("second Close returned error: %v", err)
+	}
+	if got := atomic.LoadInt32(&revokeCalls); got != 1 {
+		t.Fatalf("revoke calls = %d, want 1", got)
+	}
+
+	if _, err := src.Token(ctx); err != nil {
+		t.Fatalf("token error after revoke: %v", err)
+	}
+	if got := atomic.LoadInt32(&tokenCalls); got != 2 {
+		t.Fatalf("oauth endpoint calls = %d, want 2", got)
+	}
+}
+
+func TestOAuthClientCredentialsTokenSourceRevokeWithoutURLClearsCache(t *testing.T) {
+	var calls int32
+	mockClient := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&calls, 1)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"access_token":"token-1","expires_in":3600}`)),
+			}, nil
+		}),
+	}
+
+	src, err := NewOAuthClientCredentialsTokenSource(OAuthClientCredentialsConfig{
+		ClientID:     "id",
+		ClientSecret: "secret",
+		HTTPClient:   mockClient,
+	})
+	if err != nil {
+		t.Fatalf("unexpected constructor error: %v", err)
+	}
+
+	ctx := context.Background()
+	if _, err := src.Token(ctx); err != nil {
+		t.Fatalf("token error: %v", err)
+	}
+	if err := src.Revoke(ctx); err != nil {
+		t.Fatalf("Revoke returned error: %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("http calls after revoke = %d, want 1", got)
+	}
+	if _, err := src.Token(ctx); err != nil {
+		t.Fatalf("token error after revoke: %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Fatalf("http calls = %d, want 2", got)
+	}
+}
+
+func TestOAuthServiceCreateTokenUsesAuthURL(t *testing.T) {
+	var gotURL string
+	var payload map[string]string
+	mockClient := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			gotURL = r.URL.String()
+			if r.Header.Get(headerAuthorization) != "" {
+				t.Fatalf("token request must not carry a bearer token")
+			}
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body: io.NopCloser(strings.NewReader(
+					`{"access_token":"token-1","token_type":"Bearer","expires_in":3600,"scope":"vanta-api.all:read"}`,
+				)),
+			}, nil
+		}),
+	}
+
+	c, err := NewClient(WithHTTPClient(mockClient), WithTokenSource(StaticTokenSource("unused")))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	resp, err := c.Services.OAuth.CreateToken(context.Background(), &OAuthCreateTokenParams{
+		Body: &OAuthCreateTokenRequestBody{ClientID: "id", ClientSecret: "secret"},
+	})
+	if err != nil {
+		t.Fatalf("CreateToken returned error: %v", err)
+	}
+
+	if want := "https://api.vanta.com/oauth/token"; gotURL != want {
+		t.Fatalf("CreateToken requested %q, want %q", gotURL, want)
+	}
+	if payload["grant_type"] != "client_credentials" {
+		t.Fatalf("grant_type = %q, want client_credentials", payload["grant_type"])
+	}
+	if _, ok := payload["scope"]; ok {
+		t.Fatalf("empty scope should be omitted: %v", payload)
+	}
+	if resp.AccessToken != "token-1" || resp.ExpiresIn != 3600 || resp.Scope != "vanta-api.all:read" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if tok := resp.Token(); tok.Expiry.IsZero() || len(tok.Scopes) != 1 {
+		t.Fatalf("unexpected token: %+v", tok)
+	}
+}
+
+func TestOAuthServiceCreateTokenUsesClientPipeline(t *testing.T) {
+	var gotURL string
+	mockClient := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			gotURL = r.URL.String()
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"access_token":"token-1","token_type":"Bearer"}`)),
+			}, nil
+		}),
+	}
+
+	var logs []string
+	c, err := NewClient(
+		WithHTTPClient(mockClient),
+		WithBaseURL("https://sandbox.vanta.example/v1"),
+		WithTenant("acme"),
+		WithLogf(func(format string, args ...any) { logs = append(logs, fmt.Sprintf(format, args...)) }),
+	)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	if _, err := c.Services.OAuth.CreateToken(context.Background(), nil); err != nil {
+		t.Fatalf("CreateToken returned error: %v", err)
+	}
+
+	if want := "https://sandbox.vanta.example/oauth/token"; gotURL != want {
+		t.Fatalf("CreateToken requested %q, want %q", gotURL, want)
+	}
+	if want := "vanta-sdk-go: tenant=acme POST /oauth/token: 200 OK"; len(logs) != 1 || logs[0] != want {
+		t.Fatalf("logs = %q, want [%q]", logs, want)
+	}
+}
+
+func TestOAuthServiceCreateTokenReturnsAPIError(t *testing.T) {
+	mockClient := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusUnauthorized,
+				Status:     "401 Unauthorized",
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"error":"invalid_client"}`)),
+			}, nil
+		}),
+	}
+
+	c, err := NewClient(WithHTTPClient(mockClient))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	_, err = c.Services.OAuth.CreateToken(context.Background(), nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401 *APIError, got %v", err)
+	}
+}
+
+func testJWT(t *testing.T, claims map[string]any) string {
+	t.Helper()
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatalf("marshal claims: %v", err)
+	}
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	return header + "." + base64.RawURLEncoding.EncodeToString(payload) + ".sig"
+}
+
 type roundTripFunc func(*http.Request) (*http.Response, error)
 
 func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
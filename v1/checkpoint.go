@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// PagerCheckpoint is the serializable position of a Pager. Cursor is the
+// cursor of the first page that has not been processed yet.
+type PagerCheckpoint struct {
+	Operation string          `json:"operation,omitempty"`
+	Params    json.RawMessage `json:"params,omitempty"`
+	Cursor    string          `json:"cursor"`
+	PagesSeen int             `json:"pagesSeen"`
+	Done      bool            `json:"done"`
+}
+
+// CheckpointStore persists pager checkpoints.
+type CheckpointStore interface {
+	SaveCheckpoint(ctx context.Context, checkpoint PagerCheckpoint) error
+	LoadCheckpoint(ctx context.Context) (PagerCheckpoint, bool, error)
+}
+
+// FileCheckpointStore stores a single checkpoint as JSON in a file. Saves
+// write a temporary file and rename it over the previous checkpoint, so a
+// crash mid-write leaves the last good checkpoint in place.
+type FileCheckpointStore struct {
+	Path string
+}
+
+// SaveCheckpoint writes checkpoint to s.Path.
+func (s FileCheckpointStore) SaveCheckpoint(_ context.Context, checkpoint PagerCheckpoint) error {
+	if s.Path == "" {
+		return fmt.Errorf("checkpoint path must not be empty")
+	}
+	data, err := json.MarshalIndent(checkpoint, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal checkpoint: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("create checkpoint temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write checkpoint: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("sync checkpoint: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close checkpoint: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.Path); err != nil {
+		return fmt.Errorf("replace checkpoint: %w", err)
+	}
+	return nil
+}
+
+// LoadCheckpoint reads the checkpoint from s.Path. It reports false when no
+// checkpoint has been saved yet.
+func (s FileCheckpointStore) LoadCheckpoint(context.Context) (PagerCheckpoint, bool, error) {
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return PagerCheckpoint{}, false, nil
+	}
+	if err != nil {
+		return PagerCheckpoint{}, false, fmt.Errorf("read checkpoint: %w", err)
+	}
+	var checkpoint PagerCheckpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return PagerCheckpoint{}, false, fmt.Errorf("decode checkpoint: %w", err)
+	}
+	return checkpoint, true, nil
+}
+
+func compactJSON(raw json.RawMessage) []byte {
+	if len(raw) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return raw
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,106 @@
+package v1
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func checkpointTestFetch(calls *[]string) func(context.Context, string) (*ResultsPage[string], error) {
+	pages := map[string]struct {
+		data []string
+		next string
+	}{
+		"":   {data: []string{"a"}, next: "c1"},
+		"c1": {data: []string{"b"}, next: "c2"},
+		"c2": {data: []string{"c"}},
+	}
+	return func(_ context.Context, cursor string) (*ResultsPage[string], error) {
+		*calls = append(*calls, cursor)
+		p := pages[cursor]
+		page := &ResultsPage[string]{}
+		page.Results.Data = p.data
+		page.Results.PageInfo.HasNextPage = p.next != ""
+		page.Results.PageInfo.EndCursor = p.next
+		return page, nil
+	}
+}
+
+func TestPagerCheckpointResumesFromFileStore(t *testing.T) {
+	ctx := context.Background()
+	store := FileCheckpointStore{Path: filepath.Join(t.TempDir(), "controls.json")}
+	params := map[string]string{"frameworkMatchesAny": "soc2"}
+
+	if _, ok, err := store.LoadCheckpoint(ctx); err != nil || ok {
+		t.Fatalf("LoadCheckpoint on empty store = ok=%v err=%v", ok, err)
+	}
+
+	var calls []string
+	pager := NewPager("", checkpointTestFetch(&calls),
+		WithPagerOperation("ListControls", params),
+		WithCheckpointStore(store),
+	)
+	if _, ok, err := pager.Next(ctx); !ok || err != nil {
+		t.Fatalf("first page: ok=%v err=%v", ok, err)
+	}
+	if cp := pager.Checkpoint(); cp.PagesSeen != 0 || cp.Cursor != "" {
+		t.Fatalf("checkpoint before processing = %+v, want no pages seen", cp)
+	}
+	if _, ok, err := pager.Next(ctx); !ok || err != nil {
+		t.Fatalf("second page: ok=%v err=%v", ok, err)
+	}
+
+	// The process "crashes" while handling the second page.
+	saved, ok, err := store.LoadCheckpoint(ctx)
+	if err != nil || !ok {
+		t.Fatalf("LoadCheckpoint: ok=%v err=%v", ok, err)
+	}
+	if saved.Operation != "ListControls" || saved.Cursor != "c1" || saved.PagesSeen != 1 || saved.Done {
+		t.Fatalf("saved checkpoint = %+v", saved)
+	}
+
+	if _, err := ResumePager(saved, checkpointTestFetch(&calls), WithPagerOperation("ListDocuments", params)); err == nil {
+		t.Fatal("expected operation mismatch error")
+	}
+	if _, err := ResumePager(saved, checkpointTestFetch(&calls), WithPagerOperation("ListControls", map[string]string{"frameworkMatchesAny": "iso"})); err == nil {
+		t.Fatal("expected params mismatch error")
+	}
+
+	calls = nil
+	resumed, err := ResumePager(saved, checkpointTestFetch(&calls),
+		WithPagerOperation("ListControls", params),
+		WithCheckpointStore(store),
+	)
+	if err != nil {
+		t.Fatalf("ResumePager: %v", err)
+	}
+	var got []string
+	for item, err := range resumed.All(ctx) {
+		if err != nil {
+			t.Fatalf("resumed iteration: %v", err)
+		}
+		got = append(got, item)
+	}
+	if len(got) != 2 || got[0] != "b" || got[1] != "c" {
+		t.Fatalf("resumed items = %v, want [b c]", got)
+	}
+	if len(calls) != 2 || calls[0] != "c1" {
+		t.Fatalf("resumed fetch cursors = %v, want [c1 c2]", calls)
+	}
+
+	final, _, err := store.LoadCheckpoint(ctx)
+	if err != nil {
+		t.Fatalf("LoadCheckpoint: %v", err)
+	}
+	if !final.Done || final.PagesSeen != 3 {
+		t.Fatalf("final checkpoint = %+v, want done after 3 pages", final)
+	}
+
+	done, err := ResumePager(final, checkpointTestFetch(&calls))
+	if err != nil {
+		t.Fatalf("ResumePager: %v", err)
+	}
+	if _, ok, err := done.Next(ctx); ok || err != nil {
+		t.Fatalf("resumed done pager: ok=%v err=%v", ok, err)
+	}
+}
@@ -25,8 +25,11 @@ const (
 type Client struct {
 	httpClient  *http.Client
 	baseURL     *url.URL
+	authURL     string
 	tokenSource TokenSource
 	userAgent   string
+	tenant      string
+	logf        func(format string, args ...any)
 
 	// Generated service handles are populated by newGeneratedServices.
 	Services *Services
@@ -48,12 +51,19 @@ func NewClient(opts ...Option) (*Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("parse base URL: %w", err)
 	}
+	authURL := cfg.authURL
+	if authURL == "" {
+		authURL = (&url.URL{Scheme: baseURL.Scheme, Host: baseURL.Host, Path: defaultOAuthPath}).String()
+	}
 
 	c := &Client{
 		httpClient:  cfg.httpClient,
 		baseURL:     baseURL,
+		authURL:     authURL,
 		tokenSource: cfg.tokenSource,
 		userAgent:   cfg.userAgent,
+		tenant:      cfg.tenant,
+		logf:        cfg.logf,
 	}
 	c.Services = newGeneratedServices(c)
 	return c, nil
@@ -67,7 +77,34 @@ func (c *Client) BaseURL() string {
 	return c.baseURL.String()
 }
 
+// Tenant returns the tenant identifier set with WithTenant.
+func (c *Client) Tenant() string {
+	if c == nil {
+		return ""
+	}
+	return c.tenant
+}
+
+// Close releases the client's cached credentials. When the token source
+// implements TokenRevoker its token is revoked, so short-lived jobs do not
+// leave a token active that would conflict with the next job's fetch. Close is
+// safe to call concurrently and more than once.
+func (c *Client) Close(ctx context.Context) error {
+	if c == nil {
+		return nil
+	}
+	revoker, ok := c.tokenSource.(TokenRevoker)
+	if !ok {
+		return nil
+	}
+	if err := revoker.Revoke(ctx); err != nil {
+		return fmt.Errorf("revoke token: %w", err)
+	}
+	return nil
+}
+
 func (c *Client) newRequest(ctx context.Context, method, path string, query url.Values, body any) (*http.Request, error) {
+	ctx = c.tagContext(ctx)
 	u := *c.baseURL
 	u.Path = joinURLPath(c.baseURL.Path, path)
 	if len(query) > 0 {
@@ -105,8 +142,19 @@ func (c *Client) newRequest(ctx context.Context, method, path string, query url.
 	return req, nil
 }
 
+func (c *Client) tagContext(ctx context.Context) context.Context {
+	if c.tenant == "" {
+		return ctx
+	}
+	if _, ok := TenantFromContext(ctx); ok {
+		return ctx
+	}
+	return ContextWithTenant(ctx, c.tenant)
+}
+
 func (c *Client) do(req *http.Request) (*http.Response, error) {
 	resp, err := c.httpClient.Do(req)
+	c.logRequest(req, resp, err)
 	if err != nil {
 		return nil, err
 	}
@@ -144,6 +192,7 @@ func (c *Client) doJSON(req *http.Request, out any) error {
 }
 
 func (c *Client) newMultipartRequest(ctx context.Context, method, path string, query url.Values, form map[string]string) (*http.Request, error) {
+	ctx = c.tagContext(ctx)
 	u := *c.baseURL
 	u.Path = joinURLPath(c.baseURL.Path, path)
 	if len(query) > 0 {
@@ -180,6 +229,21 @@ func (c *Client) newMultipartRequest(ctx context.Context, method, path string, q
 	return req, nil
 }
 
+func (c *Client) logRequest(req *http.Request, resp *http.Response, err error) {
+	if c.logf == nil {
+		return
+	}
+	prefix := "vanta-sdk-go:"
+	if tenant, ok := TenantFromContext(req.Context()); ok {
+		prefix += fmt.Sprintf(" tenant=%s", tenant)
+	}
+	if err != nil {
+		c.logf("%s %s %s: %v", prefix, req.Method, req.URL.Path, err)
+		return
+	}
+	c.logf("%s %s %s: %s", prefix, req.Method, req.URL.Path, resp.Status)
+}
+
 func joinURLPath(basePath, relativePath string) string {
 	basePath = strings.TrimSuffix(basePath, "/")
 	relativePath = strings.TrimPrefix(relativePath, "/")
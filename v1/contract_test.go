@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// contractCase is one example from the API source, generated into
+// generated_contract_test.go by vanta-gen.
+type contractCase struct {
+	Operation string
+	// Method, Path and Query describe the example request. Path is relative
+	// to the base URL, except for operations without scopes, which are sent
+	// to the auth URL.
+	Method string
+	Path   string
+	Query  string
+	// Params is a JSON object keyed by params field name.
+	Params string
+	// Status and Response are an example response; Response is empty when
+	// the source has none.
+	Status   int
+	Response string
+}
+
+// contractExampleGaps lists examples, by subtest name, whose responses are
+// known not to fit the SDK's types, with the reason. Their problems are logged
+// rather than failing the test, and the test fails once they are fixed so the
+// entry can be removed.
+var contractExampleGaps = map[string]string{
+	"Documents.ListDocumentsControls":                         "the collection's first example is a documents page, not a controls page",
+	"VulnerabilityRemediations.ListVulnerabilityRemediations": "the example's severity is lowercase",
+}
+
+// TestContractExamples checks every generated method against the source's
+// examples: the request built from the example params must match the example
+// request, and the example response must decode into the method's return
+// type without errors or unknown fields.
+func TestContractExamples(t *testing.T) {
+	prevWarnf := UnknownFieldWarningf
+	defer func() { UnknownFieldWarningf = prevWarnf }()
+
+	for _, c := range contractCases {
+		t.Run(c.Operation, func(t *testing.T) {
+			op, ok := LookupOperation(c.Operation)
+			if !ok {
+				t.Fatalf("operation %s is not in the catalog", c.Operation)
+			}
+			params := op.NewParams()
+			if c.Params != "" {
+				if err := json.Unmarshal([]byte(c.Params), params); err != nil {
+					t.Fatalf("request example does not fit %T: %v", params, err)
+				}
+			}
+
+			var got *http.Request
+			client, err := NewClient(
+				WithTokenSource(StaticTokenSource("contract")),
+				WithHTTPClient(&http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+					got = r
+					return &http.Response{
+						StatusCode: c.Status,
+						Status:     fmt.Sprintf("%d %s", c.Status, http.StatusText(c.Status)),
+						Header:     http.Header{"Content-Type": []string{"application/json"}},
+						Body:       io.NopCloser(strings.NewReader(c.Response)),
+					}, nil
+				})}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var unknown []string
+			UnknownFieldWarningf = func(format string, args ...any) {
+				unknown = append(unknown, fmt.Sprintf(format, args...))
+			}
+			resetUnknownFieldWarningsForTest()
+
+			_, err = client.Invoke(context.Background(), op, params)
+			if got == nil {
+				t.Fatalf("no request sent: %v", err)
+			}
+			if c.Response != "" {
+				var problems []string
+				if err != nil {
+					problems = append(problems, err.Error())
+				}
+				problems = append(problems, unknown...)
+				name := strings.TrimPrefix(t.Name(), "TestContractExamples/")
+				if gap, ok := contractExampleGaps[name]; ok {
+					if len(problems) == 0 {
+						t.Errorf("known gap %q no longer applies; remove it from contractExampleGaps", gap)
+					}
+					for _, p := range problems {
+						t.Logf("known gap (%s): %s", gap, p)
+					}
+				} else {
+					for _, p := range problems {
+						t.Errorf("example response: %s", p)
+					}
+				}
+			}
+
+			if got.Method != c.Method {
+				t.Errorf("method = %s, want %s", got.Method, c.Method)
+			}
+			wantPath := c.Path
+			if len(op.Scopes) > 0 {
+				wantPath = client.baseURL.Path + c.Path
+			}
+			if got.URL.Path != wantPath {
+				t.Errorf("path = %s, want %s", got.URL.Path, wantPath)
+			}
+			want, err := url.ParseQuery(c.Query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if gotQuery := got.URL.Query(); !maps.EqualFunc(gotQuery, want, slices.Equal) {
+				t.Errorf("query = %s, want %s", gotQuery.Encode(), want.Encode())
+			}
+		})
+	}
+}
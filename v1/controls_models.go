@@ -0,0 +1,28 @@
+package v1
+
+// Control is a control returned from controls, documents and frameworks
+// endpoints.
+type Control struct {
+	ID                  ControlID            `json:"id"`
+	ExternalID          string               `json:"externalId"`
+	Name                string               `json:"name"`
+	Description         string               `json:"description"`
+	Source              string               `json:"source"`
+	Domains             []string             `json:"domains"`
+	Owner               *OwnerReference      `json:"owner"`
+	Role                string               `json:"role"`
+	CustomFields        []ControlCustomField `json:"customFields"`
+	CreationDate        *string              `json:"creationDate"`
+	ModificationDate    *string              `json:"modificationDate"`
+	Note                string               `json:"note"`
+	NumDocumentsPassing int                  `json:"numDocumentsPassing"`
+	NumDocumentsTotal   int                  `json:"numDocumentsTotal"`
+	NumTestsPassing     int                  `json:"numTestsPassing"`
+	NumTestsTotal       int                  `json:"numTestsTotal"`
+	Status              string               `json:"status"`
+}
+
+type ControlCustomField struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,260 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultCredentialProcessTimeout = 30 * time.Second
+	maxCredentialProcessStderr      = 4 * 1024
+
+	// credentialProcessWaitDelay bounds how long a timed-out command's output
+	// pipes may stay open after it is killed. Children the command started
+	// (a shell script's sleep or CLI, say) are not killed and keep the
+	// pipes open; without the bound the run waits for them.
+	credentialProcessWaitDelay = time.Second
+)
+
+// CredentialProcessConfig configures a token source backed by an external
+// command, such as a vault or secrets-manager CLI.
+//
+// The command must print a single JSON object to stdout. It may contain either
+// a ready-to-use access token:
+//
+//	{"access_token": "...", "token_type": "Bearer", "expires_at": "2026-01-02T15:04:05Z"}
+//
+// or client credentials to exchange via the OAuth client credentials flow:
+//
+//	{"client_id": "...", "client_secret": "...", "scope": "vanta-api.all:read"}
+//
+// Access token output may use "expires_in" (seconds) instead of "expires_at".
+type CredentialProcessConfig struct {
+	// Command is the executable followed by its arguments. It is run directly,
+	// not through a shell.
+	Command []string
+	// Env is appended to the current process environment.
+	Env []string
+	// Dir is the working directory for the command.
+	Dir string
+	// Timeout bounds a single command run, including the output of any
+	// processes it starts, which get at most a second more. Defaults to 30s.
+	Timeout time.Duration
+	// RefreshSkew re-runs the command this long before the cached token
+	// expires. Defaults to 60s.
+	RefreshSkew time.Duration
+
+	// AuthURL, HTTPClient and Scope are used when the command returns client
+	// credentials. Scope applies only when the command output omits one.
+	AuthURL    string
+	HTTPClient *http.Client
+	Scope      string
+}
+
+// CredentialProcessError describes a failed credential command run.
+type CredentialProcessError struct {
+	Command  string
+	ExitCode int
+	Stderr   string
+	Err      error
+}
+
+func (e *CredentialProcessError) Error() string {
+	if e == nil {
+		return "<nil>"
+	}
+	msg := fmt.Sprintf("credential process %q failed", e.Command)
+	if e.ExitCode > 0 {
+		msg += fmt.Sprintf(" with exit code %d", e.ExitCode)
+	}
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	if e.Stderr != "" {
+		msg += ": stderr: " + e.Stderr
+	}
+	return msg
+}
+
+func (e *CredentialProcessError) Unwrap() error {
+	if e == nil {
+		return nil
+	}
+	return e.Err
+}
+
+// CredentialProcessTokenSource runs an external command to obtain tokens and
+// caches the result until it expires.
+type CredentialProcessTokenSource struct {
+	command     []string
+	env         []string
+	dir         string
+	timeout     time.Duration
+	refreshSkew time.Duration
+	authURL     string
+	httpClient  *http.Client
+	scope       string
+
+	mu    sync.Mutex
+	token Token
+}
+
+// NewCredentialProcessTokenSource builds a token source that runs cfg.Command
+// whenever a fresh token is required.
+func NewCredentialProcessTokenSource(cfg CredentialProcessConfig) (*CredentialProcessTokenSource, error) {
+	if len(cfg.Command) == 0 || cfg.Command[0] == "" {
+		return nil, fmt.Errorf("credential process command must not be empty")
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultCredentialProcessTimeout
+	}
+	if cfg.RefreshSkew <= 0 {
+		cfg.RefreshSkew = 60 * time.Second
+	}
+
+	return &CredentialProcessTokenSource{
+		command:     append([]string(nil), cfg.Command...),
+		env:         append([]string(nil), cfg.Env...),
+		dir:         cfg.Dir,
+		timeout:     cfg.Timeout,
+		refreshSkew: cfg.RefreshSkew,
+		authURL:     cfg.AuthURL,
+		httpClient:  cfg.HTTPClient,
+		scope:       cfg.Scope,
+	}, nil
+}
+
+// Token returns a valid cached token or re-runs the command when required.
+func (s *CredentialProcessTokenSource) Token(ctx context.Context) (Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.AccessToken != "" {
+		expiresSoon := !s.token.Expiry.IsZero() && time.Until(s.token.Expiry) <= s.refreshSkew
+		if !expiresSoon && s.token.Valid() {
+			return s.token, nil
+		}
+	}
+
+	out, err := s.run(ctx)
+	if err != nil {
+		return Token{}, err
+	}
+	tok, err := s.resolve(ctx, out)
+	if err != nil {
+		return Token{}, err
+	}
+	s.token = tok
+	return tok, nil
+}
+
+// Revoke clears the cached token so the next call re-runs the command. It is
+// safe to call concurrently and more than once.
+func (s *CredentialProcessTokenSource) Revoke(context.Context) error {
+	s.mu.Lock()
+	s.token = Token{}
+	s.mu.Unlock()
+	return nil
+}
+
+type credentialProcessOutput struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	ExpiresAt    string `json:"expires_at"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	Scope        string `json:"scope"`
+}
+
+func (s *CredentialProcessTokenSource) run(ctx context.Context) (credentialProcessOutput, error) {
+	runCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(runCtx, s.command[0], s.command[1:]...)
+	cmd.Dir = s.dir
+	if len(s.env) > 0 {
+		cmd.Env = append(os.Environ(), s.env...)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.WaitDelay = credentialProcessWaitDelay
+
+	if err := cmd.Run(); err != nil {
+		procErr := &CredentialProcessError{
+			Command: s.command[0],
+			Stderr:  strings.TrimSpace(truncate(stderr.Bytes(), maxCredentialProcessStderr)),
+			Err:     err,
+		}
+		if errors.Is(runCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+			procErr.Err = fmt.Errorf("timed out after %s", s.timeout)
+		}
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			procErr.ExitCode = exitErr.ExitCode()
+		}
+		return credentialProcessOutput{}, procErr
+	}
+
+	var out credentialProcessOutput
+	if err := decodeJSONBytes(stdout.Bytes(), &out); err != nil {
+		return credentialProcessOutput{}, &CredentialProcessError{
+			Command: s.command[0],
+			Stderr:  strings.TrimSpace(truncate(stderr.Bytes(), maxCredentialProcessStderr)),
+			Err:     fmt.Errorf("decode output: %w", err),
+		}
+	}
+	return out, nil
+}
+
+func (s *CredentialProcessTokenSource) resolve(ctx context.Context, out credentialProcessOutput) (Token, error) {
+	if out.AccessToken != "" {
+		tok := Token{AccessToken: out.AccessToken, TokenType: out.TokenType}
+		switch {
+		case out.ExpiresAt != "":
+			expiry, err := time.Parse(time.RFC3339, out.ExpiresAt)
+			if err != nil {
+				return Token{}, fmt.Errorf("credential process returned invalid expires_at %q: %w", out.ExpiresAt, err)
+			}
+			tok.Expiry = expiry
+		case out.ExpiresIn > 0:
+			tok.Expiry = time.Now().Add(time.Duration(out.ExpiresIn) * time.Second)
+		}
+		if tok.TokenType == "" {
+			tok.TokenType = "Bearer"
+		}
+		tok = tok.withJWTClaims()
+		if !tok.Valid() {
+			return Token{}, fmt.Errorf("credential process returned a token that expired at %s: %w", tok.Expiry.UTC().Format(time.RFC3339), ErrTokenExpired)
+		}
+		return tok, nil
+	}
+
+	if out.ClientID == "" || out.ClientSecret == "" {
+		return Token{}, fmt.Errorf("credential process output must contain access_token or client_id and client_secret")
+	}
+	scope := out.Scope
+	if scope == "" {
+		scope = s.scope
+	}
+	oauth, err := NewOAuthClientCredentialsTokenSource(OAuthClientCredentialsConfig{
+		ClientID:     out.ClientID,
+		ClientSecret: out.ClientSecret,
+		Scope:        scope,
+		AuthURL:      s.authURL,
+		HTTPClient:   s.httpClient,
+	})
+	if err != nil {
+		return Token{}, err
+	}
+	return oauth.fetchToken(ctx)
+}
@@ -0,0 +1,199 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestCredentialProcessHelper is re-executed as the external credential
+// command by the tests below. It does nothing in a normal test run.
+func TestCredentialProcessHelper(t *testing.T) {
+	mode := os.Getenv("VANTA_TEST_CREDENTIAL_PROCESS")
+	if mode == "" {
+		return
+	}
+	switch mode {
+	case "token":
+		if counter := os.Getenv("VANTA_TEST_CREDENTIAL_COUNTER"); counter != "" {
+			f, err := os.OpenFile(counter, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+			if err == nil {
+				_, _ = f.WriteString("x")
+				_ = f.Close()
+			}
+		}
+		fmt.Fprintf(os.Stdout, `{"access_token":"process-token","expires_at":%q}`, time.Now().Add(time.Hour).Format(time.RFC3339))
+	case "credentials":
+		fmt.Fprint(os.Stdout, `{"client_id":"id","client_secret":"secret"}`)
+	case "fail":
+		fmt.Fprint(os.Stderr, "vault: permission denied")
+		os.Exit(3)
+	case "hang":
+		time.Sleep(10 * time.Second)
+	case "fork":
+		child := exec.Command(os.Args[0], os.Args[1:]...)
+		child.Env = append(os.Environ(), "VANTA_TEST_CREDENTIAL_PROCESS=hang")
+		child.Stdout = os.Stdout
+		child.Stderr = os.Stderr
+		_ = child.Run()
+	}
+	os.Exit(0)
+}
+
+func credentialProcessCommand() []string {
+	return []string{os.Args[0], "-test.run=^TestCredentialProcessHelper$"}
+}
+
+func TestCredentialProcessTokenSourceCachesToken(t *testing.T) {
+	counter := t.TempDir() + "/runs"
+	src, err := NewCredentialProcessTokenSource(CredentialProcessConfig{
+		Command: credentialProcessCommand(),
+		Env: []string{
+			"VANTA_TEST_CREDENTIAL_PROCESS=token",
+			"VANTA_TEST_CREDENTIAL_COUNTER=" + counter,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected constructor error: %v", err)
+	}
+
+	ctx := context.Background()
+	for range 2 {
+		tok, err := src.Token(ctx)
+		if err != nil {
+			t.Fatalf("token error: %v", err)
+		}
+		if tok.AccessToken != "process-token" || tok.TokenType != "Bearer" {
+			t.Fatalf("unexpected token: %+v", tok)
+		}
+		if tok.Expiry.IsZero() {
+			t.Fatal("expected expiry from expires_at")
+		}
+	}
+
+	runs, err := os.ReadFile(counter)
+	if err != nil {
+		t.Fatalf("read counter: %v", err)
+	}
+	if got := len(runs); got != 1 {
+		t.Fatalf("credential process runs = %d, want 1", got)
+	}
+}
+
+func TestCredentialProcessTokenSourceExchangesClientCredentials(t *testing.T) {
+	var calls int32
+	mockClient := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&calls, 1)
+			var payload map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			if payload["client_id"] != "id" || payload["client_secret"] != "secret" {
+				t.Fatalf("unexpected oauth payload: %v", payload)
+			}
+			if payload["scope"] != "vanta-api.all:read" {
+				t.Fatalf("scope = %q, want vanta-api.all:read", payload["scope"])
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"access_token":"exchanged","token_type":"Bearer","expires_in":3600}`)),
+			}, nil
+		}),
+	}
+
+	src, err := NewCredentialProcessTokenSource(CredentialProcessConfig{
+		Command:    credentialProcessCommand(),
+		Env:        []string{"VANTA_TEST_CREDENTIAL_PROCESS=credentials"},
+		AuthURL:    "https://api.vanta.com/oauth/token",
+		HTTPClient: mockClient,
+		Scope:      "vanta-api.all:read",
+	})
+	if err != nil {
+		t.Fatalf("unexpected constructor error: %v", err)
+	}
+
+	tok, err := src.Token(context.Background())
+	if err != nil {
+		t.Fatalf("token error: %v", err)
+	}
+	if tok.AccessToken != "exchanged" {
+		t.Fatalf("access token = %q, want exchanged", tok.AccessToken)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("oauth endpoint calls = %d, want 1", got)
+	}
+}
+
+func TestCredentialProcessTokenSourceCapturesStderr(t *testing.T) {
+	src, err := NewCredentialProcessTokenSource(CredentialProcessConfig{
+		Command: credentialProcessCommand(),
+		Env:     []string{"VANTA_TEST_CREDENTIAL_PROCESS=fail"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected constructor error: %v", err)
+	}
+
+	_, err = src.Token(context.Background())
+	var procErr *CredentialProcessError
+	if !errors.As(err, &procErr) {
+		t.Fatalf("expected *CredentialProcessError, got %T: %v", err, err)
+	}
+	if procErr.ExitCode != 3 {
+		t.Fatalf("exit code = %d, want 3", procErr.ExitCode)
+	}
+	if !strings.Contains(procErr.Stderr, "permission denied") {
+		t.Fatalf("stderr = %q, want permission denied", procErr.Stderr)
+	}
+}
+
+func TestCredentialProcessTokenSourceTimeout(t *testing.T) {
+	src, err := NewCredentialProcessTokenSource(CredentialProcessConfig{
+		Command: credentialProcessCommand(),
+		Env:     []string{"VANTA_TEST_CREDENTIAL_PROCESS=hang"},
+		Timeout: 100 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("unexpected constructor error: %v", err)
+	}
+
+	_, err = src.Token(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("expected timeout error, got %v", err)
+	}
+}
+
+func TestCredentialProcessTokenSourceTimeoutWithChildProcess(t *testing.T) {
+	src, err := NewCredentialProcessTokenSource(CredentialProcessConfig{
+		Command: credentialProcessCommand(),
+		Env:     []string{"VANTA_TEST_CREDENTIAL_PROCESS=fork"},
+		Timeout: 100 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("unexpected constructor error: %v", err)
+	}
+
+	start := time.Now()
+	_, err = src.Token(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("expected timeout error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("Token returned after %s; the child process kept it waiting", elapsed)
+	}
+}
+
+func TestNewCredentialProcessTokenSourceRequiresCommand(t *testing.T) {
+	if _, err := NewCredentialProcessTokenSource(CredentialProcessConfig{}); err == nil {
+		t.Fatal("expected error for empty command")
+	}
+}
@@ -0,0 +1,305 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// CustomFieldType is the kind of value a custom field holds.
+type CustomFieldType string
+
+// CustomFieldType values.
+const (
+	CustomFieldTypeText         CustomFieldType = "TEXT"
+	CustomFieldTypeNumber       CustomFieldType = "NUMBER"
+	CustomFieldTypeDate         CustomFieldType = "DATE"
+	CustomFieldTypeSingleSelect CustomFieldType = "SINGLE_SELECT"
+	CustomFieldTypeMultiSelect  CustomFieldType = "MULTI_SELECT"
+)
+
+// Valid reports whether v is a known CustomFieldType.
+func (v CustomFieldType) Valid() bool {
+	switch v {
+	case CustomFieldTypeText, CustomFieldTypeNumber, CustomFieldTypeDate, CustomFieldTypeSingleSelect, CustomFieldTypeMultiSelect:
+		return true
+	}
+	return false
+}
+
+// UnmarshalJSON accepts values the SDK does not know yet.
+func (v *CustomFieldType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, v)
+}
+
+// CustomFieldValue is the value of a custom field: text, a number, a date,
+// or one or more select options. The zero value is an empty field.
+//
+// On the wire Vanta sends text, dates and single-select options as JSON
+// strings and multi-select options as a list of strings, so decoded values are
+// either text, a number or a multi-select. Date and Selected interpret text
+// values accordingly.
+type CustomFieldValue struct {
+	kind     CustomFieldType
+	text     string
+	number   float64
+	date     time.Time
+	selected []string
+	raw      json.RawMessage
+}
+
+// TextValue returns a text custom field value.
+func TextValue(s string) CustomFieldValue {
+	return CustomFieldValue{kind: CustomFieldTypeText, text: s}
+}
+
+// NumberValue returns a number custom field value.
+func NumberValue(n float64) CustomFieldValue {
+	return CustomFieldValue{kind: CustomFieldTypeNumber, number: n}
+}
+
+// DateValue returns a date custom field value, sent as an RFC 3339 timestamp.
+func DateValue(t time.Time) CustomFieldValue {
+	return CustomFieldValue{kind: CustomFieldTypeDate, date: t}
+}
+
+// SingleSelectValue returns a single-select custom field value.
+func SingleSelectValue(option string) CustomFieldValue {
+	return CustomFieldValue{kind: CustomFieldTypeSingleSelect, text: option}
+}
+
+// MultiSelectValue returns a multi-select custom field value.
+func MultiSelectValue(options ...string) CustomFieldValue {
+	return CustomFieldValue{kind: CustomFieldTypeMultiSelect, selected: slices.Clone(options)}
+}
+
+// Type returns the kind of value held, or "" for an empty or unrecognized
+// value.
+func (v CustomFieldValue) Type() CustomFieldType {
+	return v.kind
+}
+
+// IsZero reports whether v holds no value.
+func (v CustomFieldValue) IsZero() bool {
+	return v.kind == "" && len(v.raw) == 0
+}
+
+// Text returns a text or single-select value.
+func (v CustomFieldValue) Text() (string, bool) {
+	switch v.kind {
+	case CustomFieldTypeText, CustomFieldTypeSingleSelect:
+		return v.text, true
+	case CustomFieldTypeDate:
+		return v.date.Format(time.RFC3339), true
+	}
+	return "", false
+}
+
+// Number returns a number value, parsing text values when needed.
+func (v CustomFieldValue) Number() (float64, bool) {
+	switch v.kind {
+	case CustomFieldTypeNumber:
+		return v.number, true
+	case CustomFieldTypeText:
+		n, err := strconv.ParseFloat(v.text, 64)
+		return n, err == nil
+	}
+	return 0, false
+}
+
+// Date returns a date value, parsing RFC 3339 or YYYY-MM-DD text when needed.
+func (v CustomFieldValue) Date() (time.Time, bool) {
+	switch v.kind {
+	case CustomFieldTypeDate:
+		return v.date, true
+	case CustomFieldTypeText:
+		for _, layout := range []string{time.RFC3339Nano, time.DateOnly} {
+			if t, err := time.Parse(layout, v.text); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// Selected returns the options of a select value. Text values count as a
+// single selected option.
+func (v CustomFieldValue) Selected() ([]string, bool) {
+	switch v.kind {
+	case CustomFieldTypeMultiSelect:
+		return slices.Clone(v.selected), true
+	case CustomFieldTypeSingleSelect, CustomFieldTypeText:
+		return []string{v.text}, true
+	}
+	return nil, false
+}
+
+// MarshalJSON writes the value in Vanta's wire format.
+func (v CustomFieldValue) MarshalJSON() ([]byte, error) {
+	switch v.kind {
+	case CustomFieldTypeText, CustomFieldTypeSingleSelect:
+		return json.Marshal(v.text)
+	case CustomFieldTypeNumber:
+		return json.Marshal(v.number)
+	case CustomFieldTypeDate:
+		return json.Marshal(v.date.Format(time.RFC3339))
+	case CustomFieldTypeMultiSelect:
+		if v.selected == nil {
+			return []byte("[]"), nil
+		}
+		return json.Marshal(v.selected)
+	}
+	if len(v.raw) > 0 {
+		return v.raw, nil
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON decodes a string, number or list of strings. Other JSON
+// values are kept as-is so they survive a round trip.
+func (v *CustomFieldValue) UnmarshalJSON(data []byte) error {
+	*v = CustomFieldValue{}
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil
+	}
+	switch trimmed[0] {
+	case '"':
+		v.kind = CustomFieldTypeText
+		return json.Unmarshal(trimmed, &v.text)
+	case '[':
+		var options []string
+		if err := json.Unmarshal(trimmed, &options); err == nil {
+			v.kind = CustomFieldTypeMultiSelect
+			v.selected = options
+			return nil
+		}
+	default:
+		var n float64
+		if err := json.Unmarshal(trimmed, &n); err == nil {
+			v.kind = CustomFieldTypeNumber
+			v.number = n
+			return nil
+		}
+	}
+	if !json.Valid(trimmed) {
+		return fmt.Errorf("invalid custom field value %s", trimmed)
+	}
+	v.raw = append(json.RawMessage(nil), trimmed...)
+	return nil
+}
+
+// CustomField is a labelled custom field on a risk scenario or vendor.
+type CustomField struct {
+	Label string           `json:"label"`
+	Value CustomFieldValue `json:"value"`
+}
+
+// CustomFields is a list of custom fields with lookup by label.
+type CustomFields []CustomField
+
+// Get returns the value of the field with label.
+func (f CustomFields) Get(label string) (CustomFieldValue, bool) {
+	for _, field := range f {
+		if field.Label == label {
+			return field.Value, true
+		}
+	}
+	return CustomFieldValue{}, false
+}
+
+// Set replaces the value of the field with label, or appends the field.
+func (f *CustomFields) Set(label string, value CustomFieldValue) {
+	for i := range *f {
+		if (*f)[i].Label == label {
+			(*f)[i].Value = value
+			return
+		}
+	}
+	*f = append(*f, CustomField{Label: label, Value: value})
+}
+
+// Delete removes the field with label.
+func (f *CustomFields) Delete(label string) {
+	*f = slices.DeleteFunc(*f, func(field CustomField) bool {
+		return field.Label == label
+	})
+}
+
+// CustomFieldDefinition describes a custom field configured in Vanta. The
+// public API does not list definitions, so callers build them from their own
+// Vanta configuration.
+type CustomFieldDefinition struct {
+	Label    string
+	Type     CustomFieldType
+	Options  []string
+	Required bool
+}
+
+// Validate checks f against defs: every field must be defined, values must
+// match their definition's type and options, and required fields must be set.
+// Failures are reported as a *ValidationError keyed by "customFields.<label>".
+func (f CustomFields) Validate(defs []CustomFieldDefinition) error {
+	var v validator
+	byLabel := make(map[string]CustomFieldDefinition, len(defs))
+	for _, def := range defs {
+		byLabel[def.Label] = def
+	}
+	seen := map[string]bool{}
+	for _, field := range f {
+		name := "customFields." + field.Label
+		seen[field.Label] = true
+		def, ok := byLabel[field.Label]
+		if !ok {
+			v.add(name, "is not a defined custom field")
+			continue
+		}
+		if field.Value.IsZero() {
+			if def.Required {
+				v.add(name, "is required")
+			}
+			continue
+		}
+		validateCustomFieldValue(&v, name, def, field.Value)
+	}
+	for _, def := range defs {
+		if def.Required && !seen[def.Label] {
+			v.add("customFields."+def.Label, "is required")
+		}
+	}
+	return v.err()
+}
+
+func validateCustomFieldValue(v *validator, name string, def CustomFieldDefinition, value CustomFieldValue) {
+	switch def.Type {
+	case CustomFieldTypeText:
+		if _, ok := value.Text(); !ok {
+			v.add(name, "must be text")
+		}
+	case CustomFieldTypeNumber:
+		if _, ok := value.Number(); !ok {
+			v.add(name, "must be a number")
+		}
+	case CustomFieldTypeDate:
+		if _, ok := value.Date(); !ok {
+			v.add(name, "must be a date")
+		}
+	case CustomFieldTypeSingleSelect, CustomFieldTypeMultiSelect:
+		selected, ok := value.Selected()
+		if !ok {
+			v.add(name, "must be a select value")
+			return
+		}
+		if def.Type == CustomFieldTypeSingleSelect && value.Type() == CustomFieldTypeMultiSelect && len(selected) != 1 {
+			v.add(name, "must have exactly one option")
+		}
+		for _, option := range selected {
+			if len(def.Options) > 0 && !slices.Contains(def.Options, option) {
+				v.add(name, "has unknown option %q", option)
+			}
+		}
+	}
+}
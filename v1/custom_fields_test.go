@@ -0,0 +1,105 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCustomFieldsDecodeAndLookup(t *testing.T) {
+	var vendor Vendor
+	data := `{"customFields": [{"label": "Region", "value": ["EU", "US"]}, {"label": "Tier", "value": "2"}, {"label": "Review", "value": "2024-03-01"}, {"label": "Empty", "value": null}]}`
+	if err := decodeJSONBytes([]byte(data), &vendor); err != nil {
+		t.Fatalf("decode returned error: %v", err)
+	}
+
+	region, ok := vendor.CustomFields.Get("Region")
+	if !ok || region.Type() != CustomFieldTypeMultiSelect {
+		t.Fatalf("unexpected region: %+v", region)
+	}
+	if options, _ := region.Selected(); len(options) != 2 || options[1] != "US" {
+		t.Fatalf("unexpected options: %v", options)
+	}
+	tier, _ := vendor.CustomFields.Get("Tier")
+	if n, ok := tier.Number(); !ok || n != 2 {
+		t.Fatalf("tier number = %v, %v", n, ok)
+	}
+	review, _ := vendor.CustomFields.Get("Review")
+	if d, ok := review.Date(); !ok || d.Month() != time.March {
+		t.Fatalf("review date = %v, %v", d, ok)
+	}
+	if empty, _ := vendor.CustomFields.Get("Empty"); !empty.IsZero() {
+		t.Fatalf("expected empty value, got %+v", empty)
+	}
+	if _, ok := vendor.CustomFields.Get("Missing"); ok {
+		t.Fatal("expected missing label")
+	}
+}
+
+func TestCustomFieldsSetAndMarshal(t *testing.T) {
+	var fields CustomFields
+	fields.Set("Tier", TextValue("1"))
+	fields.Set("Score", NumberValue(4.5))
+	fields.Set("Review", DateValue(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)))
+	fields.Set("Region", MultiSelectValue("EU"))
+	fields.Set("Tier", SingleSelectValue("High"))
+	fields.Delete("Score")
+
+	body, err := json.Marshal(RiskScenariosUpdateRiskScenarioRequestBody{CustomFields: fields})
+	if err != nil {
+		t.Fatalf("marshal returned error: %v", err)
+	}
+	var decoded struct {
+		CustomFields []map[string]any `json:"customFields"`
+	}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		t.Fatalf("unmarshal returned error: %v", err)
+	}
+	got := decoded.CustomFields
+	if len(got) != 3 || got[0]["value"] != "High" || got[1]["value"] != "2024-03-01T00:00:00Z" {
+		t.Fatalf("unexpected custom fields: %s", body)
+	}
+	if region, ok := got[2]["value"].([]any); !ok || len(region) != 1 || region[0] != "EU" {
+		t.Fatalf("unexpected region: %s", body)
+	}
+}
+
+func TestCustomFieldsValidate(t *testing.T) {
+	defs := []CustomFieldDefinition{
+		{Label: "Region", Type: CustomFieldTypeSingleSelect, Options: []string{"EU", "US"}},
+		{Label: "Score", Type: CustomFieldTypeNumber},
+		{Label: "Owner", Type: CustomFieldTypeText, Required: true},
+	}
+	valid := CustomFields{
+		{Label: "Region", Value: TextValue("EU")},
+		{Label: "Score", Value: TextValue("3")},
+		{Label: "Owner", Value: TextValue("sam")},
+	}
+	if err := valid.Validate(defs); err != nil {
+		t.Fatalf("expected valid fields, got %v", err)
+	}
+
+	invalid := CustomFields{
+		{Label: "Region", Value: MultiSelectValue("APAC")},
+		{Label: "Score", Value: TextValue("high")},
+		{Label: "Unknown", Value: TextValue("x")},
+	}
+	err := invalid.Validate(defs)
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected ValidationError, got %v", err)
+	}
+	want := map[string]bool{
+		"customFields.Region":  true,
+		"customFields.Score":   true,
+		"customFields.Unknown": true,
+		"customFields.Owner":   true,
+	}
+	for _, field := range validationErr.Fields {
+		delete(want, field.Field)
+	}
+	if len(want) != 0 {
+		t.Fatalf("missing errors for %v in %v", want, err)
+	}
+}
@@ -0,0 +1,39 @@
+package v1
+
+// DiscoveredVendor is a vendor detected from integrations but not yet managed.
+type DiscoveredVendor struct {
+	ID               DiscoveredVendorID        `json:"id"`
+	Name             string                    `json:"name"`
+	Category         *DiscoveredVendorCategory `json:"category"`
+	Source           string                    `json:"source"`
+	NormalizedName   string                    `json:"normalizedName"`
+	DiscoveredDate   string                    `json:"discoveredDate"`
+	NumberOfAccounts int                       `json:"numberOfAccounts"`
+	Ignored          *DiscoveredVendorIgnored  `json:"ignored"`
+	Rejected         any                       `json:"rejected"`
+}
+
+type DiscoveredVendorCategory struct {
+	Name string `json:"name"`
+}
+
+type DiscoveredVendorIgnored struct {
+	IgnoredByUserID string `json:"ignoredByUserId"`
+	IgnoredReason   string `json:"ignoredReason"`
+	IgnoredAtDate   string `json:"ignoredAtDate"`
+}
+
+// DiscoveredVendorAccount is an account found for a discovered vendor.
+type DiscoveredVendorAccount struct {
+	ID          string                        `json:"id"`
+	DisplayName string                        `json:"displayName"`
+	Type        string                        `json:"type"`
+	Owner       *DiscoveredVendorAccountOwner `json:"owner"`
+}
+
+type DiscoveredVendorAccountOwner struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Email       string `json:"email"`
+	Type        string `json:"type"`
+}
@@ -1,2 +1,4 @@
 // Package v1 provides a typed client for Vanta APIs.
 package v1
+
+//go:generate go run ../cmd/vanta-gen -collection "../Vanta Postman Env & Collection/Vanta API.postman_collection.json" -out .
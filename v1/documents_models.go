@@ -0,0 +1,52 @@
+package v1
+
+// Document is a document returned from documents and controls endpoints.
+type Document struct {
+	ID                DocumentID                 `json:"id"`
+	OwnerID           string                     `json:"ownerId"`
+	Category          string                     `json:"category"`
+	Description       string                     `json:"description"`
+	IsSensitive       bool                       `json:"isSensitive"`
+	Title             string                     `json:"title"`
+	UploadStatus      string                     `json:"uploadStatus"`
+	UploadStatusDate  string                     `json:"uploadStatusDate"`
+	URL               string                     `json:"url"`
+	Note              string                     `json:"note"`
+	NextRenewalDate   string                     `json:"nextRenewalDate"`
+	RenewalCadence    string                     `json:"renewalCadence"`
+	ReminderWindow    string                     `json:"reminderWindow"`
+	DeactivatedStatus *DocumentDeactivatedStatus `json:"deactivatedStatus"`
+	Subscribers       []any                      `json:"subscribers"`
+}
+
+type DocumentDeactivatedStatus struct {
+	IsDeactivated bool    `json:"isDeactivated"`
+	Reason        *string `json:"reason"`
+	Expiration    *string `json:"expiration"`
+	CreationDate  string  `json:"creationDate"`
+}
+
+// DocumentLink is an external link attached to a document.
+type DocumentLink struct {
+	ID            DocumentLinkID `json:"id"`
+	CreationDate  string         `json:"creationDate"`
+	EffectiveDate string         `json:"effectiveDate"`
+	Title         string         `json:"title"`
+	URL           string         `json:"url"`
+	Description   string         `json:"description"`
+}
+
+// DocumentUpload is a file uploaded as evidence for a document.
+type DocumentUpload struct {
+	ID            DocumentUploadID   `json:"id"`
+	FileName      string             `json:"fileName"`
+	Title         string             `json:"title"`
+	Description   string             `json:"description"`
+	URL           string             `json:"url"`
+	CreationDate  string             `json:"creationDate"`
+	UpdatedDate   string             `json:"updatedDate"`
+	EffectiveDate string             `json:"effectiveDate"`
+	DeletionDate  *string            `json:"deletionDate"`
+	MimeType      string             `json:"mimeType"`
+	UploadedBy    *UploaderReference `json:"uploadedBy"`
+}
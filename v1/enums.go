@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// enum is satisfied by the SDK's string enum types.
+type enum interface {
+	~string
+	Valid() bool
+}
+
+// unmarshalEnum decodes a JSON string or null into out. Values the SDK does
+// not know yet are kept as-is and the first one of each type is reported
+// through UnknownFieldWarningf, so new server-side values never break
+// decoding.
+func unmarshalEnum[E enum](data []byte, out *E) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == nil {
+		*out = ""
+		return nil
+	}
+	*out = E(*s)
+	warnUnknownEnum(*out)
+	return nil
+}
+
+// warnUnknownEnum reports the first value of each enum type that the SDK
+// does not know through UnknownFieldWarningf. Empty values are not reported.
+func warnUnknownEnum[E enum](value E) {
+	if value == "" || value.Valid() {
+		return
+	}
+	name := reflect.TypeFor[E]().Name()
+	warnOnce("enum "+name, "vanta-sdk-go: unknown enum value detected: %s %q", name, string(value))
+}
@@ -0,0 +1,128 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestEnumUnmarshalToleratesUnknownValues(t *testing.T) {
+	resetUnknownFieldWarningsForTest()
+	t.Cleanup(resetUnknownFieldWarningsForTest)
+
+	var warnings []string
+	previous := UnknownFieldWarningf
+	UnknownFieldWarningf = func(format string, args ...any) {
+		warnings = append(warnings, fmt.Sprintf(format, args...))
+	}
+	t.Cleanup(func() {
+		UnknownFieldWarningf = previous
+	})
+
+	var vulns []Vulnerability
+	data := `[{"severity": "HIGH"}, {"severity": "EXTREME"}, {"severity": "EXTREME"}, {"severity": null}]`
+	if err := decodeJSONBytes([]byte(data), &vulns); err != nil {
+		t.Fatalf("decode returned error: %v", err)
+	}
+	if vulns[0].Severity != VulnerabilitySeverityHigh || !vulns[0].Severity.Valid() {
+		t.Fatalf("severity = %q, want HIGH", vulns[0].Severity)
+	}
+	if vulns[1].Severity != "EXTREME" || vulns[1].Severity.Valid() {
+		t.Fatalf("unknown severity = %q, want EXTREME kept and invalid", vulns[1].Severity)
+	}
+	if vulns[3].Severity != "" {
+		t.Fatalf("null severity = %q, want empty", vulns[3].Severity)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], `VulnerabilitySeverity "EXTREME"`) {
+		t.Fatalf("warnings = %v, want one unknown enum warning", warnings)
+	}
+
+	var task PersonTaskType
+	if err := json.Unmarshal([]byte(`42`), &task); err == nil {
+		t.Fatal("expected error for non-string enum value")
+	}
+}
+
+func TestInvalidEnumParamsFailBeforeRequest(t *testing.T) {
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			t.Fatalf("unexpected request to %s", r.URL)
+			return nil, nil
+		}),
+	}
+	c, err := NewClient(WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	ctx := context.Background()
+
+	status := TestStatus("PASSING")
+	if _, err := c.Services.Tests.ListTests(ctx, &TestsListTestsParams{StatusFilter: &status}); err == nil || !strings.Contains(err.Error(), `statusFilter has invalid value "PASSING"`) {
+		t.Fatalf("ListTests error = %v", err)
+	}
+	severity := VulnerabilitySeverity("EXTREME")
+	if _, err := c.Services.Vulnerabilities.GetVulnerabilities(ctx, &VulnerabilitiesGetVulnerabilitiesParams{Severity: &severity}); err == nil || !strings.Contains(err.Error(), `severity has invalid value "EXTREME"`) {
+		t.Fatalf("GetVulnerabilities error = %v", err)
+	}
+}
+
+// Enums the collection only gives examples of may have values the SDK does
+// not know, so those are sent with a warning rather than rejected.
+func TestUndocumentedEnumParamsWarn(t *testing.T) {
+	resetUnknownFieldWarningsForTest()
+	t.Cleanup(resetUnknownFieldWarningsForTest)
+	var warnings []string
+	previous := UnknownFieldWarningf
+	UnknownFieldWarningf = func(format string, args ...any) {
+		warnings = append(warnings, fmt.Sprintf(format, args...))
+	}
+	t.Cleanup(func() {
+		UnknownFieldWarningf = previous
+	})
+
+	var requests []string
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			requests = append(requests, r.Method+" "+r.URL.RawQuery)
+			body := `{"results":{"data":[],"pageInfo":{}}}`
+			if r.Method == http.MethodPost {
+				body = `{}`
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}, nil
+		}),
+	}
+	c, err := NewClient(WithHTTPClient(httpClient), WithTokenSource(StaticTokenSource("token")))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	ctx := context.Background()
+
+	if _, err := c.Services.Vendors.ListVendors(ctx, &VendorsListVendorsParams{StatusMatchesAny: []VendorStatus{VendorStatusManaged, "UNDER_REVIEW"}}); err != nil {
+		t.Fatalf("ListVendors returned error: %v", err)
+	}
+	if _, err := c.Services.RiskScenarios.CreateRiskScenario(ctx, &RiskScenariosCreateRiskScenarioParams{
+		Body: &RiskScenariosCreateRiskScenarioRequestBody{Treatment: "Share"},
+	}); err != nil {
+		t.Fatalf("CreateRiskScenario returned error: %v", err)
+	}
+	if len(requests) != 2 || !strings.Contains(requests[0], "statusMatchesAny=UNDER_REVIEW") {
+		t.Fatalf("requests = %q", requests)
+	}
+	want := []string{`VendorStatus "UNDER_REVIEW"`, `RiskTreatment "Share"`}
+	if len(warnings) != len(want) {
+		t.Fatalf("warnings = %q, want %q", warnings, want)
+	}
+	for i, w := range want {
+		if !strings.Contains(warnings[i], w) {
+			t.Fatalf("warning %d = %q, want %s", i, warnings[i], w)
+		}
+	}
+}
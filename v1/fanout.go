@@ -0,0 +1,100 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// FanOutItemError is the failure of a single FanOut item.
+type FanOutItemError struct {
+	Index int
+	Err   error
+}
+
+func (e FanOutItemError) Error() string {
+	return fmt.Sprintf("item %d: %v", e.Index, e.Err)
+}
+
+func (e FanOutItemError) Unwrap() error {
+	return e.Err
+}
+
+// FanOutError collects every failed FanOut item, ordered by index.
+type FanOutError struct {
+	Items []FanOutItemError
+}
+
+func (e *FanOutError) Error() string {
+	if e == nil {
+		return "<nil>"
+	}
+	msgs := make([]string, 0, len(e.Items))
+	for _, item := range e.Items {
+		msgs = append(msgs, item.Error())
+	}
+	return fmt.Sprintf("%d fan-out items failed: %s", len(e.Items), strings.Join(msgs, "; "))
+}
+
+func (e *FanOutError) Unwrap() []error {
+	if e == nil {
+		return nil
+	}
+	errs := make([]error, 0, len(e.Items))
+	for _, item := range e.Items {
+		errs = append(errs, item)
+	}
+	return errs
+}
+
+// FanOut calls fn for every item with at most concurrency calls in flight and
+// returns the results in item order. A failing item does not stop the others;
+// its result is left as the zero value and its error is reported in the
+// returned *FanOutError. Items not started before ctx is done fail with
+// ctx.Err().
+//
+// The SDK has no client-side rate limiter, so concurrency is the only bound
+// on parallel requests; keep it within Vanta's documented rate limits.
+func FanOut[T, R any](ctx context.Context, items []T, concurrency int, fn func(context.Context, T) (R, error)) ([]R, error) {
+	if fn == nil {
+		return nil, fmt.Errorf("fan-out fn must not be nil")
+	}
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	results := make([]R, len(items))
+	errs := make([]error, len(items))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, item := range items {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			<-sem
+			errs[i] = err
+			continue
+		}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			results[i], errs[i] = fn(ctx, item)
+		})
+	}
+	wg.Wait()
+
+	var fanOutErr FanOutError
+	for i, err := range errs {
+		if err != nil {
+			fanOutErr.Items = append(fanOutErr.Items, FanOutItemError{Index: i, Err: err})
+		}
+	}
+	if len(fanOutErr.Items) > 0 {
+		return results, &fanOutErr
+	}
+	return results, nil
+}
@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFanOutKeepsOrderAndBoundsConcurrency(t *testing.T) {
+	var inFlight, maxInFlight int32
+	items := []int{5, 4, 3, 2, 1, 0}
+	errOdd := errors.New("odd")
+
+	results, err := FanOut(context.Background(), items, 2, func(_ context.Context, n int) (string, error) {
+		cur := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			prev := atomic.LoadInt32(&maxInFlight)
+			if cur <= prev || atomic.CompareAndSwapInt32(&maxInFlight, prev, cur) {
+				break
+			}
+		}
+		time.Sleep(time.Duration(n) * time.Millisecond)
+		if n%2 == 1 {
+			return "", errOdd
+		}
+		return fmt.Sprint(n), nil
+	})
+
+	if got := atomic.LoadInt32(&maxInFlight); got > 2 {
+		t.Fatalf("max in flight = %d, want <= 2", got)
+	}
+	want := []string{"", "4", "", "2", "", "0"}
+	for i := range want {
+		if results[i] != want[i] {
+			t.Fatalf("results = %q, want %q", results, want)
+		}
+	}
+
+	var fanOutErr *FanOutError
+	if !errors.As(err, &fanOutErr) {
+		t.Fatalf("expected *FanOutError, got %T: %v", err, err)
+	}
+	if len(fanOutErr.Items) != 3 || fanOutErr.Items[0].Index != 0 || fanOutErr.Items[1].Index != 2 || fanOutErr.Items[2].Index != 4 {
+		t.Fatalf("failed items = %+v, want indexes 0, 2, 4", fanOutErr.Items)
+	}
+	if !errors.Is(err, errOdd) {
+		t.Fatalf("expected errors.Is(err, errOdd), got %v", err)
+	}
+}
+
+func TestFanOutStopsStartingItemsAfterCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var started int32
+	_, err := FanOut(ctx, []int{0, 1, 2, 3}, 1, func(context.Context, int) (int, error) {
+		atomic.AddInt32(&started, 1)
+		cancel()
+		return 0, nil
+	})
+	if got := atomic.LoadInt32(&started); got != 1 {
+		t.Fatalf("started = %d, want 1", got)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
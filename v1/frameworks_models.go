@@ -0,0 +1,38 @@
+package v1
+
+// Framework is a compliance framework and its completion summary.
+type Framework struct {
+	ID                    FrameworkID                    `json:"id"`
+	DisplayName           string                         `json:"displayName"`
+	ShorthandName         string                         `json:"shorthandName"`
+	Description           string                         `json:"description"`
+	NumControlsCompleted  int                            `json:"numControlsCompleted"`
+	NumControlsTotal      int                            `json:"numControlsTotal"`
+	NumDocumentsPassing   int                            `json:"numDocumentsPassing"`
+	NumDocumentsTotal     int                            `json:"numDocumentsTotal"`
+	NumTestsPassing       int                            `json:"numTestsPassing"`
+	NumTestsTotal         int                            `json:"numTestsTotal"`
+	RequirementCategories []FrameworkRequirementCategory `json:"requirementCategories"`
+}
+
+type FrameworkRequirementCategory struct {
+	ID           string                 `json:"id"`
+	Name         string                 `json:"name"`
+	Shorthand    *string                `json:"shorthand"`
+	Requirements []FrameworkRequirement `json:"requirements"`
+}
+
+type FrameworkRequirement struct {
+	ID          string                        `json:"id"`
+	Name        string                        `json:"name"`
+	Shorthand   *string                       `json:"shorthand"`
+	Description string                        `json:"description"`
+	Controls    []FrameworkRequirementControl `json:"controls"`
+}
+
+type FrameworkRequirementControl struct {
+	ID          ControlID `json:"id"`
+	ExternalID  *string   `json:"externalId"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+}
@@ -0,0 +1,5075 @@
+// Code generated by vanta-gen. DO NOT EDIT.
+
+// Contract cases from the API source's request and response examples.
+
+package v1
+
+var contractCases = []contractCase{
+	{
+		Operation: "Controls.AddControlFromVantaLibrary",
+		Method:    "POST",
+		Path:      "/controls/add-from-library",
+		Params:    `{"Body":{"controlId":"string"}}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "externalId": "CRY-104",
+  "name": "Data encryption utilized",
+  "description": "Access reviews are performed to ensure that access is appropriate for the user's role and responsibilities.",
+  "source": "Vanta",
+  "domains": [
+    "CRYPTOGRAPHIC_PROTECTIONS"
+  ],
+  "owner": {
+    "id": "65e1efde08e8478f143a8ff9",
+    "emailAddress": "example-person@email.com",
+    "displayName": "Example Owner"
+  },
+  "role": "CONTROLLER",
+  "customFields": [
+    {
+      "label": "Additional context",
+      "value": "This control is critical for GDPR compliance"
+    }
+  ],
+  "creationDate": null,
+  "modificationDate": null
+}`,
+	},
+	{
+		Operation: "Controls.AddControlToDocumentMapping",
+		Method:    "POST",
+		Path:      "/controls/string/add-document-to-control",
+		Params:    `{"ControlID":"string","Body":{"documentId":"string"}}`,
+		Status:    200,
+		Response: `{
+  "control": {
+    "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+    "externalId": "CRY-104",
+    "name": "Data encryption utilized",
+    "description": "Access reviews are performed to ensure that access is appropriate for the user's role and responsibilities.",
+    "source": "Vanta",
+    "domains": [
+      "CRYPTOGRAPHIC_PROTECTIONS"
+    ],
+    "owner": {
+      "id": "65e1efde08e8478f143a8ff9",
+      "emailAddress": "example-person@email.com",
+      "displayName": "Example Owner"
+    },
+    "role": "CONTROLLER",
+    "customFields": [
+      {
+        "label": "Additional context",
+        "value": "This control is critical for GDPR compliance"
+      }
+    ],
+    "creationDate": null,
+    "modificationDate": null
+  },
+  "document": {
+    "id": "1",
+    "ownerId": "2",
+    "category": "Account setup",
+    "description": "Provide two examples of a recent access request and approval ",
+    "isSensitive": false,
+    "title": "Document Title",
+    "uploadStatus": "Needs document",
+    "uploadStatusDate": "2024-03-17T00:00:00.000Z",
+    "url": "https://example.com"
+  }
+}`,
+	},
+	{
+		Operation: "Controls.AddControlToTestMapping",
+		Method:    "POST",
+		Path:      "/controls/string/add-test-to-control",
+		Params:    `{"ControlID":"string","Body":{"testId":"string"}}`,
+		Status:    200,
+		Response: `{
+  "control": {
+    "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+    "externalId": "CRY-104",
+    "name": "Data encryption utilized",
+    "description": "Access reviews are performed to ensure that access is appropriate for the user's role and responsibilities.",
+    "source": "Vanta",
+    "domains": [
+      "CRYPTOGRAPHIC_PROTECTIONS"
+    ],
+    "owner": {
+      "id": "65e1efde08e8478f143a8ff9",
+      "emailAddress": "example-person@email.com",
+      "displayName": "Example Owner"
+    },
+    "role": "CONTROLLER",
+    "customFields": [
+      {
+        "label": "Additional context",
+        "value": "This control is critical for GDPR compliance"
+      }
+    ],
+    "creationDate": null,
+    "modificationDate": null
+  },
+  "test": {
+    "id": "aws-account-access-removed-on-termination",
+    "name": "AWS accounts deprovisioned when personnel leave",
+    "lastTestRunDate": "2024-06-18T20:17:38.463Z",
+    "latestFlipDate": null,
+    "description": "Verifies that AWS accounts linked to removed users are removed.\n",
+    "failureDescription": "Some AWS accounts associated with terminated personnel have not been deactivated.",
+    "remediationDescription": "Remove all accounts listed from AWS.\n",
+    "version": {
+      "major": 0,
+      "minor": 0
+    },
+    "category": "Account security",
+    "integrations": [
+      "aws"
+    ],
+    "status": "OK",
+    "deactivatedStatusInfo": {
+      "isDeactivated": false,
+      "deactivatedReason": null,
+      "lastUpdatedDate": null
+    },
+    "remediationStatusInfo": {
+      "status": "PASS",
+      "soonestRemediateByDate": null,
+      "itemCount": 0
+    },
+    "owner": null
+  }
+}`,
+	},
+	{
+		Operation: "Controls.CreateCustomControl",
+		Method:    "POST",
+		Path:      "/controls",
+		Params:    `{"Body":{"customFields":[{"label":"string","value":"string"},{"label":"string","value":"string"}],"description":"string","domain":"PHYSICAL_\u0026_ENVIRONMENTAL_SECURITY","effectiveDate":"2005-08-31T01:24:23.554Z","externalId":"string","name":"string","role":"PROCESSOR","sections":[{"frameworkId":"string","sectionId":"string"},{"frameworkId":"PCI_SAQ_D_SP","sectionId":"string"}]}}`,
+		Status:    201,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "externalId": "CRY-104",
+  "name": "Data encryption utilized",
+  "description": "Access reviews are performed to ensure that access is appropriate for the user's role and responsibilities.",
+  "source": "Vanta",
+  "domains": [
+    "CRYPTOGRAPHIC_PROTECTIONS"
+  ],
+  "owner": {
+    "id": "65e1efde08e8478f143a8ff9",
+    "emailAddress": "example-person@email.com",
+    "displayName": "Example Owner"
+  },
+  "role": "CONTROLLER",
+  "customFields": [
+    {
+      "label": "Additional context",
+      "value": "This control is critical for GDPR compliance"
+    }
+  ],
+  "creationDate": null,
+  "modificationDate": null
+}`,
+	},
+	{
+		Operation: "Controls.GetControlByID",
+		Method:    "GET",
+		Path:      "/controls/string",
+		Params:    `{"ControlID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "externalId": "CRY-104",
+  "name": "Data encryption utilized",
+  "description": "Access reviews are performed to ensure that access is appropriate for the user's role and responsibilities.",
+  "source": "Vanta",
+  "domains": [
+    "CRYPTOGRAPHIC_PROTECTIONS"
+  ],
+  "owner": {
+    "id": "65e1efde08e8478f143a8ff9",
+    "emailAddress": "example-person@email.com",
+    "displayName": "Example Owner"
+  },
+  "note": "Remember to do by Friday",
+  "numDocumentsPassing": 1,
+  "numDocumentsTotal": 1,
+  "numTestsPassing": 2,
+  "numTestsTotal": 3,
+  "status": "IN_PROGRESS",
+  "role": "CONTROLLER",
+  "customFields": [
+    {
+      "label": "Additional context",
+      "value": "This control is critical for GDPR compliance"
+    }
+  ],
+  "creationDate": null,
+  "modificationDate": null
+}`,
+	},
+	{
+		Operation: "Controls.ListControls",
+		Method:    "GET",
+		Path:      "/controls",
+		Query:     "frameworkMatchesAny=string&frameworkMatchesAny=string&pageCursor=string&pageSize=10",
+		Params:    `{"PageSize":10,"PageCursor":"string","FrameworkMatchesAny":["string","string"]}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+        "externalId": "CRY-104",
+        "name": "Data encryption utilized",
+        "description": "Access reviews are performed to ensure that access is appropriate for the user's role and responsibilities.",
+        "source": "Vanta",
+        "domains": [
+          "CRYPTOGRAPHIC_PROTECTIONS"
+        ],
+        "owner": {
+          "id": "65e1efde08e8478f143a8ff9",
+          "emailAddress": "example-person@email.com",
+          "displayName": "Example Owner"
+        },
+        "role": "CONTROLLER",
+        "customFields": [
+          {
+            "label": "Additional context",
+            "value": "This control is critical for GDPR compliance"
+          }
+        ],
+        "creationDate": null,
+        "modificationDate": null
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "YXJyYXljb25uZWN0aW9uOjA=",
+      "endCursor": "YXJyYXljb25uZWN0aW9uOjE="
+    },
+    "totalCount": 1
+  }
+}`,
+	},
+	{
+		Operation: "Controls.ListControlsDocuments",
+		Method:    "GET",
+		Path:      "/controls/string/documents",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"ControlID":"string","PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "1",
+        "ownerId": "2",
+        "category": "Account setup",
+        "description": "Provide two examples of a recent access request and approval ",
+        "isSensitive": false,
+        "title": "Document Title",
+        "uploadStatus": "Needs document",
+        "uploadStatusDate": "2024-03-17T00:00:00.000Z",
+        "url": "https://example.com"
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "1",
+      "endCursor": "1"
+    }
+  }
+}`,
+	},
+	{
+		Operation: "Controls.ListControlsTests",
+		Method:    "GET",
+		Path:      "/controls/string/tests",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"ControlID":"string","PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "aws-account-access-removed-on-termination",
+        "name": "AWS accounts deprovisioned when personnel leave",
+        "lastTestRunDate": "2024-06-18T20:17:38.463Z",
+        "latestFlipDate": null,
+        "description": "Verifies that AWS accounts linked to removed users are removed.\n",
+        "failureDescription": "Some AWS accounts associated with terminated personnel have not been deactivated.",
+        "remediationDescription": "Remove all accounts listed from AWS.\n",
+        "version": {
+          "major": 0,
+          "minor": 0
+        },
+        "category": "Account security",
+        "integrations": [
+          "aws"
+        ],
+        "status": "OK",
+        "deactivatedStatusInfo": {
+          "isDeactivated": false,
+          "deactivatedReason": null,
+          "lastUpdatedDate": null
+        },
+        "remediationStatusInfo": {
+          "status": "PASS",
+          "soonestRemediateByDate": null,
+          "itemCount": 0
+        },
+        "owner": null
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "1",
+      "endCursor": "1"
+    }
+  }
+}`,
+	},
+	{
+		Operation: "Controls.ListVantaControlsFromLibrary",
+		Method:    "GET",
+		Path:      "/controls/controls-library",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+        "externalId": "CRY-104",
+        "name": "Data encryption utilized",
+        "description": "Access reviews are performed to ensure that access is appropriate for the user's role and responsibilities.",
+        "source": "Vanta",
+        "domains": [
+          "CRYPTOGRAPHIC_PROTECTIONS"
+        ],
+        "owner": {
+          "id": "65e1efde08e8478f143a8ff9",
+          "emailAddress": "example-person@email.com",
+          "displayName": "Example Owner"
+        },
+        "role": "CONTROLLER",
+        "customFields": [
+          {
+            "label": "Additional context",
+            "value": "This control is critical for GDPR compliance"
+          }
+        ],
+        "creationDate": null,
+        "modificationDate": null
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "YXJyYXljb25uZWN0aW9uOjA=",
+      "endCursor": "YXJyYXljb25uZWN0aW9uOjE="
+    },
+    "totalCount": 1
+  }
+}`,
+	},
+	{
+		Operation: "Controls.RemoveControl",
+		Method:    "DELETE",
+		Path:      "/controls/string",
+		Params:    `{"ControlID":"string"}`,
+		Status:    204,
+	},
+	{
+		Operation: "Controls.RemoveControlFromDocumentMapping",
+		Method:    "DELETE",
+		Path:      "/controls/string/documents/string",
+		Params:    `{"ControlID":"string","DocumentID":"string"}`,
+		Status:    204,
+	},
+	{
+		Operation: "Controls.RemoveControlFromTestMapping",
+		Method:    "DELETE",
+		Path:      "/controls/string/tests/string",
+		Params:    `{"ControlID":"string","TestID":"string"}`,
+		Status:    204,
+	},
+	{
+		Operation: "Controls.SetOwnerOfControl",
+		Method:    "POST",
+		Path:      "/controls/string/set-owner",
+		Params:    `{"ControlID":"string","Body":{"userId":"string"}}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "externalId": "CRY-104",
+  "name": "Data encryption utilized",
+  "description": "Access reviews are performed to ensure that access is appropriate for the user's role and responsibilities.",
+  "source": "Vanta",
+  "domains": [
+    "CRYPTOGRAPHIC_PROTECTIONS"
+  ],
+  "owner": {
+    "id": "65e1efde08e8478f143a8ff9",
+    "emailAddress": "example-person@email.com",
+    "displayName": "Example Owner"
+  },
+  "role": "CONTROLLER",
+  "customFields": [
+    {
+      "label": "Additional context",
+      "value": "This control is critical for GDPR compliance"
+    }
+  ],
+  "creationDate": null,
+  "modificationDate": null
+}`,
+	},
+	{
+		Operation: "Controls.UpdateControlsMetadata",
+		Method:    "PATCH",
+		Path:      "/controls/string",
+		Params:    `{"ControlID":"string","Body":{"customFields":[{"label":"string","value":"string"},{"label":"string","value":"string"}],"description":"string","domain":"SECURE_ENGINEERING_\u0026_ARCHITECTURE","externalId":"string","name":"string","note":"string"}}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "externalId": "CRY-104",
+  "name": "Data encryption utilized",
+  "description": "Access reviews are performed to ensure that access is appropriate for the user's role and responsibilities.",
+  "source": "Vanta",
+  "domains": [
+    "CRYPTOGRAPHIC_PROTECTIONS"
+  ],
+  "owner": {
+    "id": "65e1efde08e8478f143a8ff9",
+    "emailAddress": "example-person@email.com",
+    "displayName": "Example Owner"
+  },
+  "role": "CONTROLLER",
+  "customFields": [
+    {
+      "label": "Additional context",
+      "value": "This control is critical for GDPR compliance"
+    }
+  ],
+  "creationDate": null,
+  "modificationDate": null
+}`,
+	},
+	{
+		Operation: "DiscoveredVendors.AddsDiscoveredVendorToManagedVendorByID",
+		Method:    "POST",
+		Path:      "/discovered-vendors/string/add-to-managed",
+		Params:    `{"DiscoveredVendorID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d8",
+  "name": "Vanta",
+  "websiteUrl": "https://www.vanta.com/",
+  "accountManagerName": "John Doe",
+  "accountManagerEmail": "john@doe.com",
+  "servicesProvided": "SaaS",
+  "additionalNotes": "Automate compliance and streamline security reviews with the leading trust management platform.",
+  "authDetails": {
+    "method": "O_AUTH",
+    "passwordMFA": true,
+    "passwordRequiresNumber": true,
+    "passwordRequiresSymbol": true,
+    "passwordMinimumLength": 16
+  },
+  "securityOwnerUserId": "6626afa6490ec920099773e7",
+  "businessOwnerUserId": "6626afb14c912f0a50e85619",
+  "contractStartDate": "2024-02-01T00:00:00.000Z",
+  "contractRenewalDate": "2025-02-01T00:00:00.000Z",
+  "contractTerminationDate": null,
+  "lastSecurityReviewCompletionDate": "2024-01-01T00:00:00.000Z",
+  "nextSecurityReviewDueDate": "2025-01-01T00:00:00.000Z",
+  "isVisibleToAuditors": true,
+  "isRiskAutoScored": true,
+  "category": {
+    "displayName": "cloudMonitoring"
+  },
+  "riskAttributeIds": [
+    "6626b0298acc44f8674390da",
+    "6626b02ea4cd9ba80d773c20"
+  ],
+  "status": "MANAGED",
+  "inherentRiskLevel": "HIGH",
+  "residualRiskLevel": "MEDIUM",
+  "vendorHeadquarters": "USA",
+  "contractAmount": {
+    "amount": 1000000,
+    "currency": "USD"
+  },
+  "customFields": null,
+  "tagIdentifiers": null,
+  "latestDecision": {
+    "status": "APPROVED",
+    "lastUpdatedAt": "2024-01-01T00:00:00.000Z"
+  }
+}`,
+	},
+	{
+		Operation: "DiscoveredVendors.ListDiscoveredVendors",
+		Method:    "GET",
+		Path:      "/discovered-vendors",
+		Query:     "pageCursor=string&pageSize=10&scope=NEEDS_REVIEW",
+		Params:    `{"Scope":"NEEDS_REVIEW","PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "a2f7e1b9d0c3f4e5a6c7b8d8",
+        "name": "Vanta",
+        "category": {
+          "name": "Engineering"
+        },
+        "source": "JAMF",
+        "normalizedName": "vanta",
+        "discoveredDate": "2024-01-01T00:00:00.000Z",
+        "numberOfAccounts": 7,
+        "ignored": {
+          "ignoredByUserId": "6626afb14c912f0a50e85619",
+          "ignoredReason": "reason",
+          "ignoredAtDate": "2024-02-01T00:00:00.000Z"
+        },
+        "rejected": null
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "6696ea0595df50d5cd6ec3b7",
+      "endCursor": "6696ece48eb1f98ff3d927c6"
+    }
+  }
+}`,
+	},
+	{
+		Operation: "DiscoveredVendors.ListOfDiscoveredVendorAccounts",
+		Method:    "GET",
+		Path:      "/discovered-vendors/string/accounts",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"DiscoveredVendorID":"string","PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "66c6578ce02cc3a3483024d1",
+        "displayName": "Example Computer",
+        "type": "COMPUTER",
+        "owner": {
+          "id": "a2f7e1b9d0c3f4e5a6c7b8d8",
+          "displayName": "Example user",
+          "email": "example@example.com",
+          "type": "USER"
+        }
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "6696ea0595df50d5cd6ec3b7",
+      "endCursor": "6696ece48eb1f98ff3d927c6"
+    }
+  }
+}`,
+	},
+	{
+		Operation: "Documents.CreateCustomDocument",
+		Method:    "POST",
+		Path:      "/documents",
+		Params:    `{"Body":{"cadence":"P6M","description":"string","isSensitive":false,"reminderWindow":"P1W","timeSensitivity":"DURING_AUDIT_WINDOW","title":"string"}}`,
+		Status:    201,
+		Response: `{
+  "id": "1",
+  "ownerId": "2",
+  "category": "Account setup",
+  "description": "Provide two examples of a recent access request and approval ",
+  "isSensitive": false,
+  "title": "Document Title",
+  "uploadStatus": "Needs document",
+  "uploadStatusDate": "2024-03-17T00:00:00.000Z",
+  "url": "https://example.com"
+}`,
+	},
+	{
+		Operation: "Documents.CreateDocumentLink",
+		Method:    "POST",
+		Path:      "/documents/string/links",
+		Params:    `{"DocumentID":"string","Body":{"description":"string","effectiveDate":"1986-01-23T14:45:00.745Z","title":"string","url":"string"}}`,
+		Status:    201,
+		Response: `{
+  "id": "1",
+  "creationDate": "2024-06-26T00:00:00.000Z",
+  "effectiveDate": "2024-07-01T00:00:00.000Z",
+  "title": "example link",
+  "url": "https://example.com/",
+  "description": "example link"
+}`,
+	},
+	{
+		Operation: "Documents.DeleteDocumentByID",
+		Method:    "DELETE",
+		Path:      "/documents/string",
+		Params:    `{"DocumentID":"string"}`,
+		Status:    204,
+	},
+	{
+		Operation: "Documents.DeleteFileForDocument",
+		Method:    "DELETE",
+		Path:      "/documents/string/uploads/string",
+		Params:    `{"DocumentID":"string","UploadedFileID":"string"}`,
+		Status:    204,
+	},
+	{
+		Operation: "Documents.DownloadFileForDocument",
+		Method:    "GET",
+		Path:      "/documents/string/uploads/string/media",
+		Params:    `{"DocumentID":"string","UploadedFileID":"string"}`,
+		Status:    200,
+		Response: `{
+  "readable": true
+}`,
+	},
+	{
+		Operation: "Documents.GetDocumentByID",
+		Method:    "GET",
+		Path:      "/documents/string",
+		Params:    `{"DocumentID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "access-requests",
+  "title": "Access request ticket and history",
+  "description": "Provide two examples of a recent access request and approval ",
+  "isSensitive": false,
+  "uploadStatusDate": "2024-03-17T00:00:00.000Z",
+  "category": "Account setup",
+  "uploadStatus": "Needs document",
+  "url": "https://example.com",
+  "ownerId": "1",
+  "note": "Example document note",
+  "nextRenewalDate": "2025-03-17T00:00:00.000Z",
+  "renewalCadence": "P1Y",
+  "reminderWindow": "P1M",
+  "deactivatedStatus": {
+    "isDeactivated": false,
+    "reason": null,
+    "expiration": null,
+    "creationDate": "2024-03-02T00:00:00.000Z"
+  },
+  "subscribers": []
+}`,
+	},
+	{
+		Operation: "Documents.ListDocuments",
+		Method:    "GET",
+		Path:      "/documents",
+		Query:     "frameworkMatchesAny=string&frameworkMatchesAny=string&pageCursor=string&pageSize=10&statusMatchesAny=OK&statusMatchesAny=OK",
+		Params:    `{"PageSize":10,"PageCursor":"string","FrameworkMatchesAny":["string","string"],"StatusMatchesAny":["OK","OK"]}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "1",
+        "ownerId": "2",
+        "category": "Account setup",
+        "description": "Provide two examples of a recent access request and approval ",
+        "isSensitive": false,
+        "title": "Document Title",
+        "uploadStatus": "Needs document",
+        "uploadStatusDate": "2024-03-17T00:00:00.000Z",
+        "url": "https://example.com"
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "1",
+      "endCursor": "1"
+    }
+  }
+}`,
+	},
+	{
+		Operation: "Documents.ListDocumentsControls",
+		Method:    "GET",
+		Path:      "/documents/string/controls",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"DocumentID":"string","PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "1",
+        "ownerId": "2",
+        "category": "Account setup",
+        "description": "Provide two examples of a recent access request and approval ",
+        "isSensitive": false,
+        "title": "Document Title",
+        "uploadStatus": "Needs document",
+        "uploadStatusDate": "2024-03-17T00:00:00.000Z",
+        "url": "https://example.com"
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "1",
+      "endCursor": "1"
+    }
+  }
+}`,
+	},
+	{
+		Operation: "Documents.ListDocumentsControls",
+		Method:    "GET",
+		Path:      "/documents/string/controls",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"DocumentID":"string","PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+        "externalId": "CRY-104",
+        "name": "Data encryption utilized",
+        "description": "Access reviews are performed to ensure that access is appropriate for the user's role and responsibilities.",
+        "source": "Vanta",
+        "domains": [
+          "CRYPTOGRAPHIC_PROTECTIONS"
+        ],
+        "owner": {
+          "id": "65e1efde08e8478f143a8ff9",
+          "emailAddress": "example-person@email.com",
+          "displayName": "Example Owner"
+        },
+        "role": "CONTROLLER",
+        "customFields": [
+          {
+            "label": "Additional context",
+            "value": "This control is critical for GDPR compliance"
+          }
+        ],
+        "creationDate": null,
+        "modificationDate": null
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "YXJyYXljb25uZWN0aW9uOjA=",
+      "endCursor": "YXJyYXljb25uZWN0aW9uOjE="
+    },
+    "totalCount": 1
+  }
+}`,
+	},
+	{
+		Operation: "Documents.ListDocumentsLinks",
+		Method:    "GET",
+		Path:      "/documents/string/links",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"DocumentID":"string","PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "1",
+        "creationDate": "2024-06-26T00:00:00.000Z",
+        "effectiveDate": "2024-07-01T00:00:00.000Z",
+        "title": "example link",
+        "url": "https://example.com/",
+        "description": "example link"
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "1",
+      "endCursor": "1"
+    }
+  }
+}`,
+	},
+	{
+		Operation: "Documents.ListDocumentsUploads",
+		Method:    "GET",
+		Path:      "/documents/string/uploads",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"DocumentID":"string","PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "1",
+        "fileName": "Document Name",
+        "title": "Document title",
+        "description": "Document Description",
+        "url": "https://example.com",
+        "creationDate": "2024-03-17T00:00:00.000Z",
+        "updatedDate": "2024-03-18T00:00:00.000Z",
+        "effectiveDate": "2024-03-17T00:00:00.000Z",
+        "deletionDate": null,
+        "mimeType": "application/pdf",
+        "uploadedBy": {
+          "id": "66993da0cf4ba2ad40599ba7",
+          "type": "USER"
+        }
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "1",
+      "endCursor": "1"
+    }
+  }
+}`,
+	},
+	{
+		Operation: "Documents.RemoveDocumentLink",
+		Method:    "DELETE",
+		Path:      "/documents/string/links/string",
+		Params:    `{"DocumentID":"string","LinkID":"string"}`,
+		Status:    204,
+	},
+	{
+		Operation: "Documents.SetDocumentOwner",
+		Method:    "POST",
+		Path:      "/documents/string/set-owner",
+		Params:    `{"DocumentID":"string","Body":{"userId":"string"}}`,
+		Status:    200,
+		Response: `{
+  "id": "1",
+  "ownerId": "2",
+  "category": "Account setup",
+  "description": "Provide two examples of a recent access request and approval ",
+  "isSensitive": false,
+  "title": "Document Title",
+  "uploadStatus": "Needs document",
+  "uploadStatusDate": "2024-03-17T00:00:00.000Z",
+  "url": "https://example.com"
+}`,
+	},
+	{
+		Operation: "Documents.SubmitDocumentCollection",
+		Method:    "POST",
+		Path:      "/documents/string/submit",
+		Params:    `{"DocumentID":"string"}`,
+		Status:    204,
+	},
+	{
+		Operation: "Documents.UploadFileForDocument",
+		Method:    "POST",
+		Path:      "/documents/string/uploads",
+		Params:    `{"DocumentID":"string"}`,
+		Status:    201,
+		Response: `{
+  "id": "1",
+  "fileName": "Document Name",
+  "title": "Document title",
+  "description": "Document Description",
+  "url": "https://example.com",
+  "creationDate": "2024-03-17T00:00:00.000Z",
+  "updatedDate": "2024-03-18T00:00:00.000Z",
+  "effectiveDate": "2024-03-17T00:00:00.000Z",
+  "deletionDate": null,
+  "mimeType": "application/pdf",
+  "uploadedBy": {
+    "id": "66993da0cf4ba2ad40599ba7",
+    "type": "USER"
+  }
+}`,
+	},
+	{
+		Operation: "Frameworks.GetFrameworkByID",
+		Method:    "GET",
+		Path:      "/frameworks/string",
+		Params:    `{"FrameworkID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "soc2",
+  "displayName": "SOC 2",
+  "shorthandName": "SOC 2",
+  "description": "AICPA standardized framework to prove a company’s security posture to prospective customers. For all US and international businesses.",
+  "numControlsCompleted": 43,
+  "numControlsTotal": 86,
+  "numDocumentsPassing": 7,
+  "numDocumentsTotal": 16,
+  "numTestsPassing": 21,
+  "numTestsTotal": 46,
+  "requirementCategories": [
+    {
+      "id": "CC 1.0",
+      "name": "Control Environment",
+      "shorthand": null,
+      "requirements": [
+        {
+          "id": "CC 1.1",
+          "name": "",
+          "shorthand": null,
+          "description": "COSO Principle 1: The entity demonstrates a commitment to integrity and ethical values.",
+          "controls": [
+            {
+              "id": "background-checks-performed",
+              "externalId": null,
+              "name": "Personnel background checks performed",
+              "description": "The company performs background checks on new personnel."
+            }
+          ]
+        }
+      ]
+    }
+  ]
+}`,
+	},
+	{
+		Operation: "Frameworks.ListAvailableFrameworks",
+		Method:    "GET",
+		Path:      "/frameworks",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "soc2",
+        "displayName": "SOC 2",
+        "shorthandName": "SOC 2",
+        "description": "AICPA standardized framework to prove a company’s security posture to prospective customers. For all US and international businesses.",
+        "numControlsCompleted": 43,
+        "numControlsTotal": 86,
+        "numDocumentsPassing": 7,
+        "numDocumentsTotal": 16,
+        "numTestsPassing": 21,
+        "numTestsTotal": 46
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "aXNvMjcwMDFfMjAyMg==",
+      "endCursor": "aXNvMjcwMDFfMjAyMg=="
+    }
+  }
+}`,
+	},
+	{
+		Operation: "Frameworks.ListFrameworksControls",
+		Method:    "GET",
+		Path:      "/frameworks/string/controls",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"FrameworkID":"string","PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+        "externalId": "CRY-104",
+        "name": "Data encryption utilized",
+        "description": "Access reviews are performed to ensure that access is appropriate for the user's role and responsibilities.",
+        "source": "Vanta",
+        "domains": [
+          "CRYPTOGRAPHIC_PROTECTIONS"
+        ],
+        "owner": {
+          "id": "65e1efde08e8478f143a8ff9",
+          "emailAddress": "example-person@email.com",
+          "displayName": "Example Owner"
+        },
+        "role": "CONTROLLER",
+        "customFields": [
+          {
+            "label": "Additional context",
+            "value": "This control is critical for GDPR compliance"
+          }
+        ],
+        "creationDate": null,
+        "modificationDate": null
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "YXJyYXljb25uZWN0aW9uOjA=",
+      "endCursor": "YXJyYXljb25uZWN0aW9uOjE="
+    },
+    "totalCount": 1
+  }
+}`,
+	},
+	{
+		Operation: "Groups.AddPeopleToGroup",
+		Method:    "POST",
+		Path:      "/groups/string/add-people",
+		Params:    `{"GroupID":"string","Body":{"updates":[{"id":"string"}]}}`,
+		Status:    200,
+		Response: `{
+  "results": [
+    {
+      "id": "person-id-1",
+      "status": "SUCCESS"
+    },
+    {
+      "id": "person-id-2",
+      "status": "ERROR",
+      "message": "Invalid Input"
+    }
+  ]
+}`,
+	},
+	{
+		Operation: "Groups.AddPersonToGroup",
+		Method:    "POST",
+		Path:      "/groups/string/people",
+		Params:    `{"GroupID":"string","Body":{"id":"string"}}`,
+		Status:    200,
+		Response: `{
+  "id": "65e1efde08e8478f143a8ff9",
+  "emailAddress": "example-person@email.com",
+  "employment": {
+    "endDate": null,
+    "jobTitle": "Customer success manager",
+    "startDate": "2021-01-01T00:00:00.000Z",
+    "status": "CURRENT"
+  },
+  "leaveInfo": null,
+  "groupIds": [
+    "5f2c939a52855e725c8d5824"
+  ],
+  "name": {
+    "display": "Example Person",
+    "last": "Person",
+    "first": "Example"
+  },
+  "sources": {
+    "emailAddress": {
+      "integrationId": "gsuiteadmin",
+      "resourceId": "660c701d3d344e660b032306",
+      "type": "INTEGRATION"
+    },
+    "employment": {
+      "startDate": {
+        "integrationId": "gusto",
+        "resourceId": "660c70783d344e660b032323",
+        "type": "INTEGRATION"
+      },
+      "endDate": {
+        "integrationId": "gusto",
+        "resourceId": "660c70783d344e660b032323",
+        "type": "INTEGRATION"
+      }
+    }
+  },
+  "tasksSummary": {
+    "completionDate": null,
+    "dueDate": "2021-12-01T00:00:00.000Z",
+    "status": "OVERDUE",
+    "details": {
+      "completeTrainings": {
+        "taskType": "COMPLETE_TRAININGS",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": {
+          "date": "2021-11-01T00:00:00.000Z",
+          "reason": "Training Vanta tests have been disabled for this person"
+        },
+        "incompleteTrainings": [
+          {
+            "name": "Security training 1"
+          },
+          {
+            "name": "Security training 2"
+          }
+        ],
+        "completedTrainings": [
+          {
+            "name": "Security training 3"
+          },
+          {
+            "name": "Security training 4"
+          }
+        ]
+      },
+      "acceptPolicies": {
+        "taskType": "ACCEPT_POLICIES",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": null,
+        "unacceptedPolicies": [
+          {
+            "name": "Policy 1"
+          },
+          {
+            "name": "Policy 2"
+          }
+        ],
+        "acceptedPolicies": [
+          {
+            "name": "Policy 3"
+          },
+          {
+            "name": "Policy 4"
+          }
+        ]
+      },
+      "completeCustomTasks": {
+        "taskType": "COMPLETE_CUSTOM_TASKS",
+        "status": "OVERDUE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": {
+          "date": "2021-11-01T00:00:00.000Z",
+          "reason": "Custom task Vanta tests have been disabled for this person"
+        },
+        "incompleteCustomTasks": [
+          {
+            "name": "Custom task 1"
+          },
+          {
+            "name": "Custom task 2"
+          }
+        ],
+        "completedCustomTasks": [
+          {
+            "name": "Custom task 3"
+          },
+          {
+            "name": "Custom task 4"
+          }
+        ]
+      },
+      "completeOffboardingCustomTasks": {
+        "taskType": "COMPLETE_CUSTOM_OFFBOARDING_TASKS",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": null,
+        "incompleteCustomOffboardingTasks": [],
+        "completedCustomOffboardingTasks": [
+          {
+            "name": "Custom offboarding task 1"
+          },
+          {
+            "name": "Custom offboarding task 2"
+          }
+        ]
+      },
+      "installDeviceMonitoring": {
+        "taskType": "INSTALL_DEVICE_MONITORING",
+        "status": "DUE_SOON",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": null,
+        "disabled": null
+      },
+      "completeBackgroundChecks": {
+        "taskType": "COMPLETE_BACKGROUND_CHECKS",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": null
+      }
+    }
+  }
+}`,
+	},
+	{
+		Operation: "Groups.GetGroupByID",
+		Method:    "GET",
+		Path:      "/groups/string",
+		Params:    `{"GroupID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "5f2c939a52855e725c8d5824",
+  "name": "Default Group",
+  "creationDate": "2024-03-07T18:46:05.944Z"
+}`,
+	},
+	{
+		Operation: "Groups.ListGroups",
+		Method:    "GET",
+		Path:      "/groups",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "5f2c939a52855e725c8d5824",
+        "name": "Default Group",
+        "creationDate": "2024-03-07T18:46:05.944Z"
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "5f2c939a52855e725c8d5824",
+      "endCursor": "5f2c939a52855e725c8d5824"
+    }
+  }
+}`,
+	},
+	{
+		Operation: "Groups.ListPeopleInGroup",
+		Method:    "GET",
+		Path:      "/groups/string/people",
+		Params:    `{"GroupID":"string"}`,
+		Status:    200,
+		Response: `[
+  {
+    "id": "65e1efde08e8478f143a8ff9",
+    "emailAddress": "example-person@email.com",
+    "employment": {
+      "endDate": null,
+      "jobTitle": "Customer success manager",
+      "startDate": "2021-01-01T00:00:00.000Z",
+      "status": "CURRENT"
+    },
+    "leaveInfo": null,
+    "groupIds": [
+      "5f2c939a52855e725c8d5824"
+    ],
+    "name": {
+      "display": "Example Person",
+      "last": "Person",
+      "first": "Example"
+    },
+    "sources": {
+      "emailAddress": {
+        "integrationId": "gsuiteadmin",
+        "resourceId": "660c701d3d344e660b032306",
+        "type": "INTEGRATION"
+      },
+      "employment": {
+        "startDate": {
+          "integrationId": "gusto",
+          "resourceId": "660c70783d344e660b032323",
+          "type": "INTEGRATION"
+        },
+        "endDate": {
+          "integrationId": "gusto",
+          "resourceId": "660c70783d344e660b032323",
+          "type": "INTEGRATION"
+        }
+      }
+    },
+    "tasksSummary": {
+      "completionDate": null,
+      "dueDate": "2021-12-01T00:00:00.000Z",
+      "status": "OVERDUE",
+      "details": {
+        "completeTrainings": {
+          "taskType": "COMPLETE_TRAININGS",
+          "status": "COMPLETE",
+          "dueDate": "2021-12-01T00:00:00.000Z",
+          "completionDate": "2021-11-01T00:00:00.000Z",
+          "disabled": {
+            "date": "2021-11-01T00:00:00.000Z",
+            "reason": "Training Vanta tests have been disabled for this person"
+          },
+          "incompleteTrainings": [
+            {
+              "name": "Security training 1"
+            },
+            {
+              "name": "Security training 2"
+            }
+          ],
+          "completedTrainings": [
+            {
+              "name": "Security training 3"
+            },
+            {
+              "name": "Security training 4"
+            }
+          ]
+        },
+        "acceptPolicies": {
+          "taskType": "ACCEPT_POLICIES",
+          "status": "COMPLETE",
+          "dueDate": "2021-12-01T00:00:00.000Z",
+          "completionDate": "2021-11-01T00:00:00.000Z",
+          "disabled": null,
+          "unacceptedPolicies": [
+            {
+              "name": "Policy 1"
+            },
+            {
+              "name": "Policy 2"
+            }
+          ],
+          "acceptedPolicies": [
+            {
+              "name": "Policy 3"
+            },
+            {
+              "name": "Policy 4"
+            }
+          ]
+        },
+        "completeCustomTasks": {
+          "taskType": "COMPLETE_CUSTOM_TASKS",
+          "status": "OVERDUE",
+          "dueDate": "2021-12-01T00:00:00.000Z",
+          "completionDate": "2021-11-01T00:00:00.000Z",
+          "disabled": {
+            "date": "2021-11-01T00:00:00.000Z",
+            "reason": "Custom task Vanta tests have been disabled for this person"
+          },
+          "incompleteCustomTasks": [
+            {
+              "name": "Custom task 1"
+            },
+            {
+              "name": "Custom task 2"
+            }
+          ],
+          "completedCustomTasks": [
+            {
+              "name": "Custom task 3"
+            },
+            {
+              "name": "Custom task 4"
+            }
+          ]
+        },
+        "completeOffboardingCustomTasks": {
+          "taskType": "COMPLETE_CUSTOM_OFFBOARDING_TASKS",
+          "status": "COMPLETE",
+          "dueDate": "2021-12-01T00:00:00.000Z",
+          "completionDate": "2021-11-01T00:00:00.000Z",
+          "disabled": null,
+          "incompleteCustomOffboardingTasks": [],
+          "completedCustomOffboardingTasks": [
+            {
+              "name": "Custom offboarding task 1"
+            },
+            {
+              "name": "Custom offboarding task 2"
+            }
+          ]
+        },
+        "installDeviceMonitoring": {
+          "taskType": "INSTALL_DEVICE_MONITORING",
+          "status": "DUE_SOON",
+          "dueDate": "2021-12-01T00:00:00.000Z",
+          "completionDate": null,
+          "disabled": null
+        },
+        "completeBackgroundChecks": {
+          "taskType": "COMPLETE_BACKGROUND_CHECKS",
+          "status": "COMPLETE",
+          "dueDate": "2021-12-01T00:00:00.000Z",
+          "completionDate": "2021-11-01T00:00:00.000Z",
+          "disabled": null
+        }
+      }
+    }
+  }
+]`,
+	},
+	{
+		Operation: "Groups.RemovePeopleFromGroup",
+		Method:    "POST",
+		Path:      "/groups/string/remove-people",
+		Params:    `{"GroupID":"string","Body":{"updates":[{"id":"string"}]}}`,
+		Status:    200,
+		Response: `{
+  "results": [
+    {
+      "id": "person-id-1",
+      "status": "SUCCESS"
+    },
+    {
+      "id": "person-id-2",
+      "status": "ERROR",
+      "message": "Invalid Input"
+    }
+  ]
+}`,
+	},
+	{
+		Operation: "Groups.RemovePersonFromGroup",
+		Method:    "DELETE",
+		Path:      "/groups/string/people/string",
+		Params:    `{"GroupID":"string","PersonID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "65e1efde08e8478f143a8ff9",
+  "emailAddress": "example-person@email.com",
+  "employment": {
+    "endDate": null,
+    "jobTitle": "Customer success manager",
+    "startDate": "2021-01-01T00:00:00.000Z",
+    "status": "CURRENT"
+  },
+  "leaveInfo": null,
+  "groupIds": [
+    "5f2c939a52855e725c8d5824"
+  ],
+  "name": {
+    "display": "Example Person",
+    "last": "Person",
+    "first": "Example"
+  },
+  "sources": {
+    "emailAddress": {
+      "integrationId": "gsuiteadmin",
+      "resourceId": "660c701d3d344e660b032306",
+      "type": "INTEGRATION"
+    },
+    "employment": {
+      "startDate": {
+        "integrationId": "gusto",
+        "resourceId": "660c70783d344e660b032323",
+        "type": "INTEGRATION"
+      },
+      "endDate": {
+        "integrationId": "gusto",
+        "resourceId": "660c70783d344e660b032323",
+        "type": "INTEGRATION"
+      }
+    }
+  },
+  "tasksSummary": {
+    "completionDate": null,
+    "dueDate": "2021-12-01T00:00:00.000Z",
+    "status": "OVERDUE",
+    "details": {
+      "completeTrainings": {
+        "taskType": "COMPLETE_TRAININGS",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": {
+          "date": "2021-11-01T00:00:00.000Z",
+          "reason": "Training Vanta tests have been disabled for this person"
+        },
+        "incompleteTrainings": [
+          {
+            "name": "Security training 1"
+          },
+          {
+            "name": "Security training 2"
+          }
+        ],
+        "completedTrainings": [
+          {
+            "name": "Security training 3"
+          },
+          {
+            "name": "Security training 4"
+          }
+        ]
+      },
+      "acceptPolicies": {
+        "taskType": "ACCEPT_POLICIES",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": null,
+        "unacceptedPolicies": [
+          {
+            "name": "Policy 1"
+          },
+          {
+            "name": "Policy 2"
+          }
+        ],
+        "acceptedPolicies": [
+          {
+            "name": "Policy 3"
+          },
+          {
+            "name": "Policy 4"
+          }
+        ]
+      },
+      "completeCustomTasks": {
+        "taskType": "COMPLETE_CUSTOM_TASKS",
+        "status": "OVERDUE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": {
+          "date": "2021-11-01T00:00:00.000Z",
+          "reason": "Custom task Vanta tests have been disabled for this person"
+        },
+        "incompleteCustomTasks": [
+          {
+            "name": "Custom task 1"
+          },
+          {
+            "name": "Custom task 2"
+          }
+        ],
+        "completedCustomTasks": [
+          {
+            "name": "Custom task 3"
+          },
+          {
+            "name": "Custom task 4"
+          }
+        ]
+      },
+      "completeOffboardingCustomTasks": {
+        "taskType": "COMPLETE_CUSTOM_OFFBOARDING_TASKS",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": null,
+        "incompleteCustomOffboardingTasks": [],
+        "completedCustomOffboardingTasks": [
+          {
+            "name": "Custom offboarding task 1"
+          },
+          {
+            "name": "Custom offboarding task 2"
+          }
+        ]
+      },
+      "installDeviceMonitoring": {
+        "taskType": "INSTALL_DEVICE_MONITORING",
+        "status": "DUE_SOON",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": null,
+        "disabled": null
+      },
+      "completeBackgroundChecks": {
+        "taskType": "COMPLETE_BACKGROUND_CHECKS",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": null
+      }
+    }
+  }
+}`,
+	},
+	{
+		Operation: "Integrations.GetConnectedIntegration",
+		Method:    "GET",
+		Path:      "/integrations/string",
+		Params:    `{"IntegrationID":"string"}`,
+		Status:    200,
+		Response: `{
+  "integrationId": "asana",
+  "displayName": "Asana",
+  "resourceKinds": [
+    "AsanaAccount",
+    "AsanaTask"
+  ],
+  "connections": [
+    {
+      "connectionId": "62ffd6793ef7978318baefa8",
+      "isDisabled": false,
+      "connectionErrorMessage": null
+    },
+    {
+      "connectionId": "62fed1234ef7978318baefa9",
+      "isDisabled": true,
+      "connectionErrorMessage": "Authorization Error connecting to Asana"
+    }
+  ]
+}`,
+	},
+	{
+		Operation: "Integrations.GetDetailsForResourceKind",
+		Method:    "GET",
+		Path:      "/integrations/string/resource-kinds/string",
+		Query:     "connectionId=string",
+		Params:    `{"IntegrationID":"string","ResourceKind":"string","ConnectionID":"string"}`,
+		Status:    200,
+		Response: `{
+  "integrationId": "asana",
+  "resourceKind": "AsanaAccount",
+  "isScopable": true,
+  "canUpdateDescription": true,
+  "canUpdateOwner": true,
+  "numResources": 123,
+  "numInScope": 100,
+  "numOwned": 100,
+  "numWithDescription": 100
+}`,
+	},
+	{
+		Operation: "Integrations.GetResourceByID",
+		Method:    "GET",
+		Path:      "/integrations/string/resource-kinds/string/resources/string",
+		Params:    `{"IntegrationID":"string","ResourceKind":"string","ResourceID":"string"}`,
+		Status:    200,
+		Response: `{
+  "responseType": "Resource",
+  "resourceKind": "AsanaTask",
+  "resourceId": "5e7400d77a8e3731ab2d5c8e",
+  "connectionId": "62ffd6793ef7978318baefa8",
+  "displayName": "My Security Task",
+  "owner": null,
+  "inScope": true,
+  "description": null,
+  "creationDate": "2024-03-06T19:02:25.202Z"
+}`,
+	},
+	{
+		Operation: "Integrations.GetResourceByID",
+		Method:    "GET",
+		Path:      "/integrations/string/resource-kinds/string/resources/string",
+		Params:    `{"IntegrationID":"string","ResourceKind":"string","ResourceID":"string"}`,
+		Status:    200,
+		Response: `{
+  "responseType": "Account",
+  "resourceKind": "AsanaAccount",
+  "resourceId": "5e7400d77a8e3731ab2d5c8e",
+  "connectionId": "62ffd6793ef7978318baefa8",
+  "displayName": "Vlad's Account",
+  "owner": "5e56b1e0188626620a828894",
+  "inScope": true,
+  "description": null,
+  "creationDate": "2024-03-06T19:02:25.202Z",
+  "accountName": "vlads_account",
+  "roles": [
+    "admin"
+  ],
+  "groups": [
+    "admin"
+  ],
+  "isDeactivated": false,
+  "isMfaEnabled": null
+}`,
+	},
+	{
+		Operation: "Integrations.GetResourceByID",
+		Method:    "GET",
+		Path:      "/integrations/string/resource-kinds/string/resources/string",
+		Params:    `{"IntegrationID":"string","ResourceKind":"string","ResourceID":"string"}`,
+		Status:    200,
+		Response: `{
+  "responseType": "Account",
+  "resourceKind": "AwsAccount",
+  "resourceId": "5e7400d77a8e3731ab2d5c8e",
+  "connectionId": "62ffd6793ef7978318baefa8",
+  "displayName": "Vlad's AWS Account",
+  "owner": "5e56b1e0188626620a828894",
+  "inScope": true,
+  "description": null,
+  "creationDate": "2024-03-06T19:02:25.202Z",
+  "accountName": "vlads_aws_account",
+  "roles": [
+    "admin"
+  ],
+  "groups": [
+    "admin"
+  ],
+  "isDeactivated": false,
+  "isMfaEnabled": true,
+  "awsUserAccountId": "ABCD1234567890",
+  "awsAccountNumber": "123456789012",
+  "accessKeys": [
+    {
+      "accessKeyId": "EXMAPLEKEY1",
+      "status": "Active"
+    },
+    {
+      "accessKeyId": "EXAMPLEKEY2",
+      "status": "Inactive"
+    }
+  ]
+}`,
+	},
+	{
+		Operation: "Integrations.GetResourceByID",
+		Method:    "GET",
+		Path:      "/integrations/string/resource-kinds/string/resources/string",
+		Params:    `{"IntegrationID":"string","ResourceKind":"string","ResourceID":"string"}`,
+		Status:    200,
+		Response: `{
+  "responseType": "Database",
+  "resourceKind": "DocumentDBCluster",
+  "resourceId": "661095bd5397b9c17793c420",
+  "connectionId": "661095bd5397b9c17793c41d",
+  "displayName": "document-db-cluster",
+  "owner": null,
+  "inScope": true,
+  "description": null,
+  "creationDate": "2024-03-27T00:56:20.483Z",
+  "account": "aws-account",
+  "areBackupsEnabled": true,
+  "isEncrypted": true,
+  "containsEphi": null,
+  "containsUserData": null
+}`,
+	},
+	{
+		Operation: "Integrations.GetResourceByID",
+		Method:    "GET",
+		Path:      "/integrations/string/resource-kinds/string/resources/string",
+		Params:    `{"IntegrationID":"string","ResourceKind":"string","ResourceID":"string"}`,
+		Status:    200,
+		Response: `{
+  "responseType": "Device",
+  "resourceKind": "JamfManagedComputer",
+  "resourceId": "661092077f68e200f850eb6a",
+  "connectionId": "661092077f68e200f850eb67",
+  "displayName": "jamf-managed-computer",
+  "owner": null,
+  "inScope": true,
+  "description": null,
+  "creationDate": "2024-03-27T00:56:20.483Z",
+  "operatingSystemName": "windows",
+  "isEncrypted": true,
+  "containsEphi": true,
+  "containsUserData": true
+}`,
+	},
+	{
+		Operation: "Integrations.GetResourceByID",
+		Method:    "GET",
+		Path:      "/integrations/string/resource-kinds/string/resources/string",
+		Params:    `{"IntegrationID":"string","ResourceKind":"string","ResourceID":"string"}`,
+		Status:    200,
+		Response: `{
+  "responseType": "PaaS",
+  "resourceKind": "DigitalOceanApp",
+  "resourceId": "66108f3890aac655f7d957ae",
+  "connectionId": "66108f3890aac655f7d957ab",
+  "displayName": "digital-ocean-app",
+  "owner": null,
+  "inScope": true,
+  "description": "digital ocean paas app",
+  "creationDate": "2024-03-27T00:56:20.483Z",
+  "containsEphi": null,
+  "containsUserData": null
+}`,
+	},
+	{
+		Operation: "Integrations.GetResourceByID",
+		Method:    "GET",
+		Path:      "/integrations/string/resource-kinds/string/resources/string",
+		Params:    `{"IntegrationID":"string","ResourceKind":"string","ResourceID":"string"}`,
+		Status:    200,
+		Response: `{
+  "responseType": "Queue",
+  "resourceKind": "SQS",
+  "resourceId": "6611eafe02a95ea7f943962f",
+  "connectionId": "6611eafe02a95ea7f943962c",
+  "displayName": "aws-resource",
+  "owner": null,
+  "inScope": true,
+  "description": null,
+  "creationDate": "2024-03-27T00:56:20.483Z",
+  "account": "aws-account",
+  "region": "us-east-1",
+  "containsEphi": null,
+  "containsUserData": null
+}`,
+	},
+	{
+		Operation: "Integrations.GetResourceByID",
+		Method:    "GET",
+		Path:      "/integrations/string/resource-kinds/string/resources/string",
+		Params:    `{"IntegrationID":"string","ResourceKind":"string","ResourceID":"string"}`,
+		Status:    200,
+		Response: `{
+  "responseType": "StorageBucket",
+  "resourceKind": "S3",
+  "resourceId": "6611ec449e231114e49ef91b",
+  "connectionId": "6611ec449e231114e49ef918",
+  "displayName": "aws-resource",
+  "owner": null,
+  "inScope": true,
+  "description": null,
+  "creationDate": "2024-03-27T00:56:20.483Z",
+  "account": "aws-account",
+  "region": "",
+  "isEncrypted": true,
+  "isVersioned": true,
+  "containsEphi": null,
+  "containsUserData": null
+}`,
+	},
+	{
+		Operation: "Integrations.GetResourceByID",
+		Method:    "GET",
+		Path:      "/integrations/string/resource-kinds/string/resources/string",
+		Params:    `{"IntegrationID":"string","ResourceKind":"string","ResourceID":"string"}`,
+		Status:    200,
+		Response: `{
+  "responseType": "ContainerRepository",
+  "resourceKind": "ECRContainerRepository",
+  "resourceId": "661303e12990509c5874a7b9",
+  "connectionId": "661303e12990509c5874a7b6",
+  "displayName": "aws-resource",
+  "owner": null,
+  "inScope": true,
+  "description": null,
+  "creationDate": "2024-03-27T00:56:20.483Z",
+  "account": "aws-account",
+  "region": "us-east-1",
+  "isAutoscanEnabled": true
+}`,
+	},
+	{
+		Operation: "Integrations.ListConnectedIntegrations",
+		Method:    "GET",
+		Path:      "/integrations",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "integrationId": "asana",
+        "displayName": "Asana",
+        "resourceKinds": [
+          "AsanaAccount",
+          "AsanaTask"
+        ],
+        "connections": [
+          {
+            "connectionId": "62ffd6793ef7978318baefa8",
+            "isDisabled": false,
+            "connectionErrorMessage": null
+          },
+          {
+            "connectionId": "62fed1234ef7978318baefa9",
+            "isDisabled": true,
+            "connectionErrorMessage": "Authorization Error connecting to Asana"
+          }
+        ]
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "YXBvbGxv",
+      "endCursor": "YXBvbGxv"
+    }
+  }
+}`,
+	},
+	{
+		Operation: "Integrations.ListIntegrationResourceKinds",
+		Method:    "GET",
+		Path:      "/integrations/string/resource-kinds",
+		Params:    `{"IntegrationID":"string"}`,
+		Status:    200,
+		Response: `[
+  {
+    "integrationId": "asana",
+    "resourceKind": "AsanaAccount",
+    "isScopable": true,
+    "canUpdateDescription": true,
+    "canUpdateOwner": true
+  }
+]`,
+	},
+	{
+		Operation: "Integrations.ListResources",
+		Method:    "GET",
+		Path:      "/integrations/string/resource-kinds/string/resources",
+		Query:     "connectionId=string&hasDescription=true&hasOwner=true&isInScope=true&pageCursor=string&pageSize=10",
+		Params:    `{"IntegrationID":"string","ResourceKind":"string","ConnectionID":"string","HasDescription":true,"HasOwner":true,"IsInScope":true,"PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "responseType": "Resource",
+        "resourceKind": "AsanaTask",
+        "resourceId": "5e7400d77a8e3731ab2d5c8e",
+        "connectionId": "62ffd6793ef7978318baefa8",
+        "displayName": "My Security Task",
+        "owner": null,
+        "inScope": true,
+        "description": null,
+        "creationDate": "2024-03-06T19:02:25.202Z"
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "YXBvbGxv",
+      "endCursor": "YXBvbGxv"
+    }
+  }
+}`,
+	},
+	{
+		Operation: "Integrations.UpdateResourceMetadata",
+		Method:    "PATCH",
+		Path:      "/integrations/string/resource-kinds/string/resources",
+		Params:    `{"IntegrationID":"string","ResourceKind":"string","Body":{"updates":[{"description":"string","id":"string","inScope":false,"ownerId":"string"}]}}`,
+		Status:    200,
+		Response: `{
+  "results": [
+    {
+      "id": "RESOURCE_ID",
+      "status": "SUCCESS"
+    },
+    {
+      "id": "OTHER_RESOURCE_ID",
+      "status": "ERROR",
+      "message": "Invalid Input"
+    }
+  ]
+}`,
+	},
+	{
+		Operation: "Integrations.UpdateResourceMetadataForResourceKindsResources",
+		Method:    "PATCH",
+		Path:      "/integrations/string/resource-kinds/string/resources/string",
+		Params:    `{"IntegrationID":"string","ResourceKind":"string","ResourceID":"string","Body":{"description":"string","inScope":false,"ownerId":"string"}}`,
+		Status:    204,
+	},
+	{
+		Operation: "MonitoredComputers.GetMonitoredComputerByID",
+		Method:    "GET",
+		Path:      "/monitored-computers/string",
+		Params:    `{"ComputerID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "5f2c939a52855e725c8d5823",
+  "integrationId": "vantaAgent",
+  "serialNumber": "FVFGPGV2Q6L5",
+  "udid": "280FF071-1D7A-5752-BD3A-1A68937CD187",
+  "lastCheckDate": "2024-03-07T18:46:05.944Z",
+  "screenlock": {
+    "outcome": "FAIL"
+  },
+  "diskEncryption": {
+    "outcome": "FAIL"
+  },
+  "passwordManager": {
+    "outcome": "FAIL"
+  },
+  "antivirusInstallation": {
+    "outcome": "FAIL"
+  },
+  "operatingSystem": {
+    "type": "macOS",
+    "version": "13.2.1"
+  },
+  "owner": {
+    "id": "65e1efde08e8478f143a8ff9",
+    "emailAddress": "example-person@email.com",
+    "displayName": "Example Owner"
+  }
+}`,
+	},
+	{
+		Operation: "MonitoredComputers.ListMonitoredComputers",
+		Method:    "GET",
+		Path:      "/monitored-computers",
+		Query:     "complianceStatusFilterMatchesAny=PWM_NOT_INSTALLED&complianceStatusFilterMatchesAny=HD_NOT_ENCRYPTED&pageCursor=string&pageSize=10",
+		Params:    `{"PageSize":10,"PageCursor":"string","ComplianceStatusFilterMatchesAny":["PWM_NOT_INSTALLED","HD_NOT_ENCRYPTED"]}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "5f2c939a52855e725c8d5823",
+        "integrationId": "vantaAgent",
+        "serialNumber": "FVFGPGV2Q6L5",
+        "udid": "280FF071-1D7A-5752-BD3A-1A68937CD187",
+        "lastCheckDate": "2024-03-07T18:46:05.944Z",
+        "screenlock": {
+          "outcome": "FAIL"
+        },
+        "diskEncryption": {
+          "outcome": "FAIL"
+        },
+        "passwordManager": {
+          "outcome": "FAIL"
+        },
+        "antivirusInstallation": {
+          "outcome": "FAIL"
+        },
+        "operatingSystem": {
+          "type": "macOS",
+          "version": "13.2.1"
+        },
+        "owner": {
+          "id": "65e1efde08e8478f143a8ff9",
+          "emailAddress": "example-person@email.com",
+          "displayName": "Example Owner"
+        }
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "5f2c939a52855e725c8d5823",
+      "endCursor": "5f2c939a52855e725c8d5823"
+    }
+  }
+}`,
+	},
+	{
+		Operation: "OAuth.CreateToken",
+		Method:    "POST",
+		Path:      "/oauth/token",
+		Params:    `{"Body":{"client_id":"{{clientId}}","client_secret":"{{clientSecret}}","grant_type":"client_credentials","scope":"connectors.self:write-resource connectors.self:read-resource self:read-document self:write-document"}}`,
+		Status:    200,
+	},
+	{
+		Operation: "People.GetPersonByID",
+		Method:    "GET",
+		Path:      "/people/string",
+		Params:    `{"PersonID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "65e1efde08e8478f143a8ff9",
+  "emailAddress": "example-person@email.com",
+  "employment": {
+    "endDate": null,
+    "jobTitle": "Customer success manager",
+    "startDate": "2021-01-01T00:00:00.000Z",
+    "status": "CURRENT"
+  },
+  "leaveInfo": null,
+  "groupIds": [
+    "5f2c939a52855e725c8d5824"
+  ],
+  "name": {
+    "display": "Example Person",
+    "last": "Person",
+    "first": "Example"
+  },
+  "sources": {
+    "emailAddress": {
+      "integrationId": "gsuiteadmin",
+      "resourceId": "660c701d3d344e660b032306",
+      "type": "INTEGRATION"
+    },
+    "employment": {
+      "startDate": {
+        "integrationId": "gusto",
+        "resourceId": "660c70783d344e660b032323",
+        "type": "INTEGRATION"
+      },
+      "endDate": {
+        "integrationId": "gusto",
+        "resourceId": "660c70783d344e660b032323",
+        "type": "INTEGRATION"
+      }
+    }
+  },
+  "tasksSummary": {
+    "completionDate": null,
+    "dueDate": "2021-12-01T00:00:00.000Z",
+    "status": "OVERDUE",
+    "details": {
+      "completeTrainings": {
+        "taskType": "COMPLETE_TRAININGS",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": {
+          "date": "2021-11-01T00:00:00.000Z",
+          "reason": "Training Vanta tests have been disabled for this person"
+        },
+        "incompleteTrainings": [
+          {
+            "name": "Security training 1"
+          },
+          {
+            "name": "Security training 2"
+          }
+        ],
+        "completedTrainings": [
+          {
+            "name": "Security training 3"
+          },
+          {
+            "name": "Security training 4"
+          }
+        ]
+      },
+      "acceptPolicies": {
+        "taskType": "ACCEPT_POLICIES",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": null,
+        "unacceptedPolicies": [
+          {
+            "name": "Policy 1"
+          },
+          {
+            "name": "Policy 2"
+          }
+        ],
+        "acceptedPolicies": [
+          {
+            "name": "Policy 3"
+          },
+          {
+            "name": "Policy 4"
+          }
+        ]
+      },
+      "completeCustomTasks": {
+        "taskType": "COMPLETE_CUSTOM_TASKS",
+        "status": "OVERDUE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": {
+          "date": "2021-11-01T00:00:00.000Z",
+          "reason": "Custom task Vanta tests have been disabled for this person"
+        },
+        "incompleteCustomTasks": [
+          {
+            "name": "Custom task 1"
+          },
+          {
+            "name": "Custom task 2"
+          }
+        ],
+        "completedCustomTasks": [
+          {
+            "name": "Custom task 3"
+          },
+          {
+            "name": "Custom task 4"
+          }
+        ]
+      },
+      "completeOffboardingCustomTasks": {
+        "taskType": "COMPLETE_CUSTOM_OFFBOARDING_TASKS",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": null,
+        "incompleteCustomOffboardingTasks": [],
+        "completedCustomOffboardingTasks": [
+          {
+            "name": "Custom offboarding task 1"
+          },
+          {
+            "name": "Custom offboarding task 2"
+          }
+        ]
+      },
+      "installDeviceMonitoring": {
+        "taskType": "INSTALL_DEVICE_MONITORING",
+        "status": "DUE_SOON",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": null,
+        "disabled": null
+      },
+      "completeBackgroundChecks": {
+        "taskType": "COMPLETE_BACKGROUND_CHECKS",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": null
+      }
+    }
+  }
+}`,
+	},
+	{
+		Operation: "People.ListPeople",
+		Method:    "GET",
+		Path:      "/people",
+		Query:     "pageCursor=string&pageSize=10&taskStatusMatchesAny=DUE_SOON&taskStatusMatchesAny=OVERDUE&taskTypeMatchesAny=COMPLETE_CUSTOM_TASKS&taskTypeMatchesAny=COMPLETE_TRAININGS&tasksSummaryStatusMatchesAny=DUE_SOON&tasksSummaryStatusMatchesAny=OFFBOARDING_DUE_SOON",
+		Params:    `{"PageSize":10,"PageCursor":"string","TasksSummaryStatusMatchesAny":["DUE_SOON","OFFBOARDING_DUE_SOON"],"TaskTypeMatchesAny":["COMPLETE_CUSTOM_TASKS","COMPLETE_TRAININGS"],"TaskStatusMatchesAny":["DUE_SOON","OVERDUE"]}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "65e1efde08e8478f143a8ff9",
+        "emailAddress": "example-person@email.com",
+        "employment": {
+          "endDate": null,
+          "jobTitle": "Customer success manager",
+          "startDate": "2021-01-01T00:00:00.000Z",
+          "status": "CURRENT"
+        },
+        "leaveInfo": null,
+        "groupIds": [
+          "5f2c939a52855e725c8d5824"
+        ],
+        "name": {
+          "display": "Example Person",
+          "last": "Person",
+          "first": "Example"
+        },
+        "sources": {
+          "emailAddress": {
+            "integrationId": "gsuiteadmin",
+            "resourceId": "660c701d3d344e660b032306",
+            "type": "INTEGRATION"
+          },
+          "employment": {
+            "startDate": {
+              "integrationId": "gusto",
+              "resourceId": "660c70783d344e660b032323",
+              "type": "INTEGRATION"
+            },
+            "endDate": {
+              "integrationId": "gusto",
+              "resourceId": "660c70783d344e660b032323",
+              "type": "INTEGRATION"
+            }
+          }
+        },
+        "tasksSummary": {
+          "completionDate": null,
+          "dueDate": "2021-12-01T00:00:00.000Z",
+          "status": "OVERDUE",
+          "details": {
+            "completeTrainings": {
+              "taskType": "COMPLETE_TRAININGS",
+              "status": "COMPLETE",
+              "dueDate": "2021-12-01T00:00:00.000Z",
+              "completionDate": "2021-11-01T00:00:00.000Z",
+              "disabled": {
+                "date": "2021-11-01T00:00:00.000Z",
+                "reason": "Training Vanta tests have been disabled for this person"
+              },
+              "incompleteTrainings": [
+                {
+                  "name": "Security training 1"
+                },
+                {
+                  "name": "Security training 2"
+                }
+              ],
+              "completedTrainings": [
+                {
+                  "name": "Security training 3"
+                },
+                {
+                  "name": "Security training 4"
+                }
+              ]
+            },
+            "acceptPolicies": {
+              "taskType": "ACCEPT_POLICIES",
+              "status": "COMPLETE",
+              "dueDate": "2021-12-01T00:00:00.000Z",
+              "completionDate": "2021-11-01T00:00:00.000Z",
+              "disabled": null,
+              "unacceptedPolicies": [
+                {
+                  "name": "Policy 1"
+                },
+                {
+                  "name": "Policy 2"
+                }
+              ],
+              "acceptedPolicies": [
+                {
+                  "name": "Policy 3"
+                },
+                {
+                  "name": "Policy 4"
+                }
+              ]
+            },
+            "completeCustomTasks": {
+              "taskType": "COMPLETE_CUSTOM_TASKS",
+              "status": "OVERDUE",
+              "dueDate": "2021-12-01T00:00:00.000Z",
+              "completionDate": "2021-11-01T00:00:00.000Z",
+              "disabled": {
+                "date": "2021-11-01T00:00:00.000Z",
+                "reason": "Custom task Vanta tests have been disabled for this person"
+              },
+              "incompleteCustomTasks": [
+                {
+                  "name": "Custom task 1"
+                },
+                {
+                  "name": "Custom task 2"
+                }
+              ],
+              "completedCustomTasks": [
+                {
+                  "name": "Custom task 3"
+                },
+                {
+                  "name": "Custom task 4"
+                }
+              ]
+            },
+            "completeOffboardingCustomTasks": {
+              "taskType": "COMPLETE_CUSTOM_OFFBOARDING_TASKS",
+              "status": "COMPLETE",
+              "dueDate": "2021-12-01T00:00:00.000Z",
+              "completionDate": "2021-11-01T00:00:00.000Z",
+              "disabled": null,
+              "incompleteCustomOffboardingTasks": [],
+              "completedCustomOffboardingTasks": [
+                {
+                  "name": "Custom offboarding task 1"
+                },
+                {
+                  "name": "Custom offboarding task 2"
+                }
+              ]
+            },
+            "installDeviceMonitoring": {
+              "taskType": "INSTALL_DEVICE_MONITORING",
+              "status": "DUE_SOON",
+              "dueDate": "2021-12-01T00:00:00.000Z",
+              "completionDate": null,
+              "disabled": null
+            },
+            "completeBackgroundChecks": {
+              "taskType": "COMPLETE_BACKGROUND_CHECKS",
+              "status": "COMPLETE",
+              "dueDate": "2021-12-01T00:00:00.000Z",
+              "completionDate": "2021-11-01T00:00:00.000Z",
+              "disabled": null
+            }
+          }
+        }
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "65e1efde08e8478f143a8ff9",
+      "endCursor": "65e1efde08e8478f143a8ff9"
+    }
+  }
+}`,
+	},
+	{
+		Operation: "People.MarkAsNotPeople",
+		Method:    "POST",
+		Path:      "/people/mark-as-not-people",
+		Params:    `{"Body":{"updates":[{"id":"string","reason":"string"}]}}`,
+		Status:    200,
+		Response: `{
+  "results": [
+    {
+      "id": "65e1efde08e8478f143a8ff9",
+      "status": "SUCCESS"
+    },
+    {
+      "id": "OTHER_USER_ID",
+      "status": "ERROR",
+      "message": "Invalid Input"
+    }
+  ]
+}`,
+	},
+	{
+		Operation: "People.MarkAsPeople",
+		Method:    "POST",
+		Path:      "/people/mark-as-people",
+		Params:    `{"Body":{"updates":[{"id":"string"}]}}`,
+		Status:    200,
+		Response: `{
+  "results": [
+    {
+      "id": "65e1efde08e8478f143a8ff9",
+      "status": "SUCCESS"
+    },
+    {
+      "id": "OTHER_USER_ID",
+      "status": "ERROR",
+      "message": "Invalid Input"
+    }
+  ]
+}`,
+	},
+	{
+		Operation: "People.OffboardPeople",
+		Method:    "POST",
+		Path:      "/people/offboard",
+		Params:    `{"Body":{"updates":[{"acknowledgerId":"string","id":"string"}]}}`,
+		Status:    200,
+		Response: `{
+  "results": [
+    {
+      "id": "65e1efde08e8478f143a8ff9",
+      "status": "SUCCESS"
+    },
+    {
+      "id": "OTHER_USER_ID",
+      "status": "ERROR",
+      "message": "Invalid Input"
+    }
+  ]
+}`,
+	},
+	{
+		Operation: "People.RemoveLeaveInformation",
+		Method:    "POST",
+		Path:      "/people/string/clear-leave",
+		Params:    `{"PersonID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "65e1efde08e8478f143a8ff9",
+  "emailAddress": "example-person@email.com",
+  "employment": {
+    "endDate": null,
+    "jobTitle": "Customer success manager",
+    "startDate": "2021-01-01T00:00:00.000Z",
+    "status": "CURRENT"
+  },
+  "leaveInfo": null,
+  "groupIds": [
+    "5f2c939a52855e725c8d5824"
+  ],
+  "name": {
+    "display": "Example Person",
+    "last": "Person",
+    "first": "Example"
+  },
+  "sources": {
+    "emailAddress": {
+      "integrationId": "gsuiteadmin",
+      "resourceId": "660c701d3d344e660b032306",
+      "type": "INTEGRATION"
+    },
+    "employment": {
+      "startDate": {
+        "integrationId": "gusto",
+        "resourceId": "660c70783d344e660b032323",
+        "type": "INTEGRATION"
+      },
+      "endDate": {
+        "integrationId": "gusto",
+        "resourceId": "660c70783d344e660b032323",
+        "type": "INTEGRATION"
+      }
+    }
+  },
+  "tasksSummary": {
+    "completionDate": null,
+    "dueDate": "2021-12-01T00:00:00.000Z",
+    "status": "OVERDUE",
+    "details": {
+      "completeTrainings": {
+        "taskType": "COMPLETE_TRAININGS",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": {
+          "date": "2021-11-01T00:00:00.000Z",
+          "reason": "Training Vanta tests have been disabled for this person"
+        },
+        "incompleteTrainings": [
+          {
+            "name": "Security training 1"
+          },
+          {
+            "name": "Security training 2"
+          }
+        ],
+        "completedTrainings": [
+          {
+            "name": "Security training 3"
+          },
+          {
+            "name": "Security training 4"
+          }
+        ]
+      },
+      "acceptPolicies": {
+        "taskType": "ACCEPT_POLICIES",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": null,
+        "unacceptedPolicies": [
+          {
+            "name": "Policy 1"
+          },
+          {
+            "name": "Policy 2"
+          }
+        ],
+        "acceptedPolicies": [
+          {
+            "name": "Policy 3"
+          },
+          {
+            "name": "Policy 4"
+          }
+        ]
+      },
+      "completeCustomTasks": {
+        "taskType": "COMPLETE_CUSTOM_TASKS",
+        "status": "OVERDUE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": {
+          "date": "2021-11-01T00:00:00.000Z",
+          "reason": "Custom task Vanta tests have been disabled for this person"
+        },
+        "incompleteCustomTasks": [
+          {
+            "name": "Custom task 1"
+          },
+          {
+            "name": "Custom task 2"
+          }
+        ],
+        "completedCustomTasks": [
+          {
+            "name": "Custom task 3"
+          },
+          {
+            "name": "Custom task 4"
+          }
+        ]
+      },
+      "completeOffboardingCustomTasks": {
+        "taskType": "COMPLETE_CUSTOM_OFFBOARDING_TASKS",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": null,
+        "incompleteCustomOffboardingTasks": [],
+        "completedCustomOffboardingTasks": [
+          {
+            "name": "Custom offboarding task 1"
+          },
+          {
+            "name": "Custom offboarding task 2"
+          }
+        ]
+      },
+      "installDeviceMonitoring": {
+        "taskType": "INSTALL_DEVICE_MONITORING",
+        "status": "DUE_SOON",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": null,
+        "disabled": null
+      },
+      "completeBackgroundChecks": {
+        "taskType": "COMPLETE_BACKGROUND_CHECKS",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": null
+      }
+    }
+  }
+}`,
+	},
+	{
+		Operation: "People.SetLeaveInformation",
+		Method:    "POST",
+		Path:      "/people/string/set-leave",
+		Params:    `{"PersonID":"string","Body":{"endDate":"1976-04-17T07:21:26.244Z","startDate":"1984-04-07T22:43:54.329Z"}}`,
+		Status:    200,
+		Response: `{
+  "id": "65e1efde08e8478f143a8ff9",
+  "emailAddress": "example-person@email.com",
+  "employment": {
+    "endDate": null,
+    "jobTitle": "Customer success manager",
+    "startDate": "2021-01-01T00:00:00.000Z",
+    "status": "CURRENT"
+  },
+  "groupIds": [
+    "5f2c939a52855e725c8d5824"
+  ],
+  "name": {
+    "display": "Example Person",
+    "last": "Person",
+    "first": "Example"
+  },
+  "sources": {
+    "emailAddress": {
+      "integrationId": "gsuiteadmin",
+      "resourceId": "660c701d3d344e660b032306",
+      "type": "INTEGRATION"
+    },
+    "employment": {
+      "startDate": {
+        "integrationId": "gusto",
+        "resourceId": "660c70783d344e660b032323",
+        "type": "INTEGRATION"
+      },
+      "endDate": {
+        "integrationId": "gusto",
+        "resourceId": "660c70783d344e660b032323",
+        "type": "INTEGRATION"
+      }
+    }
+  },
+  "tasksSummary": {
+    "completionDate": null,
+    "dueDate": "2021-12-01T00:00:00.000Z",
+    "status": "OVERDUE",
+    "details": {
+      "completeTrainings": {
+        "taskType": "COMPLETE_TRAININGS",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": {
+          "date": "2021-11-01T00:00:00.000Z",
+          "reason": "Training Vanta tests have been disabled for this person"
+        },
+        "incompleteTrainings": [
+          {
+            "name": "Security training 1"
+          },
+          {
+            "name": "Security training 2"
+          }
+        ],
+        "completedTrainings": [
+          {
+            "name": "Security training 3"
+          },
+          {
+            "name": "Security training 4"
+          }
+        ]
+      },
+      "acceptPolicies": {
+        "taskType": "ACCEPT_POLICIES",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": null,
+        "unacceptedPolicies": [
+          {
+            "name": "Policy 1"
+          },
+          {
+            "name": "Policy 2"
+          }
+        ],
+        "acceptedPolicies": [
+          {
+            "name": "Policy 3"
+          },
+          {
+            "name": "Policy 4"
+          }
+        ]
+      },
+      "completeCustomTasks": {
+        "taskType": "COMPLETE_CUSTOM_TASKS",
+        "status": "OVERDUE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": {
+          "date": "2021-11-01T00:00:00.000Z",
+          "reason": "Custom task Vanta tests have been disabled for this person"
+        },
+        "incompleteCustomTasks": [
+          {
+            "name": "Custom task 1"
+          },
+          {
+            "name": "Custom task 2"
+          }
+        ],
+        "completedCustomTasks": [
+          {
+            "name": "Custom task 3"
+          },
+          {
+            "name": "Custom task 4"
+          }
+        ]
+      },
+      "completeOffboardingCustomTasks": {
+        "taskType": "COMPLETE_CUSTOM_OFFBOARDING_TASKS",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": null,
+        "incompleteCustomOffboardingTasks": [],
+        "completedCustomOffboardingTasks": [
+          {
+            "name": "Custom offboarding task 1"
+          },
+          {
+            "name": "Custom offboarding task 2"
+          }
+        ]
+      },
+      "installDeviceMonitoring": {
+        "taskType": "INSTALL_DEVICE_MONITORING",
+        "status": "DUE_SOON",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": null,
+        "disabled": null
+      },
+      "completeBackgroundChecks": {
+        "taskType": "COMPLETE_BACKGROUND_CHECKS",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": null
+      }
+    }
+  },
+  "leaveInfo": {
+    "startDate": "2021-01-01T00:00:00.000Z",
+    "endDate": null,
+    "status": "ACTIVE"
+  }
+}`,
+	},
+	{
+		Operation: "People.UpdatePersonMetadata",
+		Method:    "PATCH",
+		Path:      "/people/string",
+		Params:    `{"PersonID":"string","Body":{"employment":{"startDate":"1999-02-06T01:34:20.878Z"},"name":{"first":"string","last":"string"}}}`,
+		Status:    200,
+		Response: `{
+  "id": "65e1efde08e8478f143a8ff9",
+  "emailAddress": "example-person@email.com",
+  "employment": {
+    "endDate": null,
+    "jobTitle": "Customer success manager",
+    "startDate": "2021-01-01T00:00:00.000Z",
+    "status": "CURRENT"
+  },
+  "leaveInfo": null,
+  "groupIds": [
+    "5f2c939a52855e725c8d5824"
+  ],
+  "name": {
+    "display": "Example Person",
+    "last": "Person",
+    "first": "Example"
+  },
+  "sources": {
+    "emailAddress": {
+      "integrationId": "gsuiteadmin",
+      "resourceId": "660c701d3d344e660b032306",
+      "type": "INTEGRATION"
+    },
+    "employment": {
+      "startDate": {
+        "integrationId": "gusto",
+        "resourceId": "660c70783d344e660b032323",
+        "type": "INTEGRATION"
+      },
+      "endDate": {
+        "integrationId": "gusto",
+        "resourceId": "660c70783d344e660b032323",
+        "type": "INTEGRATION"
+      }
+    }
+  },
+  "tasksSummary": {
+    "completionDate": null,
+    "dueDate": "2021-12-01T00:00:00.000Z",
+    "status": "OVERDUE",
+    "details": {
+      "completeTrainings": {
+        "taskType": "COMPLETE_TRAININGS",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": {
+          "date": "2021-11-01T00:00:00.000Z",
+          "reason": "Training Vanta tests have been disabled for this person"
+        },
+        "incompleteTrainings": [
+          {
+            "name": "Security training 1"
+          },
+          {
+            "name": "Security training 2"
+          }
+        ],
+        "completedTrainings": [
+          {
+            "name": "Security training 3"
+          },
+          {
+            "name": "Security training 4"
+          }
+        ]
+      },
+      "acceptPolicies": {
+        "taskType": "ACCEPT_POLICIES",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": null,
+        "unacceptedPolicies": [
+          {
+            "name": "Policy 1"
+          },
+          {
+            "name": "Policy 2"
+          }
+        ],
+        "acceptedPolicies": [
+          {
+            "name": "Policy 3"
+          },
+          {
+            "name": "Policy 4"
+          }
+        ]
+      },
+      "completeCustomTasks": {
+        "taskType": "COMPLETE_CUSTOM_TASKS",
+        "status": "OVERDUE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": {
+          "date": "2021-11-01T00:00:00.000Z",
+          "reason": "Custom task Vanta tests have been disabled for this person"
+        },
+        "incompleteCustomTasks": [
+          {
+            "name": "Custom task 1"
+          },
+          {
+            "name": "Custom task 2"
+          }
+        ],
+        "completedCustomTasks": [
+          {
+            "name": "Custom task 3"
+          },
+          {
+            "name": "Custom task 4"
+          }
+        ]
+      },
+      "completeOffboardingCustomTasks": {
+        "taskType": "COMPLETE_CUSTOM_OFFBOARDING_TASKS",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": null,
+        "incompleteCustomOffboardingTasks": [],
+        "completedCustomOffboardingTasks": [
+          {
+            "name": "Custom offboarding task 1"
+          },
+          {
+            "name": "Custom offboarding task 2"
+          }
+        ]
+      },
+      "installDeviceMonitoring": {
+        "taskType": "INSTALL_DEVICE_MONITORING",
+        "status": "DUE_SOON",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": null,
+        "disabled": null
+      },
+      "completeBackgroundChecks": {
+        "taskType": "COMPLETE_BACKGROUND_CHECKS",
+        "status": "COMPLETE",
+        "dueDate": "2021-12-01T00:00:00.000Z",
+        "completionDate": "2021-11-01T00:00:00.000Z",
+        "disabled": null
+      }
+    }
+  }
+}`,
+	},
+	{
+		Operation: "Policies.GetPolicyByID",
+		Method:    "GET",
+		Path:      "/policies/code-of-conduct-bsi",
+		Params:    `{"PolicyID":"code-of-conduct-bsi"}`,
+		Status:    200,
+		Response: `{
+  "id": "code-of-conduct-bsi",
+  "name": "Code of Conduct",
+  "description": "Develops and maintains a standard of conduct that is acceptable to the company and its employees, customers, and vendors.",
+  "status": "OK",
+  "approvedAtDate": "2024-01-15T10:30:00.000Z",
+  "latestVersion": {
+    "status": "APPROVED"
+  }
+}`,
+	},
+	{
+		Operation: "Policies.ListPolicies",
+		Method:    "GET",
+		Path:      "/policies",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "endCursor": "YXJyYXljb25uZWN0aW9uOjA=",
+      "startCursor": "YXJyYXljb25uZWN0aW9uOjA="
+    },
+    "data": [
+      {
+        "id": "code-of-conduct-bsi",
+        "name": "Code of Conduct",
+        "description": "Develops and maintains a standard of conduct that is acceptable to the company and its employees, customers, and vendors.",
+        "status": "OK",
+        "approvedAtDate": "2024-01-15T10:30:00.000Z",
+        "latestVersion": {
+          "status": "APPROVED"
+        }
+      }
+    ]
+  }
+}`,
+	},
+	{
+		Operation: "Resources.GetComputers",
+		Method:    "GET",
+		Path:      "/resources/macos_user_computer",
+		Query:     "resourceId=%7B%7BmdmResourceId%7D%7D",
+		Params:    `{"ResourceID":"{{mdmResourceId}}"}`,
+		Status:    200,
+	},
+	{
+		Operation: "Resources.GetCustomResourceServer",
+		Method:    "GET",
+		Path:      "/resources/custom_resource",
+		Query:     "resourceId=%7B%7BcustomResourceId%7D%7D",
+		Params:    `{"ResourceID":"{{customResourceId}}"}`,
+		Status:    200,
+	},
+	{
+		Operation: "Resources.GetUserAccounts",
+		Method:    "GET",
+		Path:      "/resources/user_account",
+		Query:     "resourceId=%7B%7BaccountResourceId%7D%7D",
+		Params:    `{"ResourceID":"{{accountResourceId}}"}`,
+		Status:    200,
+	},
+	{
+		Operation: "Resources.SyncCustomResourceServer",
+		Method:    "PUT",
+		Path:      "/resources/custom_resource",
+		Params:    `{"Body":{"resourceId":"{{customResourceId}}","resources":[{"customProperties":{"active":true,"memory":512,"name":"My Server Name"},"displayName":"PS-PROD-US-LINUX-01","externalUrl":"myprivate.app/ps-prod-us-0001","uniqueId":"PS-PROD-US-0001"}]}}`,
+		Status:    200,
+	},
+	{
+		Operation: "Resources.SyncMacOsComputers",
+		Method:    "PUT",
+		Path:      "/resources/macos_user_computer",
+		Params:    `{"Body":{"resourceId":"{{mdmResourceId}}","resources":[{"applications":[{"bundleId":"com.google.chrome.ios","lastOpenedTimestamp":"2023-07-19T15:55:34-05:00","name":"Google Chrome"},{"bundleId":"com.symantec.mobilesecurity","lastOpenedTimestamp":"2023-07-19T15:55:34-05:00","name":"Norton360"},{"bundleId":"com.1password.1password","lastOpenedTimestamp":"2023-07-19T15:55:34-05:00","name":"1Password"},{"bundleId":"com.apple.mobilenotes","lastOpenedTimestamp":"2023-07-19T15:55:34-05:00","name":"Notes"},{"bundleId":"com.tinyspeck.chatlyio","lastOpenedTimestamp":"2023-07-19T15:55:34-05:00","name":"Slack"},{"bundleId":"com.hammerandchisel.discord","lastOpenedTimestamp":"2023-07-19T15:55:34-05:00","name":"Discord"},{"bundleId":"com.apple.AppStoreConnect","lastOpenedTimestamp":"2023-07-19T15:55:34-05:00","name":"App Store"}],"autoUpdatesEnabled":true,"browserExtensions":[{"browser":"CHROME","extensionId":"hmpcknfapfmkoegemmgaoagijohjockk","name":"TrustPage"}],"collectedTimestamp":"2023-07-20T15:55:34-05:00","displayName":"Mac-TaylorHatfield","drives":[{"encrypted":true,"filevaultEnabled":true,"isBootVolume":true,"name":"Macintosh HD"}],"externalUrl":"https://vanta.com","hardwareUuid":"123e4567-e89b-12d3-a456-426614174000","isManaged":true,"isXProtectEnabled":true,"osName":"MacOS Monterey","osVersion":"12.4","owner":"taylor.hatfield@vanta.com","passwordPolicy":{"minimumLengthRequirement":8},"serialNumber":"W88401231AX ","systemScreenlockPolicies":[{"requiresPassword":true,"screenSleepTimeoutMs":300000}],"uniqueId":"mac-192845","users":[{"lastLoginTimestamp":"2023-07-19T15:55:34-05:00","screenlockPolicies":[{"requiresPassword":true,"screenSleepTimeoutMs":300000}],"screenlockSettings":{"requiresPassword":true,"screenSleepTimeoutMs":300000},"username":"taylor"}]},{"applications":[{"bundleId":"com.google.chrome.ios","lastOpenedTimestamp":"2023-07-19T15:55:34-05:00","name":"Google Chrome"},{"bundleId":"com.symantec.mobilesecurity","lastOpenedTimestamp":"2023-07-19T15:55:34-05:00","name":"Norton360"},{"bundleId":"com.1password.1password","lastOpenedTimestamp":"2023-07-19T15:55:34-05:00","name":"1Password"},{"bundleId":"com.apple.mobilenotes","lastOpenedTimestamp":"2023-07-19T15:55:34-05:00","name":"Notes"},{"bundleId":"com.tinyspeck.chatlyio","lastOpenedTimestamp":"2023-07-19T15:55:34-05:00","name":"Slack"},{"bundleId":"com.hammerandchisel.discord","lastOpenedTimestamp":"2023-07-19T15:55:34-05:00","name":"Discord"},{"bundleId":"com.apple.AppStoreConnect","lastOpenedTimestamp":"2023-07-19T15:55:34-05:00","name":"App Store"}],"autoUpdatesEnabled":true,"browserExtensions":[{"browser":"CHROME","extensionId":"hmpcknfapfmkoegemmgaoagijohjockk","name":"TrustPage"}],"collectedTimestamp":"2023-07-20T15:55:34-05:00","displayName":"Mac-AmandaMott","drives":[{"encrypted":false,"filevaultEnabled":false,"isBootVolume":true,"name":"Macintosh HD"}],"externalUrl":"https://vanta.com","hardwareUuid":"8d73j457-e89b-12d3-a456-836610495867","isManaged":true,"isXProtectEnabled":false,"osName":"MacOS Mojave","osVersion":"10.14","owner":"amanda@vanta.com","passwordPolicy":{"minimumLengthRequirement":8},"serialNumber":"XO037F829H1 ","systemScreenlockPolicies":[{"requiresPassword":true,"screenSleepTimeoutMs":300000}],"uniqueId":"mac-192846","users":[{"lastLoginTimestamp":"2023-07-19T15:55:34-05:00","screenlockPolicies":[{"requiresPassword":true,"screenSleepTimeoutMs":300000}],"screenlockSettings":{"requiresPassword":true,"screenSleepTimeoutMs":300000},"username":"taylor"}]}]}}`,
+		Status:    200,
+	},
+	{
+		Operation: "Resources.SyncUserAccounts",
+		Method:    "PUT",
+		Path:      "/resources/user_account",
+		Params:    `{"Body":{"resourceId":"{{accountResourceId}}","resources":[{"accountName":"apowers79","authMethod":"BIOMETRIC","createdTimestamp":"2022-06-15T12:32:44Z","displayName":"Test User","email":"austin.powers@vanta.com","externalUrl":"https://www.vanta.com","fullName":"Austin Powers","groupIds":["ADMIN","EDITOR"],"lastLoginTimestamp":"2023-07-19T12:32:44Z","lastPasswordResetTimestamp":"2022-10-17T12:32:44Z","mfaEnabled":true,"mfaMethods":["PUSH_PROMPT","HARDWARE_TOKEN"],"permissionLevel":"ADMIN","roleDescription":"ADMIN","status":"ACTIVE","uniqueId":"test_user_1","updatedTimestamp":"2023-07-15T12:32:44Z"}]}}`,
+		Status:    200,
+	},
+	{
+		Operation: "RiskScenarios.CancelRiskScenarioApprovalRequest",
+		Method:    "POST",
+		Path:      "/risk-scenarios/string/cancel-approval-request",
+		Params:    `{"RiskScenarioID":"string"}`,
+		Status:    200,
+		Response: `{
+  "riskId": "assets-not-identified-and-protected",
+  "description": "Assets are not identified and protected according to company requirements.",
+  "isSensitive": false,
+  "likelihood": 4,
+  "impact": 4,
+  "residualLikelihood": 2,
+  "residualImpact": 1,
+  "categories": [
+    "Access control"
+  ],
+  "ciaCategories": [
+    "Confidentiality"
+  ],
+  "treatment": "Avoid",
+  "owner": null,
+  "note": null,
+  "riskRegister": "Default",
+  "customFields": [],
+  "isArchived": false,
+  "reviewStatus": "DRAFT",
+  "requiredApprovers": [],
+  "type": "Risk Scenario"
+}`,
+	},
+	{
+		Operation: "RiskScenarios.CreateRiskScenario",
+		Method:    "POST",
+		Path:      "/risk-scenarios",
+		Params:    `{"Body":{"categories":["string","string"],"ciaCategories":["Integrity","Confidentiality"],"customFields":[{"label":"string","value":"string"},{"label":"string","value":["string","string"]}],"description":"string","impact":7278.055816715711,"isSensitive":false,"likelihood":8380.349388187728,"note":"string","owner":"string","residualImpact":8082.345185280074,"residualLikelihood":1104.320578678386,"riskId":"string","riskRegister":"string","treatment":"Mitigate","type":"Enterprise Risk"}}`,
+		Status:    200,
+		Response: `{
+  "riskId": "assets-not-identified-and-protected",
+  "description": "Assets are not identified and protected according to company requirements.",
+  "isSensitive": false,
+  "likelihood": 4,
+  "impact": 4,
+  "residualLikelihood": 2,
+  "residualImpact": 1,
+  "categories": [
+    "Access control"
+  ],
+  "ciaCategories": [
+    "Confidentiality"
+  ],
+  "treatment": "Avoid",
+  "owner": null,
+  "note": null,
+  "riskRegister": "Default",
+  "customFields": [],
+  "isArchived": false,
+  "reviewStatus": "DRAFT",
+  "requiredApprovers": [],
+  "type": "Risk Scenario"
+}`,
+	},
+	{
+		Operation: "RiskScenarios.GetRiskScenarioByID",
+		Method:    "GET",
+		Path:      "/risk-scenarios/string",
+		Params:    `{"RiskScenarioID":"string"}`,
+		Status:    200,
+		Response: `{
+  "riskId": "assets-not-identified-and-protected",
+  "description": "Assets are not identified and protected according to company requirements.",
+  "isSensitive": false,
+  "likelihood": 4,
+  "impact": 4,
+  "residualLikelihood": 2,
+  "residualImpact": 1,
+  "categories": [
+    "Access control"
+  ],
+  "ciaCategories": [
+    "Confidentiality"
+  ],
+  "treatment": "Avoid",
+  "owner": null,
+  "note": null,
+  "riskRegister": "Default",
+  "customFields": [],
+  "isArchived": false,
+  "reviewStatus": "DRAFT",
+  "requiredApprovers": [],
+  "type": "Risk Scenario"
+}`,
+	},
+	{
+		Operation: "RiskScenarios.ListRiskScenarios",
+		Method:    "GET",
+		Path:      "/risk-scenarios",
+		Query:     "categoryMatchesAny=string&categoryMatchesAny=string&ciaCategoryMatchesAny=Availability&ciaCategoryMatchesAny=Integrity&includeIgnored=true&inherentScoreGroupMatchesAny=Critical&inherentScoreGroupMatchesAny=Low&orderBy=createdAt&ownerMatchesAny=string&ownerMatchesAny=string&pageCursor=string&pageSize=10&residualScoreGroupMatchesAny=Critical&residualScoreGroupMatchesAny=Low&reviewStatusMatchesAny=REQUESTED_CHANGES&reviewStatusMatchesAny=AWAITING_SUBMISSION&searchString=string&treatmentTypeMatchesAny=Mitigate&treatmentTypeMatchesAny=Transfer&type=Enterprise+Risk",
+		Params:    `{"PageSize":10,"PageCursor":"string","IncludeIgnored":true,"OwnerMatchesAny":["string","string"],"SearchString":"string","CategoryMatchesAny":["string","string"],"CiaCategoryMatchesAny":["Availability","Integrity"],"TreatmentTypeMatchesAny":["Mitigate","Transfer"],"InherentScoreGroupMatchesAny":["Critical","Low"],"ResidualScoreGroupMatchesAny":["Critical","Low"],"ReviewStatusMatchesAny":["REQUESTED_CHANGES","AWAITING_SUBMISSION"],"Type":"Enterprise Risk","OrderBy":"createdAt"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "riskId": "assets-not-identified-and-protected",
+        "description": "Assets are not identified and protected according to company requirements.",
+        "isSensitive": false,
+        "likelihood": 4,
+        "impact": 4,
+        "residualLikelihood": 2,
+        "residualImpact": 1,
+        "categories": [
+          "Access control"
+        ],
+        "ciaCategories": [
+          "Confidentiality"
+        ],
+        "treatment": "Avoid",
+        "owner": null,
+        "note": null,
+        "riskRegister": "Default",
+        "customFields": [],
+        "isArchived": false,
+        "reviewStatus": "DRAFT",
+        "requiredApprovers": [],
+        "type": "Risk Scenario"
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "1",
+      "endCursor": "1"
+    }
+  }
+}`,
+	},
+	{
+		Operation: "RiskScenarios.SubmitRiskScenarioForApproval",
+		Method:    "POST",
+		Path:      "/risk-scenarios/string/submit-for-approval",
+		Params:    `{"RiskScenarioID":"string","Body":{"comment":"string"}}`,
+		Status:    200,
+		Response: `{
+  "riskId": "assets-not-identified-and-protected",
+  "description": "Assets are not identified and protected according to company requirements.",
+  "isSensitive": false,
+  "likelihood": 4,
+  "impact": 4,
+  "residualLikelihood": 2,
+  "residualImpact": 1,
+  "categories": [
+    "Access control"
+  ],
+  "ciaCategories": [
+    "Confidentiality"
+  ],
+  "treatment": "Avoid",
+  "owner": null,
+  "note": null,
+  "riskRegister": "Default",
+  "customFields": [],
+  "isArchived": false,
+  "reviewStatus": "DRAFT",
+  "requiredApprovers": [],
+  "type": "Risk Scenario"
+}`,
+	},
+	{
+		Operation: "RiskScenarios.UpdateRiskScenario",
+		Method:    "PATCH",
+		Path:      "/risk-scenarios/string",
+		Params:    `{"RiskScenarioID":"string","Body":{"categories":["string","string"],"ciaCategories":["Availability","Confidentiality"],"customFields":[{"label":"string","value":["string","string"]},{"label":"string","value":"string"}],"description":"string","impact":2245.38813798115,"isSensitive":true,"likelihood":8959.701874030996,"note":"string","owner":"string","residualImpact":9527.789003374353,"residualLikelihood":868.1222515235953,"riskRegister":"string","treatment":"Accept"}}`,
+		Status:    200,
+		Response: `{
+  "riskId": "assets-not-identified-and-protected",
+  "description": "Assets are not identified and protected according to company requirements.",
+  "isSensitive": false,
+  "likelihood": 4,
+  "impact": 4,
+  "residualLikelihood": 2,
+  "residualImpact": 1,
+  "categories": [
+    "Access control"
+  ],
+  "ciaCategories": [
+    "Confidentiality"
+  ],
+  "treatment": "Avoid",
+  "owner": null,
+  "note": null,
+  "riskRegister": "Default",
+  "customFields": [],
+  "isArchived": false,
+  "reviewStatus": "DRAFT",
+  "requiredApprovers": [],
+  "type": "Risk Scenario"
+}`,
+	},
+	{
+		Operation: "Tests.DeactivateTestEntity",
+		Method:    "POST",
+		Path:      "/tests/aws-account-access-removed-on-termination/entities/string/deactivate",
+		Params:    `{"TestID":"aws-account-access-removed-on-termination","EntityID":"string","Body":{"deactivateReason":"string","deactivateUntilDate":"1959-04-07T21:53:00.895Z"}}`,
+		Status:    202,
+	},
+	{
+		Operation: "Tests.GetTestByID",
+		Method:    "GET",
+		Path:      "/tests/aws-account-access-removed-on-termination",
+		Params:    `{"TestID":"aws-account-access-removed-on-termination"}`,
+		Status:    200,
+		Response: `{
+  "id": "aws-account-access-removed-on-termination",
+  "name": "AWS accounts deprovisioned when personnel leave",
+  "lastTestRunDate": "2024-06-18T20:17:38.463Z",
+  "latestFlipDate": null,
+  "description": "Verifies that AWS accounts linked to removed users are removed.\n",
+  "failureDescription": "Some AWS accounts associated with terminated personnel have not been deactivated.",
+  "remediationDescription": "Remove all accounts listed from AWS.\n",
+  "version": {
+    "major": 0,
+    "minor": 0
+  },
+  "category": "Account security",
+  "integrations": [
+    "aws"
+  ],
+  "status": "OK",
+  "deactivatedStatusInfo": {
+    "isDeactivated": false,
+    "deactivatedReason": null,
+    "lastUpdatedDate": null
+  },
+  "remediationStatusInfo": {
+    "status": "PASS",
+    "soonestRemediateByDate": null,
+    "itemCount": 0
+  },
+  "owner": null
+}`,
+	},
+	{
+		Operation: "Tests.GetTestEntitiesByTestID",
+		Method:    "GET",
+		Path:      "/tests/aws-account-access-removed-on-termination/entities",
+		Query:     "entityStatus=FAILING&pageCursor=string&pageSize=10",
+		Params:    `{"TestID":"aws-account-access-removed-on-termination","EntityStatus":"FAILING","PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "endCursor": "YXJyYXljb25uZWN0aW9uOjA=",
+      "startCursor": "YXJyYXljb25uZWN0aW9uOjE="
+    },
+    "data": [
+      {
+        "id": "65fc81a3359c8508c9af880f",
+        "entityStatus": "FAILING",
+        "displayName": "account-123456789012",
+        "responseType": "AWS account",
+        "deactivatedReason": null,
+        "lastUpdatedDate": "2024-06-18T20:17:38.463Z",
+        "createdDate": "2024-06-18T20:17:38.463Z"
+      }
+    ]
+  }
+}`,
+	},
+	{
+		Operation: "Tests.ListTests",
+		Method:    "GET",
+		Path:      "/tests",
+		Query:     "categoryFilter=SECURITY_ALERT_MANAGEMENT&controlFilter=string&frameworkFilter=string&integrationFilter=string&isInRollout=true&ownerFilter=string&pageCursor=string&pageSize=10&statusFilter=INVALID",
+		Params:    `{"PageSize":10,"PageCursor":"string","StatusFilter":"INVALID","FrameworkFilter":"string","IntegrationFilter":"string","ControlFilter":"string","OwnerFilter":"string","CategoryFilter":"SECURITY_ALERT_MANAGEMENT","IsInRollout":true}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "endCursor": "YXJyYXljb25uZWN0aW9uOjA=",
+      "startCursor": "YXJyYXljb25uZWN0aW9uOjA="
+    },
+    "data": [
+      {
+        "id": "aws-account-access-removed-on-termination",
+        "name": "AWS accounts deprovisioned when personnel leave",
+        "lastTestRunDate": "2024-06-18T20:17:38.463Z",
+        "latestFlipDate": null,
+        "description": "Verifies that AWS accounts linked to removed users are removed.\n",
+        "failureDescription": "Some AWS accounts associated with terminated personnel have not been deactivated.",
+        "remediationDescription": "Remove all accounts listed from AWS.\n",
+        "version": {
+          "major": 0,
+          "minor": 0
+        },
+        "category": "Account security",
+        "integrations": [
+          "aws"
+        ],
+        "status": "OK",
+        "deactivatedStatusInfo": {
+          "isDeactivated": false,
+          "deactivatedReason": null,
+          "lastUpdatedDate": null
+        },
+        "remediationStatusInfo": {
+          "status": "PASS",
+          "soonestRemediateByDate": null,
+          "itemCount": 0
+        },
+        "owner": null
+      }
+    ]
+  }
+}`,
+	},
+	{
+		Operation: "Tests.ReactivateTestEntity",
+		Method:    "POST",
+		Path:      "/tests/aws-account-access-removed-on-termination/entities/string/reactivate",
+		Params:    `{"TestID":"aws-account-access-removed-on-termination","EntityID":"string"}`,
+		Status:    202,
+	},
+	{
+		Operation: "TrustCenters.AddTrustCenterControl",
+		Method:    "POST",
+		Path:      "/trust-centers/string/controls",
+		Params:    `{"SlugID":"string","Body":{"categoryIds":["string"],"controlId":"string"}}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "name": "Control name",
+  "description": "Control description",
+  "categories": [
+    {
+      "id": "93d69894dd525f806d7e5c48",
+      "name": "Category name"
+    }
+  ]
+}`,
+	},
+	{
+		Operation: "TrustCenters.AddTrustCenterControlCategory",
+		Method:    "POST",
+		Path:      "/trust-centers/string/control-categories",
+		Params:    `{"SlugID":"string","Body":{"name":"string"}}`,
+		Status:    200,
+		Response: `{
+  "id": "93d69894dd525f806d7e5c48",
+  "name": "Category name"
+}`,
+	},
+	{
+		Operation: "TrustCenters.AddTrustCenterViewer",
+		Method:    "POST",
+		Path:      "/trust-centers/string/viewers",
+		Params:    `{"SlugID":"string","Body":{"accessLevel":"FULL_ACCESS","companyName":"string","email":"string","expirationDate":"1946-03-12T22:05:15.283Z","isNdaRequired":true,"name":"string","resourceIds":["string","string"]}}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "email": "exampleviewer@company.com",
+  "name": "Example Viewer",
+  "companyName": "Viewer Company, Inc.",
+  "resourceIds": null,
+  "accessLevel": "FULL_ACCESS",
+  "ndaInfo": null,
+  "externalServiceAssociations": [
+    {
+      "service": "SALESFORCE",
+      "id": "0032S000062DfqnQBG",
+      "objectType": "Salesforce Contact"
+    }
+  ],
+  "creationDate": "2020-01-01T00:00:00.000Z",
+  "updatedDate": "2020-01-01T00:00:00.000Z",
+  "expirationDate": null,
+  "addedByUser": null
+}`,
+	},
+	{
+		Operation: "TrustCenters.ApproveTrustCenterAccessRequest",
+		Method:    "POST",
+		Path:      "/trust-centers/string/access-requests/string/approve",
+		Params:    `{"SlugID":"string","AccessRequestID":"string","Body":{"accessLevel":"FULL_ACCESS","expirationDate":"2021-07-10T19:06:38.794Z","isNdaRequired":true,"resourceIds":["string","string"]}}`,
+		Status:    204,
+	},
+	{
+		Operation: "TrustCenters.CreateTrustCenterDocument",
+		Method:    "POST",
+		Path:      "/trust-centers/string/resources",
+		Params:    `{"SlugID":"string"}`,
+		Status:    201,
+		Response: `{
+  "id": "4b1e7a8c3d9f6a2b5c8d0e3f",
+  "title": "Resource title",
+  "mimeType": "application/pdf",
+  "fileName": "resource.pdf",
+  "creationDate": "2020-01-01T00:00:00.000Z",
+  "updatedDate": "2020-01-01T00:00:00.000Z",
+  "isPublic": true,
+  "description": "Resource description"
+}`,
+	},
+	{
+		Operation: "TrustCenters.CreateTrustCenterFaq",
+		Method:    "POST",
+		Path:      "/trust-centers/string/faqs",
+		Params:    `{"SlugID":"string","Body":{"answer":"string","question":"string"}}`,
+		Status:    201,
+		Response: `{
+  "id": "4b1e7a8c3d9f6a2b5c8d0e3f",
+  "question": "What is the meaning of life?",
+  "answer": "42"
+}`,
+	},
+	{
+		Operation: "TrustCenters.CreateTrustCenterSubprocessor",
+		Method:    "POST",
+		Path:      "/trust-centers/string/subprocessors",
+		Params:    `{"SlugID":"string","Body":{"description":"string","location":"string","name":"string","purpose":"string","url":"string"}}`,
+		Status:    201,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "name": "Acme, Inc.",
+  "description": "This is the description for the subprocessor.",
+  "location": "US, EU",
+  "purpose": "Cloud infrastructure",
+  "url": "acme.com"
+}`,
+	},
+	{
+		Operation: "TrustCenters.CreateTrustCenterSubscriber",
+		Method:    "POST",
+		Path:      "/trust-centers/string/subscribers",
+		Params:    `{"SlugID":"string","Body":{"email":"string"}}`,
+		Status:    201,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "email": "subscriber@example.com",
+  "isEmailVerified": true,
+  "creationDate": "2020-01-01T00:00:00.000Z"
+}`,
+	},
+	{
+		Operation: "TrustCenters.CreateTrustCenterSubscriberGroup",
+		Method:    "POST",
+		Path:      "/trust-centers/string/subscriber-groups",
+		Params:    `{"SlugID":"string","Body":{"name":"string","subscriberIds":["string","string"]}}`,
+		Status:    201,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "name": "Example Group",
+  "subscriberIds": [
+    "a2f7e1b9d0c3f4e5a6c7b8d9"
+  ],
+  "creationDate": "2020-01-01T00:00:00.000Z"
+}`,
+	},
+	{
+		Operation: "TrustCenters.CreateTrustCenterUpdate",
+		Method:    "POST",
+		Path:      "/trust-centers/string/updates",
+		Params:    `{"SlugID":"string","Body":{"category":"INCIDENT","description":"string","notificationTarget":"NONE","notifiedEmails":["string","string"],"subscriberGroupIds":["string","string"],"title":"string","visibilityType":"PRIVATE"}}`,
+		Status:    201,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "title": "Example title",
+  "description": "This is an example of an update's description.",
+  "category": "GENERAL",
+  "creationDate": "2020-01-01T00:00:00.000Z",
+  "updatedDate": "2020-01-01T00:00:00.000Z",
+  "visibilityType": "PUBLIC",
+  "notifiedEmails": [
+    "test@test.com"
+  ]
+}`,
+	},
+	{
+		Operation: "TrustCenters.DeleteTrustCenterControl",
+		Method:    "DELETE",
+		Path:      "/trust-centers/string/controls/string",
+		Params:    `{"SlugID":"string","ControlID":"string"}`,
+		Status:    204,
+	},
+	{
+		Operation: "TrustCenters.DeleteTrustCenterControlCategory",
+		Method:    "DELETE",
+		Path:      "/trust-centers/string/control-categories/string",
+		Params:    `{"SlugID":"string","CategoryID":"string"}`,
+		Status:    204,
+	},
+	{
+		Operation: "TrustCenters.DeleteTrustCenterDocument",
+		Method:    "DELETE",
+		Path:      "/trust-centers/string/resources/string",
+		Params:    `{"SlugID":"string","ResourceID":"string"}`,
+		Status:    204,
+	},
+	{
+		Operation: "TrustCenters.DeleteTrustCenterFaq",
+		Method:    "DELETE",
+		Path:      "/trust-centers/string/faqs/string",
+		Params:    `{"SlugID":"string","FaqID":"string"}`,
+		Status:    204,
+	},
+	{
+		Operation: "TrustCenters.DeleteTrustCenterSubprocessor",
+		Method:    "DELETE",
+		Path:      "/trust-centers/string/subprocessors/string",
+		Params:    `{"SlugID":"string","SubprocessorID":"string"}`,
+		Status:    204,
+	},
+	{
+		Operation: "TrustCenters.DeleteTrustCenterSubscriber",
+		Method:    "DELETE",
+		Path:      "/trust-centers/string/subscribers/string",
+		Params:    `{"SlugID":"string","SubscriberID":"string"}`,
+		Status:    204,
+	},
+	{
+		Operation: "TrustCenters.DeleteTrustCenterSubscriberGroup",
+		Method:    "DELETE",
+		Path:      "/trust-centers/string/subscriber-groups/string",
+		Params:    `{"SlugID":"string","SubscriberGroupID":"string"}`,
+		Status:    204,
+	},
+	{
+		Operation: "TrustCenters.DeleteTrustCenterUpdate",
+		Method:    "DELETE",
+		Path:      "/trust-centers/string/updates/string",
+		Params:    `{"SlugID":"string","UpdateID":"string"}`,
+		Status:    204,
+	},
+	{
+		Operation: "TrustCenters.DenyTrustCenterAccessRequest",
+		Method:    "POST",
+		Path:      "/trust-centers/string/access-requests/string/deny",
+		Params:    `{"SlugID":"string","AccessRequestID":"string"}`,
+		Status:    204,
+	},
+	{
+		Operation: "TrustCenters.EditTrustCenterSubscriberGroup",
+		Method:    "PATCH",
+		Path:      "/trust-centers/string/subscriber-groups/string",
+		Params:    `{"SlugID":"string","SubscriberGroupID":"string","Body":{"name":"string"}}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "name": "Example Group",
+  "subscriberIds": [
+    "a2f7e1b9d0c3f4e5a6c7b8d9"
+  ],
+  "creationDate": "2020-01-01T00:00:00.000Z"
+}`,
+	},
+	{
+		Operation: "TrustCenters.GetTrustCenter",
+		Method:    "GET",
+		Path:      "/trust-centers/a2f7e1b9d0c3f4e5a6c7b8d9",
+		Params:    `{"SlugID":"a2f7e1b9d0c3f4e5a6c7b8d9"}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "title": "Trust Center",
+  "companyDescription": "Company description",
+  "privacyPolicy": "Privacy policy",
+  "customDomain": "trustcenter.com",
+  "isPublic": true,
+  "bannerSetting": {
+    "setting": "GRADIENT",
+    "startColor": "#000000",
+    "endColor": "#FFFFFF"
+  },
+  "customTheme": {
+    "primary": "#000000",
+    "secondary": "#FFFFFF"
+  },
+  "creationDate": "2020-01-01T00:00:00.000Z",
+  "updatedDate": "2020-01-01T00:00:00.000Z"
+}`,
+	},
+	{
+		Operation: "TrustCenters.GetTrustCenterAccessRequest",
+		Method:    "GET",
+		Path:      "/trust-centers/string/access-requests/string",
+		Params:    `{"SlugID":"string","AccessRequestID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "email": "exampleviewer@company.com",
+  "name": "Example Viewer",
+  "companyName": "Viewer Company, Inc.",
+  "reason": "I'm an existing customer",
+  "requestedResources": null,
+  "accessLevel": "FULL_ACCESS",
+  "creationDate": "2020-01-01T00:00:00.000Z",
+  "updatedDate": "2020-01-01T00:00:00.000Z"
+}`,
+	},
+	{
+		Operation: "TrustCenters.GetTrustCenterControl",
+		Method:    "GET",
+		Path:      "/trust-centers/string/controls/string",
+		Params:    `{"SlugID":"string","ControlID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "name": "Control name",
+  "description": "Control description",
+  "categories": [
+    {
+      "id": "93d69894dd525f806d7e5c48",
+      "name": "Category name"
+    }
+  ]
+}`,
+	},
+	{
+		Operation: "TrustCenters.GetTrustCenterControlCategory",
+		Method:    "GET",
+		Path:      "/trust-centers/string/control-categories/string",
+		Params:    `{"SlugID":"string","CategoryID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "93d69894dd525f806d7e5c48",
+  "name": "Category name"
+}`,
+	},
+	{
+		Operation: "TrustCenters.GetTrustCenterDocument",
+		Method:    "GET",
+		Path:      "/trust-centers/string/resources/string",
+		Params:    `{"SlugID":"string","ResourceID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "4b1e7a8c3d9f6a2b5c8d0e3f",
+  "title": "Resource title",
+  "mimeType": "application/pdf",
+  "fileName": "resource.pdf",
+  "creationDate": "2020-01-01T00:00:00.000Z",
+  "updatedDate": "2020-01-01T00:00:00.000Z",
+  "isPublic": true,
+  "description": "Resource description"
+}`,
+	},
+	{
+		Operation: "TrustCenters.GetTrustCenterFaq",
+		Method:    "GET",
+		Path:      "/trust-centers/string/faqs/string",
+		Params:    `{"SlugID":"string","FaqID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "4b1e7a8c3d9f6a2b5c8d0e3f",
+  "question": "What is the meaning of life?",
+  "answer": "42"
+}`,
+	},
+	{
+		Operation: "TrustCenters.GetTrustCenterSubprocessor",
+		Method:    "GET",
+		Path:      "/trust-centers/string/subprocessors/string",
+		Params:    `{"SlugID":"string","SubprocessorID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "name": "Acme, Inc.",
+  "description": "This is the description for the subprocessor.",
+  "location": "US, EU",
+  "purpose": "Cloud infrastructure",
+  "url": "acme.com"
+}`,
+	},
+	{
+		Operation: "TrustCenters.GetTrustCenterSubscriber",
+		Method:    "GET",
+		Path:      "/trust-centers/string/subscribers/string",
+		Params:    `{"SlugID":"string","SubscriberID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "email": "subscriber@example.com",
+  "isEmailVerified": true,
+  "creationDate": "2020-01-01T00:00:00.000Z"
+}`,
+	},
+	{
+		Operation: "TrustCenters.GetTrustCenterSubscriberGroup",
+		Method:    "GET",
+		Path:      "/trust-centers/string/subscriber-groups/string",
+		Params:    `{"SlugID":"string","SubscriberGroupID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "name": "Example Group",
+  "subscriberIds": [
+    "a2f7e1b9d0c3f4e5a6c7b8d9"
+  ],
+  "creationDate": "2020-01-01T00:00:00.000Z"
+}`,
+	},
+	{
+		Operation: "TrustCenters.GetTrustCenterUpdate",
+		Method:    "GET",
+		Path:      "/trust-centers/string/updates/string",
+		Params:    `{"SlugID":"string","UpdateID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "title": "Example title",
+  "description": "This is an example of an update's description.",
+  "category": "GENERAL",
+  "creationDate": "2020-01-01T00:00:00.000Z",
+  "updatedDate": "2020-01-01T00:00:00.000Z",
+  "visibilityType": "PUBLIC",
+  "notifiedEmails": [
+    "test@test.com"
+  ]
+}`,
+	},
+	{
+		Operation: "TrustCenters.GetTrustCenterViewer",
+		Method:    "GET",
+		Path:      "/trust-centers/string/viewers/string",
+		Params:    `{"SlugID":"string","ViewerID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "email": "exampleviewer@company.com",
+  "name": "Example Viewer",
+  "companyName": "Viewer Company, Inc.",
+  "resourceIds": null,
+  "accessLevel": "FULL_ACCESS",
+  "ndaInfo": null,
+  "externalServiceAssociations": [
+    {
+      "service": "SALESFORCE",
+      "id": "0032S000062DfqnQBG",
+      "objectType": "Salesforce Contact"
+    }
+  ],
+  "creationDate": "2020-01-01T00:00:00.000Z",
+  "updatedDate": "2020-01-01T00:00:00.000Z",
+  "expirationDate": null,
+  "addedByUser": null
+}`,
+	},
+	{
+		Operation: "TrustCenters.GetUploadedMediaForTrustCenterDocument",
+		Method:    "GET",
+		Path:      "/trust-centers/string/resources/string/media",
+		Params:    `{"SlugID":"string","ResourceID":"string"}`,
+		Status:    200,
+		Response: `{
+  "readable": true
+}`,
+	},
+	{
+		Operation: "TrustCenters.ListHistoricalTrustCenterAccessRequests",
+		Method:    "GET",
+		Path:      "/trust-centers/string/historical-access-requests",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"SlugID":"string","PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "pageInfo": {
+      "hasNextPage": true,
+      "hasPreviousPage": false,
+      "startCursor": "YXJyYXljb25uZWN0aW9uOjA=",
+      "endCursor": "YXJyYXljb25uZWN0aW9uOjE="
+    },
+    "data": [
+      {
+        "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+        "email": "exampleviewer@company.com",
+        "name": "Example Viewer",
+        "companyName": "Viewer Company, Inc.",
+        "reason": "I'm an existing customer",
+        "requestedResources": null,
+        "accessLevel": "FULL_ACCESS",
+        "creationDate": "2020-01-01T00:00:00.000Z",
+        "updatedDate": "2020-01-01T00:00:00.000Z",
+        "outcome": "DENIED"
+      }
+    ]
+  }
+}`,
+	},
+	{
+		Operation: "TrustCenters.ListTrustCenterAccessRequests",
+		Method:    "GET",
+		Path:      "/trust-centers/string/access-requests",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"SlugID":"string","PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "pageInfo": {
+      "hasNextPage": true,
+      "hasPreviousPage": false,
+      "startCursor": "YXJyYXljb25uZWN0aW9uOjA=",
+      "endCursor": "YXJyYXljb25uZWN0aW9uOjE="
+    },
+    "data": [
+      {
+        "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+        "email": "exampleviewer@company.com",
+        "name": "Example Viewer",
+        "companyName": "Viewer Company, Inc.",
+        "reason": "I'm an existing customer",
+        "requestedResources": null,
+        "accessLevel": "FULL_ACCESS",
+        "creationDate": "2020-01-01T00:00:00.000Z",
+        "updatedDate": "2020-01-01T00:00:00.000Z"
+      }
+    ]
+  }
+}`,
+	},
+	{
+		Operation: "TrustCenters.ListTrustCenterControlCategories",
+		Method:    "GET",
+		Path:      "/trust-centers/string/control-categories",
+		Params:    `{"SlugID":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": [
+    {
+      "id": "93d69894dd525f806d7e5c48",
+      "name": "Category name"
+    }
+  ]
+}`,
+	},
+	{
+		Operation: "TrustCenters.ListTrustCenterControls",
+		Method:    "GET",
+		Path:      "/trust-centers/string/controls",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"SlugID":"string","PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "pageInfo": {
+      "hasNextPage": true,
+      "hasPreviousPage": false,
+      "startCursor": "YXJyYXljb25uZWN0aW9uOjA=",
+      "endCursor": "YXJyYXljb25uZWN0aW9uOjE="
+    },
+    "data": [
+      {
+        "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+        "name": "Control name",
+        "description": "Control description",
+        "categories": [
+          {
+            "id": "93d69894dd525f806d7e5c48",
+            "name": "Category name"
+          }
+        ]
+      }
+    ]
+  }
+}`,
+	},
+	{
+		Operation: "TrustCenters.ListTrustCenterFaqs",
+		Method:    "GET",
+		Path:      "/trust-centers/string/faqs",
+		Params:    `{"SlugID":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": [
+    {
+      "id": "4b1e7a8c3d9f6a2b5c8d0e3f",
+      "question": "What is the meaning of life?",
+      "answer": "42"
+    }
+  ]
+}`,
+	},
+	{
+		Operation: "TrustCenters.ListTrustCenterResources",
+		Method:    "GET",
+		Path:      "/trust-centers/string/resources",
+		Params:    `{"SlugID":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": [
+    {
+      "id": "4b1e7a8c3d9f6a2b5c8d0e3f",
+      "title": "Resource title",
+      "mimeType": "application/pdf",
+      "fileName": "resource.pdf",
+      "creationDate": "2020-01-01T00:00:00.000Z",
+      "updatedDate": "2020-01-01T00:00:00.000Z",
+      "isPublic": true,
+      "description": "Resource description"
+    }
+  ]
+}`,
+	},
+	{
+		Operation: "TrustCenters.ListTrustCenterSubprocessors",
+		Method:    "GET",
+		Path:      "/trust-centers/string/subprocessors",
+		Params:    `{"SlugID":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": [
+    {
+      "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+      "name": "Acme, Inc.",
+      "description": "This is the description for the subprocessor.",
+      "location": "US, EU",
+      "purpose": "Cloud infrastructure",
+      "url": "acme.com"
+    }
+  ]
+}`,
+	},
+	{
+		Operation: "TrustCenters.ListTrustCenterSubscriberGroups",
+		Method:    "GET",
+		Path:      "/trust-centers/string/subscriber-groups",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"SlugID":"string","PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "pageInfo": {
+      "hasNextPage": true,
+      "hasPreviousPage": false,
+      "startCursor": "YXJyYXljb25uZWN0aW9uOjA=",
+      "endCursor": "YXJyYXljb25uZWN0aW9uOjE="
+    },
+    "data": [
+      {
+        "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+        "name": "Example Group",
+        "subscriberIds": [
+          "a2f7e1b9d0c3f4e5a6c7b8d9"
+        ],
+        "creationDate": "2020-01-01T00:00:00.000Z"
+      }
+    ]
+  }
+}`,
+	},
+	{
+		Operation: "TrustCenters.ListTrustCenterSubscribers",
+		Method:    "GET",
+		Path:      "/trust-centers/string/subscribers",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"SlugID":"string","PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "pageInfo": {
+      "hasNextPage": true,
+      "hasPreviousPage": false,
+      "startCursor": "YXJyYXljb25uZWN0aW9uOjA=",
+      "endCursor": "YXJyYXljb25uZWN0aW9uOjE="
+    },
+    "data": [
+      {
+        "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+        "email": "subscriber@example.com",
+        "isEmailVerified": true,
+        "creationDate": "2020-01-01T00:00:00.000Z"
+      }
+    ]
+  }
+}`,
+	},
+	{
+		Operation: "TrustCenters.ListTrustCenterUpdates",
+		Method:    "GET",
+		Path:      "/trust-centers/string/updates",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"SlugID":"string","PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "pageInfo": {
+      "hasNextPage": true,
+      "hasPreviousPage": false,
+      "startCursor": "YXJyYXljb25uZWN0aW9uOjA=",
+      "endCursor": "YXJyYXljb25uZWN0aW9uOjE="
+    },
+    "data": [
+      {
+        "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+        "title": "Example title",
+        "description": "This is an example of an update's description.",
+        "category": "GENERAL",
+        "creationDate": "2020-01-01T00:00:00.000Z",
+        "updatedDate": "2020-01-01T00:00:00.000Z",
+        "visibilityType": "PUBLIC",
+        "notifiedEmails": [
+          "test@test.com"
+        ]
+      }
+    ]
+  }
+}`,
+	},
+	{
+		Operation: "TrustCenters.ListTrustCenterViewerActivityEvents",
+		Method:    "GET",
+		Path:      "/trust-centers/string/activity",
+		Query:     "afterDate=1948-10-24T09%3A15%3A03.646Z&beforeDate=1948-10-24T09%3A15%3A03.646Z&eventTypesMatchesAny=RESOURCE_VIEW&eventTypesMatchesAny=PAGE_VIEW&pageCursor=string&pageSize=10",
+		Params:    `{"SlugID":"string","PageSize":10,"PageCursor":"string","EventTypesMatchesAny":["RESOURCE_VIEW","PAGE_VIEW"],"AfterDate":"1948-10-24T09:15:03.646Z","BeforeDate":"1948-10-24T09:15:03.646Z"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "pageInfo": {
+      "hasNextPage": true,
+      "hasPreviousPage": false,
+      "startCursor": "YXJyYXljb25uZWN0aW9uOjA=",
+      "endCursor": "YXJyYXljb25uZWN0aW9uOjE="
+    },
+    "data": [
+      {
+        "id": "4b1e7a8c3d9f6a2b5c8d0e3f",
+        "date": "2020-01-01T00:00:00.000Z",
+        "eventType": "PAGE_VIEW",
+        "details": {
+          "page": "OVERVIEW"
+        },
+        "viewerEmail": "exampleviewer@company.com",
+        "viewerId": "a2f7e1b9d0c3f4e5a6c7b8d9",
+        "countryCode": "US",
+        "city": "San Francisco"
+      }
+    ]
+  }
+}`,
+	},
+	{
+		Operation: "TrustCenters.ListTrustCenterViewers",
+		Method:    "GET",
+		Path:      "/trust-centers/string/viewers",
+		Query:     "includeRemoved=true&pageCursor=string&pageSize=10",
+		Params:    `{"SlugID":"string","PageSize":10,"PageCursor":"string","IncludeRemoved":true}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "pageInfo": {
+      "hasNextPage": true,
+      "hasPreviousPage": false,
+      "startCursor": "YXJyYXljb25uZWN0aW9uOjA=",
+      "endCursor": "YXJyYXljb25uZWN0aW9uOjE="
+    },
+    "data": [
+      {
+        "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+        "email": "exampleviewer@company.com",
+        "name": "Example Viewer",
+        "companyName": "Viewer Company, Inc.",
+        "resourceIds": null,
+        "accessLevel": "FULL_ACCESS",
+        "ndaInfo": null,
+        "externalServiceAssociations": [
+          {
+            "service": "SALESFORCE",
+            "id": "0032S000062DfqnQBG",
+            "objectType": "Salesforce Contact"
+          }
+        ],
+        "creationDate": "2020-01-01T00:00:00.000Z",
+        "updatedDate": "2020-01-01T00:00:00.000Z",
+        "expirationDate": null,
+        "addedByUser": null
+      }
+    ]
+  }
+}`,
+	},
+	{
+		Operation: "TrustCenters.RemoveTrustCenterViewer",
+		Method:    "DELETE",
+		Path:      "/trust-centers/string/viewers/string",
+		Params:    `{"SlugID":"string","ViewerID":"string"}`,
+		Status:    204,
+	},
+	{
+		Operation: "TrustCenters.SendTrustCenterUpdateNotificationsToAllSubscribers",
+		Method:    "POST",
+		Path:      "/trust-centers/string/updates/string/notify-all-subscribers",
+		Params:    `{"SlugID":"string","UpdateID":"string"}`,
+		Status:    204,
+	},
+	{
+		Operation: "TrustCenters.SendTrustCenterUpdateNotificationsToSpecificSubscribers",
+		Method:    "POST",
+		Path:      "/trust-centers/string/updates/string/notify-specific-subscribers",
+		Params:    `{"SlugID":"string","UpdateID":"string","Body":{"emails":["string","string"],"subscriberGroupIds":["string","string"]}}`,
+		Status:    204,
+	},
+	{
+		Operation: "TrustCenters.SetGroupsForTrustCenterSubscriber",
+		Method:    "PUT",
+		Path:      "/trust-centers/string/subscribers/string/groups",
+		Params:    `{"SlugID":"string","SubscriberID":"string","Body":{"groupIds":["string","string"]}}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "email": "subscriber@example.com",
+  "isEmailVerified": true,
+  "creationDate": "2020-01-01T00:00:00.000Z"
+}`,
+	},
+	{
+		Operation: "TrustCenters.UpdateTrustCenter",
+		Method:    "PATCH",
+		Path:      "/trust-centers/string",
+		Params:    `{"SlugID":"string","Body":{"bannerSetting":{"endColor":"string","setting":"MINIMAL","startColor":"string"},"companyDescription":"string","customTheme":{"primary":"string","secondary":"string"},"isPublic":false,"privacyPolicy":"string","title":"string"}}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "title": "Trust Center",
+  "companyDescription": "Company description",
+  "privacyPolicy": "Privacy policy",
+  "customDomain": "trustcenter.com",
+  "isPublic": true,
+  "bannerSetting": {
+    "setting": "GRADIENT",
+    "startColor": "#000000",
+    "endColor": "#FFFFFF"
+  },
+  "customTheme": {
+    "primary": "#000000",
+    "secondary": "#FFFFFF"
+  },
+  "creationDate": "2020-01-01T00:00:00.000Z",
+  "updatedDate": "2020-01-01T00:00:00.000Z"
+}`,
+	},
+	{
+		Operation: "TrustCenters.UpdateTrustCenterControlCategory",
+		Method:    "PATCH",
+		Path:      "/trust-centers/string/control-categories/string",
+		Params:    `{"SlugID":"string","CategoryID":"string","Body":{"name":"string"}}`,
+		Status:    200,
+		Response: `{
+  "id": "93d69894dd525f806d7e5c48",
+  "name": "Category name"
+}`,
+	},
+	{
+		Operation: "TrustCenters.UpdateTrustCenterDocument",
+		Method:    "PATCH",
+		Path:      "/trust-centers/string/resources/string",
+		Params:    `{"SlugID":"string","ResourceID":"string","Body":{"description":"string","isPublic":false,"title":"string"}}`,
+		Status:    200,
+		Response: `{
+  "id": "4b1e7a8c3d9f6a2b5c8d0e3f",
+  "title": "Resource title",
+  "mimeType": "application/pdf",
+  "fileName": "resource.pdf",
+  "creationDate": "2020-01-01T00:00:00.000Z",
+  "updatedDate": "2020-01-01T00:00:00.000Z",
+  "isPublic": true,
+  "description": "Resource description"
+}`,
+	},
+	{
+		Operation: "TrustCenters.UpdateTrustCenterFaq",
+		Method:    "PATCH",
+		Path:      "/trust-centers/string/faqs/string",
+		Params:    `{"SlugID":"string","FaqID":"string","Body":{"answer":"string","question":"string"}}`,
+		Status:    200,
+		Response: `{
+  "id": "4b1e7a8c3d9f6a2b5c8d0e3f",
+  "question": "What is the meaning of life?",
+  "answer": "42"
+}`,
+	},
+	{
+		Operation: "TrustCenters.UpdateTrustCenterSubprocessor",
+		Method:    "PATCH",
+		Path:      "/trust-centers/string/subprocessors/string",
+		Params:    `{"SlugID":"string","SubprocessorID":"string","Body":{"description":"string","location":"string","purpose":"string"}}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "name": "Acme, Inc.",
+  "description": "This is the description for the subprocessor.",
+  "location": "US, EU",
+  "purpose": "Cloud infrastructure",
+  "url": "acme.com"
+}`,
+	},
+	{
+		Operation: "TrustCenters.UpdateTrustCenterUpdate",
+		Method:    "PATCH",
+		Path:      "/trust-centers/string/updates/string",
+		Params:    `{"SlugID":"string","UpdateID":"string","Body":{"category":"SECURITY","description":"string","title":"string","visibilityType":"PRIVATE"}}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "title": "Example title",
+  "description": "This is an example of an update's description.",
+  "category": "GENERAL",
+  "creationDate": "2020-01-01T00:00:00.000Z",
+  "updatedDate": "2020-01-01T00:00:00.000Z",
+  "visibilityType": "PUBLIC",
+  "notifiedEmails": [
+    "test@test.com"
+  ]
+}`,
+	},
+	{
+		Operation: "VendorRiskAttributes.ListVendorRiskAttributes",
+		Method:    "GET",
+		Path:      "/vendor-risk-attributes",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "a2f7e1b9d0c3f4e5a6c7b8d8",
+        "name": "Read internal systems",
+        "description": "The vendor can view or read data from internal systems, such as task trackers or sales tools.",
+        "vendorCategories": [
+          "Marketing",
+          "Office operation",
+          "Recruiting",
+          "Other"
+        ],
+        "enabled": true,
+        "riskLevel": "LOW"
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "6696ea0595df50d5cd6ec3b7",
+      "endCursor": "6696ece48eb1f98ff3d927c6"
+    }
+  }
+}`,
+	},
+	{
+		Operation: "Vendors.AddDocumentToSecurityReview",
+		Method:    "POST",
+		Path:      "/vendors/string/security-reviews/string/documents",
+		Params:    `{"VendorID":"string","SecurityReviewID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d8",
+  "url": "https://example.com",
+  "title": "PDF SOC2 Report",
+  "fileName": "soc2.pdf",
+  "type": "SOC2_REPORT",
+  "mimeType": "application/pdf",
+  "description": "The 2023 SOC2 file provided by the vendor",
+  "uploadedBy": {
+    "id": "66993da0cf4ba2ad40599ba7",
+    "type": "USER"
+  },
+  "creationDate": "2024-02-01T00:00:00.000Z",
+  "updatedDate": "2024-02-07T00:00:00.000Z",
+  "deletionDate": null
+}`,
+	},
+	{
+		Operation: "Vendors.AddDocumentToVendor",
+		Method:    "POST",
+		Path:      "/vendors/string/documents",
+		Params:    `{"VendorID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d8",
+  "url": "https://example.com",
+  "title": "PDF SOC2 Report",
+  "fileName": "soc2.pdf",
+  "type": "SOC2_REPORT",
+  "mimeType": "application/pdf",
+  "description": "The 2023 SOC2 file provided by the vendor",
+  "uploadedBy": {
+    "id": "66993da0cf4ba2ad40599ba7",
+    "type": "USER"
+  },
+  "creationDate": "2024-02-01T00:00:00.000Z",
+  "updatedDate": "2024-02-07T00:00:00.000Z",
+  "deletionDate": null
+}`,
+	},
+	{
+		Operation: "Vendors.AddVendorFinding",
+		Method:    "POST",
+		Path:      "/vendors/string/findings",
+		Params:    `{"VendorID":"string","Body":{"content":"string","documentId":"string","remediation":{"requirementNotes":"string","state":"CLOSED"},"riskStatus":"NONE","securityReviewId":"string"}}`,
+		Status:    200,
+		Response: `{
+  "id": "66bb83e06c54dc42afedb174",
+  "vendorId": "66bb83dc14f5709efe418859",
+  "securityReviewId": "66bb83977ffe63d2c54d6711",
+  "documentId": null,
+  "content": "This vendor has not performed a penetration test in the past 15 months.",
+  "riskStatus": "REMEDIATE",
+  "remediation": {
+    "requirementNotes": "We need them to provide an updated penetration test report.",
+    "state": "OPEN"
+  }
+}`,
+	},
+	{
+		Operation: "Vendors.CreateVendor",
+		Method:    "POST",
+		Path:      "/vendors",
+		Params:    `{"Body":{"accountManagerEmail":"string","accountManagerName":"string","additionalNotes":"string","authDetails":{"method":"AUTH_0","passwordMFA":false,"passwordMinimumLength":8169.486807552457,"passwordRequiresNumber":false,"passwordRequiresSymbol":false},"businessOwnerUserId":"string","category":"string","contractAmount":{"amount":9467.755957558618,"currency":"ILS"},"contractRenewalDate":"1955-09-12T08:29:38.036Z","contractStartDate":"2021-08-24T12:30:44.149Z","contractTerminationDate":"2014-07-02T15:38:25.786Z","customFields":[{"label":"string","value":"string"},{"label":"string","value":"string"}],"frameworkScope":{"frameworkIds":["soc2","hipaa"],"scopeType":"PARTIAL"},"inherentRiskLevel":"HIGH","isVisibleToAuditors":false,"name":"string","residualRiskLevel":"MEDIUM","securityOwnerUserId":"string","servicesProvided":"string","status":"MANAGED","vendorHeadquarters":"AZE","websiteUrl":"string"}}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d8",
+  "name": "Vanta",
+  "websiteUrl": "https://www.vanta.com/",
+  "accountManagerName": "John Doe",
+  "accountManagerEmail": "john@doe.com",
+  "servicesProvided": "SaaS",
+  "additionalNotes": "Automate compliance and streamline security reviews with the leading trust management platform.",
+  "authDetails": {
+    "method": "O_AUTH",
+    "passwordMFA": true,
+    "passwordRequiresNumber": true,
+    "passwordRequiresSymbol": true,
+    "passwordMinimumLength": 16
+  },
+  "securityOwnerUserId": "6626afa6490ec920099773e7",
+  "businessOwnerUserId": "6626afb14c912f0a50e85619",
+  "contractStartDate": "2024-02-01T00:00:00.000Z",
+  "contractRenewalDate": "2025-02-01T00:00:00.000Z",
+  "contractTerminationDate": null,
+  "lastSecurityReviewCompletionDate": "2024-01-01T00:00:00.000Z",
+  "nextSecurityReviewDueDate": "2025-01-01T00:00:00.000Z",
+  "isVisibleToAuditors": true,
+  "isRiskAutoScored": true,
+  "category": {
+    "displayName": "cloudMonitoring"
+  },
+  "riskAttributeIds": [
+    "6626b0298acc44f8674390da",
+    "6626b02ea4cd9ba80d773c20"
+  ],
+  "status": "MANAGED",
+  "inherentRiskLevel": "HIGH",
+  "residualRiskLevel": "MEDIUM",
+  "vendorHeadquarters": "USA",
+  "contractAmount": {
+    "amount": 1000000,
+    "currency": "USD"
+  },
+  "customFields": null,
+  "tagIdentifiers": null,
+  "latestDecision": {
+    "status": "APPROVED",
+    "lastUpdatedAt": "2024-01-01T00:00:00.000Z"
+  }
+}`,
+	},
+	{
+		Operation: "Vendors.DeleteFindingByID",
+		Method:    "DELETE",
+		Path:      "/vendors/string/findings/string",
+		Params:    `{"VendorID":"string","FindingID":"string"}`,
+		Status:    204,
+	},
+	{
+		Operation: "Vendors.DeleteSecurityReviewDocumentByID",
+		Method:    "DELETE",
+		Path:      "/vendors/string/security-reviews/string/documents/string",
+		Params:    `{"VendorID":"string","SecurityReviewID":"string","DocumentID":"string"}`,
+		Status:    204,
+	},
+	{
+		Operation: "Vendors.DeleteVendorByID",
+		Method:    "DELETE",
+		Path:      "/vendors/string",
+		Params:    `{"VendorID":"string"}`,
+		Status:    204,
+	},
+	{
+		Operation: "Vendors.GetSecurityReviewByID",
+		Method:    "GET",
+		Path:      "/vendors/string/security-reviews/string",
+		Params:    `{"VendorID":"string","SecurityReviewID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d8",
+  "vendorId": "6696e9bca247cbdf1c8e5054",
+  "decisionNotes": "No major concerns, limited sharing of data, low security risk.",
+  "comments": "If we expand our deal with them we will need to re-review in May.",
+  "completedByUserId": "6696ea0595df50d5cd6ec3b7",
+  "startDate": "2024-02-01T00:00:00.000Z",
+  "dueDate": "2024-03-01T00:00:00.000Z",
+  "overrideDueDate": "2024-03-15T00:00:00.000Z",
+  "completionDate": "2024-03-10T00:00:00.000Z",
+  "decision": {
+    "status": "APPROVED",
+    "lastUpdatedAt": "2024-03-17T00:00:00.000Z"
+  }
+}`,
+	},
+	{
+		Operation: "Vendors.GetVendorByID",
+		Method:    "GET",
+		Path:      "/vendors/string",
+		Params:    `{"VendorID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d8",
+  "name": "Vanta",
+  "websiteUrl": "https://www.vanta.com/",
+  "accountManagerName": "John Doe",
+  "accountManagerEmail": "john@doe.com",
+  "servicesProvided": "SaaS",
+  "additionalNotes": "Automate compliance and streamline security reviews with the leading trust management platform.",
+  "authDetails": {
+    "method": "O_AUTH",
+    "passwordMFA": true,
+    "passwordRequiresNumber": true,
+    "passwordRequiresSymbol": true,
+    "passwordMinimumLength": 16
+  },
+  "securityOwnerUserId": "6626afa6490ec920099773e7",
+  "businessOwnerUserId": "6626afb14c912f0a50e85619",
+  "contractStartDate": "2024-02-01T00:00:00.000Z",
+  "contractRenewalDate": "2025-02-01T00:00:00.000Z",
+  "contractTerminationDate": null,
+  "lastSecurityReviewCompletionDate": "2024-01-01T00:00:00.000Z",
+  "nextSecurityReviewDueDate": "2025-01-01T00:00:00.000Z",
+  "isVisibleToAuditors": true,
+  "isRiskAutoScored": true,
+  "category": {
+    "displayName": "cloudMonitoring"
+  },
+  "riskAttributeIds": [
+    "6626b0298acc44f8674390da",
+    "6626b02ea4cd9ba80d773c20"
+  ],
+  "status": "MANAGED",
+  "inherentRiskLevel": "HIGH",
+  "residualRiskLevel": "MEDIUM",
+  "vendorHeadquarters": "USA",
+  "contractAmount": {
+    "amount": 1000000,
+    "currency": "USD"
+  },
+  "customFields": null,
+  "tagIdentifiers": null,
+  "latestDecision": {
+    "status": "APPROVED",
+    "lastUpdatedAt": "2024-01-01T00:00:00.000Z"
+  }
+}`,
+	},
+	{
+		Operation: "Vendors.ListSecurityReviewDocuments",
+		Method:    "GET",
+		Path:      "/vendors/string/security-reviews/string/documents",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"VendorID":"string","SecurityReviewID":"string","PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "a2f7e1b9d0c3f4e5a6c7b8d8",
+        "url": "https://example.com",
+        "title": "PDF SOC2 Report",
+        "fileName": "soc2.pdf",
+        "type": "SOC2_REPORT",
+        "mimeType": "application/pdf",
+        "description": "The 2023 SOC2 file provided by the vendor",
+        "uploadedBy": {
+          "id": "66993da0cf4ba2ad40599ba7",
+          "type": "USER"
+        },
+        "creationDate": "2024-02-01T00:00:00.000Z",
+        "updatedDate": "2024-02-07T00:00:00.000Z",
+        "deletionDate": null
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "6696ea0595df50d5cd6ec3b7",
+      "endCursor": "6696ece48eb1f98ff3d927c6"
+    }
+  }
+}`,
+	},
+	{
+		Operation: "Vendors.ListSecurityReviewsByVendorID",
+		Method:    "GET",
+		Path:      "/vendors/string/security-reviews",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"VendorID":"string","PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "a2f7e1b9d0c3f4e5a6c7b8d8",
+        "vendorId": "6696e9bca247cbdf1c8e5054",
+        "decisionNotes": "No major concerns, limited sharing of data, low security risk.",
+        "comments": "If we expand our deal with them we will need to re-review in May.",
+        "completedByUserId": "6696ea0595df50d5cd6ec3b7",
+        "startDate": "2024-02-01T00:00:00.000Z",
+        "dueDate": "2024-03-01T00:00:00.000Z",
+        "overrideDueDate": "2024-03-15T00:00:00.000Z",
+        "completionDate": "2024-03-10T00:00:00.000Z",
+        "decision": {
+          "status": "APPROVED",
+          "lastUpdatedAt": "2024-03-17T00:00:00.000Z"
+        }
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "6696ea0595df50d5cd6ec3b7",
+      "endCursor": "6696ece48eb1f98ff3d927c6"
+    }
+  }
+}`,
+	},
+	{
+		Operation: "Vendors.ListVendorDocuments",
+		Method:    "GET",
+		Path:      "/vendors/string/documents",
+		Query:     "pageCursor=string&pageSize=10",
+		Params:    `{"VendorID":"string","PageSize":10,"PageCursor":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "a2f7e1b9d0c3f4e5a6c7b8d8",
+        "url": "https://example.com",
+        "title": "PDF SOC2 Report",
+        "fileName": "soc2.pdf",
+        "type": "SOC2_REPORT",
+        "mimeType": "application/pdf",
+        "description": "The 2023 SOC2 file provided by the vendor",
+        "uploadedBy": {
+          "id": "66993da0cf4ba2ad40599ba7",
+          "type": "USER"
+        },
+        "creationDate": "2024-02-01T00:00:00.000Z",
+        "updatedDate": "2024-02-07T00:00:00.000Z",
+        "deletionDate": null
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "6696ea0595df50d5cd6ec3b7",
+      "endCursor": "6696ece48eb1f98ff3d927c6"
+    }
+  }
+}`,
+	},
+	{
+		Operation: "Vendors.ListVendorFindings",
+		Method:    "GET",
+		Path:      "/vendors/string/findings",
+		Query:     "documentId=string&pageCursor=string&pageSize=10&securityReviewId=string",
+		Params:    `{"VendorID":"string","PageSize":10,"PageCursor":"string","SecurityReviewID":"string","DocumentID":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "66bb83e06c54dc42afedb174",
+        "vendorId": "66bb83dc14f5709efe418859",
+        "securityReviewId": "66bb83977ffe63d2c54d6711",
+        "documentId": null,
+        "content": "This vendor has not performed a penetration test in the past 15 months.",
+        "riskStatus": "REMEDIATE",
+        "remediation": {
+          "requirementNotes": "We need them to provide an updated penetration test report.",
+          "state": "OPEN"
+        }
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "6696ea0595df50d5cd6ec3b7",
+      "endCursor": "6696ece48eb1f98ff3d927c6"
+    }
+  }
+}`,
+	},
+	{
+		Operation: "Vendors.ListVendors",
+		Method:    "GET",
+		Path:      "/vendors",
+		Query:     "name=string&pageCursor=string&pageSize=10&statusMatchesAny=ARCHIVED&statusMatchesAny=ARCHIVED",
+		Params:    `{"PageSize":10,"PageCursor":"string","Name":"string","StatusMatchesAny":["ARCHIVED","ARCHIVED"]}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "data": [
+      {
+        "id": "a2f7e1b9d0c3f4e5a6c7b8d8",
+        "name": "Vanta",
+        "websiteUrl": "https://www.vanta.com/",
+        "accountManagerName": "John Doe",
+        "accountManagerEmail": "john@doe.com",
+        "servicesProvided": "SaaS",
+        "additionalNotes": "Automate compliance and streamline security reviews with the leading trust management platform.",
+        "authDetails": {
+          "method": "O_AUTH",
+          "passwordMFA": true,
+          "passwordRequiresNumber": true,
+          "passwordRequiresSymbol": true,
+          "passwordMinimumLength": 16
+        },
+        "securityOwnerUserId": "6626afa6490ec920099773e7",
+        "businessOwnerUserId": "6626afb14c912f0a50e85619",
+        "contractStartDate": "2024-02-01T00:00:00.000Z",
+        "contractRenewalDate": "2025-02-01T00:00:00.000Z",
+        "contractTerminationDate": null,
+        "lastSecurityReviewCompletionDate": "2024-01-01T00:00:00.000Z",
+        "nextSecurityReviewDueDate": "2025-01-01T00:00:00.000Z",
+        "isVisibleToAuditors": true,
+        "isRiskAutoScored": true,
+        "category": {
+          "displayName": "cloudMonitoring"
+        },
+        "riskAttributeIds": [
+          "6626b0298acc44f8674390da",
+          "6626b02ea4cd9ba80d773c20"
+        ],
+        "status": "MANAGED",
+        "inherentRiskLevel": "HIGH",
+        "residualRiskLevel": "MEDIUM",
+        "vendorHeadquarters": "USA",
+        "contractAmount": {
+          "amount": 1000000,
+          "currency": "USD"
+        },
+        "customFields": null,
+        "tagIdentifiers": null,
+        "latestDecision": {
+          "status": "APPROVED",
+          "lastUpdatedAt": "2024-01-01T00:00:00.000Z"
+        }
+      }
+    ],
+    "pageInfo": {
+      "hasNextPage": false,
+      "hasPreviousPage": false,
+      "startCursor": "6696ea0595df50d5cd6ec3b7",
+      "endCursor": "6696ece48eb1f98ff3d927c6"
+    }
+  }
+}`,
+	},
+	{
+		Operation: "Vendors.SetVendorStatus",
+		Method:    "POST",
+		Path:      "/vendors/string/set-status",
+		Params:    `{"VendorID":"string"}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d8",
+  "name": "Vanta",
+  "websiteUrl": "https://www.vanta.com/",
+  "accountManagerName": "John Doe",
+  "accountManagerEmail": "john@doe.com",
+  "servicesProvided": "SaaS",
+  "additionalNotes": "Automate compliance and streamline security reviews with the leading trust management platform.",
+  "authDetails": {
+    "method": "O_AUTH",
+    "passwordMFA": true,
+    "passwordRequiresNumber": true,
+    "passwordRequiresSymbol": true,
+    "passwordMinimumLength": 16
+  },
+  "securityOwnerUserId": "6626afa6490ec920099773e7",
+  "businessOwnerUserId": "6626afb14c912f0a50e85619",
+  "contractStartDate": "2024-02-01T00:00:00.000Z",
+  "contractRenewalDate": "2025-02-01T00:00:00.000Z",
+  "contractTerminationDate": null,
+  "lastSecurityReviewCompletionDate": "2024-01-01T00:00:00.000Z",
+  "nextSecurityReviewDueDate": "2025-01-01T00:00:00.000Z",
+  "isVisibleToAuditors": true,
+  "isRiskAutoScored": true,
+  "category": {
+    "displayName": "cloudMonitoring"
+  },
+  "riskAttributeIds": [
+    "6626b0298acc44f8674390da",
+    "6626b02ea4cd9ba80d773c20"
+  ],
+  "status": "MANAGED",
+  "inherentRiskLevel": "HIGH",
+  "residualRiskLevel": "MEDIUM",
+  "vendorHeadquarters": "USA",
+  "contractAmount": {
+    "amount": 1000000,
+    "currency": "USD"
+  },
+  "customFields": null,
+  "tagIdentifiers": null,
+  "latestDecision": {
+    "status": "APPROVED",
+    "lastUpdatedAt": "2024-01-01T00:00:00.000Z"
+  }
+}`,
+	},
+	{
+		Operation: "Vendors.UpdateVendorByID",
+		Method:    "PATCH",
+		Path:      "/vendors/string",
+		Params:    `{"VendorID":"string","Body":{"accountManagerEmail":"string","accountManagerName":"string","additionalNotes":"string","authDetails":{"method":"O365","passwordMFA":false,"passwordMinimumLength":6816.0005413750605,"passwordRequiresNumber":true,"passwordRequiresSymbol":false},"businessOwnerUserId":"string","category":"string","contractAmount":{"amount":8331.887413827519,"currency":"GBP"},"contractRenewalDate":"1963-12-05T00:51:44.662Z","contractStartDate":"2018-06-03T15:20:51.192Z","contractTerminationDate":"1994-05-31T07:06:14.200Z","customFields":[{"label":"string","value":"string"},{"label":"string","value":"string"}],"frameworkScope":{"frameworkIds":["soc2","hipaa"],"scopeType":"NONE"},"inherentRiskLevel":"UNSCORED","isVisibleToAuditors":false,"name":"string","residualRiskLevel":"CRITICAL","riskAttributeIds":["string","string"],"securityOwnerUserId":"string","servicesProvided":"string","status":"IN_PROCUREMENT","vendorHeadquarters":"LCA","websiteUrl":"string"}}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d8",
+  "name": "Vanta",
+  "websiteUrl": "https://www.vanta.com/",
+  "accountManagerName": "John Doe",
+  "accountManagerEmail": "john@doe.com",
+  "servicesProvided": "SaaS",
+  "additionalNotes": "Automate compliance and streamline security reviews with the leading trust management platform.",
+  "authDetails": {
+    "method": "O_AUTH",
+    "passwordMFA": true,
+    "passwordRequiresNumber": true,
+    "passwordRequiresSymbol": true,
+    "passwordMinimumLength": 16
+  },
+  "securityOwnerUserId": "6626afa6490ec920099773e7",
+  "businessOwnerUserId": "6626afb14c912f0a50e85619",
+  "contractStartDate": "2024-02-01T00:00:00.000Z",
+  "contractRenewalDate": "2025-02-01T00:00:00.000Z",
+  "contractTerminationDate": null,
+  "lastSecurityReviewCompletionDate": "2024-01-01T00:00:00.000Z",
+  "nextSecurityReviewDueDate": "2025-01-01T00:00:00.000Z",
+  "isVisibleToAuditors": true,
+  "isRiskAutoScored": true,
+  "category": {
+    "displayName": "cloudMonitoring"
+  },
+  "riskAttributeIds": [
+    "6626b0298acc44f8674390da",
+    "6626b02ea4cd9ba80d773c20"
+  ],
+  "status": "MANAGED",
+  "inherentRiskLevel": "HIGH",
+  "residualRiskLevel": "MEDIUM",
+  "vendorHeadquarters": "USA",
+  "contractAmount": {
+    "amount": 1000000,
+    "currency": "USD"
+  },
+  "customFields": null,
+  "tagIdentifiers": null,
+  "latestDecision": {
+    "status": "APPROVED",
+    "lastUpdatedAt": "2024-01-01T00:00:00.000Z"
+  }
+}`,
+	},
+	{
+		Operation: "Vendors.UpdateVendorFinding",
+		Method:    "PATCH",
+		Path:      "/vendors/string/findings/string",
+		Params:    `{"VendorID":"string","FindingID":"string","Body":{"content":"string","remediation":{"requirementNotes":"string","state":"CLOSED"},"riskStatus":"ACCEPT"}}`,
+		Status:    200,
+		Response: `{
+  "id": "66bb83e06c54dc42afedb174",
+  "vendorId": "66bb83dc14f5709efe418859",
+  "securityReviewId": "66bb83977ffe63d2c54d6711",
+  "documentId": null,
+  "content": "This vendor has not performed a penetration test in the past 15 months.",
+  "riskStatus": "REMEDIATE",
+  "remediation": {
+    "requirementNotes": "We need them to provide an updated penetration test report.",
+    "state": "OPEN"
+  }
+}`,
+	},
+	{
+		Operation: "Vulnerabilities.DeactivateVulnerabilityMonitoringForVulnerability",
+		Method:    "POST",
+		Path:      "/vulnerabilities/deactivate",
+		Params:    `{"Body":{"updates":[{"deactivateReason":"string","deactivateUntilDate":"1974-06-19T07:52:44.253Z","id":"string","shouldReactivateWhenFixable":true}]}}`,
+		Status:    200,
+		Response: `{
+  "results": [
+    {
+      "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+      "status": "SUCCESS"
+    },
+    {
+      "id": "OTHER_VULNERABILITY_ID",
+      "status": "ERROR",
+      "message": "Invalid Input"
+    }
+  ]
+}`,
+	},
+	{
+		Operation: "Vulnerabilities.GetVulnerabilities",
+		Method:    "GET",
+		Path:      "/vulnerabilities",
+		Query:     "externalVulnerabilityId=string&includeVulnerabilitiesWithoutSlas=true&integrationId=string&isDeactivated=true&isFixAvailable=true&packageIdentifier=string&pageCursor=string&pageSize=10&q=string&severity=MEDIUM&slaDeadlineAfterDate=1948-10-24T09%3A15%3A03.646Z&slaDeadlineBeforeDate=1948-10-24T09%3A15%3A03.646Z&vulnerableAssetId=string",
+		Params:    `{"Q":"string","PageSize":10,"PageCursor":"string","IsDeactivated":true,"ExternalVulnerabilityID":"string","IsFixAvailable":true,"PackageIDentifier":"string","SlaDeadlineAfterDate":"1948-10-24T09:15:03.646Z","SlaDeadlineBeforeDate":"1948-10-24T09:15:03.646Z","Severity":"MEDIUM","IntegrationID":"string","IncludeVulnerabilitiesWithoutSlas":true,"VulnerableAssetID":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "pageInfo": {
+      "hasNextPage": true,
+      "hasPreviousPage": false,
+      "startCursor": "YXJyYXljb25uZWN0aW9uOjA=",
+      "endCursor": "YXJyYXljb25uZWN0aW9uOjE="
+    },
+    "data": [
+      {
+        "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+        "name": "CVE-2021-12345",
+        "description": "MariaDB before 10.6.2 allows an application crash because of mishandling of a pushdown from a HAVING clause to a WHERE clause.",
+        "integrationId": "Inspector",
+        "packageIdentifier": "package",
+        "vulnerabilityType": "COMMON",
+        "targetId": "targetId",
+        "firstDetectedDate": "2021-01-01T00:00:00.000Z",
+        "sourceDetectedDate": "2021-01-01T00:00:00.000Z",
+        "lastDetectedDate": "2021-01-01T00:00:00.000Z",
+        "severity": "CRITICAL",
+        "cvssSeverityScore": 9.8,
+        "scannerScore": 100,
+        "isFixable": true,
+        "remediateByDate": "2021-01-01T00:00:00.000Z",
+        "relatedVulns": [
+          "CVE-2021-12345"
+        ],
+        "relatedUrls": [
+          "https://cve.mitre.org/cgi-bin/cvename.cgi?name=CVE-2021-12345"
+        ],
+        "externalURL": "https://cve.mitre.org/cgi-bin/cvename.cgi?name=CVE-2021-12345",
+        "scanSource": "Not provided",
+        "deactivateMetadata": {
+          "deactivatedBy": "b2f7e1b9d0c3f4e5a6c7b123",
+          "deactivatedOnDate": "2021-01-01T00:00:00.000Z",
+          "deactivationReason": "fix is too hard to carry out",
+          "deactivatedUntilDate": null,
+          "isVulnDeactivatedIndefinitely": true
+        }
+      }
+    ]
+  }
+}`,
+	},
+	{
+		Operation: "Vulnerabilities.GetVulnerabilityByID",
+		Method:    "GET",
+		Path:      "/vulnerabilities/64abcc129ff483012345d789",
+		Params:    `{"VulnerabilityID":"64abcc129ff483012345d789"}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "name": "CVE-2021-12345",
+  "description": "MariaDB before 10.6.2 allows an application crash because of mishandling of a pushdown from a HAVING clause to a WHERE clause.",
+  "integrationId": "Inspector",
+  "packageIdentifier": "package",
+  "vulnerabilityType": "COMMON",
+  "targetId": "targetId",
+  "firstDetectedDate": "2021-01-01T00:00:00.000Z",
+  "sourceDetectedDate": "2021-01-01T00:00:00.000Z",
+  "lastDetectedDate": "2021-01-01T00:00:00.000Z",
+  "severity": "CRITICAL",
+  "cvssSeverityScore": 9.8,
+  "scannerScore": 100,
+  "isFixable": true,
+  "remediateByDate": "2021-01-01T00:00:00.000Z",
+  "relatedVulns": [
+    "CVE-2021-12345"
+  ],
+  "relatedUrls": [
+    "https://cve.mitre.org/cgi-bin/cvename.cgi?name=CVE-2021-12345"
+  ],
+  "externalURL": "https://cve.mitre.org/cgi-bin/cvename.cgi?name=CVE-2021-12345",
+  "scanSource": "Not provided",
+  "deactivateMetadata": {
+    "deactivatedBy": "b2f7e1b9d0c3f4e5a6c7b123",
+    "deactivatedOnDate": "2021-01-01T00:00:00.000Z",
+    "deactivationReason": "fix is too hard to carry out",
+    "deactivatedUntilDate": null,
+    "isVulnDeactivatedIndefinitely": true
+  }
+}`,
+	},
+	{
+		Operation: "Vulnerabilities.ReactivateVulnerabilityMonitoring",
+		Method:    "POST",
+		Path:      "/vulnerabilities/reactivate",
+		Params:    `{"Body":{"updates":[{"id":"string"}]}}`,
+		Status:    200,
+		Response: `{
+  "results": [
+    {
+      "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+      "status": "SUCCESS"
+    },
+    {
+      "id": "OTHER_VULNERABILITY_ID",
+      "status": "ERROR",
+      "message": "Invalid Input"
+    }
+  ]
+}`,
+	},
+	{
+		Operation: "VulnerabilityRemediations.AcknowledgeSlaMiss",
+		Method:    "POST",
+		Path:      "/vulnerability-remediations/acknowledge-sla-miss",
+		Params:    `{"Body":{"updates":[{"id":"string","slaViolationComment":"str"}]}}`,
+		Status:    200,
+		Response: `{
+  "results": [
+    {
+      "id": "vuln-remediation-id",
+      "status": "SUCCESS"
+    },
+    {
+      "id": "vuln-remediation-id-2",
+      "status": "ERROR",
+      "message": "Invalid Input"
+    }
+  ]
+}`,
+	},
+	{
+		Operation: "VulnerabilityRemediations.ListVulnerabilityRemediations",
+		Method:    "GET",
+		Path:      "/vulnerability-remediations",
+		Query:     "integrationId=string&isRemediatedOnTime=true&pageCursor=string&pageSize=10&remediatedAfterDate=1948-10-24T09%3A15%3A03.646Z&remediatedBeforeDate=1948-10-24T09%3A15%3A03.646Z&severity=MEDIUM",
+		Params:    `{"PageSize":10,"PageCursor":"string","IntegrationID":"string","Severity":"MEDIUM","IsRemediatedOnTime":true,"RemediatedAfterDate":"1948-10-24T09:15:03.646Z","RemediatedBeforeDate":"1948-10-24T09:15:03.646Z"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "pageInfo": {
+      "hasNextPage": true,
+      "hasPreviousPage": false,
+      "startCursor": "YXJyYXljb25uZWN0aW9uOjA=",
+      "endCursor": "YXJyYXljb25uZWN0aW9uOjE="
+    },
+    "data": [
+      {
+        "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+        "vulnerabilityId": "a2f7e1b9d0c3f4e5a6c7b8d8",
+        "vulnerableAssetId": "a2f7e1b9d0c3f4e5a6c7b8d7",
+        "severity": "critical",
+        "detectedDate": "2021-01-01T00:00:00.000Z",
+        "slaDeadlineDate": "2021-03-01T00:00:00.000Z",
+        "remediationDate": "2021-02-01T00:00:00.000Z"
+      }
+    ]
+  }
+}`,
+	},
+	{
+		Operation: "VulnerableAssets.GetVulnerableAssetByID",
+		Method:    "GET",
+		Path:      "/vulnerable-assets/64abcc129ff483012345d789",
+		Params:    `{"VulnerableAssetID":"64abcc129ff483012345d789"}`,
+		Status:    200,
+		Response: `{
+  "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+  "name": "CVE-2021-12345",
+  "assetType": "SERVER",
+  "hasBeenScanned": true,
+  "imageScanTag": "apac-production:latest",
+  "scanners": [
+    {
+      "resourceId": "6733c25f852819d3b8d97a86",
+      "integrationId": "qualys",
+      "imageDigest": "sha256:123456",
+      "imagePushedAtDate": "2021-01-01T00:00:00.000Z",
+      "imageTags": [
+        "candidate-1234567890"
+      ],
+      "assetTags": [
+        {
+          "key": "company-name",
+          "value": "vanta-llama"
+        }
+      ],
+      "parentAccountOrOrganization": "12345678-abcd-cdef-ab12-abcd1234bbbb",
+      "biosUuid": "123456",
+      "ipv4s": [
+        "12.12.123.123"
+      ],
+      "ipv6s": null,
+      "macAddresses": [
+        "1234AB987FED"
+      ],
+      "hostnames": [
+        "purple-llama"
+      ],
+      "fqdns": [
+        "purple-llama"
+      ],
+      "operatingSystems": [
+        "Windows11"
+      ],
+      "targetId": "12345678-abcd-cdef-ab12-abcd1234bbbc"
+    }
+  ]
+}`,
+	},
+	{
+		Operation: "VulnerableAssets.ListAssetsAssociatedWithVulnerabilities",
+		Method:    "GET",
+		Path:      "/vulnerable-assets",
+		Query:     "assetExternalAccountId=string&assetType=WORKSTATION&integrationId=string&pageCursor=string&pageSize=10&q=string",
+		Params:    `{"Q":"string","PageSize":10,"PageCursor":"string","IntegrationID":"string","AssetType":"WORKSTATION","AssetExternalAccountID":"string"}`,
+		Status:    200,
+		Response: `{
+  "results": {
+    "pageInfo": {
+      "hasNextPage": true,
+      "hasPreviousPage": false,
+      "startCursor": "YXJyYXljb25uZWN0aW9uOjA=",
+      "endCursor": "YXJyYXljb25uZWN0aW9uOjE="
+    },
+    "data": [
+      {
+        "id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+        "name": "CVE-2021-12345",
+        "assetType": "SERVER",
+        "hasBeenScanned": true,
+        "imageScanTag": "apac-production:latest",
+        "scanners": [
+          {
+            "resourceId": "6733c25f852819d3b8d97a86",
+            "integrationId": "qualys",
+            "imageDigest": "sha256:123456",
+            "imagePushedAtDate": "2021-01-01T00:00:00.000Z",
+            "imageTags": [
+              "candidate-1234567890"
+            ],
+            "assetTags": [
+              {
+                "key": "company-name",
+                "value": "vanta-llama"
+              }
+            ],
+            "parentAccountOrOrganization": "12345678-abcd-cdef-ab12-abcd1234bbbb",
+            "biosUuid": "123456",
+            "ipv4s": [
+              "12.12.123.123"
+            ],
+            "ipv6s": null,
+            "macAddresses": [
+              "1234AB987FED"
+            ],
+            "hostnames": [
+              "purple-llama"
+            ],
+            "fqdns": [
+              "purple-llama"
+            ],
+            "operatingSystems": [
+              "Windows11"
+            ],
+            "targetId": "12345678-abcd-cdef-ab12-abcd1234bbbc"
+          }
+        ]
+      }
+    ]
+  }
+}`,
+	},
+}
@@ -0,0 +1,341 @@
+// Code generated by vanta-gen. DO NOT EDIT.
+
+// Vanta service interfaces.
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+)
+
+// ControlsAPI is the method set of ControlsService, implemented for tests by
+// vantamock.ControlsAPI.
+type ControlsAPI interface {
+	AddControlFromVantaLibrary(ctx context.Context, params *ControlsAddControlFromVantaLibraryParams) (*Control, error)
+	AddControlToDocumentMapping(ctx context.Context, params *ControlsAddControlToDocumentMappingParams) (*ControlsAddControlToDocumentMappingResponse, error)
+	AddControlToTestMapping(ctx context.Context, params *ControlsAddControlToTestMappingParams) (*ControlsAddControlToTestMappingResponse, error)
+	CreateCustomControl(ctx context.Context, params *ControlsCreateCustomControlParams) (*Control, error)
+	GetControlByID(ctx context.Context, params *ControlsGetControlByIDParams) (*Control, error)
+	ListControls(ctx context.Context, params *ControlsListControlsParams) (*ResultsPage[Control], error)
+	AllControls(ctx context.Context, params *ControlsListControlsParams) iter.Seq2[Control, error]
+	ListControlsDocuments(ctx context.Context, params *ControlsListControlsDocumentsParams) (*ResultsPage[Document], error)
+	AllControlsDocuments(ctx context.Context, params *ControlsListControlsDocumentsParams) iter.Seq2[Document, error]
+	ListControlsTests(ctx context.Context, params *ControlsListControlsTestsParams) (*ResultsPage[Test], error)
+	AllControlsTests(ctx context.Context, params *ControlsListControlsTestsParams) iter.Seq2[Test, error]
+	ListVantaControlsFromLibrary(ctx context.Context, params *ControlsListVantaControlsFromLibraryParams) (*ResultsPage[Control], error)
+	AllVantaControlsFromLibrary(ctx context.Context, params *ControlsListVantaControlsFromLibraryParams) iter.Seq2[Control, error]
+	RemoveControl(ctx context.Context, params *ControlsRemoveControlParams) (json.RawMessage, error)
+	RemoveControlFromDocumentMapping(ctx context.Context, params *ControlsRemoveControlFromDocumentMappingParams) (json.RawMessage, error)
+	RemoveControlFromTestMapping(ctx context.Context, params *ControlsRemoveControlFromTestMappingParams) (json.RawMessage, error)
+	SetOwnerOfControl(ctx context.Context, params *ControlsSetOwnerOfControlParams) (*Control, error)
+	UpdateControlsMetadata(ctx context.Context, params *ControlsUpdateControlsMetadataParams) (*Control, error)
+}
+
+var _ ControlsAPI = (*ControlsService)(nil)
+
+// DiscoveredVendorsAPI is the method set of DiscoveredVendorsService, implemented for tests by
+// vantamock.DiscoveredVendorsAPI.
+type DiscoveredVendorsAPI interface {
+	AddsDiscoveredVendorToManagedVendorByID(ctx context.Context, params *DiscoveredVendorsAddsDiscoveredVendorToManagedVendorByIDParams) (*Vendor, error)
+	ListDiscoveredVendors(ctx context.Context, params *DiscoveredVendorsListDiscoveredVendorsParams) (*ResultsPage[DiscoveredVendor], error)
+	AllDiscoveredVendors(ctx context.Context, params *DiscoveredVendorsListDiscoveredVendorsParams) iter.Seq2[DiscoveredVendor, error]
+	ListOfDiscoveredVendorAccounts(ctx context.Context, params *DiscoveredVendorsListOfDiscoveredVendorAccountsParams) (*ResultsPage[DiscoveredVendorAccount], error)
+	AllDiscoveredVendorAccounts(ctx context.Context, params *DiscoveredVendorsListOfDiscoveredVendorAccountsParams) iter.Seq2[DiscoveredVendorAccount, error]
+}
+
+var _ DiscoveredVendorsAPI = (*DiscoveredVendorsService)(nil)
+
+// DocumentsAPI is the method set of DocumentsService, implemented for tests by
+// vantamock.DocumentsAPI.
+type DocumentsAPI interface {
+	CreateCustomDocument(ctx context.Context, params *DocumentsCreateCustomDocumentParams) (*Document, error)
+	CreateDocumentLink(ctx context.Context, params *DocumentsCreateDocumentLinkParams) (*DocumentLink, error)
+	DeleteDocumentByID(ctx context.Context, params *DocumentsDeleteDocumentByIDParams) (json.RawMessage, error)
+	DeleteFileForDocument(ctx context.Context, params *DocumentsDeleteFileForDocumentParams) (json.RawMessage, error)
+	DownloadFileForDocument(ctx context.Context, params *DocumentsDownloadFileForDocumentParams) (*DocumentsDownloadFileForDocumentResponse, error)
+	GetDocumentByID(ctx context.Context, params *DocumentsGetDocumentByIDParams) (*Document, error)
+	ListDocuments(ctx context.Context, params *DocumentsListDocumentsParams) (*ResultsPage[Document], error)
+	AllDocuments(ctx context.Context, params *DocumentsListDocumentsParams) iter.Seq2[Document, error]
+	ListDocumentsControls(ctx context.Context, params *DocumentsListDocumentsControlsParams) (*ResultsPage[Control], error)
+	AllDocumentsControls(ctx context.Context, params *DocumentsListDocumentsControlsParams) iter.Seq2[Control, error]
+	ListDocumentsLinks(ctx context.Context, params *DocumentsListDocumentsLinksParams) (*ResultsPage[DocumentLink], error)
+	AllDocumentsLinks(ctx context.Context, params *DocumentsListDocumentsLinksParams) iter.Seq2[DocumentLink, error]
+	ListDocumentsUploads(ctx context.Context, params *DocumentsListDocumentsUploadsParams) (*ResultsPage[DocumentUpload], error)
+	AllDocumentsUploads(ctx context.Context, params *DocumentsListDocumentsUploadsParams) iter.Seq2[DocumentUpload, error]
+	RemoveDocumentLink(ctx context.Context, params *DocumentsRemoveDocumentLinkParams) (json.RawMessage, error)
+	SetDocumentOwner(ctx context.Context, params *DocumentsSetDocumentOwnerParams) (*Document, error)
+	SubmitDocumentCollection(ctx context.Context, params *DocumentsSubmitDocumentCollectionParams) (json.RawMessage, error)
+	UploadFileForDocument(ctx context.Context, params *DocumentsUploadFileForDocumentParams) (*DocumentUpload, error)
+}
+
+var _ DocumentsAPI = (*DocumentsService)(nil)
+
+// FrameworksAPI is the method set of FrameworksService, implemented for tests by
+// vantamock.FrameworksAPI.
+type FrameworksAPI interface {
+	GetFrameworkByID(ctx context.Context, params *FrameworksGetFrameworkByIDParams) (*Framework, error)
+	ListAvailableFrameworks(ctx context.Context, params *FrameworksListAvailableFrameworksParams) (*ResultsPage[Framework], error)
+	AllAvailableFrameworks(ctx context.Context, params *FrameworksListAvailableFrameworksParams) iter.Seq2[Framework, error]
+	ListFrameworksControls(ctx context.Context, params *FrameworksListFrameworksControlsParams) (*ResultsPage[Control], error)
+	AllFrameworksControls(ctx context.Context, params *FrameworksListFrameworksControlsParams) iter.Seq2[Control, error]
+}
+
+var _ FrameworksAPI = (*FrameworksService)(nil)
+
+// GroupsAPI is the method set of GroupsService, implemented for tests by
+// vantamock.GroupsAPI.
+type GroupsAPI interface {
+	AddPeopleToGroup(ctx context.Context, params *GroupsAddPeopleToGroupParams) (*GroupsAddPeopleToGroupResponse, error)
+	AddPersonToGroup(ctx context.Context, params *GroupsAddPersonToGroupParams) (*Person, error)
+	GetGroupByID(ctx context.Context, params *GroupsGetGroupByIDParams) (*Group, error)
+	ListGroups(ctx context.Context, params *GroupsListGroupsParams) (*ResultsPage[Group], error)
+	AllGroups(ctx context.Context, params *GroupsListGroupsParams) iter.Seq2[Group, error]
+	ListPeopleInGroup(ctx context.Context, params *GroupsListPeopleInGroupParams) ([]Person, error)
+	RemovePeopleFromGroup(ctx context.Context, params *GroupsRemovePeopleFromGroupParams) (*GroupsRemovePeopleFromGroupResponse, error)
+	RemovePersonFromGroup(ctx context.Context, params *GroupsRemovePersonFromGroupParams) (*Person, error)
+}
+
+var _ GroupsAPI = (*GroupsService)(nil)
+
+// IntegrationsAPI is the method set of IntegrationsService, implemented for tests by
+// vantamock.IntegrationsAPI.
+type IntegrationsAPI interface {
+	GetConnectedIntegration(ctx context.Context, params *IntegrationsGetConnectedIntegrationParams) (*Integration, error)
+	GetDetailsForResourceKind(ctx context.Context, params *IntegrationsGetDetailsForResourceKindParams) (*IntegrationResourceKindDetails, error)
+	GetResourceByID(ctx context.Context, params *IntegrationsGetResourceByIDParams) (*GenericResource, error)
+	ListConnectedIntegrations(ctx context.Context, params *IntegrationsListConnectedIntegrationsParams) (*ResultsPage[Integration], error)
+	AllConnectedIntegrations(ctx context.Context, params *IntegrationsListConnectedIntegrationsParams) iter.Seq2[Integration, error]
+	ListIntegrationResourceKinds(ctx context.Context, params *IntegrationsListIntegrationResourceKindsParams) ([]IntegrationResourceKind, error)
+	ListResources(ctx context.Context, params *IntegrationsListResourcesParams) (*ResultsPage[GenericResource], error)
+	AllResources(ctx context.Context, params *IntegrationsListResourcesParams) iter.Seq2[GenericResource, error]
+	UpdateResourceMetadata(ctx context.Context, params *IntegrationsUpdateResourceMetadataParams) (*IntegrationsUpdateResourceMetadataResponse, error)
+	UpdateResourceMetadataForResourceKindsResources(ctx context.Context, params *IntegrationsUpdateResourceMetadataForResourceKindsResourcesParams) (json.RawMessage, error)
+}
+
+var _ IntegrationsAPI = (*IntegrationsService)(nil)
+
+// MonitoredComputersAPI is the method set of MonitoredComputersService, implemented for tests by
+// vantamock.MonitoredComputersAPI.
+type MonitoredComputersAPI interface {
+	GetMonitoredComputerByID(ctx context.Context, params *MonitoredComputersGetMonitoredComputerByIDParams) (*MonitoredComputer, error)
+	ListMonitoredComputers(ctx context.Context, params *MonitoredComputersListMonitoredComputersParams) (*ResultsPage[MonitoredComputer], error)
+	AllMonitoredComputers(ctx context.Context, params *MonitoredComputersListMonitoredComputersParams) iter.Seq2[MonitoredComputer, error]
+}
+
+var _ MonitoredComputersAPI = (*MonitoredComputersService)(nil)
+
+// OAuthAPI is the method set of OAuthService, implemented for tests by
+// vantamock.OAuthAPI.
+type OAuthAPI interface {
+	CreateToken(ctx context.Context, params *OAuthCreateTokenParams) (*OAuthTokenResponse, error)
+}
+
+var _ OAuthAPI = (*OAuthService)(nil)
+
+// PeopleAPI is the method set of PeopleService, implemented for tests by
+// vantamock.PeopleAPI.
+type PeopleAPI interface {
+	GetPersonByID(ctx context.Context, params *PeopleGetPersonByIDParams) (*Person, error)
+	ListPeople(ctx context.Context, params *PeopleListPeopleParams) (*ResultsPage[Person], error)
+	AllPeople(ctx context.Context, params *PeopleListPeopleParams) iter.Seq2[Person, error]
+	MarkAsNotPeople(ctx context.Context, params *PeopleMarkAsNotPeopleParams) (*PeopleMarkAsNotPeopleResponse, error)
+	MarkAsPeople(ctx context.Context, params *PeopleMarkAsPeopleParams) (*PeopleMarkAsPeopleResponse, error)
+	OffboardPeople(ctx context.Context, params *PeopleOffboardPeopleParams) (*PeopleOffboardPeopleResponse, error)
+	RemoveLeaveInformation(ctx context.Context, params *PeopleRemoveLeaveInformationParams) (*Person, error)
+	SetLeaveInformation(ctx context.Context, params *PeopleSetLeaveInformationParams) (*Person, error)
+	UpdatePersonMetadata(ctx context.Context, params *PeopleUpdatePersonMetadataParams) (*Person, error)
+}
+
+var _ PeopleAPI = (*PeopleService)(nil)
+
+// PoliciesAPI is the method set of PoliciesService, implemented for tests by
+// vantamock.PoliciesAPI.
+type PoliciesAPI interface {
+	GetPolicyByID(ctx context.Context, params *PoliciesGetPolicyByIDParams) (*Policy, error)
+	ListPolicies(ctx context.Context, params *PoliciesListPoliciesParams) (*ResultsPage[Policy], error)
+	AllPolicies(ctx context.Context, params *PoliciesListPoliciesParams) iter.Seq2[Policy, error]
+}
+
+var _ PoliciesAPI = (*PoliciesService)(nil)
+
+// ResourcesAPI is the method set of ResourcesService, implemented for tests by
+// vantamock.ResourcesAPI.
+type ResourcesAPI interface {
+	GetComputers(ctx context.Context, params *ResourcesGetComputersParams) (json.RawMessage, error)
+	GetCustomResourceServer(ctx context.Context, params *ResourcesGetCustomResourceServerParams) (json.RawMessage, error)
+	GetUserAccounts(ctx context.Context, params *ResourcesGetUserAccountsParams) (json.RawMessage, error)
+	SyncCustomResourceServer(ctx context.Context, params *ResourcesSyncCustomResourceServerParams) (json.RawMessage, error)
+	SyncMacOsComputers(ctx context.Context, params *ResourcesSyncMacOsComputersParams) (json.RawMessage, error)
+	SyncUserAccounts(ctx context.Context, params *ResourcesSyncUserAccountsParams) (json.RawMessage, error)
+}
+
+var _ ResourcesAPI = (*ResourcesService)(nil)
+
+// RiskScenariosAPI is the method set of RiskScenariosService, implemented for tests by
+// vantamock.RiskScenariosAPI.
+type RiskScenariosAPI interface {
+	CancelRiskScenarioApprovalRequest(ctx context.Context, params *RiskScenariosCancelRiskScenarioApprovalRequestParams) (*RiskScenario, error)
+	CreateRiskScenario(ctx context.Context, params *RiskScenariosCreateRiskScenarioParams) (*RiskScenario, error)
+	GetRiskScenarioByID(ctx context.Context, params *RiskScenariosGetRiskScenarioByIDParams) (*RiskScenario, error)
+	ListRiskScenarios(ctx context.Context, params *RiskScenariosListRiskScenariosParams) (*ResultsPage[RiskScenario], error)
+	AllRiskScenarios(ctx context.Context, params *RiskScenariosListRiskScenariosParams) iter.Seq2[RiskScenario, error]
+	SubmitRiskScenarioForApproval(ctx context.Context, params *RiskScenariosSubmitRiskScenarioForApprovalParams) (*RiskScenario, error)
+	UpdateRiskScenario(ctx context.Context, params *RiskScenariosUpdateRiskScenarioParams) (*RiskScenario, error)
+}
+
+var _ RiskScenariosAPI = (*RiskScenariosService)(nil)
+
+// TestsAPI is the method set of TestsService, implemented for tests by
+// vantamock.TestsAPI.
+type TestsAPI interface {
+	DeactivateTestEntity(ctx context.Context, params *TestsDeactivateTestEntityParams) (json.RawMessage, error)
+	GetTestByID(ctx context.Context, params *TestsGetTestByIDParams) (*Test, error)
+	GetTestEntitiesByTestID(ctx context.Context, params *TestsGetTestEntitiesByTestIDParams) (*ResultsPage[TestEntity], error)
+	AllTestEntitiesByTestID(ctx context.Context, params *TestsGetTestEntitiesByTestIDParams) iter.Seq2[TestEntity, error]
+	ListTests(ctx context.Context, params *TestsListTestsParams) (*ResultsPage[Test], error)
+	AllTests(ctx context.Context, params *TestsListTestsParams) iter.Seq2[Test, error]
+	ReactivateTestEntity(ctx context.Context, params *TestsReactivateTestEntityParams) (json.RawMessage, error)
+}
+
+var _ TestsAPI = (*TestsService)(nil)
+
+// TrustCentersAPI is the method set of TrustCentersService, implemented for tests by
+// vantamock.TrustCentersAPI.
+type TrustCentersAPI interface {
+	AddTrustCenterControl(ctx context.Context, params *TrustCentersAddTrustCenterControlParams) (*TrustCenterControl, error)
+	AddTrustCenterControlCategory(ctx context.Context, params *TrustCentersAddTrustCenterControlCategoryParams) (*TrustCenterControlCategoryRef, error)
+	AddTrustCenterViewer(ctx context.Context, params *TrustCentersAddTrustCenterViewerParams) (*TrustCenterViewer, error)
+	ApproveTrustCenterAccessRequest(ctx context.Context, params *TrustCentersApproveTrustCenterAccessRequestParams) (json.RawMessage, error)
+	CreateTrustCenterDocument(ctx context.Context, params *TrustCentersCreateTrustCenterDocumentParams) (*TrustCentersCreateTrustCenterDocumentResponse, error)
+	CreateTrustCenterFaq(ctx context.Context, params *TrustCentersCreateTrustCenterFaqParams) (*TrustCentersCreateTrustCenterFaqResponse, error)
+	CreateTrustCenterSubprocessor(ctx context.Context, params *TrustCentersCreateTrustCenterSubprocessorParams) (*TrustCentersCreateTrustCenterSubprocessorResponse, error)
+	CreateTrustCenterSubscriber(ctx context.Context, params *TrustCentersCreateTrustCenterSubscriberParams) (*TrustCenterSubscriber, error)
+	CreateTrustCenterSubscriberGroup(ctx context.Context, params *TrustCentersCreateTrustCenterSubscriberGroupParams) (*TrustCenterSubscriberGroup, error)
+	CreateTrustCenterUpdate(ctx context.Context, params *TrustCentersCreateTrustCenterUpdateParams) (*TrustCenterUpdate, error)
+	DeleteTrustCenterControl(ctx context.Context, params *TrustCentersDeleteTrustCenterControlParams) (json.RawMessage, error)
+	DeleteTrustCenterControlCategory(ctx context.Context, params *TrustCentersDeleteTrustCenterControlCategoryParams) (json.RawMessage, error)
+	DeleteTrustCenterDocument(ctx context.Context, params *TrustCentersDeleteTrustCenterDocumentParams) (json.RawMessage, error)
+	DeleteTrustCenterFaq(ctx context.Context, params *TrustCentersDeleteTrustCenterFaqParams) (json.RawMessage, error)
+	DeleteTrustCenterSubprocessor(ctx context.Context, params *TrustCentersDeleteTrustCenterSubprocessorParams) (json.RawMessage, error)
+	DeleteTrustCenterSubscriber(ctx context.Context, params *TrustCentersDeleteTrustCenterSubscriberParams) (json.RawMessage, error)
+	DeleteTrustCenterSubscriberGroup(ctx context.Context, params *TrustCentersDeleteTrustCenterSubscriberGroupParams) (json.RawMessage, error)
+	DeleteTrustCenterUpdate(ctx context.Context, params *TrustCentersDeleteTrustCenterUpdateParams) (json.RawMessage, error)
+	DenyTrustCenterAccessRequest(ctx context.Context, params *TrustCentersDenyTrustCenterAccessRequestParams) (json.RawMessage, error)
+	EditTrustCenterSubscriberGroup(ctx context.Context, params *TrustCentersEditTrustCenterSubscriberGroupParams) (*TrustCenterSubscriberGroup, error)
+	GetTrustCenter(ctx context.Context, params *TrustCentersGetTrustCenterParams) (*TrustCentersGetTrustCenterResponse, error)
+	GetTrustCenterAccessRequest(ctx context.Context, params *TrustCentersGetTrustCenterAccessRequestParams) (*TrustCenterAccessRequest, error)
+	GetTrustCenterControl(ctx context.Context, params *TrustCentersGetTrustCenterControlParams) (*TrustCenterControl, error)
+	GetTrustCenterControlCategory(ctx context.Context, params *TrustCentersGetTrustCenterControlCategoryParams) (*TrustCenterControlCategoryRef, error)
+	GetTrustCenterDocument(ctx context.Context, params *TrustCentersGetTrustCenterDocumentParams) (*TrustCentersGetTrustCenterDocumentResponse, error)
+	GetTrustCenterFaq(ctx context.Context, params *TrustCentersGetTrustCenterFaqParams) (*TrustCentersGetTrustCenterFaqResponse, error)
+	GetTrustCenterSubprocessor(ctx context.Context, params *TrustCentersGetTrustCenterSubprocessorParams) (*TrustCentersGetTrustCenterSubprocessorResponse, error)
+	GetTrustCenterSubscriber(ctx context.Context, params *TrustCentersGetTrustCenterSubscriberParams) (*TrustCenterSubscriber, error)
+	GetTrustCenterSubscriberGroup(ctx context.Context, params *TrustCentersGetTrustCenterSubscriberGroupParams) (*TrustCenterSubscriberGroup, error)
+	GetTrustCenterUpdate(ctx context.Context, params *TrustCentersGetTrustCenterUpdateParams) (*TrustCenterUpdate, error)
+	GetTrustCenterViewer(ctx context.Context, params *TrustCentersGetTrustCenterViewerParams) (*TrustCenterViewer, error)
+	GetUploadedMediaForTrustCenterDocument(ctx context.Context, params *TrustCentersGetUploadedMediaForTrustCenterDocumentParams) (*TrustCentersGetUploadedMediaForTrustCenterDocumentResponse, error)
+	ListHistoricalTrustCenterAccessRequests(ctx context.Context, params *TrustCentersListHistoricalTrustCenterAccessRequestsParams) (*ResultsPage[TrustCenterAccessRequest], error)
+	AllHistoricalTrustCenterAccessRequests(ctx context.Context, params *TrustCentersListHistoricalTrustCenterAccessRequestsParams) iter.Seq2[TrustCenterAccessRequest, error]
+	ListTrustCenterAccessRequests(ctx context.Context, params *TrustCentersListTrustCenterAccessRequestsParams) (*ResultsPage[TrustCenterAccessRequest], error)
+	AllTrustCenterAccessRequests(ctx context.Context, params *TrustCentersListTrustCenterAccessRequestsParams) iter.Seq2[TrustCenterAccessRequest, error]
+	ListTrustCenterControlCategories(ctx context.Context, params *TrustCentersListTrustCenterControlCategoriesParams) (*TrustCentersListTrustCenterControlCategoriesResponse, error)
+	ListTrustCenterControls(ctx context.Context, params *TrustCentersListTrustCenterControlsParams) (*ResultsPage[TrustCenterControl], error)
+	AllTrustCenterControls(ctx context.Context, params *TrustCentersListTrustCenterControlsParams) iter.Seq2[TrustCenterControl, error]
+	ListTrustCenterFaqs(ctx context.Context, params *TrustCentersListTrustCenterFaqsParams) (*TrustCentersListTrustCenterFaqsResponse, error)
+	ListTrustCenterResources(ctx context.Context, params *TrustCentersListTrustCenterResourcesParams) (*TrustCentersListTrustCenterResourcesResponse, error)
+	ListTrustCenterSubprocessors(ctx context.Context, params *TrustCentersListTrustCenterSubprocessorsParams) (*TrustCentersListTrustCenterSubprocessorsResponse, error)
+	ListTrustCenterSubscriberGroups(ctx context.Context, params *TrustCentersListTrustCenterSubscriberGroupsParams) (*ResultsPage[TrustCenterSubscriberGroup], error)
+	AllTrustCenterSubscriberGroups(ctx context.Context, params *TrustCentersListTrustCenterSubscriberGroupsParams) iter.Seq2[TrustCenterSubscriberGroup, error]
+	ListTrustCenterSubscribers(ctx context.Context, params *TrustCentersListTrustCenterSubscribersParams) (*ResultsPage[TrustCenterSubscriber], error)
+	AllTrustCenterSubscribers(ctx context.Context, params *TrustCentersListTrustCenterSubscribersParams) iter.Seq2[TrustCenterSubscriber, error]
+	ListTrustCenterUpdates(ctx context.Context, params *TrustCentersListTrustCenterUpdatesParams) (*ResultsPage[TrustCenterUpdate], error)
+	AllTrustCenterUpdates(ctx context.Context, params *TrustCentersListTrustCenterUpdatesParams) iter.Seq2[TrustCenterUpdate, error]
+	ListTrustCenterViewerActivityEvents(ctx context.Context, params *TrustCentersListTrustCenterViewerActivityEventsParams) (*ResultsPage[TrustCenterViewerActivityEvent], error)
+	AllTrustCenterViewerActivityEvents(ctx context.Context, params *TrustCentersListTrustCenterViewerActivityEventsParams) iter.Seq2[TrustCenterViewerActivityEvent, error]
+	ListTrustCenterViewers(ctx context.Context, params *TrustCentersListTrustCenterViewersParams) (*ResultsPage[TrustCenterViewer], error)
+	AllTrustCenterViewers(ctx context.Context, params *TrustCentersListTrustCenterViewersParams) iter.Seq2[TrustCenterViewer, error]
+	RemoveTrustCenterViewer(ctx context.Context, params *TrustCentersRemoveTrustCenterViewerParams) (json.RawMessage, error)
+	SendTrustCenterUpdateNotificationsToAllSubscribers(ctx context.Context, params *TrustCentersSendTrustCenterUpdateNotificationsToAllSubscribersParams) (json.RawMessage, error)
+	SendTrustCenterUpdateNotificationsToSpecificSubscribers(ctx context.Context, params *TrustCentersSendTrustCenterUpdateNotificationsToSpecificSubscribersParams) (json.RawMessage, error)
+	SetGroupsForTrustCenterSubscriber(ctx context.Context, params *TrustCentersSetGroupsForTrustCenterSubscriberParams) (*TrustCenterSubscriber, error)
+	UpdateTrustCenter(ctx context.Context, params *TrustCentersUpdateTrustCenterParams) (*TrustCentersUpdateTrustCenterResponse, error)
+	UpdateTrustCenterControlCategory(ctx context.Context, params *TrustCentersUpdateTrustCenterControlCategoryParams) (*TrustCenterControlCategoryRef, error)
+	UpdateTrustCenterDocument(ctx context.Context, params *TrustCentersUpdateTrustCenterDocumentParams) (*TrustCentersUpdateTrustCenterDocumentResponse, error)
+	UpdateTrustCenterFaq(ctx context.Context, params *TrustCentersUpdateTrustCenterFaqParams) (*TrustCentersUpdateTrustCenterFaqResponse, error)
+	UpdateTrustCenterSubprocessor(ctx context.Context, params *TrustCentersUpdateTrustCenterSubprocessorParams) (*TrustCentersUpdateTrustCenterSubprocessorResponse, error)
+	UpdateTrustCenterUpdate(ctx context.Context, params *TrustCentersUpdateTrustCenterUpdateParams) (*TrustCenterUpdate, error)
+}
+
+var _ TrustCentersAPI = (*TrustCentersService)(nil)
+
+// VendorRiskAttributesAPI is the method set of VendorRiskAttributesService, implemented for tests by
+// vantamock.VendorRiskAttributesAPI.
+type VendorRiskAttributesAPI interface {
+	ListVendorRiskAttributes(ctx context.Context, params *VendorRiskAttributesListVendorRiskAttributesParams) (*ResultsPage[VendorRiskAttribute], error)
+	AllVendorRiskAttributes(ctx context.Context, params *VendorRiskAttributesListVendorRiskAttributesParams) iter.Seq2[VendorRiskAttribute, error]
+}
+
+var _ VendorRiskAttributesAPI = (*VendorRiskAttributesService)(nil)
+
+// VendorsAPI is the method set of VendorsService, implemented for tests by
+// vantamock.VendorsAPI.
+type VendorsAPI interface {
+	AddDocumentToSecurityReview(ctx context.Context, params *VendorsAddDocumentToSecurityReviewParams) (*VendorDocument, error)
+	AddDocumentToVendor(ctx context.Context, params *VendorsAddDocumentToVendorParams) (*VendorDocument, error)
+	AddVendorFinding(ctx context.Context, params *VendorsAddVendorFindingParams) (*VendorFinding, error)
+	CreateVendor(ctx context.Context, params *VendorsCreateVendorParams) (*Vendor, error)
+	DeleteFindingByID(ctx context.Context, params *VendorsDeleteFindingByIDParams) (json.RawMessage, error)
+	DeleteSecurityReviewDocumentByID(ctx context.Context, params *VendorsDeleteSecurityReviewDocumentByIDParams) (json.RawMessage, error)
+	DeleteVendorByID(ctx context.Context, params *VendorsDeleteVendorByIDParams) (json.RawMessage, error)
+	GetSecurityReviewByID(ctx context.Context, params *VendorsGetSecurityReviewByIDParams) (*VendorSecurityReview, error)
+	GetVendorByID(ctx context.Context, params *VendorsGetVendorByIDParams) (*Vendor, error)
+	ListSecurityReviewDocuments(ctx context.Context, params *VendorsListSecurityReviewDocumentsParams) (*ResultsPage[VendorDocument], error)
+	AllSecurityReviewDocuments(ctx context.Context, params *VendorsListSecurityReviewDocumentsParams) iter.Seq2[VendorDocument, error]
+	ListSecurityReviewsByVendorID(ctx context.Context, params *VendorsListSecurityReviewsByVendorIDParams) (*ResultsPage[VendorSecurityReview], error)
+	AllSecurityReviewsByVendorID(ctx context.Context, params *VendorsListSecurityReviewsByVendorIDParams) iter.Seq2[VendorSecurityReview, error]
+	ListVendorDocuments(ctx context.Context, params *VendorsListVendorDocumentsParams) (*ResultsPage[VendorDocument], error)
+	AllVendorDocuments(ctx context.Context, params *VendorsListVendorDocumentsParams) iter.Seq2[VendorDocument, error]
+	ListVendorFindings(ctx context.Context, params *VendorsListVendorFindingsParams) (*ResultsPage[VendorFinding], error)
+	AllVendorFindings(ctx context.Context, params *VendorsListVendorFindingsParams) iter.Seq2[VendorFinding, error]
+	ListVendors(ctx context.Context, params *VendorsListVendorsParams) (*ResultsPage[Vendor], error)
+	AllVendors(ctx context.Context, params *VendorsListVendorsParams) iter.Seq2[Vendor, error]
+	SetVendorStatus(ctx context.Context, params *VendorsSetVendorStatusParams) (*Vendor, error)
+	UpdateVendorByID(ctx context.Context, params *VendorsUpdateVendorByIDParams) (*Vendor, error)
+	UpdateVendorFinding(ctx context.Context, params *VendorsUpdateVendorFindingParams) (*VendorFinding, error)
+}
+
+var _ VendorsAPI = (*VendorsService)(nil)
+
+// VulnerabilitiesAPI is the method set of VulnerabilitiesService, implemented for tests by
+// vantamock.VulnerabilitiesAPI.
+type VulnerabilitiesAPI interface {
+	DeactivateVulnerabilityMonitoringForVulnerability(ctx context.Context, params *VulnerabilitiesDeactivateVulnerabilityMonitoringForVulnerabilityParams) (*VulnerabilitiesDeactivateVulnerabilityMonitoringForVulnerabilityResponse, error)
+	GetVulnerabilities(ctx context.Context, params *VulnerabilitiesGetVulnerabilitiesParams) (*ResultsPage[Vulnerability], error)
+	AllVulnerabilities(ctx context.Context, params *VulnerabilitiesGetVulnerabilitiesParams) iter.Seq2[Vulnerability, error]
+	GetVulnerabilityByID(ctx context.Context, params *VulnerabilitiesGetVulnerabilityByIDParams) (*Vulnerability, error)
+	ReactivateVulnerabilityMonitoring(ctx context.Context, params *VulnerabilitiesReactivateVulnerabilityMonitoringParams) (*VulnerabilitiesReactivateVulnerabilityMonitoringResponse, error)
+}
+
+var _ VulnerabilitiesAPI = (*VulnerabilitiesService)(nil)
+
+// VulnerabilityRemediationsAPI is the method set of VulnerabilityRemediationsService, implemented for tests by
+// vantamock.VulnerabilityRemediationsAPI.
+type VulnerabilityRemediationsAPI interface {
+	AcknowledgeSlaMiss(ctx context.Context, params *VulnerabilityRemediationsAcknowledgeSlaMissParams) (*VulnerabilityRemediationsAcknowledgeSlaMissResponse, error)
+	ListVulnerabilityRemediations(ctx context.Context, params *VulnerabilityRemediationsListVulnerabilityRemediationsParams) (*ResultsPage[VulnerabilityRemediation], error)
+	AllVulnerabilityRemediations(ctx context.Context, params *VulnerabilityRemediationsListVulnerabilityRemediationsParams) iter.Seq2[VulnerabilityRemediation, error]
+}
+
+var _ VulnerabilityRemediationsAPI = (*VulnerabilityRemediationsService)(nil)
+
+// VulnerableAssetsAPI is the method set of VulnerableAssetsService, implemented for tests by
+// vantamock.VulnerableAssetsAPI.
+type VulnerableAssetsAPI interface {
+	GetVulnerableAssetByID(ctx context.Context, params *VulnerableAssetsGetVulnerableAssetByIDParams) (*VulnerableAsset, error)
+	ListAssetsAssociatedWithVulnerabilities(ctx context.Context, params *VulnerableAssetsListAssetsAssociatedWithVulnerabilitiesParams) (*ResultsPage[VulnerableAsset], error)
+	AllAssetsAssociatedWithVulnerabilities(ctx context.Context, params *VulnerableAssetsListAssetsAssociatedWithVulnerabilitiesParams) iter.Seq2[VulnerableAsset, error]
+}
+
+var _ VulnerableAssetsAPI = (*VulnerableAssetsService)(nil)
@@ -0,0 +1,504 @@
+// Code generated by vanta-gen. DO NOT EDIT.
+
+// Vanta service pagination iterators.
+
+package v1
+
+import (
+	"context"
+	"iter"
+)
+
+// AllControls iterates every item returned by ListControls, following page cursors.
+func (s *ControlsService) AllControls(ctx context.Context, params *ControlsListControlsParams) iter.Seq2[Control, error] {
+	base := ControlsListControlsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[Control], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListControls(ctx, &p)
+	})
+}
+
+// AllControlsDocuments iterates every item returned by ListControlsDocuments, following page cursors.
+func (s *ControlsService) AllControlsDocuments(ctx context.Context, params *ControlsListControlsDocumentsParams) iter.Seq2[Document, error] {
+	base := ControlsListControlsDocumentsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[Document], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListControlsDocuments(ctx, &p)
+	})
+}
+
+// AllControlsTests iterates every item returned by ListControlsTests, following page cursors.
+func (s *ControlsService) AllControlsTests(ctx context.Context, params *ControlsListControlsTestsParams) iter.Seq2[Test, error] {
+	base := ControlsListControlsTestsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[Test], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListControlsTests(ctx, &p)
+	})
+}
+
+// AllVantaControlsFromLibrary iterates every item returned by ListVantaControlsFromLibrary, following page cursors.
+func (s *ControlsService) AllVantaControlsFromLibrary(ctx context.Context, params *ControlsListVantaControlsFromLibraryParams) iter.Seq2[Control, error] {
+	base := ControlsListVantaControlsFromLibraryParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[Control], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListVantaControlsFromLibrary(ctx, &p)
+	})
+}
+
+// AllDiscoveredVendors iterates every item returned by ListDiscoveredVendors, following page cursors.
+func (s *DiscoveredVendorsService) AllDiscoveredVendors(ctx context.Context, params *DiscoveredVendorsListDiscoveredVendorsParams) iter.Seq2[DiscoveredVendor, error] {
+	base := DiscoveredVendorsListDiscoveredVendorsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[DiscoveredVendor], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListDiscoveredVendors(ctx, &p)
+	})
+}
+
+// AllDiscoveredVendorAccounts iterates every item returned by ListOfDiscoveredVendorAccounts, following page cursors.
+func (s *DiscoveredVendorsService) AllDiscoveredVendorAccounts(ctx context.Context, params *DiscoveredVendorsListOfDiscoveredVendorAccountsParams) iter.Seq2[DiscoveredVendorAccount, error] {
+	base := DiscoveredVendorsListOfDiscoveredVendorAccountsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[DiscoveredVendorAccount], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListOfDiscoveredVendorAccounts(ctx, &p)
+	})
+}
+
+// AllDocuments iterates every item returned by ListDocuments, following page cursors.
+func (s *DocumentsService) AllDocuments(ctx context.Context, params *DocumentsListDocumentsParams) iter.Seq2[Document, error] {
+	base := DocumentsListDocumentsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[Document], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListDocuments(ctx, &p)
+	})
+}
+
+// AllDocumentsControls iterates every item returned by ListDocumentsControls, following page cursors.
+func (s *DocumentsService) AllDocumentsControls(ctx context.Context, params *DocumentsListDocumentsControlsParams) iter.Seq2[Control, error] {
+	base := DocumentsListDocumentsControlsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[Control], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListDocumentsControls(ctx, &p)
+	})
+}
+
+// AllDocumentsLinks iterates every item returned by ListDocumentsLinks, following page cursors.
+func (s *DocumentsService) AllDocumentsLinks(ctx context.Context, params *DocumentsListDocumentsLinksParams) iter.Seq2[DocumentLink, error] {
+	base := DocumentsListDocumentsLinksParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[DocumentLink], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListDocumentsLinks(ctx, &p)
+	})
+}
+
+// AllDocumentsUploads iterates every item returned by ListDocumentsUploads, following page cursors.
+func (s *DocumentsService) AllDocumentsUploads(ctx context.Context, params *DocumentsListDocumentsUploadsParams) iter.Seq2[DocumentUpload, error] {
+	base := DocumentsListDocumentsUploadsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[DocumentUpload], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListDocumentsUploads(ctx, &p)
+	})
+}
+
+// AllAvailableFrameworks iterates every item returned by ListAvailableFrameworks, following page cursors.
+func (s *FrameworksService) AllAvailableFrameworks(ctx context.Context, params *FrameworksListAvailableFrameworksParams) iter.Seq2[Framework, error] {
+	base := FrameworksListAvailableFrameworksParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[Framework], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListAvailableFrameworks(ctx, &p)
+	})
+}
+
+// AllFrameworksControls iterates every item returned by ListFrameworksControls, following page cursors.
+func (s *FrameworksService) AllFrameworksControls(ctx context.Context, params *FrameworksListFrameworksControlsParams) iter.Seq2[Control, error] {
+	base := FrameworksListFrameworksControlsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[Control], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListFrameworksControls(ctx, &p)
+	})
+}
+
+// AllGroups iterates every item returned by ListGroups, following page cursors.
+func (s *GroupsService) AllGroups(ctx context.Context, params *GroupsListGroupsParams) iter.Seq2[Group, error] {
+	base := GroupsListGroupsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[Group], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListGroups(ctx, &p)
+	})
+}
+
+// AllConnectedIntegrations iterates every item returned by ListConnectedIntegrations, following page cursors.
+func (s *IntegrationsService) AllConnectedIntegrations(ctx context.Context, params *IntegrationsListConnectedIntegrationsParams) iter.Seq2[Integration, error] {
+	base := IntegrationsListConnectedIntegrationsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[Integration], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListConnectedIntegrations(ctx, &p)
+	})
+}
+
+// AllResources iterates every item returned by ListResources, following page cursors.
+func (s *IntegrationsService) AllResources(ctx context.Context, params *IntegrationsListResourcesParams) iter.Seq2[GenericResource, error] {
+	base := IntegrationsListResourcesParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[GenericResource], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListResources(ctx, &p)
+	})
+}
+
+// AllMonitoredComputers iterates every item returned by ListMonitoredComputers, following page cursors.
+func (s *MonitoredComputersService) AllMonitoredComputers(ctx context.Context, params *MonitoredComputersListMonitoredComputersParams) iter.Seq2[MonitoredComputer, error] {
+	base := MonitoredComputersListMonitoredComputersParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[MonitoredComputer], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListMonitoredComputers(ctx, &p)
+	})
+}
+
+// AllPeople iterates every item returned by ListPeople, following page cursors.
+func (s *PeopleService) AllPeople(ctx context.Context, params *PeopleListPeopleParams) iter.Seq2[Person, error] {
+	base := PeopleListPeopleParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[Person], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListPeople(ctx, &p)
+	})
+}
+
+// AllPolicies iterates every item returned by ListPolicies, following page cursors.
+func (s *PoliciesService) AllPolicies(ctx context.Context, params *PoliciesListPoliciesParams) iter.Seq2[Policy, error] {
+	base := PoliciesListPoliciesParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[Policy], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListPolicies(ctx, &p)
+	})
+}
+
+// AllRiskScenarios iterates every item returned by ListRiskScenarios, following page cursors.
+func (s *RiskScenariosService) AllRiskScenarios(ctx context.Context, params *RiskScenariosListRiskScenariosParams) iter.Seq2[RiskScenario, error] {
+	base := RiskScenariosListRiskScenariosParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[RiskScenario], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListRiskScenarios(ctx, &p)
+	})
+}
+
+// AllTestEntitiesByTestID iterates every item returned by GetTestEntitiesByTestID, following page cursors.
+func (s *TestsService) AllTestEntitiesByTestID(ctx context.Context, params *TestsGetTestEntitiesByTestIDParams) iter.Seq2[TestEntity, error] {
+	base := TestsGetTestEntitiesByTestIDParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[TestEntity], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.GetTestEntitiesByTestID(ctx, &p)
+	})
+}
+
+// AllTests iterates every item returned by ListTests, following page cursors.
+func (s *TestsService) AllTests(ctx context.Context, params *TestsListTestsParams) iter.Seq2[Test, error] {
+	base := TestsListTestsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[Test], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListTests(ctx, &p)
+	})
+}
+
+// AllHistoricalTrustCenterAccessRequests iterates every item returned by ListHistoricalTrustCenterAccessRequests, following page cursors.
+func (s *TrustCentersService) AllHistoricalTrustCenterAccessRequests(ctx context.Context, params *TrustCentersListHistoricalTrustCenterAccessRequestsParams) iter.Seq2[TrustCenterAccessRequest, error] {
+	base := TrustCentersListHistoricalTrustCenterAccessRequestsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[TrustCenterAccessRequest], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListHistoricalTrustCenterAccessRequests(ctx, &p)
+	})
+}
+
+// AllTrustCenterAccessRequests iterates every item returned by ListTrustCenterAccessRequests, following page cursors.
+func (s *TrustCentersService) AllTrustCenterAccessRequests(ctx context.Context, params *TrustCentersListTrustCenterAccessRequestsParams) iter.Seq2[TrustCenterAccessRequest, error] {
+	base := TrustCentersListTrustCenterAccessRequestsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[TrustCenterAccessRequest], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListTrustCenterAccessRequests(ctx, &p)
+	})
+}
+
+// AllTrustCenterControls iterates every item returned by ListTrustCenterControls, following page cursors.
+func (s *TrustCentersService) AllTrustCenterControls(ctx context.Context, params *TrustCentersListTrustCenterControlsParams) iter.Seq2[TrustCenterControl, error] {
+	base := TrustCentersListTrustCenterControlsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[TrustCenterControl], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListTrustCenterControls(ctx, &p)
+	})
+}
+
+// AllTrustCenterSubscriberGroups iterates every item returned by ListTrustCenterSubscriberGroups, following page cursors.
+func (s *TrustCentersService) AllTrustCenterSubscriberGroups(ctx context.Context, params *TrustCentersListTrustCenterSubscriberGroupsParams) iter.Seq2[TrustCenterSubscriberGroup, error] {
+	base := TrustCentersListTrustCenterSubscriberGroupsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[TrustCenterSubscriberGroup], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListTrustCenterSubscriberGroups(ctx, &p)
+	})
+}
+
+// AllTrustCenterSubscribers iterates every item returned by ListTrustCenterSubscribers, following page cursors.
+func (s *TrustCentersService) AllTrustCenterSubscribers(ctx context.Context, params *TrustCentersListTrustCenterSubscribersParams) iter.Seq2[TrustCenterSubscriber, error] {
+	base := TrustCentersListTrustCenterSubscribersParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[TrustCenterSubscriber], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListTrustCenterSubscribers(ctx, &p)
+	})
+}
+
+// AllTrustCenterUpdates iterates every item returned by ListTrustCenterUpdates, following page cursors.
+func (s *TrustCentersService) AllTrustCenterUpdates(ctx context.Context, params *TrustCentersListTrustCenterUpdatesParams) iter.Seq2[TrustCenterUpdate, error] {
+	base := TrustCentersListTrustCenterUpdatesParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[TrustCenterUpdate], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListTrustCenterUpdates(ctx, &p)
+	})
+}
+
+// AllTrustCenterViewerActivityEvents iterates every item returned by ListTrustCenterViewerActivityEvents, following page cursors.
+func (s *TrustCentersService) AllTrustCenterViewerActivityEvents(ctx context.Context, params *TrustCentersListTrustCenterViewerActivityEventsParams) iter.Seq2[TrustCenterViewerActivityEvent, error] {
+	base := TrustCentersListTrustCenterViewerActivityEventsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[TrustCenterViewerActivityEvent], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListTrustCenterViewerActivityEvents(ctx, &p)
+	})
+}
+
+// AllTrustCenterViewers iterates every item returned by ListTrustCenterViewers, following page cursors.
+func (s *TrustCentersService) AllTrustCenterViewers(ctx context.Context, params *TrustCentersListTrustCenterViewersParams) iter.Seq2[TrustCenterViewer, error] {
+	base := TrustCentersListTrustCenterViewersParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[TrustCenterViewer], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListTrustCenterViewers(ctx, &p)
+	})
+}
+
+// AllVendorRiskAttributes iterates every item returned by ListVendorRiskAttributes, following page cursors.
+func (s *VendorRiskAttributesService) AllVendorRiskAttributes(ctx context.Context, params *VendorRiskAttributesListVendorRiskAttributesParams) iter.Seq2[VendorRiskAttribute, error] {
+	base := VendorRiskAttributesListVendorRiskAttributesParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[VendorRiskAttribute], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListVendorRiskAttributes(ctx, &p)
+	})
+}
+
+// AllSecurityReviewDocuments iterates every item returned by ListSecurityReviewDocuments, following page cursors.
+func (s *VendorsService) AllSecurityReviewDocuments(ctx context.Context, params *VendorsListSecurityReviewDocumentsParams) iter.Seq2[VendorDocument, error] {
+	base := VendorsListSecurityReviewDocumentsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[VendorDocument], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListSecurityReviewDocuments(ctx, &p)
+	})
+}
+
+// AllSecurityReviewsByVendorID iterates every item returned by ListSecurityReviewsByVendorID, following page cursors.
+func (s *VendorsService) AllSecurityReviewsByVendorID(ctx context.Context, params *VendorsListSecurityReviewsByVendorIDParams) iter.Seq2[VendorSecurityReview, error] {
+	base := VendorsListSecurityReviewsByVendorIDParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[VendorSecurityReview], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListSecurityReviewsByVendorID(ctx, &p)
+	})
+}
+
+// AllVendorDocuments iterates every item returned by ListVendorDocuments, following page cursors.
+func (s *VendorsService) AllVendorDocuments(ctx context.Context, params *VendorsListVendorDocumentsParams) iter.Seq2[VendorDocument, error] {
+	base := VendorsListVendorDocumentsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[VendorDocument], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListVendorDocuments(ctx, &p)
+	})
+}
+
+// AllVendorFindings iterates every item returned by ListVendorFindings, following page cursors.
+func (s *VendorsService) AllVendorFindings(ctx context.Context, params *VendorsListVendorFindingsParams) iter.Seq2[VendorFinding, error] {
+	base := VendorsListVendorFindingsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[VendorFinding], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListVendorFindings(ctx, &p)
+	})
+}
+
+// AllVendors iterates every item returned by ListVendors, following page cursors.
+func (s *VendorsService) AllVendors(ctx context.Context, params *VendorsListVendorsParams) iter.Seq2[Vendor, error] {
+	base := VendorsListVendorsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[Vendor], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListVendors(ctx, &p)
+	})
+}
+
+// AllVulnerabilities iterates every item returned by GetVulnerabilities, following page cursors.
+func (s *VulnerabilitiesService) AllVulnerabilities(ctx context.Context, params *VulnerabilitiesGetVulnerabilitiesParams) iter.Seq2[Vulnerability, error] {
+	base := VulnerabilitiesGetVulnerabilitiesParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[Vulnerability], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.GetVulnerabilities(ctx, &p)
+	})
+}
+
+// AllVulnerabilityRemediations iterates every item returned by ListVulnerabilityRemediations, following page cursors.
+func (s *VulnerabilityRemediationsService) AllVulnerabilityRemediations(ctx context.Context, params *VulnerabilityRemediationsListVulnerabilityRemediationsParams) iter.Seq2[VulnerabilityRemediation, error] {
+	base := VulnerabilityRemediationsListVulnerabilityRemediationsParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[VulnerabilityRemediation], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListVulnerabilityRemediations(ctx, &p)
+	})
+}
+
+// AllAssetsAssociatedWithVulnerabilities iterates every item returned by ListAssetsAssociatedWithVulnerabilities, following page cursors.
+func (s *VulnerableAssetsService) AllAssetsAssociatedWithVulnerabilities(ctx context.Context, params *VulnerableAssetsListAssetsAssociatedWithVulnerabilitiesParams) iter.Seq2[VulnerableAsset, error] {
+	base := VulnerableAssetsListAssetsAssociatedWithVulnerabilitiesParams{}
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[VulnerableAsset], error) {
+		p := base
+		p.PageCursor = cursor
+		return s.ListAssetsAssociatedWithVulnerabilities(ctx, &p)
+	})
+}
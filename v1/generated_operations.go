@@ -0,0 +1,2418 @@
+// Code generated by vanta-gen. DO NOT EDIT.
+
+// Vanta operation catalog.
+
+package v1
+
+// operations is the catalog returned by Operations, one entry per generated
+// method.
+var operations = []Operation{
+	{
+		ID:         "Controls.AddControlFromVantaLibrary",
+		Service:    "Controls",
+		Method:     "AddControlFromVantaLibrary",
+		HTTPMethod: "POST",
+		Path:       "/controls/add-from-library",
+		BodyType:   "*ControlsAddControlFromVantaLibraryRequestBody",
+		Scopes:     []string{ScopeAllWrite},
+		Doc:        "Add a control from the Vanta library to your organization's controls.",
+		fn:         bind(func(s *Services) ControlsAPI { return s.Controls }, ControlsAPI.AddControlFromVantaLibrary),
+	},
+	{
+		ID:         "Controls.AddControlToDocumentMapping",
+		Service:    "Controls",
+		Method:     "AddControlToDocumentMapping",
+		HTTPMethod: "POST",
+		Path:       "/controls/:controlId/add-document-to-control",
+		PathParams: []OperationParam{
+			{Name: "controlId", Field: "ControlID", Type: "ControlID", Required: true},
+		},
+		BodyType: "*ControlsAddControlToDocumentMappingRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Add a document to a control.",
+		fn:       bind(func(s *Services) ControlsAPI { return s.Controls }, ControlsAPI.AddControlToDocumentMapping),
+	},
+	{
+		ID:         "Controls.AddControlToTestMapping",
+		Service:    "Controls",
+		Method:     "AddControlToTestMapping",
+		HTTPMethod: "POST",
+		Path:       "/controls/:controlId/add-test-to-control",
+		PathParams: []OperationParam{
+			{Name: "controlId", Field: "ControlID", Type: "ControlID", Required: true},
+		},
+		BodyType: "*ControlsAddControlToTestMappingRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Add a control to test mapping.",
+		fn:       bind(func(s *Services) ControlsAPI { return s.Controls }, ControlsAPI.AddControlToTestMapping),
+	},
+	{
+		ID:         "Controls.CreateCustomControl",
+		Service:    "Controls",
+		Method:     "CreateCustomControl",
+		HTTPMethod: "POST",
+		Path:       "/controls",
+		BodyType:   "*ControlsCreateCustomControlRequestBody",
+		Scopes:     []string{ScopeAllWrite},
+		Doc:        "Create a custom control.",
+		fn:         bind(func(s *Services) ControlsAPI { return s.Controls }, ControlsAPI.CreateCustomControl),
+	},
+	{
+		ID:         "Controls.GetControlByID",
+		Service:    "Controls",
+		Method:     "GetControlByID",
+		HTTPMethod: "GET",
+		Path:       "/controls/:controlId",
+		PathParams: []OperationParam{
+			{Name: "controlId", Field: "ControlID", Type: "ControlID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Get a control by an ID.",
+		fn:       bind(func(s *Services) ControlsAPI { return s.Controls }, ControlsAPI.GetControlByID),
+	},
+	{
+		ID:         "Controls.ListControls",
+		Service:    "Controls",
+		Method:     "ListControls",
+		HTTPMethod: "GET",
+		Path:       "/controls",
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+			{Name: "frameworkMatchesAny", Field: "FrameworkMatchesAny", Type: "[]string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "List controls.",
+		fn:        bind(func(s *Services) ControlsAPI { return s.Controls }, ControlsAPI.ListControls),
+	},
+	{
+		ID:         "Controls.ListControlsDocuments",
+		Service:    "Controls",
+		Method:     "ListControlsDocuments",
+		HTTPMethod: "GET",
+		Path:       "/controls/:controlId/documents",
+		PathParams: []OperationParam{
+			{Name: "controlId", Field: "ControlID", Type: "ControlID", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "List a control's documents.",
+		fn:        bind(func(s *Services) ControlsAPI { return s.Controls }, ControlsAPI.ListControlsDocuments),
+	},
+	{
+		ID:         "Controls.ListControlsTests",
+		Service:    "Controls",
+		Method:     "ListControlsTests",
+		HTTPMethod: "GET",
+		Path:       "/controls/:controlId/tests",
+		PathParams: []OperationParam{
+			{Name: "controlId", Field: "ControlID", Type: "ControlID", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "List a control's tests.",
+		fn:        bind(func(s *Services) ControlsAPI { return s.Controls }, ControlsAPI.ListControlsTests),
+	},
+	{
+		ID:         "Controls.ListVantaControlsFromLibrary",
+		Service:    "Controls",
+		Method:     "ListVantaControlsFromLibrary",
+		HTTPMethod: "GET",
+		Path:       "/controls/controls-library",
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "List Vanta controls from the library.",
+		fn:        bind(func(s *Services) ControlsAPI { return s.Controls }, ControlsAPI.ListVantaControlsFromLibrary),
+	},
+	{
+		ID:         "Controls.RemoveControl",
+		Service:    "Controls",
+		Method:     "RemoveControl",
+		HTTPMethod: "DELETE",
+		Path:       "/controls/:controlId",
+		PathParams: []OperationParam{
+			{Name: "controlId", Field: "ControlID", Type: "ControlID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Delete a custom control or move a Vanta control back to the library.",
+		fn:     bind(func(s *Services) ControlsAPI { return s.Controls }, ControlsAPI.RemoveControl),
+	},
+	{
+		ID:         "Controls.RemoveControlFromDocumentMapping",
+		Service:    "Controls",
+		Method:     "RemoveControlFromDocumentMapping",
+		HTTPMethod: "DELETE",
+		Path:       "/controls/:controlId/documents/:documentId",
+		PathParams: []OperationParam{
+			{Name: "controlId", Field: "ControlID", Type: "ControlID", Required: true},
+			{Name: "documentId", Field: "DocumentID", Type: "DocumentID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Remove a document by ID from a control.",
+		fn:     bind(func(s *Services) ControlsAPI { return s.Controls }, ControlsAPI.RemoveControlFromDocumentMapping),
+	},
+	{
+		ID:         "Controls.RemoveControlFromTestMapping",
+		Service:    "Controls",
+		Method:     "RemoveControlFromTestMapping",
+		HTTPMethod: "DELETE",
+		Path:       "/controls/:controlId/tests/:testId",
+		PathParams: []OperationParam{
+			{Name: "controlId", Field: "ControlID", Type: "ControlID", Required: true},
+			{Name: "testId", Field: "TestID", Type: "TestID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Remove a control from test mapping.",
+		fn:     bind(func(s *Services) ControlsAPI { return s.Controls }, ControlsAPI.RemoveControlFromTestMapping),
+	},
+	{
+		ID:         "Controls.SetOwnerOfControl",
+		Service:    "Controls",
+		Method:     "SetOwnerOfControl",
+		HTTPMethod: "POST",
+		Path:       "/controls/:controlId/set-owner",
+		PathParams: []OperationParam{
+			{Name: "controlId", Field: "ControlID", Type: "ControlID", Required: true},
+		},
+		BodyType: "*ControlsSetOwnerOfControlRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Assign a control to a user or remove an owner from a control.",
+		fn:       bind(func(s *Services) ControlsAPI { return s.Controls }, ControlsAPI.SetOwnerOfControl),
+	},
+	{
+		ID:         "Controls.UpdateControlsMetadata",
+		Service:    "Controls",
+		Method:     "UpdateControlsMetadata",
+		HTTPMethod: "PATCH",
+		Path:       "/controls/:controlId",
+		PathParams: []OperationParam{
+			{Name: "controlId", Field: "ControlID", Type: "ControlID", Required: true},
+		},
+		BodyType: "*ControlsUpdateControlsMetadataRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Update a control's metadata.",
+		fn:       bind(func(s *Services) ControlsAPI { return s.Controls }, ControlsAPI.UpdateControlsMetadata),
+	},
+	{
+		ID:         "DiscoveredVendors.AddsDiscoveredVendorToManagedVendorByID",
+		Service:    "DiscoveredVendors",
+		Method:     "AddsDiscoveredVendorToManagedVendorByID",
+		HTTPMethod: "POST",
+		Path:       "/discovered-vendors/:discoveredVendorId/add-to-managed",
+		PathParams: []OperationParam{
+			{Name: "discoveredVendorId", Field: "DiscoveredVendorID", Type: "DiscoveredVendorID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Add a discovered vendor to managed vendor.",
+		fn:     bind(func(s *Services) DiscoveredVendorsAPI { return s.DiscoveredVendors }, DiscoveredVendorsAPI.AddsDiscoveredVendorToManagedVendorByID),
+	},
+	{
+		ID:         "DiscoveredVendors.ListDiscoveredVendors",
+		Service:    "DiscoveredVendors",
+		Method:     "ListDiscoveredVendors",
+		HTTPMethod: "GET",
+		Path:       "/discovered-vendors",
+		QueryParams: []OperationParam{
+			{Name: "scope", Field: "Scope", Type: "*string"},
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "List discovered vendors.",
+		fn:        bind(func(s *Services) DiscoveredVendorsAPI { return s.DiscoveredVendors }, DiscoveredVendorsAPI.ListDiscoveredVendors),
+	},
+	{
+		ID:         "DiscoveredVendors.ListOfDiscoveredVendorAccounts",
+		Service:    "DiscoveredVendors",
+		Method:     "ListOfDiscoveredVendorAccounts",
+		HTTPMethod: "GET",
+		Path:       "/discovered-vendors/:discoveredVendorId/accounts",
+		PathParams: []OperationParam{
+			{Name: "discoveredVendorId", Field: "DiscoveredVendorID", Type: "DiscoveredVendorID", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "List of discovered vendor accounts.",
+		fn:        bind(func(s *Services) DiscoveredVendorsAPI { return s.DiscoveredVendors }, DiscoveredVendorsAPI.ListOfDiscoveredVendorAccounts),
+	},
+	{
+		ID:         "Documents.CreateCustomDocument",
+		Service:    "Documents",
+		Method:     "CreateCustomDocument",
+		HTTPMethod: "POST",
+		Path:       "/documents",
+		BodyType:   "*DocumentsCreateCustomDocumentRequestBody",
+		Scopes:     []string{ScopeAllWrite},
+		Doc:        "Create a custom document.",
+		fn:         bind(func(s *Services) DocumentsAPI { return s.Documents }, DocumentsAPI.CreateCustomDocument),
+	},
+	{
+		ID:         "Documents.CreateDocumentLink",
+		Service:    "Documents",
+		Method:     "CreateDocumentLink",
+		HTTPMethod: "POST",
+		Path:       "/documents/:documentId/links",
+		PathParams: []OperationParam{
+			{Name: "documentId", Field: "DocumentID", Type: "DocumentID", Required: true},
+		},
+		BodyType: "*DocumentsCreateDocumentLinkRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Create a link for a document.",
+		fn:       bind(func(s *Services) DocumentsAPI { return s.Documents }, DocumentsAPI.CreateDocumentLink),
+	},
+	{
+		ID:         "Documents.DeleteDocumentByID",
+		Service:    "Documents",
+		Method:     "DeleteDocumentByID",
+		HTTPMethod: "DELETE",
+		Path:       "/documents/:documentId",
+		PathParams: []OperationParam{
+			{Name: "documentId", Field: "DocumentID", Type: "DocumentID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Delete a document by ID.",
+		fn:     bind(func(s *Services) DocumentsAPI { return s.Documents }, DocumentsAPI.DeleteDocumentByID),
+	},
+	{
+		ID:         "Documents.DeleteFileForDocument",
+		Service:    "Documents",
+		Method:     "DeleteFileForDocument",
+		HTTPMethod: "DELETE",
+		Path:       "/documents/:documentId/uploads/:uploadedFileId",
+		PathParams: []OperationParam{
+			{Name: "documentId", Field: "DocumentID", Type: "DocumentID", Required: true},
+			{Name: "uploadedFileId", Field: "UploadedFileID", Type: "DocumentUploadID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Delete a file for a document.",
+		fn:     bind(func(s *Services) DocumentsAPI { return s.Documents }, DocumentsAPI.DeleteFileForDocument),
+	},
+	{
+		ID:         "Documents.DownloadFileForDocument",
+		Service:    "Documents",
+		Method:     "DownloadFileForDocument",
+		HTTPMethod: "GET",
+		Path:       "/documents/:documentId/uploads/:uploadedFileId/media",
+		PathParams: []OperationParam{
+			{Name: "documentId", Field: "DocumentID", Type: "DocumentID", Required: true},
+			{Name: "uploadedFileId", Field: "UploadedFileID", Type: "DocumentUploadID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Download a file from a document.",
+		fn:       bind(func(s *Services) DocumentsAPI { return s.Documents }, DocumentsAPI.DownloadFileForDocument),
+	},
+	{
+		ID:         "Documents.GetDocumentByID",
+		Service:    "Documents",
+		Method:     "GetDocumentByID",
+		HTTPMethod: "GET",
+		Path:       "/documents/:documentId",
+		PathParams: []OperationParam{
+			{Name: "documentId", Field: "DocumentID", Type: "DocumentID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Get a document by ID.",
+		fn:       bind(func(s *Services) DocumentsAPI { return s.Documents }, DocumentsAPI.GetDocumentByID),
+	},
+	{
+		ID:         "Documents.ListDocuments",
+		Service:    "Documents",
+		Method:     "ListDocuments",
+		HTTPMethod: "GET",
+		Path:       "/documents",
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+			{Name: "frameworkMatchesAny", Field: "FrameworkMatchesAny", Type: "[]string"},
+			{Name: "statusMatchesAny", Field: "StatusMatchesAny", Type: "[]string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "List documents.",
+		fn:        bind(func(s *Services) DocumentsAPI { return s.Documents }, DocumentsAPI.ListDocuments),
+	},
+	{
+		ID:         "Documents.ListDocumentsControls",
+		Service:    "Documents",
+		Method:     "ListDocumentsControls",
+		HTTPMethod: "GET",
+		Path:       "/documents/:documentId/controls",
+		PathParams: []OperationParam{
+			{Name: "documentId", Field: "DocumentID", Type: "DocumentID", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "List a document's associated controls.",
+		fn:        bind(func(s *Services) DocumentsAPI { return s.Documents }, DocumentsAPI.ListDocumentsControls),
+	},
+	{
+		ID:         "Documents.ListDocumentsLinks",
+		Service:    "Documents",
+		Method:     "ListDocumentsLinks",
+		HTTPMethod: "GET",
+		Path:       "/documents/:documentId/links",
+		PathParams: []OperationParam{
+			{Name: "documentId", Field: "DocumentID", Type: "DocumentID", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "List the uploaded links for a document.",
+		fn:        bind(func(s *Services) DocumentsAPI { return s.Documents }, DocumentsAPI.ListDocumentsLinks),
+	},
+	{
+		ID:         "Documents.ListDocumentsUploads",
+		Service:    "Documents",
+		Method:     "ListDocumentsUploads",
+		HTTPMethod: "GET",
+		Path:       "/documents/:documentId/uploads",
+		PathParams: []OperationParam{
+			{Name: "documentId", Field: "DocumentID", Type: "DocumentID", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "List the uploaded files for a document.",
+		fn:        bind(func(s *Services) DocumentsAPI { return s.Documents }, DocumentsAPI.ListDocumentsUploads),
+	},
+	{
+		ID:         "Documents.RemoveDocumentLink",
+		Service:    "Documents",
+		Method:     "RemoveDocumentLink",
+		HTTPMethod: "DELETE",
+		Path:       "/documents/:documentId/links/:linkId",
+		PathParams: []OperationParam{
+			{Name: "documentId", Field: "DocumentID", Type: "DocumentID", Required: true},
+			{Name: "linkId", Field: "LinkID", Type: "DocumentLinkID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Remove a link from a document.",
+		fn:     bind(func(s *Services) DocumentsAPI { return s.Documents }, DocumentsAPI.RemoveDocumentLink),
+	},
+	{
+		ID:         "Documents.SetDocumentOwner",
+		Service:    "Documents",
+		Method:     "SetDocumentOwner",
+		HTTPMethod: "POST",
+		Path:       "/documents/:documentId/set-owner",
+		PathParams: []OperationParam{
+			{Name: "documentId", Field: "DocumentID", Type: "DocumentID", Required: true},
+		},
+		BodyType: "*DocumentsSetDocumentOwnerRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Assign or unassign a user to the document.",
+		fn:       bind(func(s *Services) DocumentsAPI { return s.Documents }, DocumentsAPI.SetDocumentOwner),
+	},
+	{
+		ID:         "Documents.SubmitDocumentCollection",
+		Service:    "Documents",
+		Method:     "SubmitDocumentCollection",
+		HTTPMethod: "POST",
+		Path:       "/documents/:documentId/submit",
+		PathParams: []OperationParam{
+			{Name: "documentId", Field: "DocumentID", Type: "DocumentID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Submit document collection.",
+		fn:     bind(func(s *Services) DocumentsAPI { return s.Documents }, DocumentsAPI.SubmitDocumentCollection),
+	},
+	{
+		ID:         "Documents.UploadFileForDocument",
+		Service:    "Documents",
+		Method:     "UploadFileForDocument",
+		HTTPMethod: "POST",
+		Path:       "/documents/:documentId/uploads",
+		PathParams: []OperationParam{
+			{Name: "documentId", Field: "DocumentID", Type: "DocumentID", Required: true},
+		},
+		Multipart: true,
+		Scopes:    []string{ScopeAllWrite},
+		Doc:       "Upload a file for a document.",
+		fn:        bind(func(s *Services) DocumentsAPI { return s.Documents }, DocumentsAPI.UploadFileForDocument),
+	},
+	{
+		ID:         "Frameworks.GetFrameworkByID",
+		Service:    "Frameworks",
+		Method:     "GetFrameworkByID",
+		HTTPMethod: "GET",
+		Path:       "/frameworks/:frameworkId",
+		PathParams: []OperationParam{
+			{Name: "frameworkId", Field: "FrameworkID", Type: "FrameworkID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Get a framework by ID.",
+		fn:       bind(func(s *Services) FrameworksAPI { return s.Frameworks }, FrameworksAPI.GetFrameworkByID),
+	},
+	{
+		ID:         "Frameworks.ListAvailableFrameworks",
+		Service:    "Frameworks",
+		Method:     "ListAvailableFrameworks",
+		HTTPMethod: "GET",
+		Path:       "/frameworks",
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Lists available frameworks.",
+		fn:        bind(func(s *Services) FrameworksAPI { return s.Frameworks }, FrameworksAPI.ListAvailableFrameworks),
+	},
+	{
+		ID:         "Frameworks.ListFrameworksControls",
+		Service:    "Frameworks",
+		Method:     "ListFrameworksControls",
+		HTTPMethod: "GET",
+		Path:       "/frameworks/:frameworkId/controls",
+		PathParams: []OperationParam{
+			{Name: "frameworkId", Field: "FrameworkID", Type: "FrameworkID", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "List a framework's controls.",
+		fn:        bind(func(s *Services) FrameworksAPI { return s.Frameworks }, FrameworksAPI.ListFrameworksControls),
+	},
+	{
+		ID:         "Groups.AddPeopleToGroup",
+		Service:    "Groups",
+		Method:     "AddPeopleToGroup",
+		HTTPMethod: "POST",
+		Path:       "/groups/:groupId/add-people",
+		PathParams: []OperationParam{
+			{Name: "groupId", Field: "GroupID", Type: "GroupID", Required: true},
+		},
+		BodyType: "*GroupsAddPeopleToGroupRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Add people to a group.",
+		fn:       bind(func(s *Services) GroupsAPI { return s.Groups }, GroupsAPI.AddPeopleToGroup),
+	},
+	{
+		ID:         "Groups.AddPersonToGroup",
+		Service:    "Groups",
+		Method:     "AddPersonToGroup",
+		HTTPMethod: "POST",
+		Path:       "/groups/:groupId/people",
+		PathParams: []OperationParam{
+			{Name: "groupId", Field: "GroupID", Type: "GroupID", Required: true},
+		},
+		BodyType: "*GroupsAddPersonToGroupRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Add a single person, by ID, to a group.",
+		fn:       bind(func(s *Services) GroupsAPI { return s.Groups }, GroupsAPI.AddPersonToGroup),
+	},
+	{
+		ID:         "Groups.GetGroupByID",
+		Service:    "Groups",
+		Method:     "GetGroupByID",
+		HTTPMethod: "GET",
+		Path:       "/groups/:groupId",
+		PathParams: []OperationParam{
+			{Name: "groupId", Field: "GroupID", Type: "GroupID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Get a group by ID.",
+		fn:       bind(func(s *Services) GroupsAPI { return s.Groups }, GroupsAPI.GetGroupByID),
+	},
+	{
+		ID:         "Groups.ListGroups",
+		Service:    "Groups",
+		Method:     "ListGroups",
+		HTTPMethod: "GET",
+		Path:       "/groups",
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Lists all groups by ID.",
+		fn:        bind(func(s *Services) GroupsAPI { return s.Groups }, GroupsAPI.ListGroups),
+	},
+	{
+		ID:         "Groups.ListPeopleInGroup",
+		Service:    "Groups",
+		Method:     "ListPeopleInGroup",
+		HTTPMethod: "GET",
+		Path:       "/groups/:groupId/people",
+		PathParams: []OperationParam{
+			{Name: "groupId", Field: "GroupID", Type: "GroupID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "List people in a group.",
+		fn:       bind(func(s *Services) GroupsAPI { return s.Groups }, GroupsAPI.ListPeopleInGroup),
+	},
+	{
+		ID:         "Groups.RemovePeopleFromGroup",
+		Service:    "Groups",
+		Method:     "RemovePeopleFromGroup",
+		HTTPMethod: "POST",
+		Path:       "/groups/:groupId/remove-people",
+		PathParams: []OperationParam{
+			{Name: "groupId", Field: "GroupID", Type: "GroupID", Required: true},
+		},
+		BodyType: "*GroupsRemovePeopleFromGroupRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Remove people from a group.",
+		fn:       bind(func(s *Services) GroupsAPI { return s.Groups }, GroupsAPI.RemovePeopleFromGroup),
+	},
+	{
+		ID:         "Groups.RemovePersonFromGroup",
+		Service:    "Groups",
+		Method:     "RemovePersonFromGroup",
+		HTTPMethod: "DELETE",
+		Path:       "/groups/:groupId/people/:personId",
+		PathParams: []OperationParam{
+			{Name: "groupId", Field: "GroupID", Type: "GroupID", Required: true},
+			{Name: "personId", Field: "PersonID", Type: "PersonID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Remove a single person, by ID, from a group.",
+		fn:     bind(func(s *Services) GroupsAPI { return s.Groups }, GroupsAPI.RemovePersonFromGroup),
+	},
+	{
+		ID:         "Integrations.GetConnectedIntegration",
+		Service:    "Integrations",
+		Method:     "GetConnectedIntegration",
+		HTTPMethod: "GET",
+		Path:       "/integrations/:integrationId",
+		PathParams: []OperationParam{
+			{Name: "integrationId", Field: "IntegrationID", Type: "IntegrationID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets details for a specific integration by connection ID.",
+		fn:       bind(func(s *Services) IntegrationsAPI { return s.Integrations }, IntegrationsAPI.GetConnectedIntegration),
+	},
+	{
+		ID:         "Integrations.GetDetailsForResourceKind",
+		Service:    "Integrations",
+		Method:     "GetDetailsForResourceKind",
+		HTTPMethod: "GET",
+		Path:       "/integrations/:integrationId/resource-kinds/:resourceKind",
+		PathParams: []OperationParam{
+			{Name: "integrationId", Field: "IntegrationID", Type: "IntegrationID", Required: true},
+			{Name: "resourceKind", Field: "ResourceKind", Type: "string", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "connectionId", Field: "ConnectionID", Type: "*string"},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets details for a specific resource type (kind) such as S3Bucket or CloudwatchLogGroup.",
+		fn:       bind(func(s *Services) IntegrationsAPI { return s.Integrations }, IntegrationsAPI.GetDetailsForResourceKind),
+	},
+	{
+		ID:         "Integrations.GetResourceByID",
+		Service:    "Integrations",
+		Method:     "GetResourceByID",
+		HTTPMethod: "GET",
+		Path:       "/integrations/:integrationId/resource-kinds/:resourceKind/resources/:resourceId",
+		PathParams: []OperationParam{
+			{Name: "integrationId", Field: "IntegrationID", Type: "IntegrationID", Required: true},
+			{Name: "resourceKind", Field: "ResourceKind", Type: "string", Required: true},
+			{Name: "resourceId", Field: "ResourceID", Type: "string", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets resource by its ID.",
+		fn:       bind(func(s *Services) IntegrationsAPI { return s.Integrations }, IntegrationsAPI.GetResourceByID),
+	},
+	{
+		ID:         "Integrations.ListConnectedIntegrations",
+		Service:    "Integrations",
+		Method:     "ListConnectedIntegrations",
+		HTTPMethod: "GET",
+		Path:       "/integrations",
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Lists all integrations connected to a Vanta instance.",
+		fn:        bind(func(s *Services) IntegrationsAPI { return s.Integrations }, IntegrationsAPI.ListConnectedIntegrations),
+	},
+	{
+		ID:         "Integrations.ListIntegrationResourceKinds",
+		Service:    "Integrations",
+		Method:     "ListIntegrationResourceKinds",
+		HTTPMethod: "GET",
+		Path:       "/integrations/:integrationId/resource-kinds",
+		PathParams: []OperationParam{
+			{Name: "integrationId", Field: "IntegrationID", Type: "IntegrationID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Lists a connected integration's resource types (kinds) such as S3Bucket or CloudwatchLogGroup.",
+		fn:       bind(func(s *Services) IntegrationsAPI { return s.Integrations }, IntegrationsAPI.ListIntegrationResourceKinds),
+	},
+	{
+		ID:         "Integrations.ListResources",
+		Service:    "Integrations",
+		Method:     "ListResources",
+		HTTPMethod: "GET",
+		Path:       "/integrations/:integrationId/resource-kinds/:resourceKind/resources",
+		PathParams: []OperationParam{
+			{Name: "integrationId", Field: "IntegrationID", Type: "IntegrationID", Required: true},
+			{Name: "resourceKind", Field: "ResourceKind", Type: "string", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "connectionId", Field: "ConnectionID", Type: "*string"},
+			{Name: "hasDescription", Field: "HasDescription", Type: "*bool"},
+			{Name: "hasOwner", Field: "HasOwner", Type: "*bool"},
+			{Name: "isInScope", Field: "IsInScope", Type: "*bool"},
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Lists resources for a specific integration and resource type (kind) such as S3Bucket or CloudwatchLogGroup.",
+		fn:        bind(func(s *Services) IntegrationsAPI { return s.Integrations }, IntegrationsAPI.ListResources),
+	},
+	{
+		ID:         "Integrations.UpdateResourceMetadata",
+		Service:    "Integrations",
+		Method:     "UpdateResourceMetadata",
+		HTTPMethod: "PATCH",
+		Path:       "/integrations/:integrationId/resource-kinds/:resourceKind/resources",
+		PathParams: []OperationParam{
+			{Name: "integrationId", Field: "IntegrationID", Type: "IntegrationID", Required: true},
+			{Name: "resourceKind", Field: "ResourceKind", Type: "string", Required: true},
+		},
+		BodyType: "*IntegrationsUpdateResourceMetadataRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Updates metadata for multiple resources.",
+		fn:       bind(func(s *Services) IntegrationsAPI { return s.Integrations }, IntegrationsAPI.UpdateResourceMetadata),
+	},
+	{
+		ID:         "Integrations.UpdateResourceMetadataForResourceKindsResources",
+		Service:    "Integrations",
+		Method:     "UpdateResourceMetadataForResourceKindsResources",
+		HTTPMethod: "PATCH",
+		Path:       "/integrations/:integrationId/resource-kinds/:resourceKind/resources/:resourceId",
+		PathParams: []OperationParam{
+			{Name: "integrationId", Field: "IntegrationID", Type: "IntegrationID", Required: true},
+			{Name: "resourceKind", Field: "ResourceKind", Type: "string", Required: true},
+			{Name: "resourceId", Field: "ResourceID", Type: "string", Required: true},
+		},
+		BodyType: "*IntegrationsUpdateResourceMetadataForResourceKindsResourcesRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Updates metadata for a specific resource such as an S3Bucket or CloudwatchLogGroup.",
+		fn:       bind(func(s *Services) IntegrationsAPI { return s.Integrations }, IntegrationsAPI.UpdateResourceMetadataForResourceKindsResources),
+	},
+	{
+		ID:         "MonitoredComputers.GetMonitoredComputerByID",
+		Service:    "MonitoredComputers",
+		Method:     "GetMonitoredComputerByID",
+		HTTPMethod: "GET",
+		Path:       "/monitored-computers/:computerId",
+		PathParams: []OperationParam{
+			{Name: "computerId", Field: "ComputerID", Type: "MonitoredComputerID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Returns a monitored computer by ID.",
+		fn:       bind(func(s *Services) MonitoredComputersAPI { return s.MonitoredComputers }, MonitoredComputersAPI.GetMonitoredComputerByID),
+	},
+	{
+		ID:         "MonitoredComputers.ListMonitoredComputers",
+		Service:    "MonitoredComputers",
+		Method:     "ListMonitoredComputers",
+		HTTPMethod: "GET",
+		Path:       "/monitored-computers",
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+			{Name: "complianceStatusFilterMatchesAny", Field: "ComplianceStatusFilterMatchesAny", Type: "[]string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Returns a list of computers monitored by an MDM (with an integration built by Vanta) or by the Vanta Agent. Currently this list does not include resources from partner or customer-built integrations.",
+		fn:        bind(func(s *Services) MonitoredComputersAPI { return s.MonitoredComputers }, MonitoredComputersAPI.ListMonitoredComputers),
+	},
+	{
+		ID:         "OAuth.CreateToken",
+		Service:    "OAuth",
+		Method:     "CreateToken",
+		HTTPMethod: "POST",
+		Path:       "/oauth/token",
+		BodyType:   "*OAuthCreateTokenRequestBody",
+		Doc:        "CreateToken performs POST /oauth/token against the client's auth URL.",
+		fn:         bind(func(s *Services) OAuthAPI { return s.OAuth }, OAuthAPI.CreateToken),
+	},
+	{
+		ID:         "People.GetPersonByID",
+		Service:    "People",
+		Method:     "GetPersonByID",
+		HTTPMethod: "GET",
+		Path:       "/people/:personId",
+		PathParams: []OperationParam{
+			{Name: "personId", Field: "PersonID", Type: "PersonID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Returns a person by ID.",
+		fn:       bind(func(s *Services) PeopleAPI { return s.People }, PeopleAPI.GetPersonByID),
+	},
+	{
+		ID:         "People.ListPeople",
+		Service:    "People",
+		Method:     "ListPeople",
+		HTTPMethod: "GET",
+		Path:       "/people",
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+			{Name: "tasksSummaryStatusMatchesAny", Field: "TasksSummaryStatusMatchesAny", Type: "[]string"},
+			{Name: "taskTypeMatchesAny", Field: "TaskTypeMatchesAny", Type: "[]PersonTaskType"},
+			{Name: "taskStatusMatchesAny", Field: "TaskStatusMatchesAny", Type: "[]string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Returns a list of all people.",
+		fn:        bind(func(s *Services) PeopleAPI { return s.People }, PeopleAPI.ListPeople),
+	},
+	{
+		ID:         "People.MarkAsNotPeople",
+		Service:    "People",
+		Method:     "MarkAsNotPeople",
+		HTTPMethod: "POST",
+		Path:       "/people/mark-as-not-people",
+		BodyType:   "*PeopleMarkAsNotPeopleRequestBody",
+		Scopes:     []string{ScopeAllWrite},
+		Doc:        "Mark a set of accounts on the People Page as \"not a person.\" As a result, these accounts will not be treated as people in Vanta, and you will not be able to assign them tasks or use them in tests related to your company's personnel.",
+		fn:         bind(func(s *Services) PeopleAPI { return s.People }, PeopleAPI.MarkAsNotPeople),
+	},
+	{
+		ID:         "People.MarkAsPeople",
+		Service:    "People",
+		Method:     "MarkAsPeople",
+		HTTPMethod: "POST",
+		Path:       "/people/mark-as-people",
+		BodyType:   "*PeopleMarkAsPeopleRequestBody",
+		Scopes:     []string{ScopeAllWrite},
+		Doc:        "Mark a set of accounts on the People Page as \"people.\" As a result, these accounts will be treated as people in Vanta, and you will be able to assign them tasks and use them in tests related to your company's personnel.",
+		fn:         bind(func(s *Services) PeopleAPI { return s.People }, PeopleAPI.MarkAsPeople),
+	},
+	{
+		ID:         "People.OffboardPeople",
+		Service:    "People",
+		Method:     "OffboardPeople",
+		HTTPMethod: "POST",
+		Path:       "/people/offboard",
+		BodyType:   "*PeopleOffboardPeopleRequestBody",
+		Scopes:     []string{ScopeAllWrite},
+		Doc:        "Offboard a list of people. A person is only eligible for offboarding completion when: 1. They are an ex-employee. 2. All of the person's monitored accounts are deactivated or manually overwritten as such. 3. All of a person's custom offboarding tasks have been completed. All of the person's unmonitored accounts will be automatically marked as deactivated when they are offboarded. If the person has unfinished offboarding tasks those will NOT automatically be completed and offboarding them will fail.",
+		fn:         bind(func(s *Services) PeopleAPI { return s.People }, PeopleAPI.OffboardPeople),
+	},
+	{
+		ID:         "People.RemoveLeaveInformation",
+		Service:    "People",
+		Method:     "RemoveLeaveInformation",
+		HTTPMethod: "POST",
+		Path:       "/people/:personId/clear-leave",
+		PathParams: []OperationParam{
+			{Name: "personId", Field: "PersonID", Type: "PersonID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Remove leave information on a person. The person will become active in Vanta, and will be considered in certain tests related to personnel.",
+		fn:     bind(func(s *Services) PeopleAPI { return s.People }, PeopleAPI.RemoveLeaveInformation),
+	},
+	{
+		ID:         "People.SetLeaveInformation",
+		Service:    "People",
+		Method:     "SetLeaveInformation",
+		HTTPMethod: "POST",
+		Path:       "/people/:personId/set-leave",
+		PathParams: []OperationParam{
+			{Name: "personId", Field: "PersonID", Type: "PersonID", Required: true},
+		},
+		BodyType: "*PeopleSetLeaveInformationRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Set leave information on a person. A person on leave is inactive in Vanta and will not be considered in certain personnel-related tests. If the person has existing leave information, it will be cleared and replaced.",
+		fn:       bind(func(s *Services) PeopleAPI { return s.People }, PeopleAPI.SetLeaveInformation),
+	},
+	{
+		ID:         "People.UpdatePersonMetadata",
+		Service:    "People",
+		Method:     "UpdatePersonMetadata",
+		HTTPMethod: "PATCH",
+		Path:       "/people/:personId",
+		PathParams: []OperationParam{
+			{Name: "personId", Field: "PersonID", Type: "PersonID", Required: true},
+		},
+		BodyType: "*PeopleUpdatePersonMetadataRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Update a person's basic information.",
+		fn:       bind(func(s *Services) PeopleAPI { return s.People }, PeopleAPI.UpdatePersonMetadata),
+	},
+	{
+		ID:         "Policies.GetPolicyByID",
+		Service:    "Policies",
+		Method:     "GetPolicyByID",
+		HTTPMethod: "GET",
+		Path:       "/policies/:policyId",
+		PathParams: []OperationParam{
+			{Name: "policyId", Field: "PolicyID", Type: "PolicyID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets a policy by ID. Policy IDs can be found in Vanta in URL bar after /policies/.",
+		fn:       bind(func(s *Services) PoliciesAPI { return s.Policies }, PoliciesAPI.GetPolicyByID),
+	},
+	{
+		ID:         "Policies.ListPolicies",
+		Service:    "Policies",
+		Method:     "ListPolicies",
+		HTTPMethod: "GET",
+		Path:       "/policies",
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Lists all policies.",
+		fn:        bind(func(s *Services) PoliciesAPI { return s.Policies }, PoliciesAPI.ListPolicies),
+	},
+	{
+		ID:         "Resources.GetComputers",
+		Service:    "Resources",
+		Method:     "GetComputers",
+		HTTPMethod: "GET",
+		Path:       "/resources/macos_user_computer",
+		QueryParams: []OperationParam{
+			{Name: "resourceId", Field: "ResourceID", Type: "*string"},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "GetComputers performs GET /resources/macos_user_computer.",
+		fn:       bind(func(s *Services) ResourcesAPI { return s.Resources }, ResourcesAPI.GetComputers),
+	},
+	{
+		ID:         "Resources.GetCustomResourceServer",
+		Service:    "Resources",
+		Method:     "GetCustomResourceServer",
+		HTTPMethod: "GET",
+		Path:       "/resources/custom_resource",
+		QueryParams: []OperationParam{
+			{Name: "resourceId", Field: "ResourceID", Type: "*string"},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "GetCustomResourceServer performs GET /resources/custom_resource.",
+		fn:       bind(func(s *Services) ResourcesAPI { return s.Resources }, ResourcesAPI.GetCustomResourceServer),
+	},
+	{
+		ID:         "Resources.GetUserAccounts",
+		Service:    "Resources",
+		Method:     "GetUserAccounts",
+		HTTPMethod: "GET",
+		Path:       "/resources/user_account",
+		QueryParams: []OperationParam{
+			{Name: "resourceId", Field: "ResourceID", Type: "*string"},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "GetUserAccounts performs GET /resources/user_account.",
+		fn:       bind(func(s *Services) ResourcesAPI { return s.Resources }, ResourcesAPI.GetUserAccounts),
+	},
+	{
+		ID:         "Resources.SyncCustomResourceServer",
+		Service:    "Resources",
+		Method:     "SyncCustomResourceServer",
+		HTTPMethod: "PUT",
+		Path:       "/resources/custom_resource",
+		BodyType:   "*ResourcesSyncCustomResourceServerRequestBody",
+		Scopes:     []string{ScopeAllWrite},
+		Doc:        "SyncCustomResourceServer performs PUT /resources/custom_resource.",
+		fn:         bind(func(s *Services) ResourcesAPI { return s.Resources }, ResourcesAPI.SyncCustomResourceServer),
+	},
+	{
+		ID:         "Resources.SyncMacOsComputers",
+		Service:    "Resources",
+		Method:     "SyncMacOsComputers",
+		HTTPMethod: "PUT",
+		Path:       "/resources/macos_user_computer",
+		BodyType:   "*ResourcesSyncMacOsComputersRequestBody",
+		Scopes:     []string{ScopeAllWrite},
+		Doc:        "SyncMacOsComputers performs PUT /resources/macos_user_computer.",
+		fn:         bind(func(s *Services) ResourcesAPI { return s.Resources }, ResourcesAPI.SyncMacOsComputers),
+	},
+	{
+		ID:         "Resources.SyncUserAccounts",
+		Service:    "Resources",
+		Method:     "SyncUserAccounts",
+		HTTPMethod: "PUT",
+		Path:       "/resources/user_account",
+		BodyType:   "*ResourcesSyncUserAccountsRequestBody",
+		Scopes:     []string{ScopeAllWrite},
+		Doc:        "SyncUserAccounts performs PUT /resources/user_account.",
+		fn:         bind(func(s *Services) ResourcesAPI { return s.Resources }, ResourcesAPI.SyncUserAccounts),
+	},
+	{
+		ID:         "RiskScenarios.CancelRiskScenarioApprovalRequest",
+		Service:    "RiskScenarios",
+		Method:     "CancelRiskScenarioApprovalRequest",
+		HTTPMethod: "POST",
+		Path:       "/risk-scenarios/:riskScenarioId/cancel-approval-request",
+		PathParams: []OperationParam{
+			{Name: "riskScenarioId", Field: "RiskScenarioID", Type: "RiskScenarioID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Cancel approval request for a risk scenario.",
+		fn:     bind(func(s *Services) RiskScenariosAPI { return s.RiskScenarios }, RiskScenariosAPI.CancelRiskScenarioApprovalRequest),
+	},
+	{
+		ID:         "RiskScenarios.CreateRiskScenario",
+		Service:    "RiskScenarios",
+		Method:     "CreateRiskScenario",
+		HTTPMethod: "POST",
+		Path:       "/risk-scenarios",
+		BodyType:   "*RiskScenariosCreateRiskScenarioRequestBody",
+		Scopes:     []string{ScopeAllWrite},
+		Doc:        "Create a new risk scenario.",
+		fn:         bind(func(s *Services) RiskScenariosAPI { return s.RiskScenarios }, RiskScenariosAPI.CreateRiskScenario),
+	},
+	{
+		ID:         "RiskScenarios.GetRiskScenarioByID",
+		Service:    "RiskScenarios",
+		Method:     "GetRiskScenarioByID",
+		HTTPMethod: "GET",
+		Path:       "/risk-scenarios/:riskScenarioId",
+		PathParams: []OperationParam{
+			{Name: "riskScenarioId", Field: "RiskScenarioID", Type: "RiskScenarioID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Get a risk scenario by ID (can be the Risk ID or the object ID).",
+		fn:       bind(func(s *Services) RiskScenariosAPI { return s.RiskScenarios }, RiskScenariosAPI.GetRiskScenarioByID),
+	},
+	{
+		ID:         "RiskScenarios.ListRiskScenarios",
+		Service:    "RiskScenarios",
+		Method:     "ListRiskScenarios",
+		HTTPMethod: "GET",
+		Path:       "/risk-scenarios",
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+			{Name: "includeIgnored", Field: "IncludeIgnored", Type: "*bool"},
+			{Name: "ownerMatchesAny", Field: "OwnerMatchesAny", Type: "[]string"},
+			{Name: "searchString", Field: "SearchString", Type: "*string"},
+			{Name: "categoryMatchesAny", Field: "CategoryMatchesAny", Type: "[]string"},
+			{Name: "ciaCategoryMatchesAny", Field: "CiaCategoryMatchesAny", Type: "[]string"},
+			{Name: "treatmentTypeMatchesAny", Field: "TreatmentTypeMatchesAny", Type: "[]string"},
+			{Name: "inherentScoreGroupMatchesAny", Field: "InherentScoreGroupMatchesAny", Type: "[]string"},
+			{Name: "residualScoreGroupMatchesAny", Field: "ResidualScoreGroupMatchesAny", Type: "[]string"},
+			{Name: "reviewStatusMatchesAny", Field: "ReviewStatusMatchesAny", Type: "[]string"},
+			{Name: "type", Field: "Type", Type: "*string"},
+			{Name: "orderBy", Field: "OrderBy", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "List risk scenarios.",
+		fn:        bind(func(s *Services) RiskScenariosAPI { return s.RiskScenarios }, RiskScenariosAPI.ListRiskScenarios),
+	},
+	{
+		ID:         "RiskScenarios.SubmitRiskScenarioForApproval",
+		Service:    "RiskScenarios",
+		Method:     "SubmitRiskScenarioForApproval",
+		HTTPMethod: "POST",
+		Path:       "/risk-scenarios/:riskScenarioId/submit-for-approval",
+		PathParams: []OperationParam{
+			{Name: "riskScenarioId", Field: "RiskScenarioID", Type: "RiskScenarioID", Required: true},
+		},
+		BodyType: "*RiskScenariosSubmitRiskScenarioForApprovalRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Submit a risk scenario for approval.",
+		fn:       bind(func(s *Services) RiskScenariosAPI { return s.RiskScenarios }, RiskScenariosAPI.SubmitRiskScenarioForApproval),
+	},
+	{
+		ID:         "RiskScenarios.UpdateRiskScenario",
+		Service:    "RiskScenarios",
+		Method:     "UpdateRiskScenario",
+		HTTPMethod: "PATCH",
+		Path:       "/risk-scenarios/:riskScenarioId",
+		PathParams: []OperationParam{
+			{Name: "riskScenarioId", Field: "RiskScenarioID", Type: "RiskScenarioID", Required: true},
+		},
+		BodyType: "*RiskScenariosUpdateRiskScenarioRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Update a risk scenario.",
+		fn:       bind(func(s *Services) RiskScenariosAPI { return s.RiskScenarios }, RiskScenariosAPI.UpdateRiskScenario),
+	},
+	{
+		ID:         "Tests.DeactivateTestEntity",
+		Service:    "Tests",
+		Method:     "DeactivateTestEntity",
+		HTTPMethod: "POST",
+		Path:       "/tests/:testId/entities/:entityId/deactivate",
+		PathParams: []OperationParam{
+			{Name: "testId", Field: "TestID", Type: "TestID", Required: true},
+			{Name: "entityId", Field: "EntityID", Type: "TestEntityID", Required: true},
+		},
+		BodyType: "*TestsDeactivateTestEntityRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Deactivates a single test item (test entity). There may be a delay in the deactivation of the test entity until the next test run. Use the /vulnerabilities/deactivate endpoint for vulnerabilities.",
+		fn:       bind(func(s *Services) TestsAPI { return s.Tests }, TestsAPI.DeactivateTestEntity),
+	},
+	{
+		ID:         "Tests.GetTestByID",
+		Service:    "Tests",
+		Method:     "GetTestByID",
+		HTTPMethod: "GET",
+		Path:       "/tests/:testId",
+		PathParams: []OperationParam{
+			{Name: "testId", Field: "TestID", Type: "TestID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets a test by ID. Test IDs can be found in Vanta in URL bar after /tests/.",
+		fn:       bind(func(s *Services) TestsAPI { return s.Tests }, TestsAPI.GetTestByID),
+	},
+	{
+		ID:         "Tests.GetTestEntitiesByTestID",
+		Service:    "Tests",
+		Method:     "GetTestEntitiesByTestID",
+		HTTPMethod: "GET",
+		Path:       "/tests/:testId/entities",
+		PathParams: []OperationParam{
+			{Name: "testId", Field: "TestID", Type: "TestID", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "entityStatus", Field: "EntityStatus", Type: "*TestEntityStatus"},
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Gets a list of tested items (entities) for a test by test ID. An entity is a tested item that can have its own outcome. For example, for a test that makes sure that all S3 buckets are versioned, an individual S3 bucket would be an entity.",
+		fn:        bind(func(s *Services) TestsAPI { return s.Tests }, TestsAPI.GetTestEntitiesByTestID),
+	},
+	{
+		ID:         "Tests.ListTests",
+		Service:    "Tests",
+		Method:     "ListTests",
+		HTTPMethod: "GET",
+		Path:       "/tests",
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+			{Name: "statusFilter", Field: "StatusFilter", Type: "*TestStatus"},
+			{Name: "frameworkFilter", Field: "FrameworkFilter", Type: "*FrameworkID"},
+			{Name: "integrationFilter", Field: "IntegrationFilter", Type: "*IntegrationID"},
+			{Name: "controlFilter", Field: "ControlFilter", Type: "*ControlID"},
+			{Name: "ownerFilter", Field: "OwnerFilter", Type: "*string"},
+			{Name: "categoryFilter", Field: "CategoryFilter", Type: "*string"},
+			{Name: "isInRollout", Field: "IsInRollout", Type: "*bool"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Lists all tests based on applied filters.",
+		fn:        bind(func(s *Services) TestsAPI { return s.Tests }, TestsAPI.ListTests),
+	},
+	{
+		ID:         "Tests.ReactivateTestEntity",
+		Service:    "Tests",
+		Method:     "ReactivateTestEntity",
+		HTTPMethod: "POST",
+		Path:       "/tests/:testId/entities/:entityId/reactivate",
+		PathParams: []OperationParam{
+			{Name: "testId", Field: "TestID", Type: "TestID", Required: true},
+			{Name: "entityId", Field: "EntityID", Type: "TestEntityID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Reactivates a single tested item (test entity). There may be a delay in the reactivation of the test entity until the next test run. Use the /vulnerabilities/reactivate endpoint for vulnerabilities.",
+		fn:     bind(func(s *Services) TestsAPI { return s.Tests }, TestsAPI.ReactivateTestEntity),
+	},
+	{
+		ID:         "TrustCenters.AddTrustCenterControl",
+		Service:    "TrustCenters",
+		Method:     "AddTrustCenterControl",
+		HTTPMethod: "POST",
+		Path:       "/trust-centers/:slugId/controls",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		BodyType: "*TrustCentersAddTrustCenterControlRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Adds a control to a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.AddTrustCenterControl),
+	},
+	{
+		ID:         "TrustCenters.AddTrustCenterControlCategory",
+		Service:    "TrustCenters",
+		Method:     "AddTrustCenterControlCategory",
+		HTTPMethod: "POST",
+		Path:       "/trust-centers/:slugId/control-categories",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		BodyType: "*TrustCentersAddTrustCenterControlCategoryRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Adds a control category to a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.AddTrustCenterControlCategory),
+	},
+	{
+		ID:         "TrustCenters.AddTrustCenterViewer",
+		Service:    "TrustCenters",
+		Method:     "AddTrustCenterViewer",
+		HTTPMethod: "POST",
+		Path:       "/trust-centers/:slugId/viewers",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		BodyType: "*TrustCentersAddTrustCenterViewerRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Adds a viewer and grants them access to a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.AddTrustCenterViewer),
+	},
+	{
+		ID:         "TrustCenters.ApproveTrustCenterAccessRequest",
+		Service:    "TrustCenters",
+		Method:     "ApproveTrustCenterAccessRequest",
+		HTTPMethod: "POST",
+		Path:       "/trust-centers/:slugId/access-requests/:accessRequestId/approve",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "accessRequestId", Field: "AccessRequestID", Type: "TrustCenterAccessRequestID", Required: true},
+		},
+		BodyType: "*TrustCentersApproveTrustCenterAccessRequestRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Approves an access request on a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.ApproveTrustCenterAccessRequest),
+	},
+	{
+		ID:         "TrustCenters.CreateTrustCenterDocument",
+		Service:    "TrustCenters",
+		Method:     "CreateTrustCenterDocument",
+		HTTPMethod: "POST",
+		Path:       "/trust-centers/:slugId/resources",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		Multipart: true,
+		Scopes:    []string{ScopeAllWrite},
+		Doc:       "Adds a document to a Trust Center.",
+		fn:        bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.CreateTrustCenterDocument),
+	},
+	{
+		ID:         "TrustCenters.CreateTrustCenterFaq",
+		Service:    "TrustCenters",
+		Method:     "CreateTrustCenterFaq",
+		HTTPMethod: "POST",
+		Path:       "/trust-centers/:slugId/faqs",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		BodyType: "*TrustCentersCreateTrustCenterFaqRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Adds an FAQ to a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.CreateTrustCenterFaq),
+	},
+	{
+		ID:         "TrustCenters.CreateTrustCenterSubprocessor",
+		Service:    "TrustCenters",
+		Method:     "CreateTrustCenterSubprocessor",
+		HTTPMethod: "POST",
+		Path:       "/trust-centers/:slugId/subprocessors",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		BodyType: "*TrustCentersCreateTrustCenterSubprocessorRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Adds a subprocessor to a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.CreateTrustCenterSubprocessor),
+	},
+	{
+		ID:         "TrustCenters.CreateTrustCenterSubscriber",
+		Service:    "TrustCenters",
+		Method:     "CreateTrustCenterSubscriber",
+		HTTPMethod: "POST",
+		Path:       "/trust-centers/:slugId/subscribers",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		BodyType: "*TrustCentersCreateTrustCenterSubscriberRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Adds a subscriber to a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.CreateTrustCenterSubscriber),
+	},
+	{
+		ID:         "TrustCenters.CreateTrustCenterSubscriberGroup",
+		Service:    "TrustCenters",
+		Method:     "CreateTrustCenterSubscriberGroup",
+		HTTPMethod: "POST",
+		Path:       "/trust-centers/:slugId/subscriber-groups",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		BodyType: "*TrustCentersCreateTrustCenterSubscriberGroupRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Adds a subscriber group to a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.CreateTrustCenterSubscriberGroup),
+	},
+	{
+		ID:         "TrustCenters.CreateTrustCenterUpdate",
+		Service:    "TrustCenters",
+		Method:     "CreateTrustCenterUpdate",
+		HTTPMethod: "POST",
+		Path:       "/trust-centers/:slugId/updates",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		BodyType: "*TrustCentersCreateTrustCenterUpdateRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Adds an update to a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.CreateTrustCenterUpdate),
+	},
+	{
+		ID:         "TrustCenters.DeleteTrustCenterControl",
+		Service:    "TrustCenters",
+		Method:     "DeleteTrustCenterControl",
+		HTTPMethod: "DELETE",
+		Path:       "/trust-centers/:slugId/controls/:controlId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "controlId", Field: "ControlID", Type: "ControlID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Removes a specific control from a Trust Center. This removes the control from all of the control categories that is in.",
+		fn:     bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.DeleteTrustCenterControl),
+	},
+	{
+		ID:         "TrustCenters.DeleteTrustCenterControlCategory",
+		Service:    "TrustCenters",
+		Method:     "DeleteTrustCenterControlCategory",
+		HTTPMethod: "DELETE",
+		Path:       "/trust-centers/:slugId/control-categories/:categoryId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "categoryId", Field: "CategoryID", Type: "TrustCenterControlCategoryID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Removes a control category from a Trust Center along with all of the controls in the category.",
+		fn:     bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.DeleteTrustCenterControlCategory),
+	},
+	{
+		ID:         "TrustCenters.DeleteTrustCenterDocument",
+		Service:    "TrustCenters",
+		Method:     "DeleteTrustCenterDocument",
+		HTTPMethod: "DELETE",
+		Path:       "/trust-centers/:slugId/resources/:resourceId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "resourceId", Field: "ResourceID", Type: "string", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Removes a specific document from a Trust Center.",
+		fn:     bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.DeleteTrustCenterDocument),
+	},
+	{
+		ID:         "TrustCenters.DeleteTrustCenterFaq",
+		Service:    "TrustCenters",
+		Method:     "DeleteTrustCenterFaq",
+		HTTPMethod: "DELETE",
+		Path:       "/trust-centers/:slugId/faqs/:faqId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "faqId", Field: "FaqID", Type: "TrustCenterFAQID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Remove a specific FAQ from the Trust Center by ID.",
+		fn:     bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.DeleteTrustCenterFaq),
+	},
+	{
+		ID:         "TrustCenters.DeleteTrustCenterSubprocessor",
+		Service:    "TrustCenters",
+		Method:     "DeleteTrustCenterSubprocessor",
+		HTTPMethod: "DELETE",
+		Path:       "/trust-centers/:slugId/subprocessors/:subprocessorId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "subprocessorId", Field: "SubprocessorID", Type: "TrustCenterSubprocessorID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Removes a subprocessor from a Trust Center.",
+		fn:     bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.DeleteTrustCenterSubprocessor),
+	},
+	{
+		ID:         "TrustCenters.DeleteTrustCenterSubscriber",
+		Service:    "TrustCenters",
+		Method:     "DeleteTrustCenterSubscriber",
+		HTTPMethod: "DELETE",
+		Path:       "/trust-centers/:slugId/subscribers/:subscriberId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "subscriberId", Field: "SubscriberID", Type: "TrustCenterSubscriberID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Removes a subscriber from a Trust Center.",
+		fn:     bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.DeleteTrustCenterSubscriber),
+	},
+	{
+		ID:         "TrustCenters.DeleteTrustCenterSubscriberGroup",
+		Service:    "TrustCenters",
+		Method:     "DeleteTrustCenterSubscriberGroup",
+		HTTPMethod: "DELETE",
+		Path:       "/trust-centers/:slugId/subscriber-groups/:subscriberGroupId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "subscriberGroupId", Field: "SubscriberGroupID", Type: "TrustCenterSubscriberGroupID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Removes a subscriber group from a Trust Center.",
+		fn:     bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.DeleteTrustCenterSubscriberGroup),
+	},
+	{
+		ID:         "TrustCenters.DeleteTrustCenterUpdate",
+		Service:    "TrustCenters",
+		Method:     "DeleteTrustCenterUpdate",
+		HTTPMethod: "DELETE",
+		Path:       "/trust-centers/:slugId/updates/:updateId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "updateId", Field: "UpdateID", Type: "TrustCenterUpdateID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Removes an update from a Trust Center.",
+		fn:     bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.DeleteTrustCenterUpdate),
+	},
+	{
+		ID:         "TrustCenters.DenyTrustCenterAccessRequest",
+		Service:    "TrustCenters",
+		Method:     "DenyTrustCenterAccessRequest",
+		HTTPMethod: "POST",
+		Path:       "/trust-centers/:slugId/access-requests/:accessRequestId/deny",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "accessRequestId", Field: "AccessRequestID", Type: "TrustCenterAccessRequestID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Denies an access request on a Trust Center.",
+		fn:     bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.DenyTrustCenterAccessRequest),
+	},
+	{
+		ID:         "TrustCenters.EditTrustCenterSubscriberGroup",
+		Service:    "TrustCenters",
+		Method:     "EditTrustCenterSubscriberGroup",
+		HTTPMethod: "PATCH",
+		Path:       "/trust-centers/:slugId/subscriber-groups/:subscriberGroupId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "subscriberGroupId", Field: "SubscriberGroupID", Type: "TrustCenterSubscriberGroupID", Required: true},
+		},
+		BodyType: "*TrustCentersEditTrustCenterSubscriberGroupRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Edits a Trust Center subscriber group.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.EditTrustCenterSubscriberGroup),
+	},
+	{
+		ID:         "TrustCenters.GetTrustCenter",
+		Service:    "TrustCenters",
+		Method:     "GetTrustCenter",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets a Trust Center by slug ID.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.GetTrustCenter),
+	},
+	{
+		ID:         "TrustCenters.GetTrustCenterAccessRequest",
+		Service:    "TrustCenters",
+		Method:     "GetTrustCenterAccessRequest",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/access-requests/:accessRequestId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "accessRequestId", Field: "AccessRequestID", Type: "TrustCenterAccessRequestID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets a specific access request for a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.GetTrustCenterAccessRequest),
+	},
+	{
+		ID:         "TrustCenters.GetTrustCenterControl",
+		Service:    "TrustCenters",
+		Method:     "GetTrustCenterControl",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/controls/:controlId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "controlId", Field: "ControlID", Type: "ControlID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets a specific control on a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.GetTrustCenterControl),
+	},
+	{
+		ID:         "TrustCenters.GetTrustCenterControlCategory",
+		Service:    "TrustCenters",
+		Method:     "GetTrustCenterControlCategory",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/control-categories/:categoryId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "categoryId", Field: "CategoryID", Type: "TrustCenterControlCategoryID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets a specific control category on a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.GetTrustCenterControlCategory),
+	},
+	{
+		ID:         "TrustCenters.GetTrustCenterDocument",
+		Service:    "TrustCenters",
+		Method:     "GetTrustCenterDocument",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/resources/:resourceId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "resourceId", Field: "ResourceID", Type: "string", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets a specific document on a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.GetTrustCenterDocument),
+	},
+	{
+		ID:         "TrustCenters.GetTrustCenterFaq",
+		Service:    "TrustCenters",
+		Method:     "GetTrustCenterFaq",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/faqs/:faqId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "faqId", Field: "FaqID", Type: "TrustCenterFAQID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets a specific FAQ on the Trust Center by ID.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.GetTrustCenterFaq),
+	},
+	{
+		ID:         "TrustCenters.GetTrustCenterSubprocessor",
+		Service:    "TrustCenters",
+		Method:     "GetTrustCenterSubprocessor",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/subprocessors/:subprocessorId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "subprocessorId", Field: "SubprocessorID", Type: "TrustCenterSubprocessorID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets a specific subprocessor on a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.GetTrustCenterSubprocessor),
+	},
+	{
+		ID:         "TrustCenters.GetTrustCenterSubscriber",
+		Service:    "TrustCenters",
+		Method:     "GetTrustCenterSubscriber",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/subscribers/:subscriberId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "subscriberId", Field: "SubscriberID", Type: "TrustCenterSubscriberID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets a specific subscriber on a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.GetTrustCenterSubscriber),
+	},
+	{
+		ID:         "TrustCenters.GetTrustCenterSubscriberGroup",
+		Service:    "TrustCenters",
+		Method:     "GetTrustCenterSubscriberGroup",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/subscriber-groups/:subscriberGroupId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "subscriberGroupId", Field: "SubscriberGroupID", Type: "TrustCenterSubscriberGroupID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Get a subscriber group by ID.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.GetTrustCenterSubscriberGroup),
+	},
+	{
+		ID:         "TrustCenters.GetTrustCenterUpdate",
+		Service:    "TrustCenters",
+		Method:     "GetTrustCenterUpdate",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/updates/:updateId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "updateId", Field: "UpdateID", Type: "TrustCenterUpdateID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets a specific update on a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.GetTrustCenterUpdate),
+	},
+	{
+		ID:         "TrustCenters.GetTrustCenterViewer",
+		Service:    "TrustCenters",
+		Method:     "GetTrustCenterViewer",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/viewers/:viewerId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "viewerId", Field: "ViewerID", Type: "TrustCenterViewerID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets a specific viewer for a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.GetTrustCenterViewer),
+	},
+	{
+		ID:         "TrustCenters.GetUploadedMediaForTrustCenterDocument",
+		Service:    "TrustCenters",
+		Method:     "GetUploadedMediaForTrustCenterDocument",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/resources/:resourceId/media",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "resourceId", Field: "ResourceID", Type: "string", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets the actual given uploaded document for a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.GetUploadedMediaForTrustCenterDocument),
+	},
+	{
+		ID:         "TrustCenters.ListHistoricalTrustCenterAccessRequests",
+		Service:    "TrustCenters",
+		Method:     "ListHistoricalTrustCenterAccessRequests",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/historical-access-requests",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Gets a list of historical (approved or denied) access requests for a Trust Center.",
+		fn:        bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.ListHistoricalTrustCenterAccessRequests),
+	},
+	{
+		ID:         "TrustCenters.ListTrustCenterAccessRequests",
+		Service:    "TrustCenters",
+		Method:     "ListTrustCenterAccessRequests",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/access-requests",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Gets a list of access requests for a Trust Center.",
+		fn:        bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.ListTrustCenterAccessRequests),
+	},
+	{
+		ID:         "TrustCenters.ListTrustCenterControlCategories",
+		Service:    "TrustCenters",
+		Method:     "ListTrustCenterControlCategories",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/control-categories",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets a list of control categories on a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.ListTrustCenterControlCategories),
+	},
+	{
+		ID:         "TrustCenters.ListTrustCenterControls",
+		Service:    "TrustCenters",
+		Method:     "ListTrustCenterControls",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/controls",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Gets a list of controls on a Trust Center.",
+		fn:        bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.ListTrustCenterControls),
+	},
+	{
+		ID:         "TrustCenters.ListTrustCenterFaqs",
+		Service:    "TrustCenters",
+		Method:     "ListTrustCenterFaqs",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/faqs",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets a list of FAQs on a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.ListTrustCenterFaqs),
+	},
+	{
+		ID:         "TrustCenters.ListTrustCenterResources",
+		Service:    "TrustCenters",
+		Method:     "ListTrustCenterResources",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/resources",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets a list of resources on a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.ListTrustCenterResources),
+	},
+	{
+		ID:         "TrustCenters.ListTrustCenterSubprocessors",
+		Service:    "TrustCenters",
+		Method:     "ListTrustCenterSubprocessors",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/subprocessors",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets the list of subprocessors on a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.ListTrustCenterSubprocessors),
+	},
+	{
+		ID:         "TrustCenters.ListTrustCenterSubscriberGroups",
+		Service:    "TrustCenters",
+		Method:     "ListTrustCenterSubscriberGroups",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/subscriber-groups",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Gets a list of subscriber groups on a Trust Center.",
+		fn:        bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.ListTrustCenterSubscriberGroups),
+	},
+	{
+		ID:         "TrustCenters.ListTrustCenterSubscribers",
+		Service:    "TrustCenters",
+		Method:     "ListTrustCenterSubscribers",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/subscribers",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Gets a list of subscribers on a Trust Center.",
+		fn:        bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.ListTrustCenterSubscribers),
+	},
+	{
+		ID:         "TrustCenters.ListTrustCenterUpdates",
+		Service:    "TrustCenters",
+		Method:     "ListTrustCenterUpdates",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/updates",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Gets a list of updates on a Trust Center.",
+		fn:        bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.ListTrustCenterUpdates),
+	},
+	{
+		ID:         "TrustCenters.ListTrustCenterViewerActivityEvents",
+		Service:    "TrustCenters",
+		Method:     "ListTrustCenterViewerActivityEvents",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/activity",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+			{Name: "eventTypesMatchesAny", Field: "EventTypesMatchesAny", Type: "[]string"},
+			{Name: "afterDate", Field: "AfterDate", Type: "*string"},
+			{Name: "beforeDate", Field: "BeforeDate", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Gets a list of viewer activity events on a Trust Center.",
+		fn:        bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.ListTrustCenterViewerActivityEvents),
+	},
+	{
+		ID:         "TrustCenters.ListTrustCenterViewers",
+		Service:    "TrustCenters",
+		Method:     "ListTrustCenterViewers",
+		HTTPMethod: "GET",
+		Path:       "/trust-centers/:slugId/viewers",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+			{Name: "includeRemoved", Field: "IncludeRemoved", Type: "*bool"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Gets a list of viewers that have been granted access to a Trust Center.",
+		fn:        bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.ListTrustCenterViewers),
+	},
+	{
+		ID:         "TrustCenters.RemoveTrustCenterViewer",
+		Service:    "TrustCenters",
+		Method:     "RemoveTrustCenterViewer",
+		HTTPMethod: "DELETE",
+		Path:       "/trust-centers/:slugId/viewers/:viewerId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "viewerId", Field: "ViewerID", Type: "TrustCenterViewerID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Revokes a viewer's access to a Trust Center.",
+		fn:     bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.RemoveTrustCenterViewer),
+	},
+	{
+		ID:         "TrustCenters.SendTrustCenterUpdateNotificationsToAllSubscribers",
+		Service:    "TrustCenters",
+		Method:     "SendTrustCenterUpdateNotificationsToAllSubscribers",
+		HTTPMethod: "POST",
+		Path:       "/trust-centers/:slugId/updates/:updateId/notify-all-subscribers",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "updateId", Field: "UpdateID", Type: "TrustCenterUpdateID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Sends notifications for a specific Trust Center update to all subscribers.",
+		fn:     bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.SendTrustCenterUpdateNotificationsToAllSubscribers),
+	},
+	{
+		ID:         "TrustCenters.SendTrustCenterUpdateNotificationsToSpecificSubscribers",
+		Service:    "TrustCenters",
+		Method:     "SendTrustCenterUpdateNotificationsToSpecificSubscribers",
+		HTTPMethod: "POST",
+		Path:       "/trust-centers/:slugId/updates/:updateId/notify-specific-subscribers",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "updateId", Field: "UpdateID", Type: "TrustCenterUpdateID", Required: true},
+		},
+		BodyType: "*TrustCentersSendTrustCenterUpdateNotificationsToSpecificSubscribersRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Sends notifications for a specific Trust Center update to specific subscribers. At least one subscriber group or email address is required.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.SendTrustCenterUpdateNotificationsToSpecificSubscribers),
+	},
+	{
+		ID:         "TrustCenters.SetGroupsForTrustCenterSubscriber",
+		Service:    "TrustCenters",
+		Method:     "SetGroupsForTrustCenterSubscriber",
+		HTTPMethod: "PUT",
+		Path:       "/trust-centers/:slugId/subscribers/:subscriberId/groups",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "subscriberId", Field: "SubscriberID", Type: "TrustCenterSubscriberID", Required: true},
+		},
+		BodyType: "*TrustCentersSetGroupsForTrustCenterSubscriberRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Sets groups on a subscriber.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.SetGroupsForTrustCenterSubscriber),
+	},
+	{
+		ID:         "TrustCenters.UpdateTrustCenter",
+		Service:    "TrustCenters",
+		Method:     "UpdateTrustCenter",
+		HTTPMethod: "PATCH",
+		Path:       "/trust-centers/:slugId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+		},
+		BodyType: "*TrustCentersUpdateTrustCenterRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Updates a Trust Center by slug ID.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.UpdateTrustCenter),
+	},
+	{
+		ID:         "TrustCenters.UpdateTrustCenterControlCategory",
+		Service:    "TrustCenters",
+		Method:     "UpdateTrustCenterControlCategory",
+		HTTPMethod: "PATCH",
+		Path:       "/trust-centers/:slugId/control-categories/:categoryId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "categoryId", Field: "CategoryID", Type: "TrustCenterControlCategoryID", Required: true},
+		},
+		BodyType: "*TrustCentersUpdateTrustCenterControlCategoryRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Updates a control category on a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.UpdateTrustCenterControlCategory),
+	},
+	{
+		ID:         "TrustCenters.UpdateTrustCenterDocument",
+		Service:    "TrustCenters",
+		Method:     "UpdateTrustCenterDocument",
+		HTTPMethod: "PATCH",
+		Path:       "/trust-centers/:slugId/resources/:resourceId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "resourceId", Field: "ResourceID", Type: "string", Required: true},
+		},
+		BodyType: "*TrustCentersUpdateTrustCenterDocumentRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Updates a specific document on a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.UpdateTrustCenterDocument),
+	},
+	{
+		ID:         "TrustCenters.UpdateTrustCenterFaq",
+		Service:    "TrustCenters",
+		Method:     "UpdateTrustCenterFaq",
+		HTTPMethod: "PATCH",
+		Path:       "/trust-centers/:slugId/faqs/:faqId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "faqId", Field: "FaqID", Type: "TrustCenterFAQID", Required: true},
+		},
+		BodyType: "*TrustCentersUpdateTrustCenterFaqRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Update a specific FAQ on the Trust Center by ID.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.UpdateTrustCenterFaq),
+	},
+	{
+		ID:         "TrustCenters.UpdateTrustCenterSubprocessor",
+		Service:    "TrustCenters",
+		Method:     "UpdateTrustCenterSubprocessor",
+		HTTPMethod: "PATCH",
+		Path:       "/trust-centers/:slugId/subprocessors/:subprocessorId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "subprocessorId", Field: "SubprocessorID", Type: "TrustCenterSubprocessorID", Required: true},
+		},
+		BodyType: "*TrustCentersUpdateTrustCenterSubprocessorRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Updates a subprocessor on a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.UpdateTrustCenterSubprocessor),
+	},
+	{
+		ID:         "TrustCenters.UpdateTrustCenterUpdate",
+		Service:    "TrustCenters",
+		Method:     "UpdateTrustCenterUpdate",
+		HTTPMethod: "PATCH",
+		Path:       "/trust-centers/:slugId/updates/:updateId",
+		PathParams: []OperationParam{
+			{Name: "slugId", Field: "SlugID", Type: "TrustCenterSlug", Required: true},
+			{Name: "updateId", Field: "UpdateID", Type: "TrustCenterUpdateID", Required: true},
+		},
+		BodyType: "*TrustCentersUpdateTrustCenterUpdateRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Updates an update on a Trust Center.",
+		fn:       bind(func(s *Services) TrustCentersAPI { return s.TrustCenters }, TrustCentersAPI.UpdateTrustCenterUpdate),
+	},
+	{
+		ID:         "VendorRiskAttributes.ListVendorRiskAttributes",
+		Service:    "VendorRiskAttributes",
+		Method:     "ListVendorRiskAttributes",
+		HTTPMethod: "GET",
+		Path:       "/vendor-risk-attributes",
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Returns a list of vendor risk attributes.",
+		fn:        bind(func(s *Services) VendorRiskAttributesAPI { return s.VendorRiskAttributes }, VendorRiskAttributesAPI.ListVendorRiskAttributes),
+	},
+	{
+		ID:         "Vendors.AddDocumentToSecurityReview",
+		Service:    "Vendors",
+		Method:     "AddDocumentToSecurityReview",
+		HTTPMethod: "POST",
+		Path:       "/vendors/:vendorId/security-reviews/:securityReviewId/documents",
+		PathParams: []OperationParam{
+			{Name: "vendorId", Field: "VendorID", Type: "VendorID", Required: true},
+			{Name: "securityReviewId", Field: "SecurityReviewID", Type: "VendorSecurityReviewID", Required: true},
+		},
+		Multipart: true,
+		Scopes:    []string{ScopeAllWrite},
+		Doc:       "Add document to a security review.",
+		fn:        bind(func(s *Services) VendorsAPI { return s.Vendors }, VendorsAPI.AddDocumentToSecurityReview),
+	},
+	{
+		ID:         "Vendors.AddDocumentToVendor",
+		Service:    "Vendors",
+		Method:     "AddDocumentToVendor",
+		HTTPMethod: "POST",
+		Path:       "/vendors/:vendorId/documents",
+		PathParams: []OperationParam{
+			{Name: "vendorId", Field: "VendorID", Type: "VendorID", Required: true},
+		},
+		Multipart: true,
+		Scopes:    []string{ScopeAllWrite},
+		Doc:       "Add document to a vendor.",
+		fn:        bind(func(s *Services) VendorsAPI { return s.Vendors }, VendorsAPI.AddDocumentToVendor),
+	},
+	{
+		ID:         "Vendors.AddVendorFinding",
+		Service:    "Vendors",
+		Method:     "AddVendorFinding",
+		HTTPMethod: "POST",
+		Path:       "/vendors/:vendorId/findings",
+		PathParams: []OperationParam{
+			{Name: "vendorId", Field: "VendorID", Type: "VendorID", Required: true},
+		},
+		BodyType: "*VendorsAddVendorFindingRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Add vendor finding.",
+		fn:       bind(func(s *Services) VendorsAPI { return s.Vendors }, VendorsAPI.AddVendorFinding),
+	},
+	{
+		ID:         "Vendors.CreateVendor",
+		Service:    "Vendors",
+		Method:     "CreateVendor",
+		HTTPMethod: "POST",
+		Path:       "/vendors",
+		BodyType:   "*VendorsCreateVendorRequestBody",
+		Scopes:     []string{ScopeAllWrite},
+		Doc:        "Add vendor with metadata.",
+		fn:         bind(func(s *Services) VendorsAPI { return s.Vendors }, VendorsAPI.CreateVendor),
+	},
+	{
+		ID:         "Vendors.DeleteFindingByID",
+		Service:    "Vendors",
+		Method:     "DeleteFindingByID",
+		HTTPMethod: "DELETE",
+		Path:       "/vendors/:vendorId/findings/:findingId",
+		PathParams: []OperationParam{
+			{Name: "vendorId", Field: "VendorID", Type: "VendorID", Required: true},
+			{Name: "findingId", Field: "FindingID", Type: "VendorFindingID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Deletes a finding.",
+		fn:     bind(func(s *Services) VendorsAPI { return s.Vendors }, VendorsAPI.DeleteFindingByID),
+	},
+	{
+		ID:         "Vendors.DeleteSecurityReviewDocumentByID",
+		Service:    "Vendors",
+		Method:     "DeleteSecurityReviewDocumentByID",
+		HTTPMethod: "DELETE",
+		Path:       "/vendors/:vendorId/security-reviews/:securityReviewId/documents/:documentId",
+		PathParams: []OperationParam{
+			{Name: "vendorId", Field: "VendorID", Type: "VendorID", Required: true},
+			{Name: "securityReviewId", Field: "SecurityReviewID", Type: "VendorSecurityReviewID", Required: true},
+			{Name: "documentId", Field: "DocumentID", Type: "DocumentID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Delete a security review document.",
+		fn:     bind(func(s *Services) VendorsAPI { return s.Vendors }, VendorsAPI.DeleteSecurityReviewDocumentByID),
+	},
+	{
+		ID:         "Vendors.DeleteVendorByID",
+		Service:    "Vendors",
+		Method:     "DeleteVendorByID",
+		HTTPMethod: "DELETE",
+		Path:       "/vendors/:vendorId",
+		PathParams: []OperationParam{
+			{Name: "vendorId", Field: "VendorID", Type: "VendorID", Required: true},
+		},
+		Scopes: []string{ScopeAllWrite},
+		Doc:    "Deletes a vendor.",
+		fn:     bind(func(s *Services) VendorsAPI { return s.Vendors }, VendorsAPI.DeleteVendorByID),
+	},
+	{
+		ID:         "Vendors.GetSecurityReviewByID",
+		Service:    "Vendors",
+		Method:     "GetSecurityReviewByID",
+		HTTPMethod: "GET",
+		Path:       "/vendors/:vendorId/security-reviews/:securityReviewId",
+		PathParams: []OperationParam{
+			{Name: "vendorId", Field: "VendorID", Type: "VendorID", Required: true},
+			{Name: "securityReviewId", Field: "SecurityReviewID", Type: "VendorSecurityReviewID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Returns a security review.",
+		fn:       bind(func(s *Services) VendorsAPI { return s.Vendors }, VendorsAPI.GetSecurityReviewByID),
+	},
+	{
+		ID:         "Vendors.GetVendorByID",
+		Service:    "Vendors",
+		Method:     "GetVendorByID",
+		HTTPMethod: "GET",
+		Path:       "/vendors/:vendorId",
+		PathParams: []OperationParam{
+			{Name: "vendorId", Field: "VendorID", Type: "VendorID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Get a vendor.",
+		fn:       bind(func(s *Services) VendorsAPI { return s.Vendors }, VendorsAPI.GetVendorByID),
+	},
+	{
+		ID:         "Vendors.ListSecurityReviewDocuments",
+		Service:    "Vendors",
+		Method:     "ListSecurityReviewDocuments",
+		HTTPMethod: "GET",
+		Path:       "/vendors/:vendorId/security-reviews/:securityReviewId/documents",
+		PathParams: []OperationParam{
+			{Name: "vendorId", Field: "VendorID", Type: "VendorID", Required: true},
+			{Name: "securityReviewId", Field: "SecurityReviewID", Type: "VendorSecurityReviewID", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Lists a security review's documents.",
+		fn:        bind(func(s *Services) VendorsAPI { return s.Vendors }, VendorsAPI.ListSecurityReviewDocuments),
+	},
+	{
+		ID:         "Vendors.ListSecurityReviewsByVendorID",
+		Service:    "Vendors",
+		Method:     "ListSecurityReviewsByVendorID",
+		HTTPMethod: "GET",
+		Path:       "/vendors/:vendorId/security-reviews",
+		PathParams: []OperationParam{
+			{Name: "vendorId", Field: "VendorID", Type: "VendorID", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Returns a vendor's security reviews.",
+		fn:        bind(func(s *Services) VendorsAPI { return s.Vendors }, VendorsAPI.ListSecurityReviewsByVendorID),
+	},
+	{
+		ID:         "Vendors.ListVendorDocuments",
+		Service:    "Vendors",
+		Method:     "ListVendorDocuments",
+		HTTPMethod: "GET",
+		Path:       "/vendors/:vendorId/documents",
+		PathParams: []OperationParam{
+			{Name: "vendorId", Field: "VendorID", Type: "VendorID", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Returns a vendor's list of documents.",
+		fn:        bind(func(s *Services) VendorsAPI { return s.Vendors }, VendorsAPI.ListVendorDocuments),
+	},
+	{
+		ID:         "Vendors.ListVendorFindings",
+		Service:    "Vendors",
+		Method:     "ListVendorFindings",
+		HTTPMethod: "GET",
+		Path:       "/vendors/:vendorId/findings",
+		PathParams: []OperationParam{
+			{Name: "vendorId", Field: "VendorID", Type: "VendorID", Required: true},
+		},
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+			{Name: "securityReviewId", Field: "SecurityReviewID", Type: "*VendorSecurityReviewID"},
+			{Name: "documentId", Field: "DocumentID", Type: "*DocumentID"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "Lists a vendor's findings.",
+		fn:        bind(func(s *Services) VendorsAPI { return s.Vendors }, VendorsAPI.ListVendorFindings),
+	},
+	{
+		ID:         "Vendors.ListVendors",
+		Service:    "Vendors",
+		Method:     "ListVendors",
+		HTTPMethod: "GET",
+		Path:       "/vendors",
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+			{Name: "name", Field: "Name", Type: "*string"},
+			{Name: "statusMatchesAny", Field: "StatusMatchesAny", Type: "[]VendorStatus"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "List of vendors.",
+		fn:        bind(func(s *Services) VendorsAPI { return s.Vendors }, VendorsAPI.ListVendors),
+	},
+	{
+		ID:         "Vendors.SetVendorStatus",
+		Service:    "Vendors",
+		Method:     "SetVendorStatus",
+		HTTPMethod: "POST",
+		Path:       "/vendors/:vendorId/set-status",
+		PathParams: []OperationParam{
+			{Name: "vendorId", Field: "VendorID", Type: "VendorID", Required: true},
+		},
+		Multipart: true,
+		Scopes:    []string{ScopeAllWrite},
+		Doc:       "Sets the status of a vendor, which can be MANAGED, ARCHIVED, or IN_PROCUREMENT.",
+		fn:        bind(func(s *Services) VendorsAPI { return s.Vendors }, VendorsAPI.SetVendorStatus),
+	},
+	{
+		ID:         "Vendors.UpdateVendorByID",
+		Service:    "Vendors",
+		Method:     "UpdateVendorByID",
+		HTTPMethod: "PATCH",
+		Path:       "/vendors/:vendorId",
+		PathParams: []OperationParam{
+			{Name: "vendorId", Field: "VendorID", Type: "VendorID", Required: true},
+		},
+		BodyType: "*VendorsUpdateVendorByIDRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Update vendor.",
+		fn:       bind(func(s *Services) VendorsAPI { return s.Vendors }, VendorsAPI.UpdateVendorByID),
+	},
+	{
+		ID:         "Vendors.UpdateVendorFinding",
+		Service:    "Vendors",
+		Method:     "UpdateVendorFinding",
+		HTTPMethod: "PATCH",
+		Path:       "/vendors/:vendorId/findings/:findingId",
+		PathParams: []OperationParam{
+			{Name: "vendorId", Field: "VendorID", Type: "VendorID", Required: true},
+			{Name: "findingId", Field: "FindingID", Type: "VendorFindingID", Required: true},
+		},
+		BodyType: "*VendorsUpdateVendorFindingRequestBody",
+		Scopes:   []string{ScopeAllWrite},
+		Doc:      "Update vendor finding.",
+		fn:       bind(func(s *Services) VendorsAPI { return s.Vendors }, VendorsAPI.UpdateVendorFinding),
+	},
+	{
+		ID:         "Vulnerabilities.DeactivateVulnerabilityMonitoringForVulnerability",
+		Service:    "Vulnerabilities",
+		Method:     "DeactivateVulnerabilityMonitoringForVulnerability",
+		HTTPMethod: "POST",
+		Path:       "/vulnerabilities/deactivate",
+		BodyType:   "*VulnerabilitiesDeactivateVulnerabilityMonitoringForVulnerabilityRequestBody",
+		Scopes:     []string{ScopeAllWrite},
+		Doc:        "Deactivate monitoring for select vulnerabilities. Vanta will not monitor a deactivated vulnerability until it is reactivated.",
+		fn:         bind(func(s *Services) VulnerabilitiesAPI { return s.Vulnerabilities }, VulnerabilitiesAPI.DeactivateVulnerabilityMonitoringForVulnerability),
+	},
+	{
+		ID:         "Vulnerabilities.GetVulnerabilities",
+		Service:    "Vulnerabilities",
+		Method:     "GetVulnerabilities",
+		HTTPMethod: "GET",
+		Path:       "/vulnerabilities",
+		QueryParams: []OperationParam{
+			{Name: "q", Field: "Q", Type: "*string"},
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+			{Name: "isDeactivated", Field: "IsDeactivated", Type: "*bool"},
+			{Name: "externalVulnerabilityId", Field: "ExternalVulnerabilityID", Type: "*string"},
+			{Name: "isFixAvailable", Field: "IsFixAvailable", Type: "*bool"},
+			{Name: "packageIdentifier", Field: "PackageIDentifier", Type: "*string"},
+			{Name: "slaDeadlineAfterDate", Field: "SlaDeadlineAfterDate", Type: "*string"},
+			{Name: "slaDeadlineBeforeDate", Field: "SlaDeadlineBeforeDate", Type: "*string"},
+			{Name: "severity", Field: "Severity", Type: "*VulnerabilitySeverity"},
+			{Name: "integrationId", Field: "IntegrationID", Type: "*IntegrationID"},
+			{Name: "includeVulnerabilitiesWithoutSlas", Field: "IncludeVulnerabilitiesWithoutSlas", Type: "*bool"},
+			{Name: "vulnerableAssetId", Field: "VulnerableAssetID", Type: "*VulnerableAssetID"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "List all vulnerabilities based on selected filters.",
+		fn:        bind(func(s *Services) VulnerabilitiesAPI { return s.Vulnerabilities }, VulnerabilitiesAPI.GetVulnerabilities),
+	},
+	{
+		ID:         "Vulnerabilities.GetVulnerabilityByID",
+		Service:    "Vulnerabilities",
+		Method:     "GetVulnerabilityByID",
+		HTTPMethod: "GET",
+		Path:       "/vulnerabilities/:vulnerabilityId",
+		PathParams: []OperationParam{
+			{Name: "vulnerabilityId", Field: "VulnerabilityID", Type: "VulnerabilityID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets a vulnerability by an ID.",
+		fn:       bind(func(s *Services) VulnerabilitiesAPI { return s.Vulnerabilities }, VulnerabilitiesAPI.GetVulnerabilityByID),
+	},
+	{
+		ID:         "Vulnerabilities.ReactivateVulnerabilityMonitoring",
+		Service:    "Vulnerabilities",
+		Method:     "ReactivateVulnerabilityMonitoring",
+		HTTPMethod: "POST",
+		Path:       "/vulnerabilities/reactivate",
+		BodyType:   "*VulnerabilitiesReactivateVulnerabilityMonitoringRequestBody",
+		Scopes:     []string{ScopeAllWrite},
+		Doc:        "Reactivate vulnerabilities and resume Vanta monitoring.",
+		fn:         bind(func(s *Services) VulnerabilitiesAPI { return s.Vulnerabilities }, VulnerabilitiesAPI.ReactivateVulnerabilityMonitoring),
+	},
+	{
+		ID:         "VulnerabilityRemediations.AcknowledgeSlaMiss",
+		Service:    "VulnerabilityRemediations",
+		Method:     "AcknowledgeSlaMiss",
+		HTTPMethod: "POST",
+		Path:       "/vulnerability-remediations/acknowledge-sla-miss",
+		BodyType:   "*VulnerabilityRemediationsAcknowledgeSlaMissRequestBody",
+		Scopes:     []string{ScopeAllWrite},
+		Doc:        "Acknowledge an SLA miss for a vulnerability remediation.",
+		fn:         bind(func(s *Services) VulnerabilityRemediationsAPI { return s.VulnerabilityRemediations }, VulnerabilityRemediationsAPI.AcknowledgeSlaMiss),
+	},
+	{
+		ID:         "VulnerabilityRemediations.ListVulnerabilityRemediations",
+		Service:    "VulnerabilityRemediations",
+		Method:     "ListVulnerabilityRemediations",
+		HTTPMethod: "GET",
+		Path:       "/vulnerability-remediations",
+		QueryParams: []OperationParam{
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+			{Name: "integrationId", Field: "IntegrationID", Type: "*IntegrationID"},
+			{Name: "severity", Field: "Severity", Type: "*VulnerabilitySeverity"},
+			{Name: "isRemediatedOnTime", Field: "IsRemediatedOnTime", Type: "*bool"},
+			{Name: "remediatedAfterDate", Field: "RemediatedAfterDate", Type: "*string"},
+			{Name: "remediatedBeforeDate", Field: "RemediatedBeforeDate", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "List all vulnerability remediations based on selected filters.",
+		fn:        bind(func(s *Services) VulnerabilityRemediationsAPI { return s.VulnerabilityRemediations }, VulnerabilityRemediationsAPI.ListVulnerabilityRemediations),
+	},
+	{
+		ID:         "VulnerableAssets.GetVulnerableAssetByID",
+		Service:    "VulnerableAssets",
+		Method:     "GetVulnerableAssetByID",
+		HTTPMethod: "GET",
+		Path:       "/vulnerable-assets/:vulnerableAssetId",
+		PathParams: []OperationParam{
+			{Name: "vulnerableAssetId", Field: "VulnerableAssetID", Type: "VulnerableAssetID", Required: true},
+		},
+		ReadOnly: true,
+		Scopes:   []string{ScopeAllRead},
+		Doc:      "Gets a vulnerable asset by ID.",
+		fn:       bind(func(s *Services) VulnerableAssetsAPI { return s.VulnerableAssets }, VulnerableAssetsAPI.GetVulnerableAssetByID),
+	},
+	{
+		ID:         "VulnerableAssets.ListAssetsAssociatedWithVulnerabilities",
+		Service:    "VulnerableAssets",
+		Method:     "ListAssetsAssociatedWithVulnerabilities",
+		HTTPMethod: "GET",
+		Path:       "/vulnerable-assets",
+		QueryParams: []OperationParam{
+			{Name: "q", Field: "Q", Type: "*string"},
+			{Name: "pageSize", Field: "PageSize", Type: "*int"},
+			{Name: "pageCursor", Field: "PageCursor", Type: "*string"},
+			{Name: "integrationId", Field: "IntegrationID", Type: "*IntegrationID"},
+			{Name: "assetType", Field: "AssetType", Type: "*VulnerableAssetType"},
+			{Name: "assetExternalAccountId", Field: "AssetExternalAccountID", Type: "*string"},
+		},
+		Paginated: true,
+		ReadOnly:  true,
+		Scopes:    []string{ScopeAllRead},
+		Doc:       "List assets that Vanta monitors that are associated with vulnerabilities.",
+		fn:        bind(func(s *Services) VulnerableAssetsAPI { return s.VulnerableAssets }, VulnerableAssetsAPI.ListAssetsAssociatedWithVulnerabilities),
+	},
+}
@@ -1,3 +1,5 @@
+// Code generated by vanta-gen. DO NOT EDIT.
+
 // Vanta service endpoint bindings.
 
 package v1
@@ -12,25 +14,25 @@ import (
 
 // Services is the generated service registry for Vanta APIs.
 type Services struct {
-	Controls                  *ControlsService
-	DiscoveredVendors         *DiscoveredVendorsService
-	Documents                 *DocumentsService
-	Frameworks                *FrameworksService
-	Groups                    *GroupsService
-	Integrations              *IntegrationsService
-	MonitoredComputers        *MonitoredComputersService
-	OAuth                     *OAuthService
-	People                    *PeopleService
-	Policies                  *PoliciesService
-	Resources                 *ResourcesService
-	RiskScenarios             *RiskScenariosService
-	Tests                     *TestsService
-	TrustCenters              *TrustCentersService
-	VendorRiskAttributes      *VendorRiskAttributesService
-	Vendors                   *VendorsService
-	Vulnerabilities           *VulnerabilitiesService
-	VulnerabilityRemediations *VulnerabilityRemediationsService
-	VulnerableAssets          *VulnerableAssetsService
+	Controls                  ControlsAPI
+	DiscoveredVendors         DiscoveredVendorsAPI
+	Documents                 DocumentsAPI
+	Frameworks                FrameworksAPI
+	Groups                    GroupsAPI
+	Integrations              IntegrationsAPI
+	MonitoredComputers        MonitoredComputersAPI
+	OAuth                     OAuthAPI
+	People                    PeopleAPI
+	Policies                  PoliciesAPI
+	Resources                 ResourcesAPI
+	RiskScenarios             RiskScenariosAPI
+	Tests                     TestsAPI
+	TrustCenters              TrustCentersAPI
+	VendorRiskAttributes      VendorRiskAttributesAPI
+	Vendors                   VendorsAPI
+	Vulnerabilities           VulnerabilitiesAPI
+	VulnerabilityRemediations VulnerabilityRemediationsAPI
+	VulnerableAssets          VulnerableAssetsAPI
 }
 
 func newGeneratedServices(c *Client) *Services {
@@ -63,21 +65,7 @@ type ControlsService struct {
 }
 
 type ControlsAddControlFromVantaLibraryRequestBody struct {
-	ControlID string `json:"controlId"`
-}
-
-type ControlsAddControlFromVantaLibraryResponse struct {
-	CreationDate     any              `json:"creationDate"`
-	CustomFields     []map[string]any `json:"customFields"`
-	Description      string           `json:"description"`
-	Domains          []string         `json:"domains"`
-	ExternalID       string           `json:"externalId"`
-	ID               string           `json:"id"`
-	ModificationDate any              `json:"modificationDate"`
-	Name             string           `json:"name"`
-	Owner            map[string]any   `json:"owner"`
-	Role             string           `json:"role"`
-	Source           string           `json:"source"`
+	ControlID ControlID `json:"controlId"`
 }
 
 type ControlsAddControlFromVantaLibraryParams struct {
@@ -85,17 +73,20 @@ type ControlsAddControlFromVantaLibraryParams struct {
 }
 
 // AddControlFromVantaLibrary Add a control from the Vanta library to your organization's controls.
-func (s *ControlsService) AddControlFromVantaLibrary(ctx context.Context, params *ControlsAddControlFromVantaLibraryParams) (*ControlsAddControlFromVantaLibraryResponse, error) {
+func (s *ControlsService) AddControlFromVantaLibrary(ctx context.Context, params *ControlsAddControlFromVantaLibraryParams) (*Control, error) {
 	if params == nil {
 		params = &ControlsAddControlFromVantaLibraryParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/controls/add-from-library"
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &ControlsAddControlFromVantaLibraryResponse{}
+	out := &Control{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -103,7 +94,7 @@ func (s *ControlsService) AddControlFromVantaLibrary(ctx context.Context, params
 }
 
 type ControlsAddControlToDocumentMappingRequestBody struct {
-	DocumentID string `json:"documentId"`
+	DocumentID DocumentID `json:"documentId"`
 }
 
 type ControlsAddControlToDocumentMappingResponse struct {
@@ -112,7 +103,7 @@ type ControlsAddControlToDocumentMappingResponse struct {
 }
 
 type ControlsAddControlToDocumentMappingParams struct {
-	ControlID string
+	ControlID ControlID
 	Body      *ControlsAddControlToDocumentMappingRequestBody
 }
 
@@ -121,11 +112,11 @@ func (s *ControlsService) AddControlToDocumentMapping(ctx context.Context, param
 	if params == nil {
 		params = &ControlsAddControlToDocumentMappingParams{}
 	}
-	path := "/controls/:controlId/add-document-to-control"
-	if params.ControlID == "" {
-		return nil, fmt.Errorf("controlId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(params.ControlID))
+	path := "/controls/:controlId/add-document-to-control"
+	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(string(params.ControlID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
@@ -139,7 +130,7 @@ func (s *ControlsService) AddControlToDocumentMapping(ctx context.Context, param
 }
 
 type ControlsAddControlToTestMappingRequestBody struct {
-	TestID string `json:"testId"`
+	TestID TestID `json:"testId"`
 }
 
 type ControlsAddControlToTestMappingResponse struct {
@@ -148,7 +139,7 @@ type ControlsAddControlToTestMappingResponse struct {
 }
 
 type ControlsAddControlToTestMappingParams struct {
-	ControlID string
+	ControlID ControlID
 	Body      *ControlsAddControlToTestMappingRequestBody
 }
 
@@ -157,11 +148,11 @@ func (s *ControlsService) AddControlToTestMapping(ctx context.Context, params *C
 	if params == nil {
 		params = &ControlsAddControlToTestMappingParams{}
 	}
-	path := "/controls/:controlId/add-test-to-control"
-	if params.ControlID == "" {
-		return nil, fmt.Errorf("controlId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(params.ControlID))
+	path := "/controls/:controlId/add-test-to-control"
+	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(string(params.ControlID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
@@ -185,92 +176,57 @@ type ControlsCreateCustomControlRequestBody struct {
 	Sections      []map[string]any `json:"sections"`
 }
 
-type ControlsCreateCustomControlResponse struct {
-	CreationDate     any              `json:"creationDate"`
-	CustomFields     []map[string]any `json:"customFields"`
-	Description      string           `json:"description"`
-	Domains          []string         `json:"domains"`
-	ExternalID       string           `json:"externalId"`
-	ID               string           `json:"id"`
-	ModificationDate any              `json:"modificationDate"`
-	Name             string           `json:"name"`
-	Owner            map[string]any   `json:"owner"`
-	Role             string           `json:"role"`
-	Source           string           `json:"source"`
-}
-
 type ControlsCreateCustomControlParams struct {
 	Body *ControlsCreateCustomControlRequestBody
 }
 
 // CreateCustomControl Create a custom control.
-func (s *ControlsService) CreateCustomControl(ctx context.Context, params *ControlsCreateCustomControlParams) (*ControlsCreateCustomControlResponse, error) {
+func (s *ControlsService) CreateCustomControl(ctx context.Context, params *ControlsCreateCustomControlParams) (*Control, error) {
 	if params == nil {
 		params = &ControlsCreateCustomControlParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/controls"
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &ControlsCreateCustomControlResponse{}
+	out := &Control{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type ControlsGetControlByIDResponse struct {
-	CreationDate        any              `json:"creationDate"`
-	CustomFields        []map[string]any `json:"customFields"`
-	Description         string           `json:"description"`
-	Domains             []string         `json:"domains"`
-	ExternalID          string           `json:"externalId"`
-	ID                  string           `json:"id"`
-	ModificationDate    any              `json:"modificationDate"`
-	Name                string           `json:"name"`
-	Note                string           `json:"note"`
-	NumDocumentsPassing float64          `json:"numDocumentsPassing"`
-	NumDocumentsTotal   float64          `json:"numDocumentsTotal"`
-	NumTestsPassing     float64          `json:"numTestsPassing"`
-	NumTestsTotal       float64          `json:"numTestsTotal"`
-	Owner               map[string]any   `json:"owner"`
-	Role                string           `json:"role"`
-	Source              string           `json:"source"`
-	Status              string           `json:"status"`
-}
-
 type ControlsGetControlByIDParams struct {
-	ControlID string
+	ControlID ControlID
 }
 
 // GetControlByID Get a control by an ID.
-func (s *ControlsService) GetControlByID(ctx context.Context, params *ControlsGetControlByIDParams) (*ControlsGetControlByIDResponse, error) {
+func (s *ControlsService) GetControlByID(ctx context.Context, params *ControlsGetControlByIDParams) (*Control, error) {
 	if params == nil {
 		params = &ControlsGetControlByIDParams{}
 	}
-	path := "/controls/:controlId"
-	if params.ControlID == "" {
-		return nil, fmt.Errorf("controlId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(params.ControlID))
+	path := "/controls/:controlId"
+	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(string(params.ControlID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &ControlsGetControlByIDResponse{}
+	out := &Control{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type ControlsListControlsResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type ControlsListControlsParams struct {
 	PageSize            *int
 	PageCursor          *string
@@ -278,10 +234,13 @@ type ControlsListControlsParams struct {
 }
 
 // ListControls List controls.
-func (s *ControlsService) ListControls(ctx context.Context, params *ControlsListControlsParams) (*ControlsListControlsResponse, error) {
+func (s *ControlsService) ListControls(ctx context.Context, params *ControlsListControlsParams) (*ResultsPage[Control], error) {
 	if params == nil {
 		params = &ControlsListControlsParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/controls"
 	query := url.Values{}
 	if params.PageSize != nil {
@@ -297,33 +256,29 @@ func (s *ControlsService) ListControls(ctx context.Context, params *ControlsList
 	if err != nil {
 		return nil, err
 	}
-	out := &ControlsListControlsResponse{}
+	out := &ResultsPage[Control]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type ControlsListControlsDocumentsResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type ControlsListControlsDocumentsParams struct {
-	ControlID  string
+	ControlID  ControlID
 	PageSize   *int
 	PageCursor *string
 }
 
 // ListControlsDocuments List a control's documents.
-func (s *ControlsService) ListControlsDocuments(ctx context.Context, params *ControlsListControlsDocumentsParams) (*ControlsListControlsDocumentsResponse, error) {
+func (s *ControlsService) ListControlsDocuments(ctx context.Context, params *ControlsListControlsDocumentsParams) (*ResultsPage[Document], error) {
 	if params == nil {
 		params = &ControlsListControlsDocumentsParams{}
 	}
-	path := "/controls/:controlId/documents"
-	if params.ControlID == "" {
-		return nil, fmt.Errorf("controlId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(params.ControlID))
+	path := "/controls/:controlId/documents"
+	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(string(params.ControlID)))
 	query := url.Values{}
 	if params.PageSize != nil {
 		query.Set("pageSize", fmt.Sprint(*params.PageSize))
@@ -335,33 +290,29 @@ func (s *ControlsService) ListControlsDocuments(ctx context.Context, params *Con
 	if err != nil {
 		return nil, err
 	}
-	out := &ControlsListControlsDocumentsResponse{}
+	out := &ResultsPage[Document]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type ControlsListControlsTestsResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type ControlsListControlsTestsParams struct {
-	ControlID  string
+	ControlID  ControlID
 	PageSize   *int
 	PageCursor *string
 }
 
 // ListControlsTests List a control's tests.
-func (s *ControlsService) ListControlsTests(ctx context.Context, params *ControlsListControlsTestsParams) (*ControlsListControlsTestsResponse, error) {
+func (s *ControlsService) ListControlsTests(ctx context.Context, params *ControlsListControlsTestsParams) (*ResultsPage[Test], error) {
 	if params == nil {
 		params = &ControlsListControlsTestsParams{}
 	}
-	path := "/controls/:controlId/tests"
-	if params.ControlID == "" {
-		return nil, fmt.Errorf("controlId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(params.ControlID))
+	path := "/controls/:controlId/tests"
+	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(string(params.ControlID)))
 	query := url.Values{}
 	if params.PageSize != nil {
 		query.Set("pageSize", fmt.Sprint(*params.PageSize))
@@ -373,27 +324,26 @@ func (s *ControlsService) ListControlsTests(ctx context.Context, params *Control
 	if err != nil {
 		return nil, err
 	}
-	out := &ControlsListControlsTestsResponse{}
+	out := &ResultsPage[Test]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type ControlsListVantaControlsFromLibraryResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type ControlsListVantaControlsFromLibraryParams struct {
 	PageSize   *int
 	PageCursor *string
 }
 
 // ListVantaControlsFromLibrary List Vanta controls from the library.
-func (s *ControlsService) ListVantaControlsFromLibrary(ctx context.Context, params *ControlsListVantaControlsFromLibraryParams) (*ControlsListVantaControlsFromLibraryResponse, error) {
+func (s *ControlsService) ListVantaControlsFromLibrary(ctx context.Context, params *ControlsListVantaControlsFromLibraryParams) (*ResultsPage[Control], error) {
 	if params == nil {
 		params = &ControlsListVantaControlsFromLibraryParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/controls/controls-library"
 	query := url.Values{}
 	if params.PageSize != nil {
@@ -406,7 +356,7 @@ func (s *ControlsService) ListVantaControlsFromLibrary(ctx context.Context, para
 	if err != nil {
 		return nil, err
 	}
-	out := &ControlsListVantaControlsFromLibraryResponse{}
+	out := &ResultsPage[Control]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -414,7 +364,7 @@ func (s *ControlsService) ListVantaControlsFromLibrary(ctx context.Context, para
 }
 
 type ControlsRemoveControlParams struct {
-	ControlID string
+	ControlID ControlID
 }
 
 // RemoveControl Delete a custom control or move a Vanta control back to the library.
@@ -422,11 +372,11 @@ func (s *ControlsService) RemoveControl(ctx context.Context, params *ControlsRem
 	if params == nil {
 		params = &ControlsRemoveControlParams{}
 	}
-	path := "/controls/:controlId"
-	if params.ControlID == "" {
-		return nil, fmt.Errorf("controlId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(params.ControlID))
+	path := "/controls/:controlId"
+	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(string(params.ControlID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "DELETE", path, query, nil)
 	if err != nil {
@@ -440,8 +390,8 @@ func (s *ControlsService) RemoveControl(ctx context.Context, params *ControlsRem
 }
 
 type ControlsRemoveControlFromDocumentMappingParams struct {
-	ControlID  string
-	DocumentID string
+	ControlID  ControlID
+	DocumentID DocumentID
 }
 
 // RemoveControlFromDocumentMapping Remove a document by ID from a control.
@@ -449,15 +399,12 @@ func (s *ControlsService) RemoveControlFromDocumentMapping(ctx context.Context,
 	if params == nil {
 		params = &ControlsRemoveControlFromDocumentMappingParams{}
 	}
-	path := "/controls/:controlId/documents/:documentId"
-	if params.ControlID == "" {
-		return nil, fmt.Errorf("controlId is required")
-	}
-	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(params.ControlID))
-	if params.DocumentID == "" {
-		return nil, fmt.Errorf("documentId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(params.DocumentID))
+	path := "/controls/:controlId/documents/:documentId"
+	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(string(params.ControlID)))
+	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(string(params.DocumentID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "DELETE", path, query, nil)
 	if err != nil {
@@ -471,8 +418,8 @@ func (s *ControlsService) RemoveControlFromDocumentMapping(ctx context.Context,
 }
 
 type ControlsRemoveControlFromTestMappingParams struct {
-	ControlID string
-	TestID    string
+	ControlID ControlID
+	TestID    TestID
 }
 
 // RemoveControlFromTestMapping Remove a control from test mapping.
@@ -480,15 +427,12 @@ func (s *ControlsService) RemoveControlFromTestMapping(ctx context.Context, para
 	if params == nil {
 		params = &ControlsRemoveControlFromTestMappingParams{}
 	}
-	path := "/controls/:controlId/tests/:testId"
-	if params.ControlID == "" {
-		return nil, fmt.Errorf("controlId is required")
-	}
-	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(params.ControlID))
-	if params.TestID == "" {
-		return nil, fmt.Errorf("testId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":testId", url.PathEscape(params.TestID))
+	path := "/controls/:controlId/tests/:testId"
+	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(string(params.ControlID)))
+	path = strings.ReplaceAll(path, ":testId", url.PathEscape(string(params.TestID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "DELETE", path, query, nil)
 	if err != nil {
@@ -505,41 +449,27 @@ type ControlsSetOwnerOfControlRequestBody struct {
 	UserID string `json:"userId"`
 }
 
-type ControlsSetOwnerOfControlResponse struct {
-	CreationDate     any              `json:"creationDate"`
-	CustomFields     []map[string]any `json:"customFields"`
-	Description      string           `json:"description"`
-	Domains          []string         `json:"domains"`
-	ExternalID       string           `json:"externalId"`
-	ID               string           `json:"id"`
-	ModificationDate any              `json:"modificationDate"`
-	Name             string           `json:"name"`
-	Owner            map[string]any   `json:"owner"`
-	Role             string           `json:"role"`
-	Source           string           `json:"source"`
-}
-
 type ControlsSetOwnerOfControlParams struct {
-	ControlID string
+	ControlID ControlID
 	Body      *ControlsSetOwnerOfControlRequestBody
 }
 
 // SetOwnerOfControl Assign a control to a user or remove an owner from a control.
-func (s *ControlsService) SetOwnerOfControl(ctx context.Context, params *ControlsSetOwnerOfControlParams) (*ControlsSetOwnerOfControlResponse, error) {
+func (s *ControlsService) SetOwnerOfControl(ctx context.Context, params *ControlsSetOwnerOfControlParams) (*Control, error) {
 	if params == nil {
 		params = &ControlsSetOwnerOfControlParams{}
 	}
-	path := "/controls/:controlId/set-owner"
-	if params.ControlID == "" {
-		return nil, fmt.Errorf("controlId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(params.ControlID))
+	path := "/controls/:controlId/set-owner"
+	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(string(params.ControlID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &ControlsSetOwnerOfControlResponse{}
+	out := &Control{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -555,41 +485,27 @@ type ControlsUpdateControlsMetadataRequestBody struct {
 	Note         string           `json:"note"`
 }
 
-type ControlsUpdateControlsMetadataResponse struct {
-	CreationDate     any              `json:"creationDate"`
-	CustomFields     []map[string]any `json:"customFields"`
-	Description      string           `json:"description"`
-	Domains          []string         `json:"domains"`
-	ExternalID       string           `json:"externalId"`
-	ID               string           `json:"id"`
-	ModificationDate any              `json:"modificationDate"`
-	Name             string           `json:"name"`
-	Owner            map[string]any   `json:"owner"`
-	Role             string           `json:"role"`
-	Source           string           `json:"source"`
-}
-
 type ControlsUpdateControlsMetadataParams struct {
-	ControlID string
+	ControlID ControlID
 	Body      *ControlsUpdateControlsMetadataRequestBody
 }
 
 // UpdateControlsMetadata Update a control's metadata.
-func (s *ControlsService) UpdateControlsMetadata(ctx context.Context, params *ControlsUpdateControlsMetadataParams) (*ControlsUpdateControlsMetadataResponse, error) {
+func (s *ControlsService) UpdateControlsMetadata(ctx context.Context, params *ControlsUpdateControlsMetadataParams) (*Control, error) {
 	if params == nil {
 		params = &ControlsUpdateControlsMetadataParams{}
 	}
-	path := "/controls/:controlId"
-	if params.ControlID == "" {
-		return nil, fmt.Errorf("controlId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(params.ControlID))
+	path := "/controls/:controlId"
+	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(string(params.ControlID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "PATCH", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &ControlsUpdateControlsMetadataResponse{}
+	out := &Control{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -601,66 +517,32 @@ type DiscoveredVendorsService struct {
 	client *Client
 }
 
-type DiscoveredVendorsAddsDiscoveredVendorToManagedVendorByIDResponse struct {
-	AccountManagerEmail              string         `json:"accountManagerEmail"`
-	AccountManagerName               string         `json:"accountManagerName"`
-	AdditionalNotes                  string         `json:"additionalNotes"`
-	AuthDetails                      map[string]any `json:"authDetails"`
-	BusinessOwnerUserID              string         `json:"businessOwnerUserId"`
-	Category                         map[string]any `json:"category"`
-	ContractAmount                   map[string]any `json:"contractAmount"`
-	ContractRenewalDate              string         `json:"contractRenewalDate"`
-	ContractStartDate                string         `json:"contractStartDate"`
-	ContractTerminationDate          any            `json:"contractTerminationDate"`
-	CustomFields                     any            `json:"customFields"`
-	ID                               string         `json:"id"`
-	InherentRiskLevel                string         `json:"inherentRiskLevel"`
-	IsRiskAutoScored                 bool           `json:"isRiskAutoScored"`
-	IsVisibleToAuditors              bool           `json:"isVisibleToAuditors"`
-	LastSecurityReviewCompletionDate string         `json:"lastSecurityReviewCompletionDate"`
-	LatestDecision                   map[string]any `json:"latestDecision"`
-	Name                             string         `json:"name"`
-	NextSecurityReviewDueDate        string         `json:"nextSecurityReviewDueDate"`
-	ResidualRiskLevel                string         `json:"residualRiskLevel"`
-	RiskAttributeIDs                 []string       `json:"riskAttributeIds"`
-	SecurityOwnerUserID              string         `json:"securityOwnerUserId"`
-	ServicesProvided                 string         `json:"servicesProvided"`
-	Status                           string         `json:"status"`
-	TagIDentifiers                   any            `json:"tagIdentifiers"`
-	VendorHeadquarters               string         `json:"vendorHeadquarters"`
-	WebsiteURL                       string         `json:"websiteUrl"`
-}
-
 type DiscoveredVendorsAddsDiscoveredVendorToManagedVendorByIDParams struct {
-	DiscoveredVendorID string
+	DiscoveredVendorID DiscoveredVendorID
 }
 
 // AddsDiscoveredVendorToManagedVendorByID Add a discovered vendor to managed vendor.
-func (s *DiscoveredVendorsService) AddsDiscoveredVendorToManagedVendorByID(ctx context.Context, params *DiscoveredVendorsAddsDiscoveredVendorToManagedVendorByIDParams) (*DiscoveredVendorsAddsDiscoveredVendorToManagedVendorByIDResponse, error) {
+func (s *DiscoveredVendorsService) AddsDiscoveredVendorToManagedVendorByID(ctx context.Context, params *DiscoveredVendorsAddsDiscoveredVendorToManagedVendorByIDParams) (*Vendor, error) {
 	if params == nil {
 		params = &DiscoveredVendorsAddsDiscoveredVendorToManagedVendorByIDParams{}
 	}
-	path := "/discovered-vendors/:discoveredVendorId/add-to-managed"
-	if params.DiscoveredVendorID == "" {
-		return nil, fmt.Errorf("discoveredVendorId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":discoveredVendorId", url.PathEscape(params.DiscoveredVendorID))
+	path := "/discovered-vendors/:discoveredVendorId/add-to-managed"
+	path = strings.ReplaceAll(path, ":discoveredVendorId", url.PathEscape(string(params.DiscoveredVendorID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &DiscoveredVendorsAddsDiscoveredVendorToManagedVendorByIDResponse{}
+	out := &Vendor{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type DiscoveredVendorsListDiscoveredVendorsResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type DiscoveredVendorsListDiscoveredVendorsParams struct {
 	Scope      *string
 	PageSize   *int
@@ -668,10 +550,13 @@ type DiscoveredVendorsListDiscoveredVendorsParams struct {
 }
 
 // ListDiscoveredVendors List discovered vendors.
-func (s *DiscoveredVendorsService) ListDiscoveredVendors(ctx context.Context, params *DiscoveredVendorsListDiscoveredVendorsParams) (*DiscoveredVendorsListDiscoveredVendorsResponse, error) {
+func (s *DiscoveredVendorsService) ListDiscoveredVendors(ctx context.Context, params *DiscoveredVendorsListDiscoveredVendorsParams) (*ResultsPage[DiscoveredVendor], error) {
 	if params == nil {
 		params = &DiscoveredVendorsListDiscoveredVendorsParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/discovered-vendors"
 	query := url.Values{}
 	if params.Scope != nil {
@@ -687,33 +572,29 @@ func (s *DiscoveredVendorsService) ListDiscoveredVendors(ctx context.Context, pa
 	if err != nil {
 		return nil, err
 	}
-	out := &DiscoveredVendorsListDiscoveredVendorsResponse{}
+	out := &ResultsPage[DiscoveredVendor]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type DiscoveredVendorsListOfDiscoveredVendorAccountsResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type DiscoveredVendorsListOfDiscoveredVendorAccountsParams struct {
-	DiscoveredVendorID string
+	DiscoveredVendorID DiscoveredVendorID
 	PageSize           *int
 	PageCursor         *string
 }
 
 // ListOfDiscoveredVendorAccounts List of discovered vendor accounts.
-func (s *DiscoveredVendorsService) ListOfDiscoveredVendorAccounts(ctx context.Context, params *DiscoveredVendorsListOfDiscoveredVendorAccountsParams) (*DiscoveredVendorsListOfDiscoveredVendorAccountsResponse, error) {
+func (s *DiscoveredVendorsService) ListOfDiscoveredVendorAccounts(ctx context.Context, params *DiscoveredVendorsListOfDiscoveredVendorAccountsParams) (*ResultsPage[DiscoveredVendorAccount], error) {
 	if params == nil {
 		params = &DiscoveredVendorsListOfDiscoveredVendorAccountsParams{}
 	}
-	path := "/discovered-vendors/:discoveredVendorId/accounts"
-	if params.DiscoveredVendorID == "" {
-		return nil, fmt.Errorf("discoveredVendorId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":discoveredVendorId", url.PathEscape(params.DiscoveredVendorID))
+	path := "/discovered-vendors/:discoveredVendorId/accounts"
+	path = strings.ReplaceAll(path, ":discoveredVendorId", url.PathEscape(string(params.DiscoveredVendorID)))
 	query := url.Values{}
 	if params.PageSize != nil {
 		query.Set("pageSize", fmt.Sprint(*params.PageSize))
@@ -725,7 +606,7 @@ func (s *DiscoveredVendorsService) ListOfDiscoveredVendorAccounts(ctx context.Co
 	if err != nil {
 		return nil, err
 	}
-	out := &DiscoveredVendorsListOfDiscoveredVendorAccountsResponse{}
+	out := &ResultsPage[DiscoveredVendorAccount]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -746,34 +627,25 @@ type DocumentsCreateCustomDocumentRequestBody struct {
 	Title           string `json:"title"`
 }
 
-type DocumentsCreateCustomDocumentResponse struct {
-	Category         string `json:"category"`
-	Description      string `json:"description"`
-	ID               string `json:"id"`
-	IsSensitive      bool   `json:"isSensitive"`
-	OwnerID          string `json:"ownerId"`
-	Title            string `json:"title"`
-	URL              string `json:"url"`
-	UploadStatus     string `json:"uploadStatus"`
-	UploadStatusDate string `json:"uploadStatusDate"`
-}
-
 type DocumentsCreateCustomDocumentParams struct {
 	Body *DocumentsCreateCustomDocumentRequestBody
 }
 
 // CreateCustomDocument Create a custom document.
-func (s *DocumentsService) CreateCustomDocument(ctx context.Context, params *DocumentsCreateCustomDocumentParams) (*DocumentsCreateCustomDocumentResponse, error) {
+func (s *DocumentsService) CreateCustomDocument(ctx context.Context, params *DocumentsCreateCustomDocumentParams) (*Document, error) {
 	if params == nil {
 		params = &DocumentsCreateCustomDocumentParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/documents"
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &DocumentsCreateCustomDocumentResponse{}
+	out := &Document{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -787,36 +659,27 @@ type DocumentsCreateDocumentLinkRequestBody struct {
 	URL           string `json:"url"`
 }
 
-type DocumentsCreateDocumentLinkResponse struct {
-	CreationDate  string `json:"creationDate"`
-	Description   string `json:"description"`
-	EffectiveDate string `json:"effectiveDate"`
-	ID            string `json:"id"`
-	Title         string `json:"title"`
-	URL           string `json:"url"`
-}
-
 type DocumentsCreateDocumentLinkParams struct {
-	DocumentID string
+	DocumentID DocumentID
 	Body       *DocumentsCreateDocumentLinkRequestBody
 }
 
 // CreateDocumentLink Create a link for a document.
-func (s *DocumentsService) CreateDocumentLink(ctx context.Context, params *DocumentsCreateDocumentLinkParams) (*DocumentsCreateDocumentLinkResponse, error) {
+func (s *DocumentsService) CreateDocumentLink(ctx context.Context, params *DocumentsCreateDocumentLinkParams) (*DocumentLink, error) {
 	if params == nil {
 		params = &DocumentsCreateDocumentLinkParams{}
 	}
-	path := "/documents/:documentId/links"
-	if params.DocumentID == "" {
-		return nil, fmt.Errorf("documentId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(params.DocumentID))
+	path := "/documents/:documentId/links"
+	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(string(params.DocumentID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &DocumentsCreateDocumentLinkResponse{}
+	out := &DocumentLink{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -824,7 +687,7 @@ func (s *DocumentsService) CreateDocumentLink(ctx context.Context, params *Docum
 }
 
 type DocumentsDeleteDocumentByIDParams struct {
-	DocumentID string
+	DocumentID DocumentID
 }
 
 // DeleteDocumentByID Delete a document by ID.
@@ -832,11 +695,11 @@ func (s *DocumentsService) DeleteDocumentByID(ctx context.Context, params *Docum
 	if params == nil {
 		params = &DocumentsDeleteDocumentByIDParams{}
 	}
-	path := "/documents/:documentId"
-	if params.DocumentID == "" {
-		return nil, fmt.Errorf("documentId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(params.DocumentID))
+	path := "/documents/:documentId"
+	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(string(params.DocumentID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "DELETE", path, query, nil)
 	if err != nil {
@@ -850,8 +713,8 @@ func (s *DocumentsService) DeleteDocumentByID(ctx context.Context, params *Docum
 }
 
 type DocumentsDeleteFileForDocumentParams struct {
-	DocumentID     string
-	UploadedFileID string
+	DocumentID     DocumentID
+	UploadedFileID DocumentUploadID
 }
 
 // DeleteFileForDocument Delete a file for a document.
@@ -859,15 +722,12 @@ func (s *DocumentsService) DeleteFileForDocument(ctx context.Context, params *Do
 	if params == nil {
 		params = &DocumentsDeleteFileForDocumentParams{}
 	}
-	path := "/documents/:documentId/uploads/:uploadedFileId"
-	if params.DocumentID == "" {
-		return nil, fmt.Errorf("documentId is required")
-	}
-	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(params.DocumentID))
-	if params.UploadedFileID == "" {
-		return nil, fmt.Errorf("uploadedFileId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":uploadedFileId", url.PathEscape(params.UploadedFileID))
+	path := "/documents/:documentId/uploads/:uploadedFileId"
+	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(string(params.DocumentID)))
+	path = strings.ReplaceAll(path, ":uploadedFileId", url.PathEscape(string(params.UploadedFileID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "DELETE", path, query, nil)
 	if err != nil {
@@ -885,8 +745,8 @@ type DocumentsDownloadFileForDocumentResponse struct {
 }
 
 type DocumentsDownloadFileForDocumentParams struct {
-	DocumentID     string
-	UploadedFileID string
+	DocumentID     DocumentID
+	UploadedFileID DocumentUploadID
 }
 
 // DownloadFileForDocument Download a file from a document.
@@ -894,15 +754,12 @@ func (s *DocumentsService) DownloadFileForDocument(ctx context.Context, params *
 	if params == nil {
 		params = &DocumentsDownloadFileForDocumentParams{}
 	}
-	path := "/documents/:documentId/uploads/:uploadedFileId/media"
-	if params.DocumentID == "" {
-		return nil, fmt.Errorf("documentId is required")
-	}
-	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(params.DocumentID))
-	if params.UploadedFileID == "" {
-		return nil, fmt.Errorf("uploadedFileId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":uploadedFileId", url.PathEscape(params.UploadedFileID))
+	path := "/documents/:documentId/uploads/:uploadedFileId/media"
+	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(string(params.DocumentID)))
+	path = strings.ReplaceAll(path, ":uploadedFileId", url.PathEscape(string(params.UploadedFileID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
@@ -915,54 +772,32 @@ func (s *DocumentsService) DownloadFileForDocument(ctx context.Context, params *
 	return out, nil
 }
 
-type DocumentsGetDocumentByIDResponse struct {
-	Category          string         `json:"category"`
-	DeactivatedStatus map[string]any `json:"deactivatedStatus"`
-	Description       string         `json:"description"`
-	ID                string         `json:"id"`
-	IsSensitive       bool           `json:"isSensitive"`
-	NextRenewalDate   string         `json:"nextRenewalDate"`
-	Note              string         `json:"note"`
-	OwnerID           string         `json:"ownerId"`
-	ReminderWindow    string         `json:"reminderWindow"`
-	RenewalCadence    string         `json:"renewalCadence"`
-	Subscribers       []any          `json:"subscribers"`
-	Title             string         `json:"title"`
-	URL               string         `json:"url"`
-	UploadStatus      string         `json:"uploadStatus"`
-	UploadStatusDate  string         `json:"uploadStatusDate"`
-}
-
 type DocumentsGetDocumentByIDParams struct {
-	DocumentID string
+	DocumentID DocumentID
 }
 
 // GetDocumentByID Get a document by ID.
-func (s *DocumentsService) GetDocumentByID(ctx context.Context, params *DocumentsGetDocumentByIDParams) (*DocumentsGetDocumentByIDResponse, error) {
+func (s *DocumentsService) GetDocumentByID(ctx context.Context, params *DocumentsGetDocumentByIDParams) (*Document, error) {
 	if params == nil {
 		params = &DocumentsGetDocumentByIDParams{}
 	}
-	path := "/documents/:documentId"
-	if params.DocumentID == "" {
-		return nil, fmt.Errorf("documentId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(params.DocumentID))
+	path := "/documents/:documentId"
+	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(string(params.DocumentID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &DocumentsGetDocumentByIDResponse{}
+	out := &Document{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type DocumentsListDocumentsResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type DocumentsListDocumentsParams struct {
 	PageSize            *int
 	PageCursor          *string
@@ -971,10 +806,13 @@ type DocumentsListDocumentsParams struct {
 }
 
 // ListDocuments List documents.
-func (s *DocumentsService) ListDocuments(ctx context.Context, params *DocumentsListDocumentsParams) (*DocumentsListDocumentsResponse, error) {
+func (s *DocumentsService) ListDocuments(ctx context.Context, params *DocumentsListDocumentsParams) (*ResultsPage[Document], error) {
 	if params == nil {
 		params = &DocumentsListDocumentsParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/documents"
 	query := url.Values{}
 	if params.PageSize != nil {
@@ -993,33 +831,29 @@ func (s *DocumentsService) ListDocuments(ctx context.Context, params *DocumentsL
 	if err != nil {
 		return nil, err
 	}
-	out := &DocumentsListDocumentsResponse{}
+	out := &ResultsPage[Document]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type DocumentsListDocumentsControlsResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type DocumentsListDocumentsControlsParams struct {
-	DocumentID string
+	DocumentID DocumentID
 	PageSize   *int
 	PageCursor *string
 }
 
 // ListDocumentsControls List a document's associated controls.
-func (s *DocumentsService) ListDocumentsControls(ctx context.Context, params *DocumentsListDocumentsControlsParams) (*DocumentsListDocumentsControlsResponse, error) {
+func (s *DocumentsService) ListDocumentsControls(ctx context.Context, params *DocumentsListDocumentsControlsParams) (*ResultsPage[Control], error) {
 	if params == nil {
 		params = &DocumentsListDocumentsControlsParams{}
 	}
-	path := "/documents/:documentId/controls"
-	if params.DocumentID == "" {
-		return nil, fmt.Errorf("documentId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(params.DocumentID))
+	path := "/documents/:documentId/controls"
+	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(string(params.DocumentID)))
 	query := url.Values{}
 	if params.PageSize != nil {
 		query.Set("pageSize", fmt.Sprint(*params.PageSize))
@@ -1031,33 +865,29 @@ func (s *DocumentsService) ListDocumentsControls(ctx context.Context, params *Do
 	if err != nil {
 		return nil, err
 	}
-	out := &DocumentsListDocumentsControlsResponse{}
+	out := &ResultsPage[Control]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type DocumentsListDocumentsLinksResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type DocumentsListDocumentsLinksParams struct {
-	DocumentID string
+	DocumentID DocumentID
 	PageSize   *int
 	PageCursor *string
 }
 
 // ListDocumentsLinks List the uploaded links for a document.
-func (s *DocumentsService) ListDocumentsLinks(ctx context.Context, params *DocumentsListDocumentsLinksParams) (*DocumentsListDocumentsLinksResponse, error) {
+func (s *DocumentsService) ListDocumentsLinks(ctx context.Context, params *DocumentsListDocumentsLinksParams) (*ResultsPage[DocumentLink], error) {
 	if params == nil {
 		params = &DocumentsListDocumentsLinksParams{}
 	}
-	path := "/documents/:documentId/links"
-	if params.DocumentID == "" {
-		return nil, fmt.Errorf("documentId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(params.DocumentID))
+	path := "/documents/:documentId/links"
+	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(string(params.DocumentID)))
 	query := url.Values{}
 	if params.PageSize != nil {
 		query.Set("pageSize", fmt.Sprint(*params.PageSize))
@@ -1069,33 +899,29 @@ func (s *DocumentsService) ListDocumentsLinks(ctx context.Context, params *Docum
 	if err != nil {
 		return nil, err
 	}
-	out := &DocumentsListDocumentsLinksResponse{}
+	out := &ResultsPage[DocumentLink]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type DocumentsListDocumentsUploadsResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type DocumentsListDocumentsUploadsParams struct {
-	DocumentID string
+	DocumentID DocumentID
 	PageSize   *int
 	PageCursor *string
 }
 
 // ListDocumentsUploads List the uploaded files for a document.
-func (s *DocumentsService) ListDocumentsUploads(ctx context.Context, params *DocumentsListDocumentsUploadsParams) (*DocumentsListDocumentsUploadsResponse, error) {
+func (s *DocumentsService) ListDocumentsUploads(ctx context.Context, params *DocumentsListDocumentsUploadsParams) (*ResultsPage[DocumentUpload], error) {
 	if params == nil {
 		params = &DocumentsListDocumentsUploadsParams{}
 	}
-	path := "/documents/:documentId/uploads"
-	if params.DocumentID == "" {
-		return nil, fmt.Errorf("documentId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(params.DocumentID))
+	path := "/documents/:documentId/uploads"
+	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(string(params.DocumentID)))
 	query := url.Values{}
 	if params.PageSize != nil {
 		query.Set("pageSize", fmt.Sprint(*params.PageSize))
@@ -1107,7 +933,7 @@ func (s *DocumentsService) ListDocumentsUploads(ctx context.Context, params *Doc
 	if err != nil {
 		return nil, err
 	}
-	out := &DocumentsListDocumentsUploadsResponse{}
+	out := &ResultsPage[DocumentUpload]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -1115,8 +941,8 @@ func (s *DocumentsService) ListDocumentsUploads(ctx context.Context, params *Doc
 }
 
 type DocumentsRemoveDocumentLinkParams struct {
-	DocumentID string
-	LinkID     string
+	DocumentID DocumentID
+	LinkID     DocumentLinkID
 }
 
 // RemoveDocumentLink Remove a link from a document.
@@ -1124,15 +950,12 @@ func (s *DocumentsService) RemoveDocumentLink(ctx context.Context, params *Docum
 	if params == nil {
 		params = &DocumentsRemoveDocumentLinkParams{}
 	}
-	path := "/documents/:documentId/links/:linkId"
-	if params.DocumentID == "" {
-		return nil, fmt.Errorf("documentId is required")
-	}
-	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(params.DocumentID))
-	if params.LinkID == "" {
-		return nil, fmt.Errorf("linkId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":linkId", url.PathEscape(params.LinkID))
+	path := "/documents/:documentId/links/:linkId"
+	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(string(params.DocumentID)))
+	path = strings.ReplaceAll(path, ":linkId", url.PathEscape(string(params.LinkID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "DELETE", path, query, nil)
 	if err != nil {
@@ -1149,39 +972,27 @@ type DocumentsSetDocumentOwnerRequestBody struct {
 	UserID string `json:"userId"`
 }
 
-type DocumentsSetDocumentOwnerResponse struct {
-	Category         string `json:"category"`
-	Description      string `json:"description"`
-	ID               string `json:"id"`
-	IsSensitive      bool   `json:"isSensitive"`
-	OwnerID          string `json:"ownerId"`
-	Title            string `json:"title"`
-	URL              string `json:"url"`
-	UploadStatus     string `json:"uploadStatus"`
-	UploadStatusDate string `json:"uploadStatusDate"`
-}
-
 type DocumentsSetDocumentOwnerParams struct {
-	DocumentID string
+	DocumentID DocumentID
 	Body       *DocumentsSetDocumentOwnerRequestBody
 }
 
 // SetDocumentOwner Assign or unassign a user to the document.
-func (s *DocumentsService) SetDocumentOwner(ctx context.Context, params *DocumentsSetDocumentOwnerParams) (*DocumentsSetDocumentOwnerResponse, error) {
+func (s *DocumentsService) SetDocumentOwner(ctx context.Context, params *DocumentsSetDocumentOwnerParams) (*Document, error) {
 	if params == nil {
 		params = &DocumentsSetDocumentOwnerParams{}
 	}
-	path := "/documents/:documentId/set-owner"
-	if params.DocumentID == "" {
-		return nil, fmt.Errorf("documentId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(params.DocumentID))
+	path := "/documents/:documentId/set-owner"
+	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(string(params.DocumentID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &DocumentsSetDocumentOwnerResponse{}
+	out := &Document{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -1189,7 +1000,7 @@ func (s *DocumentsService) SetDocumentOwner(ctx context.Context, params *Documen
 }
 
 type DocumentsSubmitDocumentCollectionParams struct {
-	DocumentID string
+	DocumentID DocumentID
 }
 
 // SubmitDocumentCollection Submit document collection.
@@ -1197,11 +1008,11 @@ func (s *DocumentsService) SubmitDocumentCollection(ctx context.Context, params
 	if params == nil {
 		params = &DocumentsSubmitDocumentCollectionParams{}
 	}
-	path := "/documents/:documentId/submit"
-	if params.DocumentID == "" {
-		return nil, fmt.Errorf("documentId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(params.DocumentID))
+	path := "/documents/:documentId/submit"
+	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(string(params.DocumentID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, nil)
 	if err != nil {
@@ -1214,42 +1025,28 @@ func (s *DocumentsService) SubmitDocumentCollection(ctx context.Context, params
 	return out, nil
 }
 
-type DocumentsUploadFileForDocumentResponse struct {
-	CreationDate  string         `json:"creationDate"`
-	DeletionDate  any            `json:"deletionDate"`
-	Description   string         `json:"description"`
-	EffectiveDate string         `json:"effectiveDate"`
-	FileName      string         `json:"fileName"`
-	ID            string         `json:"id"`
-	MimeType      string         `json:"mimeType"`
-	Title         string         `json:"title"`
-	URL           string         `json:"url"`
-	UpdatedDate   string         `json:"updatedDate"`
-	UploadedBy    map[string]any `json:"uploadedBy"`
-}
-
 type DocumentsUploadFileForDocumentParams struct {
-	DocumentID string
+	DocumentID DocumentID
 	// FormData maps multipart field names to values.
 	FormData map[string]string
 }
 
 // UploadFileForDocument Upload a file for a document.
-func (s *DocumentsService) UploadFileForDocument(ctx context.Context, params *DocumentsUploadFileForDocumentParams) (*DocumentsUploadFileForDocumentResponse, error) {
+func (s *DocumentsService) UploadFileForDocument(ctx context.Context, params *DocumentsUploadFileForDocumentParams) (*DocumentUpload, error) {
 	if params == nil {
 		params = &DocumentsUploadFileForDocumentParams{}
 	}
-	path := "/documents/:documentId/uploads"
-	if params.DocumentID == "" {
-		return nil, fmt.Errorf("documentId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(params.DocumentID))
+	path := "/documents/:documentId/uploads"
+	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(string(params.DocumentID)))
 	query := url.Values{}
 	req, err := s.client.newMultipartRequest(ctx, "POST", path, query, params.FormData)
 	if err != nil {
 		return nil, err
 	}
-	out := &DocumentsUploadFileForDocumentResponse{}
+	out := &DocumentUpload{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -1261,60 +1058,45 @@ type FrameworksService struct {
 	client *Client
 }
 
-type FrameworksGetFrameworkByIDResponse struct {
-	Description           string           `json:"description"`
-	DisplayName           string           `json:"displayName"`
-	ID                    string           `json:"id"`
-	NumControlsCompleted  float64          `json:"numControlsCompleted"`
-	NumControlsTotal      float64          `json:"numControlsTotal"`
-	NumDocumentsPassing   float64          `json:"numDocumentsPassing"`
-	NumDocumentsTotal     float64          `json:"numDocumentsTotal"`
-	NumTestsPassing       float64          `json:"numTestsPassing"`
-	NumTestsTotal         float64          `json:"numTestsTotal"`
-	RequirementCategories []map[string]any `json:"requirementCategories"`
-	ShorthandName         string           `json:"shorthandName"`
-}
-
 type FrameworksGetFrameworkByIDParams struct {
-	FrameworkID string
+	FrameworkID FrameworkID
 }
 
 // GetFrameworkByID Get a framework by ID.
-func (s *FrameworksService) GetFrameworkByID(ctx context.Context, params *FrameworksGetFrameworkByIDParams) (*FrameworksGetFrameworkByIDResponse, error) {
+func (s *FrameworksService) GetFrameworkByID(ctx context.Context, params *FrameworksGetFrameworkByIDParams) (*Framework, error) {
 	if params == nil {
 		params = &FrameworksGetFrameworkByIDParams{}
 	}
-	path := "/frameworks/:frameworkId"
-	if params.FrameworkID == "" {
-		return nil, fmt.Errorf("frameworkId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":frameworkId", url.PathEscape(params.FrameworkID))
+	path := "/frameworks/:frameworkId"
+	path = strings.ReplaceAll(path, ":frameworkId", url.PathEscape(string(params.FrameworkID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &FrameworksGetFrameworkByIDResponse{}
+	out := &Framework{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type FrameworksListAvailableFrameworksResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type FrameworksListAvailableFrameworksParams struct {
 	PageSize   *int
 	PageCursor *string
 }
 
 // ListAvailableFrameworks Lists available frameworks.
-func (s *FrameworksService) ListAvailableFrameworks(ctx context.Context, params *FrameworksListAvailableFrameworksParams) (*FrameworksListAvailableFrameworksResponse, error) {
+func (s *FrameworksService) ListAvailableFrameworks(ctx context.Context, params *FrameworksListAvailableFrameworksParams) (*ResultsPage[Framework], error) {
 	if params == nil {
 		params = &FrameworksListAvailableFrameworksParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/frameworks"
 	query := url.Values{}
 	if params.PageSize != nil {
@@ -1327,33 +1109,29 @@ func (s *FrameworksService) ListAvailableFrameworks(ctx context.Context, params
 	if err != nil {
 		return nil, err
 	}
-	out := &FrameworksListAvailableFrameworksResponse{}
+	out := &ResultsPage[Framework]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type FrameworksListFrameworksControlsResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type FrameworksListFrameworksControlsParams struct {
-	FrameworkID string
+	FrameworkID FrameworkID
 	PageSize    *int
 	PageCursor  *string
 }
 
 // ListFrameworksControls List a framework's controls.
-func (s *FrameworksService) ListFrameworksControls(ctx context.Context, params *FrameworksListFrameworksControlsParams) (*FrameworksListFrameworksControlsResponse, error) {
+func (s *FrameworksService) ListFrameworksControls(ctx context.Context, params *FrameworksListFrameworksControlsParams) (*ResultsPage[Control], error) {
 	if params == nil {
 		params = &FrameworksListFrameworksControlsParams{}
 	}
-	path := "/frameworks/:frameworkId/controls"
-	if params.FrameworkID == "" {
-		return nil, fmt.Errorf("frameworkId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":frameworkId", url.PathEscape(params.FrameworkID))
+	path := "/frameworks/:frameworkId/controls"
+	path = strings.ReplaceAll(path, ":frameworkId", url.PathEscape(string(params.FrameworkID)))
 	query := url.Values{}
 	if params.PageSize != nil {
 		query.Set("pageSize", fmt.Sprint(*params.PageSize))
@@ -1365,7 +1143,7 @@ func (s *FrameworksService) ListFrameworksControls(ctx context.Context, params *
 	if err != nil {
 		return nil, err
 	}
-	out := &FrameworksListFrameworksControlsResponse{}
+	out := &ResultsPage[Control]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -1386,7 +1164,7 @@ type GroupsAddPeopleToGroupResponse struct {
 }
 
 type GroupsAddPeopleToGroupParams struct {
-	GroupID string
+	GroupID GroupID
 	Body    *GroupsAddPeopleToGroupRequestBody
 }
 
@@ -1395,11 +1173,11 @@ func (s *GroupsService) AddPeopleToGroup(ctx context.Context, params *GroupsAddP
 	if params == nil {
 		params = &GroupsAddPeopleToGroupParams{}
 	}
-	path := "/groups/:groupId/add-people"
-	if params.GroupID == "" {
-		return nil, fmt.Errorf("groupId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":groupId", url.PathEscape(params.GroupID))
+	path := "/groups/:groupId/add-people"
+	path = strings.ReplaceAll(path, ":groupId", url.PathEscape(string(params.GroupID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
@@ -1417,7 +1195,7 @@ type GroupsAddPersonToGroupRequestBody struct {
 }
 
 type GroupsAddPersonToGroupParams struct {
-	GroupID string
+	GroupID GroupID
 	Body    *GroupsAddPersonToGroupRequestBody
 }
 
@@ -1426,11 +1204,11 @@ func (s *GroupsService) AddPersonToGroup(ctx context.Context, params *GroupsAddP
 	if params == nil {
 		params = &GroupsAddPersonToGroupParams{}
 	}
-	path := "/groups/:groupId/people"
-	if params.GroupID == "" {
-		return nil, fmt.Errorf("groupId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":groupId", url.PathEscape(params.GroupID))
+	path := "/groups/:groupId/people"
+	path = strings.ReplaceAll(path, ":groupId", url.PathEscape(string(params.GroupID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
@@ -1443,52 +1221,45 @@ func (s *GroupsService) AddPersonToGroup(ctx context.Context, params *GroupsAddP
 	return out, nil
 }
 
-type GroupsGetGroupByIDResponse struct {
-	CreationDate string `json:"creationDate"`
-	ID           string `json:"id"`
-	Name         string `json:"name"`
-}
-
 type GroupsGetGroupByIDParams struct {
-	GroupID string
+	GroupID GroupID
 }
 
 // GetGroupByID Get a group by ID.
-func (s *GroupsService) GetGroupByID(ctx context.Context, params *GroupsGetGroupByIDParams) (*GroupsGetGroupByIDResponse, error) {
+func (s *GroupsService) GetGroupByID(ctx context.Context, params *GroupsGetGroupByIDParams) (*Group, error) {
 	if params == nil {
 		params = &GroupsGetGroupByIDParams{}
 	}
-	path := "/groups/:groupId"
-	if params.GroupID == "" {
-		return nil, fmt.Errorf("groupId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":groupId", url.PathEscape(params.GroupID))
+	path := "/groups/:groupId"
+	path = strings.ReplaceAll(path, ":groupId", url.PathEscape(string(params.GroupID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &GroupsGetGroupByIDResponse{}
+	out := &Group{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type GroupsListGroupsResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type GroupsListGroupsParams struct {
 	PageSize   *int
 	PageCursor *string
 }
 
 // ListGroups Lists all groups by ID.
-func (s *GroupsService) ListGroups(ctx context.Context, params *GroupsListGroupsParams) (*GroupsListGroupsResponse, error) {
+func (s *GroupsService) ListGroups(ctx context.Context, params *GroupsListGroupsParams) (*ResultsPage[Group], error) {
 	if params == nil {
 		params = &GroupsListGroupsParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/groups"
 	query := url.Values{}
 	if params.PageSize != nil {
@@ -1501,7 +1272,7 @@ func (s *GroupsService) ListGroups(ctx context.Context, params *GroupsListGroups
 	if err != nil {
 		return nil, err
 	}
-	out := &GroupsListGroupsResponse{}
+	out := &ResultsPage[Group]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -1509,7 +1280,7 @@ func (s *GroupsService) ListGroups(ctx context.Context, params *GroupsListGroups
 }
 
 type GroupsListPeopleInGroupParams struct {
-	GroupID string
+	GroupID GroupID
 }
 
 // ListPeopleInGroup List people in a group.
@@ -1517,11 +1288,11 @@ func (s *GroupsService) ListPeopleInGroup(ctx context.Context, params *GroupsLis
 	if params == nil {
 		params = &GroupsListPeopleInGroupParams{}
 	}
-	path := "/groups/:groupId/people"
-	if params.GroupID == "" {
-		return nil, fmt.Errorf("groupId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":groupId", url.PathEscape(params.GroupID))
+	path := "/groups/:groupId/people"
+	path = strings.ReplaceAll(path, ":groupId", url.PathEscape(string(params.GroupID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
@@ -1543,7 +1314,7 @@ type GroupsRemovePeopleFromGroupResponse struct {
 }
 
 type GroupsRemovePeopleFromGroupParams struct {
-	GroupID string
+	GroupID GroupID
 	Body    *GroupsRemovePeopleFromGroupRequestBody
 }
 
@@ -1552,11 +1323,11 @@ func (s *GroupsService) RemovePeopleFromGroup(ctx context.Context, params *Group
 	if params == nil {
 		params = &GroupsRemovePeopleFromGroupParams{}
 	}
-	path := "/groups/:groupId/remove-people"
-	if params.GroupID == "" {
-		return nil, fmt.Errorf("groupId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":groupId", url.PathEscape(params.GroupID))
+	path := "/groups/:groupId/remove-people"
+	path = strings.ReplaceAll(path, ":groupId", url.PathEscape(string(params.GroupID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
@@ -1570,8 +1341,8 @@ func (s *GroupsService) RemovePeopleFromGroup(ctx context.Context, params *Group
 }
 
 type GroupsRemovePersonFromGroupParams struct {
-	GroupID  string
-	PersonID string
+	GroupID  GroupID
+	PersonID PersonID
 }
 
 // RemovePersonFromGroup Remove a single person, by ID, from a group.
@@ -1579,15 +1350,12 @@ func (s *GroupsService) RemovePersonFromGroup(ctx context.Context, params *Group
 	if params == nil {
 		params = &GroupsRemovePersonFromGroupParams{}
 	}
-	path := "/groups/:groupId/people/:personId"
-	if params.GroupID == "" {
-		return nil, fmt.Errorf("groupId is required")
-	}
-	path = strings.ReplaceAll(path, ":groupId", url.PathEscape(params.GroupID))
-	if params.PersonID == "" {
-		return nil, fmt.Errorf("personId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":personId", url.PathEscape(params.PersonID))
+	path := "/groups/:groupId/people/:personId"
+	path = strings.ReplaceAll(path, ":groupId", url.PathEscape(string(params.GroupID)))
+	path = strings.ReplaceAll(path, ":personId", url.PathEscape(string(params.PersonID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "DELETE", path, query, nil)
 	if err != nil {
@@ -1605,70 +1373,48 @@ type IntegrationsService struct {
 	client *Client
 }
 
-type IntegrationsGetConnectedIntegrationResponse struct {
-	Connections   []map[string]any `json:"connections"`
-	DisplayName   string           `json:"displayName"`
-	IntegrationID string           `json:"integrationId"`
-	ResourceKinds []string         `json:"resourceKinds"`
-}
-
 type IntegrationsGetConnectedIntegrationParams struct {
-	IntegrationID string
+	IntegrationID IntegrationID
 }
 
 // GetConnectedIntegration Gets details for a specific integration by connection ID.
-func (s *IntegrationsService) GetConnectedIntegration(ctx context.Context, params *IntegrationsGetConnectedIntegrationParams) (*IntegrationsGetConnectedIntegrationResponse, error) {
+func (s *IntegrationsService) GetConnectedIntegration(ctx context.Context, params *IntegrationsGetConnectedIntegrationParams) (*Integration, error) {
 	if params == nil {
 		params = &IntegrationsGetConnectedIntegrationParams{}
 	}
-	path := "/integrations/:integrationId"
-	if params.IntegrationID == "" {
-		return nil, fmt.Errorf("integrationId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":integrationId", url.PathEscape(params.IntegrationID))
+	path := "/integrations/:integrationId"
+	path = strings.ReplaceAll(path, ":integrationId", url.PathEscape(string(params.IntegrationID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &IntegrationsGetConnectedIntegrationResponse{}
+	out := &Integration{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type IntegrationsGetDetailsForResourceKindResponse struct {
-	CanUpdateDescription bool    `json:"canUpdateDescription"`
-	CanUpdateOwner       bool    `json:"canUpdateOwner"`
-	IntegrationID        string  `json:"integrationId"`
-	IsScopable           bool    `json:"isScopable"`
-	NumInScope           float64 `json:"numInScope"`
-	NumOwned             float64 `json:"numOwned"`
-	NumResources         float64 `json:"numResources"`
-	NumWithDescription   float64 `json:"numWithDescription"`
-	ResourceKind         string  `json:"resourceKind"`
-}
-
 type IntegrationsGetDetailsForResourceKindParams struct {
-	IntegrationID string
+	IntegrationID IntegrationID
 	ResourceKind  string
 	ConnectionID  *string
 }
 
 // GetDetailsForResourceKind Gets details for a specific resource type (kind) such as S3Bucket or CloudwatchLogGroup.
-func (s *IntegrationsService) GetDetailsForResourceKind(ctx context.Context, params *IntegrationsGetDetailsForResourceKindParams) (*IntegrationsGetDetailsForResourceKindResponse, error) {
+func (s *IntegrationsService) GetDetailsForResourceKind(ctx context.Context, params *IntegrationsGetDetailsForResourceKindParams) (*IntegrationResourceKindDetails, error) {
 	if params == nil {
 		params = &IntegrationsGetDetailsForResourceKindParams{}
 	}
-	path := "/integrations/:integrationId/resource-kinds/:resourceKind"
-	if params.IntegrationID == "" {
-		return nil, fmt.Errorf("integrationId is required")
-	}
-	path = strings.ReplaceAll(path, ":integrationId", url.PathEscape(params.IntegrationID))
-	if params.ResourceKind == "" {
-		return nil, fmt.Errorf("resourceKind is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
+	path := "/integrations/:integrationId/resource-kinds/:resourceKind"
+	path = strings.ReplaceAll(path, ":integrationId", url.PathEscape(string(params.IntegrationID)))
 	path = strings.ReplaceAll(path, ":resourceKind", url.PathEscape(params.ResourceKind))
 	query := url.Values{}
 	if params.ConnectionID != nil {
@@ -1678,63 +1424,22 @@ func (s *IntegrationsService) GetDetailsForResourceKind(ctx context.Context, par
 	if err != nil {
 		return nil, err
 	}
-	out := &IntegrationsGetDetailsForResourceKindResponse{}
+	out := &IntegrationResourceKindDetails{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type IntegrationsGetResourceByIDResponse struct {
-	ConnectionID string `json:"connectionId"`
-	CreationDate string `json:"creationDate"`
-	Description  any    `json:"description"`
-	DisplayName  string `json:"displayName"`
-	InScope      bool   `json:"inScope"`
-	Owner        any    `json:"owner"`
-	ResourceID   string `json:"resourceId"`
-	ResourceKind string `json:"resourceKind"`
-	ResponseType string `json:"responseType"`
-}
-
 type IntegrationsGetResourceByIDParams struct {
-	IntegrationID string
+	IntegrationID IntegrationID
 	ResourceKind  string
 	ResourceID    string
 }
 
 // GetResourceByID Gets resource by its ID.
-func (s *IntegrationsService) GetResourceByID(ctx context.Context, params *IntegrationsGetResourceByIDParams) (*IntegrationsGetResourceByIDResponse, error) {
-	if params == nil {
-		params = &IntegrationsGetResourceByIDParams{}
-	}
-	path := "/integrations/:integrationId/resource-kinds/:resourceKind/resources/:resourceId"
-	if params.IntegrationID == "" {
-		return nil, fmt.Errorf("integrationId is required")
-	}
-	path = strings.ReplaceAll(path, ":integrationId", url.PathEscape(params.IntegrationID))
-	if params.ResourceKind == "" {
-		return nil, fmt.Errorf("resourceKind is required")
-	}
-	path = strings.ReplaceAll(path, ":resourceKind", url.PathEscape(params.ResourceKind))
-	if params.ResourceID == "" {
-		return nil, fmt.Errorf("resourceId is required")
-	}
-	path = strings.ReplaceAll(path, ":resourceId", url.PathEscape(params.ResourceID))
-	query := url.Values{}
-	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
-	if err != nil {
-		return nil, err
-	}
-	out := &IntegrationsGetResourceByIDResponse{}
-	if err := s.client.doJSON(req, out); err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-type IntegrationsListConnectedIntegrationsResponse struct {
-	Results map[string]any `json:"results"`
+func (s *IntegrationsService) GetResourceByID(ctx context.Context, params *IntegrationsGetResourceByIDParams) (*GenericResource, error) {
+	return getIntegrationResource[GenericResource](ctx, s.client, params)
 }
 
 type IntegrationsListConnectedIntegrationsParams struct {
@@ -1743,10 +1448,13 @@ type IntegrationsListConnectedIntegrationsParams struct {
 }
 
 // ListConnectedIntegrations Lists all integrations connected to a Vanta instance.
-func (s *IntegrationsService) ListConnectedIntegrations(ctx context.Context, params *IntegrationsListConnectedIntegrationsParams) (*IntegrationsListConnectedIntegrationsResponse, error) {
+func (s *IntegrationsService) ListConnectedIntegrations(ctx context.Context, params *IntegrationsListConnectedIntegrationsParams) (*ResultsPage[Integration], error) {
 	if params == nil {
 		params = &IntegrationsListConnectedIntegrationsParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/integrations"
 	query := url.Values{}
 	if params.PageSize != nil {
@@ -1759,7 +1467,7 @@ func (s *IntegrationsService) ListConnectedIntegrations(ctx context.Context, par
 	if err != nil {
 		return nil, err
 	}
-	out := &IntegrationsListConnectedIntegrationsResponse{}
+	out := &ResultsPage[Integration]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -1767,37 +1475,33 @@ func (s *IntegrationsService) ListConnectedIntegrations(ctx context.Context, par
 }
 
 type IntegrationsListIntegrationResourceKindsParams struct {
-	IntegrationID string
+	IntegrationID IntegrationID
 }
 
 // ListIntegrationResourceKinds Lists a connected integration's resource types (kinds) such as S3Bucket or CloudwatchLogGroup.
-func (s *IntegrationsService) ListIntegrationResourceKinds(ctx context.Context, params *IntegrationsListIntegrationResourceKindsParams) (json.RawMessage, error) {
+func (s *IntegrationsService) ListIntegrationResourceKinds(ctx context.Context, params *IntegrationsListIntegrationResourceKindsParams) ([]IntegrationResourceKind, error) {
 	if params == nil {
 		params = &IntegrationsListIntegrationResourceKindsParams{}
 	}
-	path := "/integrations/:integrationId/resource-kinds"
-	if params.IntegrationID == "" {
-		return nil, fmt.Errorf("integrationId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":integrationId", url.PathEscape(params.IntegrationID))
+	path := "/integrations/:integrationId/resource-kinds"
+	path = strings.ReplaceAll(path, ":integrationId", url.PathEscape(string(params.IntegrationID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	var out json.RawMessage
+	out := make([]IntegrationResourceKind, 0)
 	if err := s.client.doJSON(req, &out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type IntegrationsListResourcesResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type IntegrationsListResourcesParams struct {
-	IntegrationID  string
+	IntegrationID  IntegrationID
 	ResourceKind   string
 	ConnectionID   *string
 	HasDescription *bool
@@ -1808,47 +1512,8 @@ type IntegrationsListResourcesParams struct {
 }
 
 // ListResources Lists resources for a specific integration and resource type (kind) such as S3Bucket or CloudwatchLogGroup.
-func (s *IntegrationsService) ListResources(ctx context.Context, params *IntegrationsListResourcesParams) (*IntegrationsListResourcesResponse, error) {
-	if params == nil {
-		params = &IntegrationsListResourcesParams{}
-	}
-	path := "/integrations/:integrationId/resource-kinds/:resourceKind/resources"
-	if params.IntegrationID == "" {
-		return nil, fmt.Errorf("integrationId is required")
-	}
-	path = strings.ReplaceAll(path, ":integrationId", url.PathEscape(params.IntegrationID))
-	if params.ResourceKind == "" {
-		return nil, fmt.Errorf("resourceKind is required")
-	}
-	path = strings.ReplaceAll(path, ":resourceKind", url.PathEscape(params.ResourceKind))
-	query := url.Values{}
-	if params.ConnectionID != nil {
-		query.Set("connectionId", fmt.Sprint(*params.ConnectionID))
-	}
-	if params.HasDescription != nil {
-		query.Set("hasDescription", fmt.Sprint(*params.HasDescription))
-	}
-	if params.HasOwner != nil {
-		query.Set("hasOwner", fmt.Sprint(*params.HasOwner))
-	}
-	if params.IsInScope != nil {
-		query.Set("isInScope", fmt.Sprint(*params.IsInScope))
-	}
-	if params.PageSize != nil {
-		query.Set("pageSize", fmt.Sprint(*params.PageSize))
-	}
-	if params.PageCursor != nil {
-		query.Set("pageCursor", fmt.Sprint(*params.PageCursor))
-	}
-	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
-	if err != nil {
-		return nil, err
-	}
-	out := &IntegrationsListResourcesResponse{}
-	if err := s.client.doJSON(req, out); err != nil {
-		return nil, err
-	}
-	return out, nil
+func (s *IntegrationsService) ListResources(ctx context.Context, params *IntegrationsListResourcesParams) (*ResultsPage[GenericResource], error) {
+	return listIntegrationResources[GenericResource](ctx, s.client, params)
 }
 
 type IntegrationsUpdateResourceMetadataRequestBody struct {
@@ -1860,7 +1525,7 @@ type IntegrationsUpdateResourceMetadataResponse struct {
 }
 
 type IntegrationsUpdateResourceMetadataParams struct {
-	IntegrationID string
+	IntegrationID IntegrationID
 	ResourceKind  string
 	Body          *IntegrationsUpdateResourceMetadataRequestBody
 }
@@ -1870,14 +1535,11 @@ func (s *IntegrationsService) UpdateResourceMetadata(ctx context.Context, params
 	if params == nil {
 		params = &IntegrationsUpdateResourceMetadataParams{}
 	}
-	path := "/integrations/:integrationId/resource-kinds/:resourceKind/resources"
-	if params.IntegrationID == "" {
-		return nil, fmt.Errorf("integrationId is required")
-	}
-	path = strings.ReplaceAll(path, ":integrationId", url.PathEscape(params.IntegrationID))
-	if params.ResourceKind == "" {
-		return nil, fmt.Errorf("resourceKind is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
+	path := "/integrations/:integrationId/resource-kinds/:resourceKind/resources"
+	path = strings.ReplaceAll(path, ":integrationId", url.PathEscape(string(params.IntegrationID)))
 	path = strings.ReplaceAll(path, ":resourceKind", url.PathEscape(params.ResourceKind))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "PATCH", path, query, params.Body)
@@ -1898,7 +1560,7 @@ type IntegrationsUpdateResourceMetadataForResourceKindsResourcesRequestBody stru
 }
 
 type IntegrationsUpdateResourceMetadataForResourceKindsResourcesParams struct {
-	IntegrationID string
+	IntegrationID IntegrationID
 	ResourceKind  string
 	ResourceID    string
 	Body          *IntegrationsUpdateResourceMetadataForResourceKindsResourcesRequestBody
@@ -1909,18 +1571,12 @@ func (s *IntegrationsService) UpdateResourceMetadataForResourceKindsResources(ct
 	if params == nil {
 		params = &IntegrationsUpdateResourceMetadataForResourceKindsResourcesParams{}
 	}
-	path := "/integrations/:integrationId/resource-kinds/:resourceKind/resources/:resourceId"
-	if params.IntegrationID == "" {
-		return nil, fmt.Errorf("integrationId is required")
-	}
-	path = strings.ReplaceAll(path, ":integrationId", url.PathEscape(params.IntegrationID))
-	if params.ResourceKind == "" {
-		return nil, fmt.Errorf("resourceKind is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
+	path := "/integrations/:integrationId/resource-kinds/:resourceKind/resources/:resourceId"
+	path = strings.ReplaceAll(path, ":integrationId", url.PathEscape(string(params.IntegrationID)))
 	path = strings.ReplaceAll(path, ":resourceKind", url.PathEscape(params.ResourceKind))
-	if params.ResourceID == "" {
-		return nil, fmt.Errorf("resourceId is required")
-	}
 	path = strings.ReplaceAll(path, ":resourceId", url.PathEscape(params.ResourceID))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "PATCH", path, query, params.Body)
@@ -1939,50 +1595,32 @@ type MonitoredComputersService struct {
 	client *Client
 }
 
-type MonitoredComputersGetMonitoredComputerByIDResponse struct {
-	AntivirusInstallation map[string]any `json:"antivirusInstallation"`
-	DiskEncryption        map[string]any `json:"diskEncryption"`
-	ID                    string         `json:"id"`
-	IntegrationID         string         `json:"integrationId"`
-	LastCheckDate         string         `json:"lastCheckDate"`
-	OperatingSystem       map[string]any `json:"operatingSystem"`
-	Owner                 map[string]any `json:"owner"`
-	PasswordManager       map[string]any `json:"passwordManager"`
-	Screenlock            map[string]any `json:"screenlock"`
-	SerialNumber          string         `json:"serialNumber"`
-	Udid                  string         `json:"udid"`
-}
-
 type MonitoredComputersGetMonitoredComputerByIDParams struct {
-	ComputerID string
+	ComputerID MonitoredComputerID
 }
 
 // GetMonitoredComputerByID Returns a monitored computer by ID.
-func (s *MonitoredComputersService) GetMonitoredComputerByID(ctx context.Context, params *MonitoredComputersGetMonitoredComputerByIDParams) (*MonitoredComputersGetMonitoredComputerByIDResponse, error) {
+func (s *MonitoredComputersService) GetMonitoredComputerByID(ctx context.Context, params *MonitoredComputersGetMonitoredComputerByIDParams) (*MonitoredComputer, error) {
 	if params == nil {
 		params = &MonitoredComputersGetMonitoredComputerByIDParams{}
 	}
-	path := "/monitored-computers/:computerId"
-	if params.ComputerID == "" {
-		return nil, fmt.Errorf("computerId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":computerId", url.PathEscape(params.ComputerID))
+	path := "/monitored-computers/:computerId"
+	path = strings.ReplaceAll(path, ":computerId", url.PathEscape(string(params.ComputerID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &MonitoredComputersGetMonitoredComputerByIDResponse{}
+	out := &MonitoredComputer{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type MonitoredComputersListMonitoredComputersResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type MonitoredComputersListMonitoredComputersParams struct {
 	PageSize                         *int
 	PageCursor                       *string
@@ -1990,10 +1628,13 @@ type MonitoredComputersListMonitoredComputersParams struct {
 }
 
 // ListMonitoredComputers Returns a list of computers monitored by an MDM (with an integration built by Vanta) or by the Vanta Agent. Currently this list does not include resources from partner or customer-built integrations.
-func (s *MonitoredComputersService) ListMonitoredComputers(ctx context.Context, params *MonitoredComputersListMonitoredComputersParams) (*MonitoredComputersListMonitoredComputersResponse, error) {
+func (s *MonitoredComputersService) ListMonitoredComputers(ctx context.Context, params *MonitoredComputersListMonitoredComputersParams) (*ResultsPage[MonitoredComputer], error) {
 	if params == nil {
 		params = &MonitoredComputersListMonitoredComputersParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/monitored-computers"
 	query := url.Values{}
 	if params.PageSize != nil {
@@ -2009,7 +1650,7 @@ func (s *MonitoredComputersService) ListMonitoredComputers(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &MonitoredComputersListMonitoredComputersResponse{}
+	out := &ResultsPage[MonitoredComputer]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -2021,33 +1662,19 @@ type OAuthService struct {
 	client *Client
 }
 
-type OAuthCreateTokenRequestBody struct {
-	ClientID     string `json:"client_id"`
-	ClientSecret string `json:"client_secret"`
-	GrantType    string `json:"grant_type"`
-	Scope        string `json:"scope"`
-}
-
 type OAuthCreateTokenParams struct {
 	Body *OAuthCreateTokenRequestBody
 }
 
-// CreateToken CreateToken performs POST /oauth/token.
-func (s *OAuthService) CreateToken(ctx context.Context, params *OAuthCreateTokenParams) (json.RawMessage, error) {
+// CreateToken CreateToken performs POST /oauth/token against the client's auth URL.
+func (s *OAuthService) CreateToken(ctx context.Context, params *OAuthCreateTokenParams) (*OAuthTokenResponse, error) {
 	if params == nil {
 		params = &OAuthCreateTokenParams{}
 	}
-	path := "/oauth/token"
-	query := url.Values{}
-	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
-	if err != nil {
-		return nil, err
-	}
-	var out json.RawMessage
-	if err := s.client.doJSON(req, &out); err != nil {
+	if err := params.Validate(); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return s.client.createToken(ctx, params.Body)
 }
 
 // PeopleService groups 8 endpoint methods under the "People" API segment.
@@ -2056,7 +1683,7 @@ type PeopleService struct {
 }
 
 type PeopleGetPersonByIDParams struct {
-	PersonID string
+	PersonID PersonID
 }
 
 // GetPersonByID Returns a person by ID.
@@ -2064,11 +1691,11 @@ func (s *PeopleService) GetPersonByID(ctx context.Context, params *PeopleGetPers
 	if params == nil {
 		params = &PeopleGetPersonByIDParams{}
 	}
-	path := "/people/:personId"
-	if params.PersonID == "" {
-		return nil, fmt.Errorf("personId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":personId", url.PathEscape(params.PersonID))
+	path := "/people/:personId"
+	path = strings.ReplaceAll(path, ":personId", url.PathEscape(string(params.PersonID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
@@ -2085,15 +1712,18 @@ type PeopleListPeopleParams struct {
 	PageSize                     *int
 	PageCursor                   *string
 	TasksSummaryStatusMatchesAny []string
-	TaskTypeMatchesAny           []string
+	TaskTypeMatchesAny           []PersonTaskType
 	TaskStatusMatchesAny         []string
 }
 
 // ListPeople Returns a list of all people.
-func (s *PeopleService) ListPeople(ctx context.Context, params *PeopleListPeopleParams) (*PeopleListPeopleResponse, error) {
+func (s *PeopleService) ListPeople(ctx context.Context, params *PeopleListPeopleParams) (*ResultsPage[Person], error) {
 	if params == nil {
 		params = &PeopleListPeopleParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/people"
 	query := url.Values{}
 	if params.PageSize != nil {
@@ -2106,7 +1736,7 @@ func (s *PeopleService) ListPeople(ctx context.Context, params *PeopleListPeople
 		query.Add("tasksSummaryStatusMatchesAny", v)
 	}
 	for _, v := range params.TaskTypeMatchesAny {
-		query.Add("taskTypeMatchesAny", v)
+		query.Add("taskTypeMatchesAny", string(v))
 	}
 	for _, v := range params.TaskStatusMatchesAny {
 		query.Add("taskStatusMatchesAny", v)
@@ -2115,7 +1745,7 @@ func (s *PeopleService) ListPeople(ctx context.Context, params *PeopleListPeople
 	if err != nil {
 		return nil, err
 	}
-	out := &PeopleListPeopleResponse{}
+	out := &ResultsPage[Person]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -2130,11 +1760,6 @@ type PeopleMarkAsNotPeopleResponse struct {
 	Results []PeopleBulkUpdateResult `json:"results"`
 }
 
-type PeopleMarkAsNotPeopleUpdate struct {
-	ID     string `json:"id"`
-	Reason string `json:"reason"`
-}
-
 type PeopleMarkAsNotPeopleParams struct {
 	Body *PeopleMarkAsNotPeopleRequestBody
 }
@@ -2144,6 +1769,9 @@ func (s *PeopleService) MarkAsNotPeople(ctx context.Context, params *PeopleMarkA
 	if params == nil {
 		params = &PeopleMarkAsNotPeopleParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/people/mark-as-not-people"
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
@@ -2165,10 +1793,6 @@ type PeopleMarkAsPeopleResponse struct {
 	Results []PeopleBulkUpdateResult `json:"results"`
 }
 
-type PeopleMarkAsPeopleUpdate struct {
-	ID string `json:"id"`
-}
-
 type PeopleMarkAsPeopleParams struct {
 	Body *PeopleMarkAsPeopleRequestBody
 }
@@ -2178,6 +1802,9 @@ func (s *PeopleService) MarkAsPeople(ctx context.Context, params *PeopleMarkAsPe
 	if params == nil {
 		params = &PeopleMarkAsPeopleParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/people/mark-as-people"
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
@@ -2199,17 +1826,6 @@ type PeopleOffboardPeopleResponse struct {
 	Results []PeopleBulkUpdateResult `json:"results"`
 }
 
-type PeopleOffboardPeopleUpdate struct {
-	AcknowledgerID string `json:"acknowledgerId"`
-	ID             string `json:"id"`
-}
-
-type PeopleBulkUpdateResult struct {
-	ID      string  `json:"id"`
-	Status  string  `json:"status"`
-	Message *string `json:"message"`
-}
-
 type PeopleOffboardPeopleParams struct {
 	Body *PeopleOffboardPeopleRequestBody
 }
@@ -2219,6 +1835,9 @@ func (s *PeopleService) OffboardPeople(ctx context.Context, params *PeopleOffboa
 	if params == nil {
 		params = &PeopleOffboardPeopleParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/people/offboard"
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
@@ -2233,7 +1852,7 @@ func (s *PeopleService) OffboardPeople(ctx context.Context, params *PeopleOffboa
 }
 
 type PeopleRemoveLeaveInformationParams struct {
-	PersonID string
+	PersonID PersonID
 }
 
 // RemoveLeaveInformation Remove leave information on a person. The person will become active in Vanta, and will be considered in certain tests related to personnel.
@@ -2241,11 +1860,11 @@ func (s *PeopleService) RemoveLeaveInformation(ctx context.Context, params *Peop
 	if params == nil {
 		params = &PeopleRemoveLeaveInformationParams{}
 	}
-	path := "/people/:personId/clear-leave"
-	if params.PersonID == "" {
-		return nil, fmt.Errorf("personId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":personId", url.PathEscape(params.PersonID))
+	path := "/people/:personId/clear-leave"
+	path = strings.ReplaceAll(path, ":personId", url.PathEscape(string(params.PersonID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, nil)
 	if err != nil {
@@ -2264,7 +1883,7 @@ type PeopleSetLeaveInformationRequestBody struct {
 }
 
 type PeopleSetLeaveInformationParams struct {
-	PersonID string
+	PersonID PersonID
 	Body     *PeopleSetLeaveInformationRequestBody
 }
 
@@ -2273,11 +1892,11 @@ func (s *PeopleService) SetLeaveInformation(ctx context.Context, params *PeopleS
 	if params == nil {
 		params = &PeopleSetLeaveInformationParams{}
 	}
-	path := "/people/:personId/set-leave"
-	if params.PersonID == "" {
-		return nil, fmt.Errorf("personId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":personId", url.PathEscape(params.PersonID))
+	path := "/people/:personId/set-leave"
+	path = strings.ReplaceAll(path, ":personId", url.PathEscape(string(params.PersonID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
@@ -2290,25 +1909,8 @@ func (s *PeopleService) SetLeaveInformation(ctx context.Context, params *PeopleS
 	return out, nil
 }
 
-type PeopleUpdatePersonMetadataRequestBody struct {
-	Employment *PeopleUpdatePersonMetadataEmployment `json:"employment,omitempty"`
-	Name       *PeopleUpdatePersonMetadataName       `json:"name,omitempty"`
-}
-
-type PeopleUpdatePersonMetadataEmployment struct {
-	EndDate   *string `json:"endDate,omitempty"`
-	JobTitle  *string `json:"jobTitle,omitempty"`
-	StartDate *string `json:"startDate,omitempty"`
-	Status    *string `json:"status,omitempty"`
-}
-
-type PeopleUpdatePersonMetadataName struct {
-	First *string `json:"first,omitempty"`
-	Last  *string `json:"last,omitempty"`
-}
-
 type PeopleUpdatePersonMetadataParams struct {
-	PersonID string
+	PersonID PersonID
 	Body     *PeopleUpdatePersonMetadataRequestBody
 }
 
@@ -2317,11 +1919,11 @@ func (s *PeopleService) UpdatePersonMetadata(ctx context.Context, params *People
 	if params == nil {
 		params = &PeopleUpdatePersonMetadataParams{}
 	}
-	path := "/people/:personId"
-	if params.PersonID == "" {
-		return nil, fmt.Errorf("personId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":personId", url.PathEscape(params.PersonID))
+	path := "/people/:personId"
+	path = strings.ReplaceAll(path, ":personId", url.PathEscape(string(params.PersonID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "PATCH", path, query, params.Body)
 	if err != nil {
@@ -2339,55 +1941,45 @@ type PoliciesService struct {
 	client *Client
 }
 
-type PoliciesGetPolicyByIDResponse struct {
-	ApprovedAtDate string         `json:"approvedAtDate"`
-	Description    string         `json:"description"`
-	ID             string         `json:"id"`
-	LatestVersion  map[string]any `json:"latestVersion"`
-	Name           string         `json:"name"`
-	Status         string         `json:"status"`
-}
-
 type PoliciesGetPolicyByIDParams struct {
-	PolicyID string
+	PolicyID PolicyID
 }
 
 // GetPolicyByID Gets a policy by ID. Policy IDs can be found in Vanta in URL bar after /policies/.
-func (s *PoliciesService) GetPolicyByID(ctx context.Context, params *PoliciesGetPolicyByIDParams) (*PoliciesGetPolicyByIDResponse, error) {
+func (s *PoliciesService) GetPolicyByID(ctx context.Context, params *PoliciesGetPolicyByIDParams) (*Policy, error) {
 	if params == nil {
 		params = &PoliciesGetPolicyByIDParams{}
 	}
-	path := "/policies/:policyId"
-	if params.PolicyID == "" {
-		return nil, fmt.Errorf("policyId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":policyId", url.PathEscape(params.PolicyID))
+	path := "/policies/:policyId"
+	path = strings.ReplaceAll(path, ":policyId", url.PathEscape(string(params.PolicyID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &PoliciesGetPolicyByIDResponse{}
+	out := &Policy{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type PoliciesListPoliciesResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type PoliciesListPoliciesParams struct {
 	PageSize   *int
 	PageCursor *string
 }
 
 // ListPolicies Lists all policies.
-func (s *PoliciesService) ListPolicies(ctx context.Context, params *PoliciesListPoliciesParams) (*PoliciesListPoliciesResponse, error) {
+func (s *PoliciesService) ListPolicies(ctx context.Context, params *PoliciesListPoliciesParams) (*ResultsPage[Policy], error) {
 	if params == nil {
 		params = &PoliciesListPoliciesParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/policies"
 	query := url.Values{}
 	if params.PageSize != nil {
@@ -2400,7 +1992,7 @@ func (s *PoliciesService) ListPolicies(ctx context.Context, params *PoliciesList
 	if err != nil {
 		return nil, err
 	}
-	out := &PoliciesListPoliciesResponse{}
+	out := &ResultsPage[Policy]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -2416,12 +2008,15 @@ type ResourcesGetComputersParams struct {
 	ResourceID *string
 }
 
-// GetComputers GetComputers performs GET /v1/resources/macos_user_computer.
+// GetComputers GetComputers performs GET /resources/macos_user_computer.
 func (s *ResourcesService) GetComputers(ctx context.Context, params *ResourcesGetComputersParams) (json.RawMessage, error) {
 	if params == nil {
 		params = &ResourcesGetComputersParams{}
 	}
-	path := "/v1/resources/macos_user_computer"
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	path := "/resources/macos_user_computer"
 	query := url.Values{}
 	if params.ResourceID != nil {
 		query.Set("resourceId", fmt.Sprint(*params.ResourceID))
@@ -2441,12 +2036,15 @@ type ResourcesGetCustomResourceServerParams struct {
 	ResourceID *string
 }
 
-// GetCustomResourceServer GetCustomResourceServer performs GET /v1/resources/custom_resource.
+// GetCustomResourceServer GetCustomResourceServer performs GET /resources/custom_resource.
 func (s *ResourcesService) GetCustomResourceServer(ctx context.Context, params *ResourcesGetCustomResourceServerParams) (json.RawMessage, error) {
 	if params == nil {
 		params = &ResourcesGetCustomResourceServerParams{}
 	}
-	path := "/v1/resources/custom_resource"
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	path := "/resources/custom_resource"
 	query := url.Values{}
 	if params.ResourceID != nil {
 		query.Set("resourceId", fmt.Sprint(*params.ResourceID))
@@ -2466,12 +2064,15 @@ type ResourcesGetUserAccountsParams struct {
 	ResourceID *string
 }
 
-// GetUserAccounts GetUserAccounts performs GET /v1/resources/user_account.
+// GetUserAccounts GetUserAccounts performs GET /resources/user_account.
 func (s *ResourcesService) GetUserAccounts(ctx context.Context, params *ResourcesGetUserAccountsParams) (json.RawMessage, error) {
 	if params == nil {
 		params = &ResourcesGetUserAccountsParams{}
 	}
-	path := "/v1/resources/user_account"
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	path := "/resources/user_account"
 	query := url.Values{}
 	if params.ResourceID != nil {
 		query.Set("resourceId", fmt.Sprint(*params.ResourceID))
@@ -2496,12 +2097,15 @@ type ResourcesSyncCustomResourceServerParams struct {
 	Body *ResourcesSyncCustomResourceServerRequestBody
 }
 
-// SyncCustomResourceServer SyncCustomResourceServer performs PUT /v1/resources/custom_resource.
+// SyncCustomResourceServer SyncCustomResourceServer performs PUT /resources/custom_resource.
 func (s *ResourcesService) SyncCustomResourceServer(ctx context.Context, params *ResourcesSyncCustomResourceServerParams) (json.RawMessage, error) {
 	if params == nil {
 		params = &ResourcesSyncCustomResourceServerParams{}
 	}
-	path := "/v1/resources/custom_resource"
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	path := "/resources/custom_resource"
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "PUT", path, query, params.Body)
 	if err != nil {
@@ -2523,12 +2127,15 @@ type ResourcesSyncMacOsComputersParams struct {
 	Body *ResourcesSyncMacOsComputersRequestBody
 }
 
-// SyncMacOsComputers SyncMacOsComputers performs PUT /v1/resources/macos_user_computer.
+// SyncMacOsComputers SyncMacOsComputers performs PUT /resources/macos_user_computer.
 func (s *ResourcesService) SyncMacOsComputers(ctx context.Context, params *ResourcesSyncMacOsComputersParams) (json.RawMessage, error) {
 	if params == nil {
 		params = &ResourcesSyncMacOsComputersParams{}
 	}
-	path := "/v1/resources/macos_user_computer"
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	path := "/resources/macos_user_computer"
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "PUT", path, query, params.Body)
 	if err != nil {
@@ -2550,12 +2157,15 @@ type ResourcesSyncUserAccountsParams struct {
 	Body *ResourcesSyncUserAccountsRequestBody
 }
 
-// SyncUserAccounts SyncUserAccounts performs PUT /v1/resources/user_account.
+// SyncUserAccounts SyncUserAccounts performs PUT /resources/user_account.
 func (s *ResourcesService) SyncUserAccounts(ctx context.Context, params *ResourcesSyncUserAccountsParams) (json.RawMessage, error) {
 	if params == nil {
 		params = &ResourcesSyncUserAccountsParams{}
 	}
-	path := "/v1/resources/user_account"
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	path := "/resources/user_account"
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "PUT", path, query, params.Body)
 	if err != nil {
@@ -2573,47 +2183,26 @@ type RiskScenariosService struct {
 	client *Client
 }
 
-type RiskScenariosCancelRiskScenarioApprovalRequestResponse struct {
-	Categories         []string `json:"categories"`
-	CiaCategories      []string `json:"ciaCategories"`
-	CustomFields       []any    `json:"customFields"`
-	Description        string   `json:"description"`
-	Impact             float64  `json:"impact"`
-	IsArchived         bool     `json:"isArchived"`
-	IsSensitive        bool     `json:"isSensitive"`
-	Likelihood         float64  `json:"likelihood"`
-	Note               any      `json:"note"`
-	Owner              any      `json:"owner"`
-	RequiredApprovers  []any    `json:"requiredApprovers"`
-	ResidualImpact     float64  `json:"residualImpact"`
-	ResidualLikelihood float64  `json:"residualLikelihood"`
-	ReviewStatus       string   `json:"reviewStatus"`
-	RiskID             string   `json:"riskId"`
-	RiskRegister       string   `json:"riskRegister"`
-	Treatment          string   `json:"treatment"`
-	Type               string   `json:"type"`
-}
-
 type RiskScenariosCancelRiskScenarioApprovalRequestParams struct {
-	RiskScenarioID string
+	RiskScenarioID RiskScenarioID
 }
 
 // CancelRiskScenarioApprovalRequest Cancel approval request for a risk scenario.
-func (s *RiskScenariosService) CancelRiskScenarioApprovalRequest(ctx context.Context, params *RiskScenariosCancelRiskScenarioApprovalRequestParams) (*RiskScenariosCancelRiskScenarioApprovalRequestResponse, error) {
+func (s *RiskScenariosService) CancelRiskScenarioApprovalRequest(ctx context.Context, params *RiskScenariosCancelRiskScenarioApprovalRequestParams) (*RiskScenario, error) {
 	if params == nil {
 		params = &RiskScenariosCancelRiskScenarioApprovalRequestParams{}
 	}
-	path := "/risk-scenarios/:riskScenarioId/cancel-approval-request"
-	if params.RiskScenarioID == "" {
-		return nil, fmt.Errorf("riskScenarioId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":riskScenarioId", url.PathEscape(params.RiskScenarioID))
+	path := "/risk-scenarios/:riskScenarioId/cancel-approval-request"
+	path = strings.ReplaceAll(path, ":riskScenarioId", url.PathEscape(string(params.RiskScenarioID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &RiskScenariosCancelRiskScenarioApprovalRequestResponse{}
+	out := &RiskScenario{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -2621,42 +2210,21 @@ func (s *RiskScenariosService) CancelRiskScenarioApprovalRequest(ctx context.Con
 }
 
 type RiskScenariosCreateRiskScenarioRequestBody struct {
-	Categories         []string         `json:"categories"`
-	CiaCategories      []string         `json:"ciaCategories"`
-	CustomFields       []map[string]any `json:"customFields"`
-	Description        string           `json:"description"`
-	Impact             float64          `json:"impact"`
-	IsSensitive        bool             `json:"isSensitive"`
-	Likelihood         float64          `json:"likelihood"`
-	Note               string           `json:"note"`
-	Owner              string           `json:"owner"`
-	ResidualImpact     float64          `json:"residualImpact"`
-	ResidualLikelihood float64          `json:"residualLikelihood"`
-	RiskID             string           `json:"riskId"`
-	RiskRegister       string           `json:"riskRegister"`
-	Treatment          string           `json:"treatment"`
-	Type               string           `json:"type"`
-}
-
-type RiskScenariosCreateRiskScenarioResponse struct {
-	Categories         []string `json:"categories"`
-	CiaCategories      []string `json:"ciaCategories"`
-	CustomFields       []any    `json:"customFields"`
-	Description        string   `json:"description"`
-	Impact             float64  `json:"impact"`
-	IsArchived         bool     `json:"isArchived"`
-	IsSensitive        bool     `json:"isSensitive"`
-	Likelihood         float64  `json:"likelihood"`
-	Note               any      `json:"note"`
-	Owner              any      `json:"owner"`
-	RequiredApprovers  []any    `json:"requiredApprovers"`
-	ResidualImpact     float64  `json:"residualImpact"`
-	ResidualLikelihood float64  `json:"residualLikelihood"`
-	ReviewStatus       string   `json:"reviewStatus"`
-	RiskID             string   `json:"riskId"`
-	RiskRegister       string   `json:"riskRegister"`
-	Treatment          string   `json:"treatment"`
-	Type               string   `json:"type"`
+	Categories         []string      `json:"categories"`
+	CiaCategories      []string      `json:"ciaCategories"`
+	CustomFields       CustomFields  `json:"customFields"`
+	Description        string        `json:"description"`
+	Impact             float64       `json:"impact"`
+	IsSensitive        bool          `json:"isSensitive"`
+	Likelihood         float64       `json:"likelihood"`
+	Note               string        `json:"note"`
+	Owner              string        `json:"owner"`
+	ResidualImpact     float64       `json:"residualImpact"`
+	ResidualLikelihood float64       `json:"residualLikelihood"`
+	RiskID             string        `json:"riskId"`
+	RiskRegister       string        `json:"riskRegister"`
+	Treatment          RiskTreatment `json:"treatment"`
+	Type               string        `json:"type"`
 }
 
 type RiskScenariosCreateRiskScenarioParams struct {
@@ -2664,74 +2232,52 @@ type RiskScenariosCreateRiskScenarioParams struct {
 }
 
 // CreateRiskScenario Create a new risk scenario.
-func (s *RiskScenariosService) CreateRiskScenario(ctx context.Context, params *RiskScenariosCreateRiskScenarioParams) (*RiskScenariosCreateRiskScenarioResponse, error) {
+func (s *RiskScenariosService) CreateRiskScenario(ctx context.Context, params *RiskScenariosCreateRiskScenarioParams) (*RiskScenario, error) {
 	if params == nil {
 		params = &RiskScenariosCreateRiskScenarioParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/risk-scenarios"
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &RiskScenariosCreateRiskScenarioResponse{}
+	out := &RiskScenario{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type RiskScenariosGetRiskScenarioByIDResponse struct {
-	Categories         []string `json:"categories"`
-	CiaCategories      []string `json:"ciaCategories"`
-	CustomFields       []any    `json:"customFields"`
-	Description        string   `json:"description"`
-	Impact             float64  `json:"impact"`
-	IsArchived         bool     `json:"isArchived"`
-	IsSensitive        bool     `json:"isSensitive"`
-	Likelihood         float64  `json:"likelihood"`
-	Note               any      `json:"note"`
-	Owner              any      `json:"owner"`
-	RequiredApprovers  []any    `json:"requiredApprovers"`
-	ResidualImpact     float64  `json:"residualImpact"`
-	ResidualLikelihood float64  `json:"residualLikelihood"`
-	ReviewStatus       string   `json:"reviewStatus"`
-	RiskID             string   `json:"riskId"`
-	RiskRegister       string   `json:"riskRegister"`
-	Treatment          string   `json:"treatment"`
-	Type               string   `json:"type"`
-}
-
 type RiskScenariosGetRiskScenarioByIDParams struct {
-	RiskScenarioID string
+	RiskScenarioID RiskScenarioID
 }
 
 // GetRiskScenarioByID Get a risk scenario by ID (can be the Risk ID or the object ID).
-func (s *RiskScenariosService) GetRiskScenarioByID(ctx context.Context, params *RiskScenariosGetRiskScenarioByIDParams) (*RiskScenariosGetRiskScenarioByIDResponse, error) {
+func (s *RiskScenariosService) GetRiskScenarioByID(ctx context.Context, params *RiskScenariosGetRiskScenarioByIDParams) (*RiskScenario, error) {
 	if params == nil {
 		params = &RiskScenariosGetRiskScenarioByIDParams{}
 	}
-	path := "/risk-scenarios/:riskScenarioId"
-	if params.RiskScenarioID == "" {
-		return nil, fmt.Errorf("riskScenarioId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":riskScenarioId", url.PathEscape(params.RiskScenarioID))
+	path := "/risk-scenarios/:riskScenarioId"
+	path = strings.ReplaceAll(path, ":riskScenarioId", url.PathEscape(string(params.RiskScenarioID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &RiskScenariosGetRiskScenarioByIDResponse{}
+	out := &RiskScenario{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type RiskScenariosListRiskScenariosResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type RiskScenariosListRiskScenariosParams struct {
 	PageSize                     *int
 	PageCursor                   *string
@@ -2749,10 +2295,13 @@ type RiskScenariosListRiskScenariosParams struct {
 }
 
 // ListRiskScenarios List risk scenarios.
-func (s *RiskScenariosService) ListRiskScenarios(ctx context.Context, params *RiskScenariosListRiskScenariosParams) (*RiskScenariosListRiskScenariosResponse, error) {
+func (s *RiskScenariosService) ListRiskScenarios(ctx context.Context, params *RiskScenariosListRiskScenariosParams) (*ResultsPage[RiskScenario], error) {
 	if params == nil {
 		params = &RiskScenariosListRiskScenariosParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/risk-scenarios"
 	query := url.Values{}
 	if params.PageSize != nil {
@@ -2798,7 +2347,7 @@ func (s *RiskScenariosService) ListRiskScenarios(ctx context.Context, params *Ri
 	if err != nil {
 		return nil, err
 	}
-	out := &RiskScenariosListRiskScenariosResponse{}
+	out := &ResultsPage[RiskScenario]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -2809,48 +2358,27 @@ type RiskScenariosSubmitRiskScenarioForApprovalRequestBody struct {
 	Comment string `json:"comment"`
 }
 
-type RiskScenariosSubmitRiskScenarioForApprovalResponse struct {
-	Categories         []string `json:"categories"`
-	CiaCategories      []string `json:"ciaCategories"`
-	CustomFields       []any    `json:"customFields"`
-	Description        string   `json:"description"`
-	Impact             float64  `json:"impact"`
-	IsArchived         bool     `json:"isArchived"`
-	IsSensitive        bool     `json:"isSensitive"`
-	Likelihood         float64  `json:"likelihood"`
-	Note               any      `json:"note"`
-	Owner              any      `json:"owner"`
-	RequiredApprovers  []any    `json:"requiredApprovers"`
-	ResidualImpact     float64  `json:"residualImpact"`
-	ResidualLikelihood float64  `json:"residualLikelihood"`
-	ReviewStatus       string   `json:"reviewStatus"`
-	RiskID             string   `json:"riskId"`
-	RiskRegister       string   `json:"riskRegister"`
-	Treatment          string   `json:"treatment"`
-	Type               string   `json:"type"`
-}
-
 type RiskScenariosSubmitRiskScenarioForApprovalParams struct {
-	RiskScenarioID string
+	RiskScenarioID RiskScenarioID
 	Body           *RiskScenariosSubmitRiskScenarioForApprovalRequestBody
 }
 
 // SubmitRiskScenarioForApproval Submit a risk scenario for approval.
-func (s *RiskScenariosService) SubmitRiskScenarioForApproval(ctx context.Context, params *RiskScenariosSubmitRiskScenarioForApprovalParams) (*RiskScenariosSubmitRiskScenarioForApprovalResponse, error) {
+func (s *RiskScenariosService) SubmitRiskScenarioForApproval(ctx context.Context, params *RiskScenariosSubmitRiskScenarioForApprovalParams) (*RiskScenario, error) {
 	if params == nil {
 		params = &RiskScenariosSubmitRiskScenarioForApprovalParams{}
 	}
-	path := "/risk-scenarios/:riskScenarioId/submit-for-approval"
-	if params.RiskScenarioID == "" {
-		return nil, fmt.Errorf("riskScenarioId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":riskScenarioId", url.PathEscape(params.RiskScenarioID))
+	path := "/risk-scenarios/:riskScenarioId/submit-for-approval"
+	path = strings.ReplaceAll(path, ":riskScenarioId", url.PathEscape(string(params.RiskScenarioID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &RiskScenariosSubmitRiskScenarioForApprovalResponse{}
+	out := &RiskScenario{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -2858,63 +2386,42 @@ func (s *RiskScenariosService) SubmitRiskScenarioForApproval(ctx context.Context
 }
 
 type RiskScenariosUpdateRiskScenarioRequestBody struct {
-	Categories         []string         `json:"categories"`
-	CiaCategories      []string         `json:"ciaCategories"`
-	CustomFields       []map[string]any `json:"customFields"`
-	Description        string           `json:"description"`
-	Impact             float64          `json:"impact"`
-	IsSensitive        bool             `json:"isSensitive"`
-	Likelihood         float64          `json:"likelihood"`
-	Note               string           `json:"note"`
-	Owner              string           `json:"owner"`
-	ResidualImpact     float64          `json:"residualImpact"`
-	ResidualLikelihood float64          `json:"residualLikelihood"`
-	RiskRegister       string           `json:"riskRegister"`
-	Treatment          string           `json:"treatment"`
-}
-
-type RiskScenariosUpdateRiskScenarioResponse struct {
-	Categories         []string `json:"categories"`
-	CiaCategories      []string `json:"ciaCategories"`
-	CustomFields       []any    `json:"customFields"`
-	Description        string   `json:"description"`
-	Impact             float64  `json:"impact"`
-	IsArchived         bool     `json:"isArchived"`
-	IsSensitive        bool     `json:"isSensitive"`
-	Likelihood         float64  `json:"likelihood"`
-	Note               any      `json:"note"`
-	Owner              any      `json:"owner"`
-	RequiredApprovers  []any    `json:"requiredApprovers"`
-	ResidualImpact     float64  `json:"residualImpact"`
-	ResidualLikelihood float64  `json:"residualLikelihood"`
-	ReviewStatus       string   `json:"reviewStatus"`
-	RiskID             string   `json:"riskId"`
-	RiskRegister       string   `json:"riskRegister"`
-	Treatment          string   `json:"treatment"`
-	Type               string   `json:"type"`
+	Categories         []string      `json:"categories"`
+	CiaCategories      []string      `json:"ciaCategories"`
+	CustomFields       CustomFields  `json:"customFields"`
+	Description        string        `json:"description"`
+	Impact             float64       `json:"impact"`
+	IsSensitive        bool          `json:"isSensitive"`
+	Likelihood         float64       `json:"likelihood"`
+	Note               string        `json:"note"`
+	Owner              string        `json:"owner"`
+	ResidualImpact     float64       `json:"residualImpact"`
+	ResidualLikelihood float64       `json:"residualLikelihood"`
+	RiskRegister       string        `json:"riskRegister"`
+	Treatment          RiskTreatment `json:"treatment"`
 }
 
 type RiskScenariosUpdateRiskScenarioParams struct {
-	RiskScenarioID string
+	RiskScenarioID RiskScenarioID
 	Body           *RiskScenariosUpdateRiskScenarioRequestBody
 }
 
 // UpdateRiskScenario Update a risk scenario.
-func (s *RiskScenariosService) UpdateRiskScenario(ctx context.Context, params *RiskScenariosUpdateRiskScenarioParams) (*RiskScenariosUpdateRiskScenarioResponse, error) {
+func (s *RiskScenariosService) UpdateRiskScenario(ctx context.Context, params *RiskScenariosUpdateRiskScenarioParams) (*RiskScenario, error) {
 	if params == nil {
 		params = &RiskScenariosUpdateRiskScenarioParams{}
 	}
-	path := "/risk-scenarios/:riskScenarioId"
-	if params.RiskScenarioID == "" {
-		return nil, fmt.Errorf("riskScenarioId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":riskScenarioId", url.PathEscape(params.RiskScenarioID))
+	path := "/risk-scenarios/:riskScenarioId"
+	path = strings.ReplaceAll(path, ":riskScenarioId", url.PathEscape(string(params.RiskScenarioID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "PATCH", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &RiskScenariosUpdateRiskScenarioResponse{}
+	out := &RiskScenario{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -2932,8 +2439,8 @@ type TestsDeactivateTestEntityRequestBody struct {
 }
 
 type TestsDeactivateTestEntityParams struct {
-	TestID   string
-	EntityID string
+	TestID   TestID
+	EntityID TestEntityID
 	Body     *TestsDeactivateTestEntityRequestBody
 }
 
@@ -2942,15 +2449,12 @@ func (s *TestsService) DeactivateTestEntity(ctx context.Context, params *TestsDe
 	if params == nil {
 		params = &TestsDeactivateTestEntityParams{}
 	}
-	path := "/tests/:testId/entities/:entityId/deactivate"
-	if params.TestID == "" {
-		return nil, fmt.Errorf("testId is required")
-	}
-	path = strings.ReplaceAll(path, ":testId", url.PathEscape(params.TestID))
-	if params.EntityID == "" {
-		return nil, fmt.Errorf("entityId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":entityId", url.PathEscape(params.EntityID))
+	path := "/tests/:testId/entities/:entityId/deactivate"
+	path = strings.ReplaceAll(path, ":testId", url.PathEscape(string(params.TestID)))
+	path = strings.ReplaceAll(path, ":entityId", url.PathEscape(string(params.EntityID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
@@ -2963,70 +2467,49 @@ func (s *TestsService) DeactivateTestEntity(ctx context.Context, params *TestsDe
 	return out, nil
 }
 
-type TestsGetTestByIDResponse struct {
-	Category               string         `json:"category"`
-	DeactivatedStatusInfo  map[string]any `json:"deactivatedStatusInfo"`
-	Description            string         `json:"description"`
-	FailureDescription     string         `json:"failureDescription"`
-	ID                     string         `json:"id"`
-	Integrations           []string       `json:"integrations"`
-	LastTestRunDate        string         `json:"lastTestRunDate"`
-	LatestFlipDate         any            `json:"latestFlipDate"`
-	Name                   string         `json:"name"`
-	Owner                  any            `json:"owner"`
-	RemediationDescription string         `json:"remediationDescription"`
-	RemediationStatusInfo  map[string]any `json:"remediationStatusInfo"`
-	Status                 string         `json:"status"`
-	Version                map[string]any `json:"version"`
-}
-
 type TestsGetTestByIDParams struct {
-	TestID string
+	TestID TestID
 }
 
 // GetTestByID Gets a test by ID. Test IDs can be found in Vanta in URL bar after /tests/.
-func (s *TestsService) GetTestByID(ctx context.Context, params *TestsGetTestByIDParams) (*TestsGetTestByIDResponse, error) {
+func (s *TestsService) GetTestByID(ctx context.Context, params *TestsGetTestByIDParams) (*Test, error) {
 	if params == nil {
 		params = &TestsGetTestByIDParams{}
 	}
-	path := "/tests/:testId"
-	if params.TestID == "" {
-		return nil, fmt.Errorf("testId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":testId", url.PathEscape(params.TestID))
+	path := "/tests/:testId"
+	path = strings.ReplaceAll(path, ":testId", url.PathEscape(string(params.TestID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &TestsGetTestByIDResponse{}
+	out := &Test{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type TestsGetTestEntitiesByTestIDResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type TestsGetTestEntitiesByTestIDParams struct {
-	TestID       string
-	EntityStatus *string
+	TestID       TestID
+	EntityStatus *TestEntityStatus
 	PageSize     *int
 	PageCursor   *string
 }
 
 // GetTestEntitiesByTestID Gets a list of tested items (entities) for a test by test ID. An entity is a tested item that can have its own outcome. For example, for a test that makes sure that all S3 buckets are versioned, an individual S3 bucket would be an entity.
-func (s *TestsService) GetTestEntitiesByTestID(ctx context.Context, params *TestsGetTestEntitiesByTestIDParams) (*TestsGetTestEntitiesByTestIDResponse, error) {
+func (s *TestsService) GetTestEntitiesByTestID(ctx context.Context, params *TestsGetTestEntitiesByTestIDParams) (*ResultsPage[TestEntity], error) {
 	if params == nil {
 		params = &TestsGetTestEntitiesByTestIDParams{}
 	}
-	path := "/tests/:testId/entities"
-	if params.TestID == "" {
-		return nil, fmt.Errorf("testId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":testId", url.PathEscape(params.TestID))
+	path := "/tests/:testId/entities"
+	path = strings.ReplaceAll(path, ":testId", url.PathEscape(string(params.TestID)))
 	query := url.Values{}
 	if params.EntityStatus != nil {
 		query.Set("entityStatus", fmt.Sprint(*params.EntityStatus))
@@ -3041,34 +2524,33 @@ func (s *TestsService) GetTestEntitiesByTestID(ctx context.Context, params *Test
 	if err != nil {
 		return nil, err
 	}
-	out := &TestsGetTestEntitiesByTestIDResponse{}
+	out := &ResultsPage[TestEntity]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type TestsListTestsResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type TestsListTestsParams struct {
 	PageSize          *int
 	PageCursor        *string
-	StatusFilter      *string
-	FrameworkFilter   *string
-	IntegrationFilter *string
-	ControlFilter     *string
+	StatusFilter      *TestStatus
+	FrameworkFilter   *FrameworkID
+	IntegrationFilter *IntegrationID
+	ControlFilter     *ControlID
 	OwnerFilter       *string
 	CategoryFilter    *string
 	IsInRollout       *bool
 }
 
 // ListTests Lists all tests based on applied filters.
-func (s *TestsService) ListTests(ctx context.Context, params *TestsListTestsParams) (*TestsListTestsResponse, error) {
+func (s *TestsService) ListTests(ctx context.Context, params *TestsListTestsParams) (*ResultsPage[Test], error) {
 	if params == nil {
 		params = &TestsListTestsParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/tests"
 	query := url.Values{}
 	if params.PageSize != nil {
@@ -3102,7 +2584,7 @@ func (s *TestsService) ListTests(ctx context.Context, params *TestsListTestsPara
 	if err != nil {
 		return nil, err
 	}
-	out := &TestsListTestsResponse{}
+	out := &ResultsPage[Test]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -3110,8 +2592,8 @@ func (s *TestsService) ListTests(ctx context.Context, params *TestsListTestsPara
 }
 
 type TestsReactivateTestEntityParams struct {
-	TestID   string
-	EntityID string
+	TestID   TestID
+	EntityID TestEntityID
 }
 
 // ReactivateTestEntity Reactivates a single tested item (test entity). There may be a delay in the reactivation of the test entity until the next test run. Use the /vulnerabilities/reactivate endpoint for vulnerabilities.
@@ -3119,15 +2601,12 @@ func (s *TestsService) ReactivateTestEntity(ctx context.Context, params *TestsRe
 	if params == nil {
 		params = &TestsReactivateTestEntityParams{}
 	}
-	path := "/tests/:testId/entities/:entityId/reactivate"
-	if params.TestID == "" {
-		return nil, fmt.Errorf("testId is required")
-	}
-	path = strings.ReplaceAll(path, ":testId", url.PathEscape(params.TestID))
-	if params.EntityID == "" {
-		return nil, fmt.Errorf("entityId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":entityId", url.PathEscape(params.EntityID))
+	path := "/tests/:testId/entities/:entityId/reactivate"
+	path = strings.ReplaceAll(path, ":testId", url.PathEscape(string(params.TestID)))
+	path = strings.ReplaceAll(path, ":entityId", url.PathEscape(string(params.EntityID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, nil)
 	if err != nil {
@@ -3146,38 +2625,31 @@ type TrustCentersService struct {
 }
 
 type TrustCentersAddTrustCenterControlRequestBody struct {
-	CategoryIDs []string `json:"categoryIds"`
-	ControlID   string   `json:"controlId"`
-}
-
-type TrustCentersAddTrustCenterControlResponse struct {
-	Categories  []map[string]any `json:"categories"`
-	Description string           `json:"description"`
-	ID          string           `json:"id"`
-	Name        string           `json:"name"`
+	CategoryIDs []TrustCenterControlCategoryID `json:"categoryIds"`
+	ControlID   ControlID                      `json:"controlId"`
 }
 
 type TrustCentersAddTrustCenterControlParams struct {
-	SlugID string
+	SlugID TrustCenterSlug
 	Body   *TrustCentersAddTrustCenterControlRequestBody
 }
 
 // AddTrustCenterControl Adds a control to a Trust Center.
-func (s *TrustCentersService) AddTrustCenterControl(ctx context.Context, params *TrustCentersAddTrustCenterControlParams) (*TrustCentersAddTrustCenterControlResponse, error) {
+func (s *TrustCentersService) AddTrustCenterControl(ctx context.Context, params *TrustCentersAddTrustCenterControlParams) (*TrustCenterControl, error) {
 	if params == nil {
 		params = &TrustCentersAddTrustCenterControlParams{}
 	}
-	path := "/trust-centers/:slugId/controls"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId/controls"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersAddTrustCenterControlResponse{}
+	out := &TrustCenterControl{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -3188,32 +2660,27 @@ type TrustCentersAddTrustCenterControlCategoryRequestBody struct {
 	Name string `json:"name"`
 }
 
-type TrustCentersAddTrustCenterControlCategoryResponse struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-}
-
 type TrustCentersAddTrustCenterControlCategoryParams struct {
-	SlugID string
+	SlugID TrustCenterSlug
 	Body   *TrustCentersAddTrustCenterControlCategoryRequestBody
 }
 
 // AddTrustCenterControlCategory Adds a control category to a Trust Center.
-func (s *TrustCentersService) AddTrustCenterControlCategory(ctx context.Context, params *TrustCentersAddTrustCenterControlCategoryParams) (*TrustCentersAddTrustCenterControlCategoryResponse, error) {
+func (s *TrustCentersService) AddTrustCenterControlCategory(ctx context.Context, params *TrustCentersAddTrustCenterControlCategoryParams) (*TrustCenterControlCategoryRef, error) {
 	if params == nil {
 		params = &TrustCentersAddTrustCenterControlCategoryParams{}
 	}
-	path := "/trust-centers/:slugId/control-categories"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId/control-categories"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersAddTrustCenterControlCategoryResponse{}
+	out := &TrustCenterControlCategoryRef{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -3230,42 +2697,27 @@ type TrustCentersAddTrustCenterViewerRequestBody struct {
 	ResourceIDs    []string `json:"resourceIds"`
 }
 
-type TrustCentersAddTrustCenterViewerResponse struct {
-	AccessLevel                 string           `json:"accessLevel"`
-	AddedByUser                 any              `json:"addedByUser"`
-	CompanyName                 string           `json:"companyName"`
-	CreationDate                string           `json:"creationDate"`
-	Email                       string           `json:"email"`
-	ExpirationDate              any              `json:"expirationDate"`
-	ExternalServiceAssociations []map[string]any `json:"externalServiceAssociations"`
-	ID                          string           `json:"id"`
-	Name                        string           `json:"name"`
-	NdaInfo                     any              `json:"ndaInfo"`
-	ResourceIDs                 any              `json:"resourceIds"`
-	UpdatedDate                 string           `json:"updatedDate"`
-}
-
 type TrustCentersAddTrustCenterViewerParams struct {
-	SlugID string
+	SlugID TrustCenterSlug
 	Body   *TrustCentersAddTrustCenterViewerRequestBody
 }
 
 // AddTrustCenterViewer Adds a viewer and grants them access to a Trust Center.
-func (s *TrustCentersService) AddTrustCenterViewer(ctx context.Context, params *TrustCentersAddTrustCenterViewerParams) (*TrustCentersAddTrustCenterViewerResponse, error) {
+func (s *TrustCentersService) AddTrustCenterViewer(ctx context.Context, params *TrustCentersAddTrustCenterViewerParams) (*TrustCenterViewer, error) {
 	if params == nil {
 		params = &TrustCentersAddTrustCenterViewerParams{}
 	}
-	path := "/trust-centers/:slugId/viewers"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId/viewers"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersAddTrustCenterViewerResponse{}
+	out := &TrustCenterViewer{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -3280,8 +2732,8 @@ type TrustCentersApproveTrustCenterAccessRequestRequestBody struct {
 }
 
 type TrustCentersApproveTrustCenterAccessRequestParams struct {
-	SlugID          string
-	AccessRequestID string
+	SlugID          TrustCenterSlug
+	AccessRequestID TrustCenterAccessRequestID
 	Body            *TrustCentersApproveTrustCenterAccessRequestRequestBody
 }
 
@@ -3290,15 +2742,12 @@ func (s *TrustCentersService) ApproveTrustCenterAccessRequest(ctx context.Contex
 	if params == nil {
 		params = &TrustCentersApproveTrustCenterAccessRequestParams{}
 	}
-	path := "/trust-centers/:slugId/access-requests/:accessRequestId/approve"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.AccessRequestID == "" {
-		return nil, fmt.Errorf("accessRequestId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":accessRequestId", url.PathEscape(params.AccessRequestID))
+	path := "/trust-centers/:slugId/access-requests/:accessRequestId/approve"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":accessRequestId", url.PathEscape(string(params.AccessRequestID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
@@ -3323,7 +2772,7 @@ type TrustCentersCreateTrustCenterDocumentResponse struct {
 }
 
 type TrustCentersCreateTrustCenterDocumentParams struct {
-	SlugID string
+	SlugID TrustCenterSlug
 	// FormData maps multipart field names to values.
 	FormData map[string]string
 }
@@ -3333,11 +2782,11 @@ func (s *TrustCentersService) CreateTrustCenterDocument(ctx context.Context, par
 	if params == nil {
 		params = &TrustCentersCreateTrustCenterDocumentParams{}
 	}
-	path := "/trust-centers/:slugId/resources"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId/resources"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	req, err := s.client.newMultipartRequest(ctx, "POST", path, query, params.FormData)
 	if err != nil {
@@ -3362,7 +2811,7 @@ type TrustCentersCreateTrustCenterFaqResponse struct {
 }
 
 type TrustCentersCreateTrustCenterFaqParams struct {
-	SlugID string
+	SlugID TrustCenterSlug
 	Body   *TrustCentersCreateTrustCenterFaqRequestBody
 }
 
@@ -3371,11 +2820,11 @@ func (s *TrustCentersService) CreateTrustCenterFaq(ctx context.Context, params *
 	if params == nil {
 		params = &TrustCentersCreateTrustCenterFaqParams{}
 	}
-	path := "/trust-centers/:slugId/faqs"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId/faqs"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
@@ -3406,7 +2855,7 @@ type TrustCentersCreateTrustCenterSubprocessorResponse struct {
 }
 
 type TrustCentersCreateTrustCenterSubprocessorParams struct {
-	SlugID string
+	SlugID TrustCenterSlug
 	Body   *TrustCentersCreateTrustCenterSubprocessorRequestBody
 }
 
@@ -3415,11 +2864,11 @@ func (s *TrustCentersService) CreateTrustCenterSubprocessor(ctx context.Context,
 	if params == nil {
 		params = &TrustCentersCreateTrustCenterSubprocessorParams{}
 	}
-	path := "/trust-centers/:slugId/subprocessors"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId/subprocessors"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
@@ -3436,34 +2885,27 @@ type TrustCentersCreateTrustCenterSubscriberRequestBody struct {
 	Email string `json:"email"`
 }
 
-type TrustCentersCreateTrustCenterSubscriberResponse struct {
-	CreationDate    string `json:"creationDate"`
-	Email           string `json:"email"`
-	ID              string `json:"id"`
-	IsEmailVerified bool   `json:"isEmailVerified"`
-}
-
 type TrustCentersCreateTrustCenterSubscriberParams struct {
-	SlugID string
+	SlugID TrustCenterSlug
 	Body   *TrustCentersCreateTrustCenterSubscriberRequestBody
 }
 
 // CreateTrustCenterSubscriber Adds a subscriber to a Trust Center.
-func (s *TrustCentersService) CreateTrustCenterSubscriber(ctx context.Context, params *TrustCentersCreateTrustCenterSubscriberParams) (*TrustCentersCreateTrustCenterSubscriberResponse, error) {
+func (s *TrustCentersService) CreateTrustCenterSubscriber(ctx context.Context, params *TrustCentersCreateTrustCenterSubscriberParams) (*TrustCenterSubscriber, error) {
 	if params == nil {
 		params = &TrustCentersCreateTrustCenterSubscriberParams{}
 	}
-	path := "/trust-centers/:slugId/subscribers"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId/subscribers"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersCreateTrustCenterSubscriberResponse{}
+	out := &TrustCenterSubscriber{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -3471,38 +2913,31 @@ func (s *TrustCentersService) CreateTrustCenterSubscriber(ctx context.Context, p
 }
 
 type TrustCentersCreateTrustCenterSubscriberGroupRequestBody struct {
-	Name          string   `json:"name"`
-	SubscriberIDs []string `json:"subscriberIds"`
-}
-
-type TrustCentersCreateTrustCenterSubscriberGroupResponse struct {
-	CreationDate  string   `json:"creationDate"`
-	ID            string   `json:"id"`
-	Name          string   `json:"name"`
-	SubscriberIDs []string `json:"subscriberIds"`
+	Name          string                    `json:"name"`
+	SubscriberIDs []TrustCenterSubscriberID `json:"subscriberIds"`
 }
 
 type TrustCentersCreateTrustCenterSubscriberGroupParams struct {
-	SlugID string
+	SlugID TrustCenterSlug
 	Body   *TrustCentersCreateTrustCenterSubscriberGroupRequestBody
 }
 
 // CreateTrustCenterSubscriberGroup Adds a subscriber group to a Trust Center.
-func (s *TrustCentersService) CreateTrustCenterSubscriberGroup(ctx context.Context, params *TrustCentersCreateTrustCenterSubscriberGroupParams) (*TrustCentersCreateTrustCenterSubscriberGroupResponse, error) {
+func (s *TrustCentersService) CreateTrustCenterSubscriberGroup(ctx context.Context, params *TrustCentersCreateTrustCenterSubscriberGroupParams) (*TrustCenterSubscriberGroup, error) {
 	if params == nil {
 		params = &TrustCentersCreateTrustCenterSubscriberGroupParams{}
 	}
-	path := "/trust-centers/:slugId/subscriber-groups"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId/subscriber-groups"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersCreateTrustCenterSubscriberGroupResponse{}
+	out := &TrustCenterSubscriberGroup{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -3510,47 +2945,36 @@ func (s *TrustCentersService) CreateTrustCenterSubscriberGroup(ctx context.Conte
 }
 
 type TrustCentersCreateTrustCenterUpdateRequestBody struct {
-	Category           string   `json:"category"`
-	Description        string   `json:"description"`
-	NotificationTarget string   `json:"notificationTarget"`
-	NotifiedEmails     []string `json:"notifiedEmails"`
-	SubscriberGroupIDs []string `json:"subscriberGroupIds"`
-	Title              string   `json:"title"`
-	VisibilityType     string   `json:"visibilityType"`
-}
-
-type TrustCentersCreateTrustCenterUpdateResponse struct {
-	Category       string   `json:"category"`
-	CreationDate   string   `json:"creationDate"`
-	Description    string   `json:"description"`
-	ID             string   `json:"id"`
-	NotifiedEmails []string `json:"notifiedEmails"`
-	Title          string   `json:"title"`
-	UpdatedDate    string   `json:"updatedDate"`
-	VisibilityType string   `json:"visibilityType"`
+	Category           string                         `json:"category"`
+	Description        string                         `json:"description"`
+	NotificationTarget string                         `json:"notificationTarget"`
+	NotifiedEmails     []string                       `json:"notifiedEmails"`
+	SubscriberGroupIDs []TrustCenterSubscriberGroupID `json:"subscriberGroupIds"`
+	Title              string                         `json:"title"`
+	VisibilityType     string                         `json:"visibilityType"`
 }
 
 type TrustCentersCreateTrustCenterUpdateParams struct {
-	SlugID string
+	SlugID TrustCenterSlug
 	Body   *TrustCentersCreateTrustCenterUpdateRequestBody
 }
 
 // CreateTrustCenterUpdate Adds an update to a Trust Center.
-func (s *TrustCentersService) CreateTrustCenterUpdate(ctx context.Context, params *TrustCentersCreateTrustCenterUpdateParams) (*TrustCentersCreateTrustCenterUpdateResponse, error) {
+func (s *TrustCentersService) CreateTrustCenterUpdate(ctx context.Context, params *TrustCentersCreateTrustCenterUpdateParams) (*TrustCenterUpdate, error) {
 	if params == nil {
 		params = &TrustCentersCreateTrustCenterUpdateParams{}
 	}
-	path := "/trust-centers/:slugId/updates"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId/updates"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersCreateTrustCenterUpdateResponse{}
+	out := &TrustCenterUpdate{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -3558,8 +2982,8 @@ func (s *TrustCentersService) CreateTrustCenterUpdate(ctx context.Context, param
 }
 
 type TrustCentersDeleteTrustCenterControlParams struct {
-	SlugID    string
-	ControlID string
+	SlugID    TrustCenterSlug
+	ControlID ControlID
 }
 
 // DeleteTrustCenterControl Removes a specific control from a Trust Center. This removes the control from all of the control categories that is in.
@@ -3567,15 +2991,12 @@ func (s *TrustCentersService) DeleteTrustCenterControl(ctx context.Context, para
 	if params == nil {
 		params = &TrustCentersDeleteTrustCenterControlParams{}
 	}
-	path := "/trust-centers/:slugId/controls/:controlId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.ControlID == "" {
-		return nil, fmt.Errorf("controlId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(params.ControlID))
+	path := "/trust-centers/:slugId/controls/:controlId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(string(params.ControlID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "DELETE", path, query, nil)
 	if err != nil {
@@ -3589,8 +3010,8 @@ func (s *TrustCentersService) DeleteTrustCenterControl(ctx context.Context, para
 }
 
 type TrustCentersDeleteTrustCenterControlCategoryParams struct {
-	SlugID     string
-	CategoryID string
+	SlugID     TrustCenterSlug
+	CategoryID TrustCenterControlCategoryID
 }
 
 // DeleteTrustCenterControlCategory Removes a control category from a Trust Center along with all of the controls in the category.
@@ -3598,15 +3019,12 @@ func (s *TrustCentersService) DeleteTrustCenterControlCategory(ctx context.Conte
 	if params == nil {
 		params = &TrustCentersDeleteTrustCenterControlCategoryParams{}
 	}
-	path := "/trust-centers/:slugId/control-categories/:categoryId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.CategoryID == "" {
-		return nil, fmt.Errorf("categoryId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":categoryId", url.PathEscape(params.CategoryID))
+	path := "/trust-centers/:slugId/control-categories/:categoryId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":categoryId", url.PathEscape(string(params.CategoryID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "DELETE", path, query, nil)
 	if err != nil {
@@ -3620,7 +3038,7 @@ func (s *TrustCentersService) DeleteTrustCenterControlCategory(ctx context.Conte
 }
 
 type TrustCentersDeleteTrustCenterDocumentParams struct {
-	SlugID     string
+	SlugID     TrustCenterSlug
 	ResourceID string
 }
 
@@ -3629,14 +3047,11 @@ func (s *TrustCentersService) DeleteTrustCenterDocument(ctx context.Context, par
 	if params == nil {
 		params = &TrustCentersDeleteTrustCenterDocumentParams{}
 	}
-	path := "/trust-centers/:slugId/resources/:resourceId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.ResourceID == "" {
-		return nil, fmt.Errorf("resourceId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
+	path := "/trust-centers/:slugId/resources/:resourceId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	path = strings.ReplaceAll(path, ":resourceId", url.PathEscape(params.ResourceID))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "DELETE", path, query, nil)
@@ -3651,8 +3066,8 @@ func (s *TrustCentersService) DeleteTrustCenterDocument(ctx context.Context, par
 }
 
 type TrustCentersDeleteTrustCenterFaqParams struct {
-	SlugID string
-	FaqID  string
+	SlugID TrustCenterSlug
+	FaqID  TrustCenterFAQID
 }
 
 // DeleteTrustCenterFaq Remove a specific FAQ from the Trust Center by ID.
@@ -3660,15 +3075,12 @@ func (s *TrustCentersService) DeleteTrustCenterFaq(ctx context.Context, params *
 	if params == nil {
 		params = &TrustCentersDeleteTrustCenterFaqParams{}
 	}
-	path := "/trust-centers/:slugId/faqs/:faqId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.FaqID == "" {
-		return nil, fmt.Errorf("faqId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":faqId", url.PathEscape(params.FaqID))
+	path := "/trust-centers/:slugId/faqs/:faqId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":faqId", url.PathEscape(string(params.FaqID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "DELETE", path, query, nil)
 	if err != nil {
@@ -3682,8 +3094,8 @@ func (s *TrustCentersService) DeleteTrustCenterFaq(ctx context.Context, params *
 }
 
 type TrustCentersDeleteTrustCenterSubprocessorParams struct {
-	SlugID         string
-	SubprocessorID string
+	SlugID         TrustCenterSlug
+	SubprocessorID TrustCenterSubprocessorID
 }
 
 // DeleteTrustCenterSubprocessor Removes a subprocessor from a Trust Center.
@@ -3691,15 +3103,12 @@ func (s *TrustCentersService) DeleteTrustCenterSubprocessor(ctx context.Context,
 	if params == nil {
 		params = &TrustCentersDeleteTrustCenterSubprocessorParams{}
 	}
-	path := "/trust-centers/:slugId/subprocessors/:subprocessorId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.SubprocessorID == "" {
-		return nil, fmt.Errorf("subprocessorId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":subprocessorId", url.PathEscape(params.SubprocessorID))
+	path := "/trust-centers/:slugId/subprocessors/:subprocessorId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":subprocessorId", url.PathEscape(string(params.SubprocessorID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "DELETE", path, query, nil)
 	if err != nil {
@@ -3713,8 +3122,8 @@ func (s *TrustCentersService) DeleteTrustCenterSubprocessor(ctx context.Context,
 }
 
 type TrustCentersDeleteTrustCenterSubscriberParams struct {
-	SlugID       string
-	SubscriberID string
+	SlugID       TrustCenterSlug
+	SubscriberID TrustCenterSubscriberID
 }
 
 // DeleteTrustCenterSubscriber Removes a subscriber from a Trust Center.
@@ -3722,15 +3131,12 @@ func (s *TrustCentersService) DeleteTrustCenterSubscriber(ctx context.Context, p
 	if params == nil {
 		params = &TrustCentersDeleteTrustCenterSubscriberParams{}
 	}
-	path := "/trust-centers/:slugId/subscribers/:subscriberId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.SubscriberID == "" {
-		return nil, fmt.Errorf("subscriberId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":subscriberId", url.PathEscape(params.SubscriberID))
+	path := "/trust-centers/:slugId/subscribers/:subscriberId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":subscriberId", url.PathEscape(string(params.SubscriberID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "DELETE", path, query, nil)
 	if err != nil {
@@ -3744,8 +3150,8 @@ func (s *TrustCentersService) DeleteTrustCenterSubscriber(ctx context.Context, p
 }
 
 type TrustCentersDeleteTrustCenterSubscriberGroupParams struct {
-	SlugID            string
-	SubscriberGroupID string
+	SlugID            TrustCenterSlug
+	SubscriberGroupID TrustCenterSubscriberGroupID
 }
 
 // DeleteTrustCenterSubscriberGroup Removes a subscriber group from a Trust Center.
@@ -3753,15 +3159,12 @@ func (s *TrustCentersService) DeleteTrustCenterSubscriberGroup(ctx context.Conte
 	if params == nil {
 		params = &TrustCentersDeleteTrustCenterSubscriberGroupParams{}
 	}
-	path := "/trust-centers/:slugId/subscriber-groups/:subscriberGroupId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.SubscriberGroupID == "" {
-		return nil, fmt.Errorf("subscriberGroupId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":subscriberGroupId", url.PathEscape(params.SubscriberGroupID))
+	path := "/trust-centers/:slugId/subscriber-groups/:subscriberGroupId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":subscriberGroupId", url.PathEscape(string(params.SubscriberGroupID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "DELETE", path, query, nil)
 	if err != nil {
@@ -3775,8 +3178,8 @@ func (s *TrustCentersService) DeleteTrustCenterSubscriberGroup(ctx context.Conte
 }
 
 type TrustCentersDeleteTrustCenterUpdateParams struct {
-	SlugID   string
-	UpdateID string
+	SlugID   TrustCenterSlug
+	UpdateID TrustCenterUpdateID
 }
 
 // DeleteTrustCenterUpdate Removes an update from a Trust Center.
@@ -3784,15 +3187,12 @@ func (s *TrustCentersService) DeleteTrustCenterUpdate(ctx context.Context, param
 	if params == nil {
 		params = &TrustCentersDeleteTrustCenterUpdateParams{}
 	}
-	path := "/trust-centers/:slugId/updates/:updateId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.UpdateID == "" {
-		return nil, fmt.Errorf("updateId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":updateId", url.PathEscape(params.UpdateID))
+	path := "/trust-centers/:slugId/updates/:updateId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":updateId", url.PathEscape(string(params.UpdateID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "DELETE", path, query, nil)
 	if err != nil {
@@ -3806,8 +3206,8 @@ func (s *TrustCentersService) DeleteTrustCenterUpdate(ctx context.Context, param
 }
 
 type TrustCentersDenyTrustCenterAccessRequestParams struct {
-	SlugID          string
-	AccessRequestID string
+	SlugID          TrustCenterSlug
+	AccessRequestID TrustCenterAccessRequestID
 }
 
 // DenyTrustCenterAccessRequest Denies an access request on a Trust Center.
@@ -3815,15 +3215,12 @@ func (s *TrustCentersService) DenyTrustCenterAccessRequest(ctx context.Context,
 	if params == nil {
 		params = &TrustCentersDenyTrustCenterAccessRequestParams{}
 	}
-	path := "/trust-centers/:slugId/access-requests/:accessRequestId/deny"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.AccessRequestID == "" {
-		return nil, fmt.Errorf("accessRequestId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":accessRequestId", url.PathEscape(params.AccessRequestID))
+	path := "/trust-centers/:slugId/access-requests/:accessRequestId/deny"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":accessRequestId", url.PathEscape(string(params.AccessRequestID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, nil)
 	if err != nil {
@@ -3840,39 +3237,29 @@ type TrustCentersEditTrustCenterSubscriberGroupRequestBody struct {
 	Name string `json:"name"`
 }
 
-type TrustCentersEditTrustCenterSubscriberGroupResponse struct {
-	CreationDate  string   `json:"creationDate"`
-	ID            string   `json:"id"`
-	Name          string   `json:"name"`
-	SubscriberIDs []string `json:"subscriberIds"`
-}
-
 type TrustCentersEditTrustCenterSubscriberGroupParams struct {
-	SlugID            string
-	SubscriberGroupID string
+	SlugID            TrustCenterSlug
+	SubscriberGroupID TrustCenterSubscriberGroupID
 	Body              *TrustCentersEditTrustCenterSubscriberGroupRequestBody
 }
 
 // EditTrustCenterSubscriberGroup Edits a Trust Center subscriber group.
-func (s *TrustCentersService) EditTrustCenterSubscriberGroup(ctx context.Context, params *TrustCentersEditTrustCenterSubscriberGroupParams) (*TrustCentersEditTrustCenterSubscriberGroupResponse, error) {
+func (s *TrustCentersService) EditTrustCenterSubscriberGroup(ctx context.Context, params *TrustCentersEditTrustCenterSubscriberGroupParams) (*TrustCenterSubscriberGroup, error) {
 	if params == nil {
 		params = &TrustCentersEditTrustCenterSubscriberGroupParams{}
 	}
-	path := "/trust-centers/:slugId/subscriber-groups/:subscriberGroupId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.SubscriberGroupID == "" {
-		return nil, fmt.Errorf("subscriberGroupId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":subscriberGroupId", url.PathEscape(params.SubscriberGroupID))
+	path := "/trust-centers/:slugId/subscriber-groups/:subscriberGroupId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":subscriberGroupId", url.PathEscape(string(params.SubscriberGroupID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "PATCH", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersEditTrustCenterSubscriberGroupResponse{}
+	out := &TrustCenterSubscriberGroup{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -3893,7 +3280,7 @@ type TrustCentersGetTrustCenterResponse struct {
 }
 
 type TrustCentersGetTrustCenterParams struct {
-	SlugID string
+	SlugID TrustCenterSlug
 }
 
 // GetTrustCenter Gets a Trust Center by slug ID.
@@ -3901,11 +3288,11 @@ func (s *TrustCentersService) GetTrustCenter(ctx context.Context, params *TrustC
 	if params == nil {
 		params = &TrustCentersGetTrustCenterParams{}
 	}
-	path := "/trust-centers/:slugId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
@@ -3918,117 +3305,84 @@ func (s *TrustCentersService) GetTrustCenter(ctx context.Context, params *TrustC
 	return out, nil
 }
 
-type TrustCentersGetTrustCenterAccessRequestResponse struct {
-	AccessLevel        string `json:"accessLevel"`
-	CompanyName        string `json:"companyName"`
-	CreationDate       string `json:"creationDate"`
-	Email              string `json:"email"`
-	ID                 string `json:"id"`
-	Name               string `json:"name"`
-	Reason             string `json:"reason"`
-	RequestedResources any    `json:"requestedResources"`
-	UpdatedDate        string `json:"updatedDate"`
-}
-
 type TrustCentersGetTrustCenterAccessRequestParams struct {
-	SlugID          string
-	AccessRequestID string
+	SlugID          TrustCenterSlug
+	AccessRequestID TrustCenterAccessRequestID
 }
 
 // GetTrustCenterAccessRequest Gets a specific access request for a Trust Center.
-func (s *TrustCentersService) GetTrustCenterAccessRequest(ctx context.Context, params *TrustCentersGetTrustCenterAccessRequestParams) (*TrustCentersGetTrustCenterAccessRequestResponse, error) {
+func (s *TrustCentersService) GetTrustCenterAccessRequest(ctx context.Context, params *TrustCentersGetTrustCenterAccessRequestParams) (*TrustCenterAccessRequest, error) {
 	if params == nil {
 		params = &TrustCentersGetTrustCenterAccessRequestParams{}
 	}
-	path := "/trust-centers/:slugId/access-requests/:accessRequestId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.AccessRequestID == "" {
-		return nil, fmt.Errorf("accessRequestId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":accessRequestId", url.PathEscape(params.AccessRequestID))
+	path := "/trust-centers/:slugId/access-requests/:accessRequestId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":accessRequestId", url.PathEscape(string(params.AccessRequestID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersGetTrustCenterAccessRequestResponse{}
+	out := &TrustCenterAccessRequest{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type TrustCentersGetTrustCenterControlResponse struct {
-	Categories  []map[string]any `json:"categories"`
-	Description string           `json:"description"`
-	ID          string           `json:"id"`
-	Name        string           `json:"name"`
-}
-
 type TrustCentersGetTrustCenterControlParams struct {
-	SlugID    string
-	ControlID string
+	SlugID    TrustCenterSlug
+	ControlID ControlID
 }
 
 // GetTrustCenterControl Gets a specific control on a Trust Center.
-func (s *TrustCentersService) GetTrustCenterControl(ctx context.Context, params *TrustCentersGetTrustCenterControlParams) (*TrustCentersGetTrustCenterControlResponse, error) {
+func (s *TrustCentersService) GetTrustCenterControl(ctx context.Context, params *TrustCentersGetTrustCenterControlParams) (*TrustCenterControl, error) {
 	if params == nil {
 		params = &TrustCentersGetTrustCenterControlParams{}
 	}
-	path := "/trust-centers/:slugId/controls/:controlId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.ControlID == "" {
-		return nil, fmt.Errorf("controlId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(params.ControlID))
+	path := "/trust-centers/:slugId/controls/:controlId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":controlId", url.PathEscape(string(params.ControlID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersGetTrustCenterControlResponse{}
+	out := &TrustCenterControl{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type TrustCentersGetTrustCenterControlCategoryResponse struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-}
-
 type TrustCentersGetTrustCenterControlCategoryParams struct {
-	SlugID     string
-	CategoryID string
+	SlugID     TrustCenterSlug
+	CategoryID TrustCenterControlCategoryID
 }
 
 // GetTrustCenterControlCategory Gets a specific control category on a Trust Center.
-func (s *TrustCentersService) GetTrustCenterControlCategory(ctx context.Context, params *TrustCentersGetTrustCenterControlCategoryParams) (*TrustCentersGetTrustCenterControlCategoryResponse, error) {
+func (s *TrustCentersService) GetTrustCenterControlCategory(ctx context.Context, params *TrustCentersGetTrustCenterControlCategoryParams) (*TrustCenterControlCategoryRef, error) {
 	if params == nil {
 		params = &TrustCentersGetTrustCenterControlCategoryParams{}
 	}
-	path := "/trust-centers/:slugId/control-categories/:categoryId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.CategoryID == "" {
-		return nil, fmt.Errorf("categoryId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":categoryId", url.PathEscape(params.CategoryID))
+	path := "/trust-centers/:slugId/control-categories/:categoryId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":categoryId", url.PathEscape(string(params.CategoryID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersGetTrustCenterControlCategoryResponse{}
+	out := &TrustCenterControlCategoryRef{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -4047,7 +3401,7 @@ type TrustCentersGetTrustCenterDocumentResponse struct {
 }
 
 type TrustCentersGetTrustCenterDocumentParams struct {
-	SlugID     string
+	SlugID     TrustCenterSlug
 	ResourceID string
 }
 
@@ -4056,14 +3410,11 @@ func (s *TrustCentersService) GetTrustCenterDocument(ctx context.Context, params
 	if params == nil {
 		params = &TrustCentersGetTrustCenterDocumentParams{}
 	}
-	path := "/trust-centers/:slugId/resources/:resourceId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.ResourceID == "" {
-		return nil, fmt.Errorf("resourceId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
+	path := "/trust-centers/:slugId/resources/:resourceId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	path = strings.ReplaceAll(path, ":resourceId", url.PathEscape(params.ResourceID))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
@@ -4084,8 +3435,8 @@ type TrustCentersGetTrustCenterFaqResponse struct {
 }
 
 type TrustCentersGetTrustCenterFaqParams struct {
-	SlugID string
-	FaqID  string
+	SlugID TrustCenterSlug
+	FaqID  TrustCenterFAQID
 }
 
 // GetTrustCenterFaq Gets a specific FAQ on the Trust Center by ID.
@@ -4093,15 +3444,12 @@ func (s *TrustCentersService) GetTrustCenterFaq(ctx context.Context, params *Tru
 	if params == nil {
 		params = &TrustCentersGetTrustCenterFaqParams{}
 	}
-	path := "/trust-centers/:slugId/faqs/:faqId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.FaqID == "" {
-		return nil, fmt.Errorf("faqId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":faqId", url.PathEscape(params.FaqID))
+	path := "/trust-centers/:slugId/faqs/:faqId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":faqId", url.PathEscape(string(params.FaqID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
@@ -4124,8 +3472,8 @@ type TrustCentersGetTrustCenterSubprocessorResponse struct {
 }
 
 type TrustCentersGetTrustCenterSubprocessorParams struct {
-	SlugID         string
-	SubprocessorID string
+	SlugID         TrustCenterSlug
+	SubprocessorID TrustCenterSubprocessorID
 }
 
 // GetTrustCenterSubprocessor Gets a specific subprocessor on a Trust Center.
@@ -4133,15 +3481,12 @@ func (s *TrustCentersService) GetTrustCenterSubprocessor(ctx context.Context, pa
 	if params == nil {
 		params = &TrustCentersGetTrustCenterSubprocessorParams{}
 	}
-	path := "/trust-centers/:slugId/subprocessors/:subprocessorId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.SubprocessorID == "" {
-		return nil, fmt.Errorf("subprocessorId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":subprocessorId", url.PathEscape(params.SubprocessorID))
+	path := "/trust-centers/:slugId/subprocessors/:subprocessorId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":subprocessorId", url.PathEscape(string(params.SubprocessorID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
@@ -4154,164 +3499,112 @@ func (s *TrustCentersService) GetTrustCenterSubprocessor(ctx context.Context, pa
 	return out, nil
 }
 
-type TrustCentersGetTrustCenterSubscriberResponse struct {
-	CreationDate    string `json:"creationDate"`
-	Email           string `json:"email"`
-	ID              string `json:"id"`
-	IsEmailVerified bool   `json:"isEmailVerified"`
-}
-
 type TrustCentersGetTrustCenterSubscriberParams struct {
-	SlugID       string
-	SubscriberID string
+	SlugID       TrustCenterSlug
+	SubscriberID TrustCenterSubscriberID
 }
 
 // GetTrustCenterSubscriber Gets a specific subscriber on a Trust Center.
-func (s *TrustCentersService) GetTrustCenterSubscriber(ctx context.Context, params *TrustCentersGetTrustCenterSubscriberParams) (*TrustCentersGetTrustCenterSubscriberResponse, error) {
+func (s *TrustCentersService) GetTrustCenterSubscriber(ctx context.Context, params *TrustCentersGetTrustCenterSubscriberParams) (*TrustCenterSubscriber, error) {
 	if params == nil {
 		params = &TrustCentersGetTrustCenterSubscriberParams{}
 	}
-	path := "/trust-centers/:slugId/subscribers/:subscriberId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.SubscriberID == "" {
-		return nil, fmt.Errorf("subscriberId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":subscriberId", url.PathEscape(params.SubscriberID))
+	path := "/trust-centers/:slugId/subscribers/:subscriberId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":subscriberId", url.PathEscape(string(params.SubscriberID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersGetTrustCenterSubscriberResponse{}
+	out := &TrustCenterSubscriber{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type TrustCentersGetTrustCenterSubscriberGroupResponse struct {
-	CreationDate  string   `json:"creationDate"`
-	ID            string   `json:"id"`
-	Name          string   `json:"name"`
-	SubscriberIDs []string `json:"subscriberIds"`
-}
-
 type TrustCentersGetTrustCenterSubscriberGroupParams struct {
-	SlugID            string
-	SubscriberGroupID string
+	SlugID            TrustCenterSlug
+	SubscriberGroupID TrustCenterSubscriberGroupID
 }
 
 // GetTrustCenterSubscriberGroup Get a subscriber group by ID.
-func (s *TrustCentersService) GetTrustCenterSubscriberGroup(ctx context.Context, params *TrustCentersGetTrustCenterSubscriberGroupParams) (*TrustCentersGetTrustCenterSubscriberGroupResponse, error) {
+func (s *TrustCentersService) GetTrustCenterSubscriberGroup(ctx context.Context, params *TrustCentersGetTrustCenterSubscriberGroupParams) (*TrustCenterSubscriberGroup, error) {
 	if params == nil {
 		params = &TrustCentersGetTrustCenterSubscriberGroupParams{}
 	}
-	path := "/trust-centers/:slugId/subscriber-groups/:subscriberGroupId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.SubscriberGroupID == "" {
-		return nil, fmt.Errorf("subscriberGroupId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":subscriberGroupId", url.PathEscape(params.SubscriberGroupID))
+	path := "/trust-centers/:slugId/subscriber-groups/:subscriberGroupId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":subscriberGroupId", url.PathEscape(string(params.SubscriberGroupID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersGetTrustCenterSubscriberGroupResponse{}
+	out := &TrustCenterSubscriberGroup{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type TrustCentersGetTrustCenterUpdateResponse struct {
-	Category       string   `json:"category"`
-	CreationDate   string   `json:"creationDate"`
-	Description    string   `json:"description"`
-	ID             string   `json:"id"`
-	NotifiedEmails []string `json:"notifiedEmails"`
-	Title          string   `json:"title"`
-	UpdatedDate    string   `json:"updatedDate"`
-	VisibilityType string   `json:"visibilityType"`
-}
-
 type TrustCentersGetTrustCenterUpdateParams struct {
-	SlugID   string
-	UpdateID string
+	SlugID   TrustCenterSlug
+	UpdateID TrustCenterUpdateID
 }
 
 // GetTrustCenterUpdate Gets a specific update on a Trust Center.
-func (s *TrustCentersService) GetTrustCenterUpdate(ctx context.Context, params *TrustCentersGetTrustCenterUpdateParams) (*TrustCentersGetTrustCenterUpdateResponse, error) {
+func (s *TrustCentersService) GetTrustCenterUpdate(ctx context.Context, params *TrustCentersGetTrustCenterUpdateParams) (*TrustCenterUpdate, error) {
 	if params == nil {
 		params = &TrustCentersGetTrustCenterUpdateParams{}
 	}
-	path := "/trust-centers/:slugId/updates/:updateId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.UpdateID == "" {
-		return nil, fmt.Errorf("updateId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":updateId", url.PathEscape(params.UpdateID))
+	path := "/trust-centers/:slugId/updates/:updateId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":updateId", url.PathEscape(string(params.UpdateID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersGetTrustCenterUpdateResponse{}
+	out := &TrustCenterUpdate{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type TrustCentersGetTrustCenterViewerResponse struct {
-	AccessLevel                 string           `json:"accessLevel"`
-	AddedByUser                 any              `json:"addedByUser"`
-	CompanyName                 string           `json:"companyName"`
-	CreationDate                string           `json:"creationDate"`
-	Email                       string           `json:"email"`
-	ExpirationDate              any              `json:"expirationDate"`
-	ExternalServiceAssociations []map[string]any `json:"externalServiceAssociations"`
-	ID                          string           `json:"id"`
-	Name                        string           `json:"name"`
-	NdaInfo                     any              `json:"ndaInfo"`
-	ResourceIDs                 any              `json:"resourceIds"`
-	UpdatedDate                 string           `json:"updatedDate"`
-}
-
 type TrustCentersGetTrustCenterViewerParams struct {
-	SlugID   string
-	ViewerID string
+	SlugID   TrustCenterSlug
+	ViewerID TrustCenterViewerID
 }
 
 // GetTrustCenterViewer Gets a specific viewer for a Trust Center.
-func (s *TrustCentersService) GetTrustCenterViewer(ctx context.Context, params *TrustCentersGetTrustCenterViewerParams) (*TrustCentersGetTrustCenterViewerResponse, error) {
+func (s *TrustCentersService) GetTrustCenterViewer(ctx context.Context, params *TrustCentersGetTrustCenterViewerParams) (*TrustCenterViewer, error) {
 	if params == nil {
 		params = &TrustCentersGetTrustCenterViewerParams{}
 	}
-	path := "/trust-centers/:slugId/viewers/:viewerId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.ViewerID == "" {
-		return nil, fmt.Errorf("viewerId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":viewerId", url.PathEscape(params.ViewerID))
+	path := "/trust-centers/:slugId/viewers/:viewerId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":viewerId", url.PathEscape(string(params.ViewerID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersGetTrustCenterViewerResponse{}
+	out := &TrustCenterViewer{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -4323,7 +3616,7 @@ type TrustCentersGetUploadedMediaForTrustCenterDocumentResponse struct {
 }
 
 type TrustCentersGetUploadedMediaForTrustCenterDocumentParams struct {
-	SlugID     string
+	SlugID     TrustCenterSlug
 	ResourceID string
 }
 
@@ -4332,14 +3625,11 @@ func (s *TrustCentersService) GetUploadedMediaForTrustCenterDocument(ctx context
 	if params == nil {
 		params = &TrustCentersGetUploadedMediaForTrustCenterDocumentParams{}
 	}
-	path := "/trust-centers/:slugId/resources/:resourceId/media"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.ResourceID == "" {
-		return nil, fmt.Errorf("resourceId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
+	path := "/trust-centers/:slugId/resources/:resourceId/media"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	path = strings.ReplaceAll(path, ":resourceId", url.PathEscape(params.ResourceID))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
@@ -4353,26 +3643,22 @@ func (s *TrustCentersService) GetUploadedMediaForTrustCenterDocument(ctx context
 	return out, nil
 }
 
-type TrustCentersListHistoricalTrustCenterAccessRequestsResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type TrustCentersListHistoricalTrustCenterAccessRequestsParams struct {
-	SlugID     string
+	SlugID     TrustCenterSlug
 	PageSize   *int
 	PageCursor *string
 }
 
 // ListHistoricalTrustCenterAccessRequests Gets a list of historical (approved or denied) access requests for a Trust Center.
-func (s *TrustCentersService) ListHistoricalTrustCenterAccessRequests(ctx context.Context, params *TrustCentersListHistoricalTrustCenterAccessRequestsParams) (*TrustCentersListHistoricalTrustCenterAccessRequestsResponse, error) {
+func (s *TrustCentersService) ListHistoricalTrustCenterAccessRequests(ctx context.Context, params *TrustCentersListHistoricalTrustCenterAccessRequestsParams) (*ResultsPage[TrustCenterAccessRequest], error) {
 	if params == nil {
 		params = &TrustCentersListHistoricalTrustCenterAccessRequestsParams{}
 	}
-	path := "/trust-centers/:slugId/historical-access-requests"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId/historical-access-requests"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	if params.PageSize != nil {
 		query.Set("pageSize", fmt.Sprint(*params.PageSize))
@@ -4384,33 +3670,29 @@ func (s *TrustCentersService) ListHistoricalTrustCenterAccessRequests(ctx contex
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersListHistoricalTrustCenterAccessRequestsResponse{}
+	out := &ResultsPage[TrustCenterAccessRequest]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type TrustCentersListTrustCenterAccessRequestsResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type TrustCentersListTrustCenterAccessRequestsParams struct {
-	SlugID     string
+	SlugID     TrustCenterSlug
 	PageSize   *int
 	PageCursor *string
 }
 
 // ListTrustCenterAccessRequests Gets a list of access requests for a Trust Center.
-func (s *TrustCentersService) ListTrustCenterAccessRequests(ctx context.Context, params *TrustCentersListTrustCenterAccessRequestsParams) (*TrustCentersListTrustCenterAccessRequestsResponse, error) {
+func (s *TrustCentersService) ListTrustCenterAccessRequests(ctx context.Context, params *TrustCentersListTrustCenterAccessRequestsParams) (*ResultsPage[TrustCenterAccessRequest], error) {
 	if params == nil {
 		params = &TrustCentersListTrustCenterAccessRequestsParams{}
 	}
-	path := "/trust-centers/:slugId/access-requests"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId/access-requests"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	if params.PageSize != nil {
 		query.Set("pageSize", fmt.Sprint(*params.PageSize))
@@ -4422,7 +3704,7 @@ func (s *TrustCentersService) ListTrustCenterAccessRequests(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersListTrustCenterAccessRequestsResponse{}
+	out := &ResultsPage[TrustCenterAccessRequest]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -4434,7 +3716,7 @@ type TrustCentersListTrustCenterControlCategoriesResponse struct {
 }
 
 type TrustCentersListTrustCenterControlCategoriesParams struct {
-	SlugID string
+	SlugID TrustCenterSlug
 }
 
 // ListTrustCenterControlCategories Gets a list of control categories on a Trust Center.
@@ -4442,11 +3724,11 @@ func (s *TrustCentersService) ListTrustCenterControlCategories(ctx context.Conte
 	if params == nil {
 		params = &TrustCentersListTrustCenterControlCategoriesParams{}
 	}
-	path := "/trust-centers/:slugId/control-categories"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId/control-categories"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
@@ -4459,26 +3741,22 @@ func (s *TrustCentersService) ListTrustCenterControlCategories(ctx context.Conte
 	return out, nil
 }
 
-type TrustCentersListTrustCenterControlsResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type TrustCentersListTrustCenterControlsParams struct {
-	SlugID     string
+	SlugID     TrustCenterSlug
 	PageSize   *int
 	PageCursor *string
 }
 
 // ListTrustCenterControls Gets a list of controls on a Trust Center.
-func (s *TrustCentersService) ListTrustCenterControls(ctx context.Context, params *TrustCentersListTrustCenterControlsParams) (*TrustCentersListTrustCenterControlsResponse, error) {
+func (s *TrustCentersService) ListTrustCenterControls(ctx context.Context, params *TrustCentersListTrustCenterControlsParams) (*ResultsPage[TrustCenterControl], error) {
 	if params == nil {
 		params = &TrustCentersListTrustCenterControlsParams{}
 	}
-	path := "/trust-centers/:slugId/controls"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId/controls"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	if params.PageSize != nil {
 		query.Set("pageSize", fmt.Sprint(*params.PageSize))
@@ -4490,7 +3768,7 @@ func (s *TrustCentersService) ListTrustCenterControls(ctx context.Context, param
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersListTrustCenterControlsResponse{}
+	out := &ResultsPage[TrustCenterControl]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -4502,7 +3780,7 @@ type TrustCentersListTrustCenterFaqsResponse struct {
 }
 
 type TrustCentersListTrustCenterFaqsParams struct {
-	SlugID string
+	SlugID TrustCenterSlug
 }
 
 // ListTrustCenterFaqs Gets a list of FAQs on a Trust Center.
@@ -4510,11 +3788,11 @@ func (s *TrustCentersService) ListTrustCenterFaqs(ctx context.Context, params *T
 	if params == nil {
 		params = &TrustCentersListTrustCenterFaqsParams{}
 	}
-	path := "/trust-centers/:slugId/faqs"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId/faqs"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
@@ -4532,7 +3810,7 @@ type TrustCentersListTrustCenterResourcesResponse struct {
 }
 
 type TrustCentersListTrustCenterResourcesParams struct {
-	SlugID string
+	SlugID TrustCenterSlug
 }
 
 // ListTrustCenterResources Gets a list of resources on a Trust Center.
@@ -4540,11 +3818,11 @@ func (s *TrustCentersService) ListTrustCenterResources(ctx context.Context, para
 	if params == nil {
 		params = &TrustCentersListTrustCenterResourcesParams{}
 	}
-	path := "/trust-centers/:slugId/resources"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId/resources"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
@@ -4562,7 +3840,7 @@ type TrustCentersListTrustCenterSubprocessorsResponse struct {
 }
 
 type TrustCentersListTrustCenterSubprocessorsParams struct {
-	SlugID string
+	SlugID TrustCenterSlug
 }
 
 // ListTrustCenterSubprocessors Gets the list of subprocessors on a Trust Center.
@@ -4570,11 +3848,11 @@ func (s *TrustCentersService) ListTrustCenterSubprocessors(ctx context.Context,
 	if params == nil {
 		params = &TrustCentersListTrustCenterSubprocessorsParams{}
 	}
-	path := "/trust-centers/:slugId/subprocessors"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId/subprocessors"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
@@ -4587,26 +3865,22 @@ func (s *TrustCentersService) ListTrustCenterSubprocessors(ctx context.Context,
 	return out, nil
 }
 
-type TrustCentersListTrustCenterSubscriberGroupsResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type TrustCentersListTrustCenterSubscriberGroupsParams struct {
-	SlugID     string
+	SlugID     TrustCenterSlug
 	PageSize   *int
 	PageCursor *string
 }
 
 // ListTrustCenterSubscriberGroups Gets a list of subscriber groups on a Trust Center.
-func (s *TrustCentersService) ListTrustCenterSubscriberGroups(ctx context.Context, params *TrustCentersListTrustCenterSubscriberGroupsParams) (*TrustCentersListTrustCenterSubscriberGroupsResponse, error) {
+func (s *TrustCentersService) ListTrustCenterSubscriberGroups(ctx context.Context, params *TrustCentersListTrustCenterSubscriberGroupsParams) (*ResultsPage[TrustCenterSubscriberGroup], error) {
 	if params == nil {
 		params = &TrustCentersListTrustCenterSubscriberGroupsParams{}
 	}
-	path := "/trust-centers/:slugId/subscriber-groups"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId/subscriber-groups"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	if params.PageSize != nil {
 		query.Set("pageSize", fmt.Sprint(*params.PageSize))
@@ -4618,33 +3892,29 @@ func (s *TrustCentersService) ListTrustCenterSubscriberGroups(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersListTrustCenterSubscriberGroupsResponse{}
+	out := &ResultsPage[TrustCenterSubscriberGroup]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type TrustCentersListTrustCenterSubscribersResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type TrustCentersListTrustCenterSubscribersParams struct {
-	SlugID     string
+	SlugID     TrustCenterSlug
 	PageSize   *int
 	PageCursor *string
 }
 
 // ListTrustCenterSubscribers Gets a list of subscribers on a Trust Center.
-func (s *TrustCentersService) ListTrustCenterSubscribers(ctx context.Context, params *TrustCentersListTrustCenterSubscribersParams) (*TrustCentersListTrustCenterSubscribersResponse, error) {
+func (s *TrustCentersService) ListTrustCenterSubscribers(ctx context.Context, params *TrustCentersListTrustCenterSubscribersParams) (*ResultsPage[TrustCenterSubscriber], error) {
 	if params == nil {
 		params = &TrustCentersListTrustCenterSubscribersParams{}
 	}
-	path := "/trust-centers/:slugId/subscribers"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId/subscribers"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	if params.PageSize != nil {
 		query.Set("pageSize", fmt.Sprint(*params.PageSize))
@@ -4656,33 +3926,29 @@ func (s *TrustCentersService) ListTrustCenterSubscribers(ctx context.Context, pa
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersListTrustCenterSubscribersResponse{}
+	out := &ResultsPage[TrustCenterSubscriber]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type TrustCentersListTrustCenterUpdatesResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type TrustCentersListTrustCenterUpdatesParams struct {
-	SlugID     string
+	SlugID     TrustCenterSlug
 	PageSize   *int
 	PageCursor *string
 }
 
 // ListTrustCenterUpdates Gets a list of updates on a Trust Center.
-func (s *TrustCentersService) ListTrustCenterUpdates(ctx context.Context, params *TrustCentersListTrustCenterUpdatesParams) (*TrustCentersListTrustCenterUpdatesResponse, error) {
+func (s *TrustCentersService) ListTrustCenterUpdates(ctx context.Context, params *TrustCentersListTrustCenterUpdatesParams) (*ResultsPage[TrustCenterUpdate], error) {
 	if params == nil {
 		params = &TrustCentersListTrustCenterUpdatesParams{}
 	}
-	path := "/trust-centers/:slugId/updates"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId/updates"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	if params.PageSize != nil {
 		query.Set("pageSize", fmt.Sprint(*params.PageSize))
@@ -4694,19 +3960,15 @@ func (s *TrustCentersService) ListTrustCenterUpdates(ctx context.Context, params
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersListTrustCenterUpdatesResponse{}
+	out := &ResultsPage[TrustCenterUpdate]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type TrustCentersListTrustCenterViewerActivityEventsResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type TrustCentersListTrustCenterViewerActivityEventsParams struct {
-	SlugID               string
+	SlugID               TrustCenterSlug
 	PageSize             *int
 	PageCursor           *string
 	EventTypesMatchesAny []string
@@ -4715,15 +3977,15 @@ type TrustCentersListTrustCenterViewerActivityEventsParams struct {
 }
 
 // ListTrustCenterViewerActivityEvents Gets a list of viewer activity events on a Trust Center.
-func (s *TrustCentersService) ListTrustCenterViewerActivityEvents(ctx context.Context, params *TrustCentersListTrustCenterViewerActivityEventsParams) (*TrustCentersListTrustCenterViewerActivityEventsResponse, error) {
+func (s *TrustCentersService) ListTrustCenterViewerActivityEvents(ctx context.Context, params *TrustCentersListTrustCenterViewerActivityEventsParams) (*ResultsPage[TrustCenterViewerActivityEvent], error) {
 	if params == nil {
 		params = &TrustCentersListTrustCenterViewerActivityEventsParams{}
 	}
-	path := "/trust-centers/:slugId/activity"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId/activity"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	if params.PageSize != nil {
 		query.Set("pageSize", fmt.Sprint(*params.PageSize))
@@ -4744,34 +4006,30 @@ func (s *TrustCentersService) ListTrustCenterViewerActivityEvents(ctx context.Co
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersListTrustCenterViewerActivityEventsResponse{}
+	out := &ResultsPage[TrustCenterViewerActivityEvent]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type TrustCentersListTrustCenterViewersResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type TrustCentersListTrustCenterViewersParams struct {
-	SlugID         string
+	SlugID         TrustCenterSlug
 	PageSize       *int
 	PageCursor     *string
 	IncludeRemoved *bool
 }
 
 // ListTrustCenterViewers Gets a list of viewers that have been granted access to a Trust Center.
-func (s *TrustCentersService) ListTrustCenterViewers(ctx context.Context, params *TrustCentersListTrustCenterViewersParams) (*TrustCentersListTrustCenterViewersResponse, error) {
+func (s *TrustCentersService) ListTrustCenterViewers(ctx context.Context, params *TrustCentersListTrustCenterViewersParams) (*ResultsPage[TrustCenterViewer], error) {
 	if params == nil {
 		params = &TrustCentersListTrustCenterViewersParams{}
 	}
-	path := "/trust-centers/:slugId/viewers"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId/viewers"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	if params.PageSize != nil {
 		query.Set("pageSize", fmt.Sprint(*params.PageSize))
@@ -4786,7 +4044,7 @@ func (s *TrustCentersService) ListTrustCenterViewers(ctx context.Context, params
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersListTrustCenterViewersResponse{}
+	out := &ResultsPage[TrustCenterViewer]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -4794,8 +4052,8 @@ func (s *TrustCentersService) ListTrustCenterViewers(ctx context.Context, params
 }
 
 type TrustCentersRemoveTrustCenterViewerParams struct {
-	SlugID   string
-	ViewerID string
+	SlugID   TrustCenterSlug
+	ViewerID TrustCenterViewerID
 }
 
 // RemoveTrustCenterViewer Revokes a viewer's access to a Trust Center.
@@ -4803,15 +4061,12 @@ func (s *TrustCentersService) RemoveTrustCenterViewer(ctx context.Context, param
 	if params == nil {
 		params = &TrustCentersRemoveTrustCenterViewerParams{}
 	}
-	path := "/trust-centers/:slugId/viewers/:viewerId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.ViewerID == "" {
-		return nil, fmt.Errorf("viewerId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":viewerId", url.PathEscape(params.ViewerID))
+	path := "/trust-centers/:slugId/viewers/:viewerId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":viewerId", url.PathEscape(string(params.ViewerID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "DELETE", path, query, nil)
 	if err != nil {
@@ -4825,8 +4080,8 @@ func (s *TrustCentersService) RemoveTrustCenterViewer(ctx context.Context, param
 }
 
 type TrustCentersSendTrustCenterUpdateNotificationsToAllSubscribersParams struct {
-	SlugID   string
-	UpdateID string
+	SlugID   TrustCenterSlug
+	UpdateID TrustCenterUpdateID
 }
 
 // SendTrustCenterUpdateNotificationsToAllSubscribers Sends notifications for a specific Trust Center update to all subscribers.
@@ -4834,15 +4089,12 @@ func (s *TrustCentersService) SendTrustCenterUpdateNotificationsToAllSubscribers
 	if params == nil {
 		params = &TrustCentersSendTrustCenterUpdateNotificationsToAllSubscribersParams{}
 	}
-	path := "/trust-centers/:slugId/updates/:updateId/notify-all-subscribers"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.UpdateID == "" {
-		return nil, fmt.Errorf("updateId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":updateId", url.PathEscape(params.UpdateID))
+	path := "/trust-centers/:slugId/updates/:updateId/notify-all-subscribers"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":updateId", url.PathEscape(string(params.UpdateID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, nil)
 	if err != nil {
@@ -4856,13 +4108,13 @@ func (s *TrustCentersService) SendTrustCenterUpdateNotificationsToAllSubscribers
 }
 
 type TrustCentersSendTrustCenterUpdateNotificationsToSpecificSubscribersRequestBody struct {
-	Emails             []string `json:"emails"`
-	SubscriberGroupIDs []string `json:"subscriberGroupIds"`
+	Emails             []string                       `json:"emails"`
+	SubscriberGroupIDs []TrustCenterSubscriberGroupID `json:"subscriberGroupIds"`
 }
 
 type TrustCentersSendTrustCenterUpdateNotificationsToSpecificSubscribersParams struct {
-	SlugID   string
-	UpdateID string
+	SlugID   TrustCenterSlug
+	UpdateID TrustCenterUpdateID
 	Body     *TrustCentersSendTrustCenterUpdateNotificationsToSpecificSubscribersRequestBody
 }
 
@@ -4871,15 +4123,12 @@ func (s *TrustCentersService) SendTrustCenterUpdateNotificationsToSpecificSubscr
 	if params == nil {
 		params = &TrustCentersSendTrustCenterUpdateNotificationsToSpecificSubscribersParams{}
 	}
-	path := "/trust-centers/:slugId/updates/:updateId/notify-specific-subscribers"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.UpdateID == "" {
-		return nil, fmt.Errorf("updateId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":updateId", url.PathEscape(params.UpdateID))
+	path := "/trust-centers/:slugId/updates/:updateId/notify-specific-subscribers"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":updateId", url.PathEscape(string(params.UpdateID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
@@ -4893,42 +4142,32 @@ func (s *TrustCentersService) SendTrustCenterUpdateNotificationsToSpecificSubscr
 }
 
 type TrustCentersSetGroupsForTrustCenterSubscriberRequestBody struct {
-	GroupIDs []string `json:"groupIds"`
-}
-
-type TrustCentersSetGroupsForTrustCenterSubscriberResponse struct {
-	CreationDate    string `json:"creationDate"`
-	Email           string `json:"email"`
-	ID              string `json:"id"`
-	IsEmailVerified bool   `json:"isEmailVerified"`
+	GroupIDs []TrustCenterSubscriberGroupID `json:"groupIds"`
 }
 
 type TrustCentersSetGroupsForTrustCenterSubscriberParams struct {
-	SlugID       string
-	SubscriberID string
+	SlugID       TrustCenterSlug
+	SubscriberID TrustCenterSubscriberID
 	Body         *TrustCentersSetGroupsForTrustCenterSubscriberRequestBody
 }
 
 // SetGroupsForTrustCenterSubscriber Sets groups on a subscriber.
-func (s *TrustCentersService) SetGroupsForTrustCenterSubscriber(ctx context.Context, params *TrustCentersSetGroupsForTrustCenterSubscriberParams) (*TrustCentersSetGroupsForTrustCenterSubscriberResponse, error) {
+func (s *TrustCentersService) SetGroupsForTrustCenterSubscriber(ctx context.Context, params *TrustCentersSetGroupsForTrustCenterSubscriberParams) (*TrustCenterSubscriber, error) {
 	if params == nil {
 		params = &TrustCentersSetGroupsForTrustCenterSubscriberParams{}
 	}
-	path := "/trust-centers/:slugId/subscribers/:subscriberId/groups"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.SubscriberID == "" {
-		return nil, fmt.Errorf("subscriberId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":subscriberId", url.PathEscape(params.SubscriberID))
+	path := "/trust-centers/:slugId/subscribers/:subscriberId/groups"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":subscriberId", url.PathEscape(string(params.SubscriberID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "PUT", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersSetGroupsForTrustCenterSubscriberResponse{}
+	out := &TrustCenterSubscriber{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -4958,7 +4197,7 @@ type TrustCentersUpdateTrustCenterResponse struct {
 }
 
 type TrustCentersUpdateTrustCenterParams struct {
-	SlugID string
+	SlugID TrustCenterSlug
 	Body   *TrustCentersUpdateTrustCenterRequestBody
 }
 
@@ -4967,11 +4206,11 @@ func (s *TrustCentersService) UpdateTrustCenter(ctx context.Context, params *Tru
 	if params == nil {
 		params = &TrustCentersUpdateTrustCenterParams{}
 	}
-	path := "/trust-centers/:slugId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
+	path := "/trust-centers/:slugId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "PATCH", path, query, params.Body)
 	if err != nil {
@@ -4988,37 +4227,29 @@ type TrustCentersUpdateTrustCenterControlCategoryRequestBody struct {
 	Name string `json:"name"`
 }
 
-type TrustCentersUpdateTrustCenterControlCategoryResponse struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-}
-
 type TrustCentersUpdateTrustCenterControlCategoryParams struct {
-	SlugID     string
-	CategoryID string
+	SlugID     TrustCenterSlug
+	CategoryID TrustCenterControlCategoryID
 	Body       *TrustCentersUpdateTrustCenterControlCategoryRequestBody
 }
 
 // UpdateTrustCenterControlCategory Updates a control category on a Trust Center.
-func (s *TrustCentersService) UpdateTrustCenterControlCategory(ctx context.Context, params *TrustCentersUpdateTrustCenterControlCategoryParams) (*TrustCentersUpdateTrustCenterControlCategoryResponse, error) {
+func (s *TrustCentersService) UpdateTrustCenterControlCategory(ctx context.Context, params *TrustCentersUpdateTrustCenterControlCategoryParams) (*TrustCenterControlCategoryRef, error) {
 	if params == nil {
 		params = &TrustCentersUpdateTrustCenterControlCategoryParams{}
 	}
-	path := "/trust-centers/:slugId/control-categories/:categoryId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.CategoryID == "" {
-		return nil, fmt.Errorf("categoryId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":categoryId", url.PathEscape(params.CategoryID))
+	path := "/trust-centers/:slugId/control-categories/:categoryId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":categoryId", url.PathEscape(string(params.CategoryID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "PATCH", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersUpdateTrustCenterControlCategoryResponse{}
+	out := &TrustCenterControlCategoryRef{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -5043,7 +4274,7 @@ type TrustCentersUpdateTrustCenterDocumentResponse struct {
 }
 
 type TrustCentersUpdateTrustCenterDocumentParams struct {
-	SlugID     string
+	SlugID     TrustCenterSlug
 	ResourceID string
 	Body       *TrustCentersUpdateTrustCenterDocumentRequestBody
 }
@@ -5053,14 +4284,11 @@ func (s *TrustCentersService) UpdateTrustCenterDocument(ctx context.Context, par
 	if params == nil {
 		params = &TrustCentersUpdateTrustCenterDocumentParams{}
 	}
-	path := "/trust-centers/:slugId/resources/:resourceId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.ResourceID == "" {
-		return nil, fmt.Errorf("resourceId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
+	path := "/trust-centers/:slugId/resources/:resourceId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
 	path = strings.ReplaceAll(path, ":resourceId", url.PathEscape(params.ResourceID))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "PATCH", path, query, params.Body)
@@ -5086,8 +4314,8 @@ type TrustCentersUpdateTrustCenterFaqResponse struct {
 }
 
 type TrustCentersUpdateTrustCenterFaqParams struct {
-	SlugID string
-	FaqID  string
+	SlugID TrustCenterSlug
+	FaqID  TrustCenterFAQID
 	Body   *TrustCentersUpdateTrustCenterFaqRequestBody
 }
 
@@ -5096,15 +4324,12 @@ func (s *TrustCentersService) UpdateTrustCenterFaq(ctx context.Context, params *
 	if params == nil {
 		params = &TrustCentersUpdateTrustCenterFaqParams{}
 	}
-	path := "/trust-centers/:slugId/faqs/:faqId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.FaqID == "" {
-		return nil, fmt.Errorf("faqId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":faqId", url.PathEscape(params.FaqID))
+	path := "/trust-centers/:slugId/faqs/:faqId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":faqId", url.PathEscape(string(params.FaqID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "PATCH", path, query, params.Body)
 	if err != nil {
@@ -5133,8 +4358,8 @@ type TrustCentersUpdateTrustCenterSubprocessorResponse struct {
 }
 
 type TrustCentersUpdateTrustCenterSubprocessorParams struct {
-	SlugID         string
-	SubprocessorID string
+	SlugID         TrustCenterSlug
+	SubprocessorID TrustCenterSubprocessorID
 	Body           *TrustCentersUpdateTrustCenterSubprocessorRequestBody
 }
 
@@ -5143,15 +4368,12 @@ func (s *TrustCentersService) UpdateTrustCenterSubprocessor(ctx context.Context,
 	if params == nil {
 		params = &TrustCentersUpdateTrustCenterSubprocessorParams{}
 	}
-	path := "/trust-centers/:slugId/subprocessors/:subprocessorId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.SubprocessorID == "" {
-		return nil, fmt.Errorf("subprocessorId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":subprocessorId", url.PathEscape(params.SubprocessorID))
+	path := "/trust-centers/:slugId/subprocessors/:subprocessorId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":subprocessorId", url.PathEscape(string(params.SubprocessorID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "PATCH", path, query, params.Body)
 	if err != nil {
@@ -5171,43 +4393,29 @@ type TrustCentersUpdateTrustCenterUpdateRequestBody struct {
 	VisibilityType string `json:"visibilityType"`
 }
 
-type TrustCentersUpdateTrustCenterUpdateResponse struct {
-	Category       string   `json:"category"`
-	CreationDate   string   `json:"creationDate"`
-	Description    string   `json:"description"`
-	ID             string   `json:"id"`
-	NotifiedEmails []string `json:"notifiedEmails"`
-	Title          string   `json:"title"`
-	UpdatedDate    string   `json:"updatedDate"`
-	VisibilityType string   `json:"visibilityType"`
-}
-
 type TrustCentersUpdateTrustCenterUpdateParams struct {
-	SlugID   string
-	UpdateID string
+	SlugID   TrustCenterSlug
+	UpdateID TrustCenterUpdateID
 	Body     *TrustCentersUpdateTrustCenterUpdateRequestBody
 }
 
 // UpdateTrustCenterUpdate Updates an update on a Trust Center.
-func (s *TrustCentersService) UpdateTrustCenterUpdate(ctx context.Context, params *TrustCentersUpdateTrustCenterUpdateParams) (*TrustCentersUpdateTrustCenterUpdateResponse, error) {
+func (s *TrustCentersService) UpdateTrustCenterUpdate(ctx context.Context, params *TrustCentersUpdateTrustCenterUpdateParams) (*TrustCenterUpdate, error) {
 	if params == nil {
 		params = &TrustCentersUpdateTrustCenterUpdateParams{}
 	}
-	path := "/trust-centers/:slugId/updates/:updateId"
-	if params.SlugID == "" {
-		return nil, fmt.Errorf("slugId is required")
-	}
-	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(params.SlugID))
-	if params.UpdateID == "" {
-		return nil, fmt.Errorf("updateId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":updateId", url.PathEscape(params.UpdateID))
+	path := "/trust-centers/:slugId/updates/:updateId"
+	path = strings.ReplaceAll(path, ":slugId", url.PathEscape(string(params.SlugID)))
+	path = strings.ReplaceAll(path, ":updateId", url.PathEscape(string(params.UpdateID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "PATCH", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &TrustCentersUpdateTrustCenterUpdateResponse{}
+	out := &TrustCenterUpdate{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -5219,20 +4427,19 @@ type VendorRiskAttributesService struct {
 	client *Client
 }
 
-type VendorRiskAttributesListVendorRiskAttributesResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type VendorRiskAttributesListVendorRiskAttributesParams struct {
 	PageSize   *int
 	PageCursor *string
 }
 
 // ListVendorRiskAttributes Returns a list of vendor risk attributes.
-func (s *VendorRiskAttributesService) ListVendorRiskAttributes(ctx context.Context, params *VendorRiskAttributesListVendorRiskAttributesParams) (*VendorRiskAttributesListVendorRiskAttributesResponse, error) {
+func (s *VendorRiskAttributesService) ListVendorRiskAttributes(ctx context.Context, params *VendorRiskAttributesListVendorRiskAttributesParams) (*ResultsPage[VendorRiskAttribute], error) {
 	if params == nil {
 		params = &VendorRiskAttributesListVendorRiskAttributesParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/vendor-risk-attributes"
 	query := url.Values{}
 	if params.PageSize != nil {
@@ -5245,7 +4452,7 @@ func (s *VendorRiskAttributesService) ListVendorRiskAttributes(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
-	out := &VendorRiskAttributesListVendorRiskAttributesResponse{}
+	out := &ResultsPage[VendorRiskAttribute]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -5257,89 +4464,58 @@ type VendorsService struct {
 	client *Client
 }
 
-type VendorsAddDocumentToSecurityReviewResponse struct {
-	CreationDate string         `json:"creationDate"`
-	DeletionDate any            `json:"deletionDate"`
-	Description  string         `json:"description"`
-	FileName     string         `json:"fileName"`
-	ID           string         `json:"id"`
-	MimeType     string         `json:"mimeType"`
-	Title        string         `json:"title"`
-	Type         string         `json:"type"`
-	URL          string         `json:"url"`
-	UpdatedDate  string         `json:"updatedDate"`
-	UploadedBy   map[string]any `json:"uploadedBy"`
-}
-
 type VendorsAddDocumentToSecurityReviewParams struct {
-	VendorID         string
-	SecurityReviewID string
+	VendorID         VendorID
+	SecurityReviewID VendorSecurityReviewID
 	// FormData maps multipart field names to values.
 	FormData map[string]string
 }
 
 // AddDocumentToSecurityReview Add document to a security review.
-func (s *VendorsService) AddDocumentToSecurityReview(ctx context.Context, params *VendorsAddDocumentToSecurityReviewParams) (*VendorsAddDocumentToSecurityReviewResponse, error) {
+func (s *VendorsService) AddDocumentToSecurityReview(ctx context.Context, params *VendorsAddDocumentToSecurityReviewParams) (*VendorDocument, error) {
 	if params == nil {
 		params = &VendorsAddDocumentToSecurityReviewParams{}
 	}
-	path := "/vendors/:vendorId/security-reviews/:securityReviewId/documents"
-	if params.VendorID == "" {
-		return nil, fmt.Errorf("vendorId is required")
-	}
-	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(params.VendorID))
-	if params.SecurityReviewID == "" {
-		return nil, fmt.Errorf("securityReviewId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":securityReviewId", url.PathEscape(params.SecurityReviewID))
+	path := "/vendors/:vendorId/security-reviews/:securityReviewId/documents"
+	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(string(params.VendorID)))
+	path = strings.ReplaceAll(path, ":securityReviewId", url.PathEscape(string(params.SecurityReviewID)))
 	query := url.Values{}
 	req, err := s.client.newMultipartRequest(ctx, "POST", path, query, params.FormData)
 	if err != nil {
 		return nil, err
 	}
-	out := &VendorsAddDocumentToSecurityReviewResponse{}
+	out := &VendorDocument{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type VendorsAddDocumentToVendorResponse struct {
-	CreationDate string         `json:"creationDate"`
-	DeletionDate any            `json:"deletionDate"`
-	Description  string         `json:"description"`
-	FileName     string         `json:"fileName"`
-	ID           string         `json:"id"`
-	MimeType     string         `json:"mimeType"`
-	Title        string         `json:"title"`
-	Type         string         `json:"type"`
-	URL          string         `json:"url"`
-	UpdatedDate  string         `json:"updatedDate"`
-	UploadedBy   map[string]any `json:"uploadedBy"`
-}
-
 type VendorsAddDocumentToVendorParams struct {
-	VendorID string
+	VendorID VendorID
 	// FormData maps multipart field names to values.
 	FormData map[string]string
 }
 
 // AddDocumentToVendor Add document to a vendor.
-func (s *VendorsService) AddDocumentToVendor(ctx context.Context, params *VendorsAddDocumentToVendorParams) (*VendorsAddDocumentToVendorResponse, error) {
+func (s *VendorsService) AddDocumentToVendor(ctx context.Context, params *VendorsAddDocumentToVendorParams) (*VendorDocument, error) {
 	if params == nil {
 		params = &VendorsAddDocumentToVendorParams{}
 	}
-	path := "/vendors/:vendorId/documents"
-	if params.VendorID == "" {
-		return nil, fmt.Errorf("vendorId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(params.VendorID))
+	path := "/vendors/:vendorId/documents"
+	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(string(params.VendorID)))
 	query := url.Values{}
 	req, err := s.client.newMultipartRequest(ctx, "POST", path, query, params.FormData)
 	if err != nil {
 		return nil, err
 	}
-	out := &VendorsAddDocumentToVendorResponse{}
+	out := &VendorDocument{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -5347,44 +4523,34 @@ func (s *VendorsService) AddDocumentToVendor(ctx context.Context, params *Vendor
 }
 
 type VendorsAddVendorFindingRequestBody struct {
-	Content          string         `json:"content"`
-	DocumentID       string         `json:"documentId"`
-	Remediation      map[string]any `json:"remediation"`
-	RiskStatus       string         `json:"riskStatus"`
-	SecurityReviewID string         `json:"securityReviewId"`
-}
-
-type VendorsAddVendorFindingResponse struct {
-	Content          string         `json:"content"`
-	DocumentID       any            `json:"documentId"`
-	ID               string         `json:"id"`
-	Remediation      map[string]any `json:"remediation"`
-	RiskStatus       string         `json:"riskStatus"`
-	SecurityReviewID string         `json:"securityReviewId"`
-	VendorID         string         `json:"vendorId"`
+	Content          string                 `json:"content"`
+	DocumentID       DocumentID             `json:"documentId"`
+	Remediation      map[string]any         `json:"remediation"`
+	RiskStatus       string                 `json:"riskStatus"`
+	SecurityReviewID VendorSecurityReviewID `json:"securityReviewId"`
 }
 
 type VendorsAddVendorFindingParams struct {
-	VendorID string
+	VendorID VendorID
 	Body     *VendorsAddVendorFindingRequestBody
 }
 
 // AddVendorFinding Add vendor finding.
-func (s *VendorsService) AddVendorFinding(ctx context.Context, params *VendorsAddVendorFindingParams) (*VendorsAddVendorFindingResponse, error) {
+func (s *VendorsService) AddVendorFinding(ctx context.Context, params *VendorsAddVendorFindingParams) (*VendorFinding, error) {
 	if params == nil {
 		params = &VendorsAddVendorFindingParams{}
 	}
-	path := "/vendors/:vendorId/findings"
-	if params.VendorID == "" {
-		return nil, fmt.Errorf("vendorId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(params.VendorID))
+	path := "/vendors/:vendorId/findings"
+	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(string(params.VendorID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &VendorsAddVendorFindingResponse{}
+	out := &VendorFinding{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -5392,57 +4558,27 @@ func (s *VendorsService) AddVendorFinding(ctx context.Context, params *VendorsAd
 }
 
 type VendorsCreateVendorRequestBody struct {
-	AccountManagerEmail     string           `json:"accountManagerEmail"`
-	AccountManagerName      string           `json:"accountManagerName"`
-	AdditionalNotes         string           `json:"additionalNotes"`
-	AuthDetails             map[string]any   `json:"authDetails"`
-	BusinessOwnerUserID     string           `json:"businessOwnerUserId"`
-	Category                string           `json:"category"`
-	ContractAmount          map[string]any   `json:"contractAmount"`
-	ContractRenewalDate     string           `json:"contractRenewalDate"`
-	ContractStartDate       string           `json:"contractStartDate"`
-	ContractTerminationDate string           `json:"contractTerminationDate"`
-	CustomFields            []map[string]any `json:"customFields"`
-	FrameworkScope          map[string]any   `json:"frameworkScope"`
-	InherentRiskLevel       string           `json:"inherentRiskLevel"`
-	IsVisibleToAuditors     bool             `json:"isVisibleToAuditors"`
-	Name                    string           `json:"name"`
-	ResidualRiskLevel       string           `json:"residualRiskLevel"`
-	SecurityOwnerUserID     string           `json:"securityOwnerUserId"`
-	ServicesProvided        string           `json:"servicesProvided"`
-	Status                  string           `json:"status"`
-	VendorHeadquarters      string           `json:"vendorHeadquarters"`
-	WebsiteURL              string           `json:"websiteUrl"`
-}
-
-type VendorsCreateVendorResponse struct {
-	AccountManagerEmail              string         `json:"accountManagerEmail"`
-	AccountManagerName               string         `json:"accountManagerName"`
-	AdditionalNotes                  string         `json:"additionalNotes"`
-	AuthDetails                      map[string]any `json:"authDetails"`
-	BusinessOwnerUserID              string         `json:"businessOwnerUserId"`
-	Category                         map[string]any `json:"category"`
-	ContractAmount                   map[string]any `json:"contractAmount"`
-	ContractRenewalDate              string         `json:"contractRenewalDate"`
-	ContractStartDate                string         `json:"contractStartDate"`
-	ContractTerminationDate          any            `json:"contractTerminationDate"`
-	CustomFields                     any            `json:"customFields"`
-	ID                               string         `json:"id"`
-	InherentRiskLevel                string         `json:"inherentRiskLevel"`
-	IsRiskAutoScored                 bool           `json:"isRiskAutoScored"`
-	IsVisibleToAuditors              bool           `json:"isVisibleToAuditors"`
-	LastSecurityReviewCompletionDate string         `json:"lastSecurityReviewCompletionDate"`
-	LatestDecision                   map[string]any `json:"latestDecision"`
-	Name                             string         `json:"name"`
-	NextSecurityReviewDueDate        string         `json:"nextSecurityReviewDueDate"`
-	ResidualRiskLevel                string         `json:"residualRiskLevel"`
-	RiskAttributeIDs                 []string       `json:"riskAttributeIds"`
-	SecurityOwnerUserID              string         `json:"securityOwnerUserId"`
-	ServicesProvided                 string         `json:"servicesProvided"`
-	Status                           string         `json:"status"`
-	TagIDentifiers                   any            `json:"tagIdentifiers"`
-	VendorHeadquarters               string         `json:"vendorHeadquarters"`
-	WebsiteURL                       string         `json:"websiteUrl"`
+	AccountManagerEmail     string         `json:"accountManagerEmail"`
+	AccountManagerName      string         `json:"accountManagerName"`
+	AdditionalNotes         string         `json:"additionalNotes"`
+	AuthDetails             map[string]any `json:"authDetails"`
+	BusinessOwnerUserID     string         `json:"businessOwnerUserId"`
+	Category                string         `json:"category"`
+	ContractAmount          map[string]any `json:"contractAmount"`
+	ContractRenewalDate     string         `json:"contractRenewalDate"`
+	ContractStartDate       string         `json:"contractStartDate"`
+	ContractTerminationDate string         `json:"contractTerminationDate"`
+	CustomFields            CustomFields   `json:"customFields"`
+	FrameworkScope          map[string]any `json:"frameworkScope"`
+	InherentRiskLevel       string         `json:"inherentRiskLevel"`
+	IsVisibleToAuditors     bool           `json:"isVisibleToAuditors"`
+	Name                    string         `json:"name"`
+	ResidualRiskLevel       string         `json:"residualRiskLevel"`
+	SecurityOwnerUserID     string         `json:"securityOwnerUserId"`
+	ServicesProvided        string         `json:"servicesProvided"`
+	Status                  string         `json:"status"`
+	VendorHeadquarters      string         `json:"vendorHeadquarters"`
+	WebsiteURL              string         `json:"websiteUrl"`
 }
 
 type VendorsCreateVendorParams struct {
@@ -5450,17 +4586,20 @@ type VendorsCreateVendorParams struct {
 }
 
 // CreateVendor Add vendor with metadata.
-func (s *VendorsService) CreateVendor(ctx context.Context, params *VendorsCreateVendorParams) (*VendorsCreateVendorResponse, error) {
+func (s *VendorsService) CreateVendor(ctx context.Context, params *VendorsCreateVendorParams) (*Vendor, error) {
 	if params == nil {
 		params = &VendorsCreateVendorParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/vendors"
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &VendorsCreateVendorResponse{}
+	out := &Vendor{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -5468,8 +4607,8 @@ func (s *VendorsService) CreateVendor(ctx context.Context, params *VendorsCreate
 }
 
 type VendorsDeleteFindingByIDParams struct {
-	VendorID  string
-	FindingID string
+	VendorID  VendorID
+	FindingID VendorFindingID
 }
 
 // DeleteFindingByID Deletes a finding.
@@ -5477,15 +4616,12 @@ func (s *VendorsService) DeleteFindingByID(ctx context.Context, params *VendorsD
 	if params == nil {
 		params = &VendorsDeleteFindingByIDParams{}
 	}
-	path := "/vendors/:vendorId/findings/:findingId"
-	if params.VendorID == "" {
-		return nil, fmt.Errorf("vendorId is required")
-	}
-	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(params.VendorID))
-	if params.FindingID == "" {
-		return nil, fmt.Errorf("findingId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":findingId", url.PathEscape(params.FindingID))
+	path := "/vendors/:vendorId/findings/:findingId"
+	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(string(params.VendorID)))
+	path = strings.ReplaceAll(path, ":findingId", url.PathEscape(string(params.FindingID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "DELETE", path, query, nil)
 	if err != nil {
@@ -5499,9 +4635,9 @@ func (s *VendorsService) DeleteFindingByID(ctx context.Context, params *VendorsD
 }
 
 type VendorsDeleteSecurityReviewDocumentByIDParams struct {
-	VendorID         string
-	SecurityReviewID string
-	DocumentID       string
+	VendorID         VendorID
+	SecurityReviewID VendorSecurityReviewID
+	DocumentID       DocumentID
 }
 
 // DeleteSecurityReviewDocumentByID Delete a security review document.
@@ -5509,19 +4645,13 @@ func (s *VendorsService) DeleteSecurityReviewDocumentByID(ctx context.Context, p
 	if params == nil {
 		params = &VendorsDeleteSecurityReviewDocumentByIDParams{}
 	}
-	path := "/vendors/:vendorId/security-reviews/:securityReviewId/documents/:documentId"
-	if params.VendorID == "" {
-		return nil, fmt.Errorf("vendorId is required")
-	}
-	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(params.VendorID))
-	if params.SecurityReviewID == "" {
-		return nil, fmt.Errorf("securityReviewId is required")
-	}
-	path = strings.ReplaceAll(path, ":securityReviewId", url.PathEscape(params.SecurityReviewID))
-	if params.DocumentID == "" {
-		return nil, fmt.Errorf("documentId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(params.DocumentID))
+	path := "/vendors/:vendorId/security-reviews/:securityReviewId/documents/:documentId"
+	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(string(params.VendorID)))
+	path = strings.ReplaceAll(path, ":securityReviewId", url.PathEscape(string(params.SecurityReviewID)))
+	path = strings.ReplaceAll(path, ":documentId", url.PathEscape(string(params.DocumentID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "DELETE", path, query, nil)
 	if err != nil {
@@ -5535,7 +4665,7 @@ func (s *VendorsService) DeleteSecurityReviewDocumentByID(ctx context.Context, p
 }
 
 type VendorsDeleteVendorByIDParams struct {
-	VendorID string
+	VendorID VendorID
 }
 
 // DeleteVendorByID Deletes a vendor.
@@ -5543,11 +4673,11 @@ func (s *VendorsService) DeleteVendorByID(ctx context.Context, params *VendorsDe
 	if params == nil {
 		params = &VendorsDeleteVendorByIDParams{}
 	}
-	path := "/vendors/:vendorId"
-	if params.VendorID == "" {
-		return nil, fmt.Errorf("vendorId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(params.VendorID))
+	path := "/vendors/:vendorId"
+	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(string(params.VendorID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "DELETE", path, query, nil)
 	if err != nil {
@@ -5560,131 +4690,78 @@ func (s *VendorsService) DeleteVendorByID(ctx context.Context, params *VendorsDe
 	return out, nil
 }
 
-type VendorsGetSecurityReviewByIDResponse struct {
-	Comments          string         `json:"comments"`
-	CompletedByUserID string         `json:"completedByUserId"`
-	CompletionDate    string         `json:"completionDate"`
-	Decision          map[string]any `json:"decision"`
-	DecisionNotes     string         `json:"decisionNotes"`
-	DueDate           string         `json:"dueDate"`
-	ID                string         `json:"id"`
-	OverrideDueDate   string         `json:"overrideDueDate"`
-	StartDate         string         `json:"startDate"`
-	VendorID          string         `json:"vendorId"`
-}
-
 type VendorsGetSecurityReviewByIDParams struct {
-	VendorID         string
-	SecurityReviewID string
+	VendorID         VendorID
+	SecurityReviewID VendorSecurityReviewID
 }
 
 // GetSecurityReviewByID Returns a security review.
-func (s *VendorsService) GetSecurityReviewByID(ctx context.Context, params *VendorsGetSecurityReviewByIDParams) (*VendorsGetSecurityReviewByIDResponse, error) {
+func (s *VendorsService) GetSecurityReviewByID(ctx context.Context, params *VendorsGetSecurityReviewByIDParams) (*VendorSecurityReview, error) {
 	if params == nil {
 		params = &VendorsGetSecurityReviewByIDParams{}
 	}
-	path := "/vendors/:vendorId/security-reviews/:securityReviewId"
-	if params.VendorID == "" {
-		return nil, fmt.Errorf("vendorId is required")
-	}
-	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(params.VendorID))
-	if params.SecurityReviewID == "" {
-		return nil, fmt.Errorf("securityReviewId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":securityReviewId", url.PathEscape(params.SecurityReviewID))
+	path := "/vendors/:vendorId/security-reviews/:securityReviewId"
+	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(string(params.VendorID)))
+	path = strings.ReplaceAll(path, ":securityReviewId", url.PathEscape(string(params.SecurityReviewID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &VendorsGetSecurityReviewByIDResponse{}
+	out := &VendorSecurityReview{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type VendorsGetVendorByIDResponse struct {
-	AccountManagerEmail              string         `json:"accountManagerEmail"`
-	AccountManagerName               string         `json:"accountManagerName"`
-	AdditionalNotes                  string         `json:"additionalNotes"`
-	AuthDetails                      map[string]any `json:"authDetails"`
-	BusinessOwnerUserID              string         `json:"businessOwnerUserId"`
-	Category                         map[string]any `json:"category"`
-	ContractAmount                   map[string]any `json:"contractAmount"`
-	ContractRenewalDate              string         `json:"contractRenewalDate"`
-	ContractStartDate                string         `json:"contractStartDate"`
-	ContractTerminationDate          any            `json:"contractTerminationDate"`
-	CustomFields                     any            `json:"customFields"`
-	ID                               string         `json:"id"`
-	InherentRiskLevel                string         `json:"inherentRiskLevel"`
-	IsRiskAutoScored                 bool           `json:"isRiskAutoScored"`
-	IsVisibleToAuditors              bool           `json:"isVisibleToAuditors"`
-	LastSecurityReviewCompletionDate string         `json:"lastSecurityReviewCompletionDate"`
-	LatestDecision                   map[string]any `json:"latestDecision"`
-	Name                             string         `json:"name"`
-	NextSecurityReviewDueDate        string         `json:"nextSecurityReviewDueDate"`
-	ResidualRiskLevel                string         `json:"residualRiskLevel"`
-	RiskAttributeIDs                 []string       `json:"riskAttributeIds"`
-	SecurityOwnerUserID              string         `json:"securityOwnerUserId"`
-	ServicesProvided                 string         `json:"servicesProvided"`
-	Status                           string         `json:"status"`
-	TagIDentifiers                   any            `json:"tagIdentifiers"`
-	VendorHeadquarters               string         `json:"vendorHeadquarters"`
-	WebsiteURL                       string         `json:"websiteUrl"`
-}
-
 type VendorsGetVendorByIDParams struct {
-	VendorID string
+	VendorID VendorID
 }
 
 // GetVendorByID Get a vendor.
-func (s *VendorsService) GetVendorByID(ctx context.Context, params *VendorsGetVendorByIDParams) (*VendorsGetVendorByIDResponse, error) {
+func (s *VendorsService) GetVendorByID(ctx context.Context, params *VendorsGetVendorByIDParams) (*Vendor, error) {
 	if params == nil {
 		params = &VendorsGetVendorByIDParams{}
 	}
-	path := "/vendors/:vendorId"
-	if params.VendorID == "" {
-		return nil, fmt.Errorf("vendorId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(params.VendorID))
+	path := "/vendors/:vendorId"
+	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(string(params.VendorID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &VendorsGetVendorByIDResponse{}
+	out := &Vendor{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type VendorsListSecurityReviewDocumentsResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type VendorsListSecurityReviewDocumentsParams struct {
-	VendorID         string
-	SecurityReviewID string
+	VendorID         VendorID
+	SecurityReviewID VendorSecurityReviewID
 	PageSize         *int
 	PageCursor       *string
 }
 
 // ListSecurityReviewDocuments Lists a security review's documents.
-func (s *VendorsService) ListSecurityReviewDocuments(ctx context.Context, params *VendorsListSecurityReviewDocumentsParams) (*VendorsListSecurityReviewDocumentsResponse, error) {
+func (s *VendorsService) ListSecurityReviewDocuments(ctx context.Context, params *VendorsListSecurityReviewDocumentsParams) (*ResultsPage[VendorDocument], error) {
 	if params == nil {
 		params = &VendorsListSecurityReviewDocumentsParams{}
 	}
-	path := "/vendors/:vendorId/security-reviews/:securityReviewId/documents"
-	if params.VendorID == "" {
-		return nil, fmt.Errorf("vendorId is required")
-	}
-	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(params.VendorID))
-	if params.SecurityReviewID == "" {
-		return nil, fmt.Errorf("securityReviewId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":securityReviewId", url.PathEscape(params.SecurityReviewID))
+	path := "/vendors/:vendorId/security-reviews/:securityReviewId/documents"
+	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(string(params.VendorID)))
+	path = strings.ReplaceAll(path, ":securityReviewId", url.PathEscape(string(params.SecurityReviewID)))
 	query := url.Values{}
 	if params.PageSize != nil {
 		query.Set("pageSize", fmt.Sprint(*params.PageSize))
@@ -5696,33 +4773,29 @@ func (s *VendorsService) ListSecurityReviewDocuments(ctx context.Context, params
 	if err != nil {
 		return nil, err
 	}
-	out := &VendorsListSecurityReviewDocumentsResponse{}
+	out := &ResultsPage[VendorDocument]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type VendorsListSecurityReviewsByVendorIDResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type VendorsListSecurityReviewsByVendorIDParams struct {
-	VendorID   string
+	VendorID   VendorID
 	PageSize   *int
 	PageCursor *string
 }
 
 // ListSecurityReviewsByVendorID Returns a vendor's security reviews.
-func (s *VendorsService) ListSecurityReviewsByVendorID(ctx context.Context, params *VendorsListSecurityReviewsByVendorIDParams) (*VendorsListSecurityReviewsByVendorIDResponse, error) {
+func (s *VendorsService) ListSecurityReviewsByVendorID(ctx context.Context, params *VendorsListSecurityReviewsByVendorIDParams) (*ResultsPage[VendorSecurityReview], error) {
 	if params == nil {
 		params = &VendorsListSecurityReviewsByVendorIDParams{}
 	}
-	path := "/vendors/:vendorId/security-reviews"
-	if params.VendorID == "" {
-		return nil, fmt.Errorf("vendorId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(params.VendorID))
+	path := "/vendors/:vendorId/security-reviews"
+	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(string(params.VendorID)))
 	query := url.Values{}
 	if params.PageSize != nil {
 		query.Set("pageSize", fmt.Sprint(*params.PageSize))
@@ -5734,33 +4807,29 @@ func (s *VendorsService) ListSecurityReviewsByVendorID(ctx context.Context, para
 	if err != nil {
 		return nil, err
 	}
-	out := &VendorsListSecurityReviewsByVendorIDResponse{}
+	out := &ResultsPage[VendorSecurityReview]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type VendorsListVendorDocumentsResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type VendorsListVendorDocumentsParams struct {
-	VendorID   string
+	VendorID   VendorID
 	PageSize   *int
 	PageCursor *string
 }
 
 // ListVendorDocuments Returns a vendor's list of documents.
-func (s *VendorsService) ListVendorDocuments(ctx context.Context, params *VendorsListVendorDocumentsParams) (*VendorsListVendorDocumentsResponse, error) {
+func (s *VendorsService) ListVendorDocuments(ctx context.Context, params *VendorsListVendorDocumentsParams) (*ResultsPage[VendorDocument], error) {
 	if params == nil {
 		params = &VendorsListVendorDocumentsParams{}
 	}
-	path := "/vendors/:vendorId/documents"
-	if params.VendorID == "" {
-		return nil, fmt.Errorf("vendorId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(params.VendorID))
+	path := "/vendors/:vendorId/documents"
+	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(string(params.VendorID)))
 	query := url.Values{}
 	if params.PageSize != nil {
 		query.Set("pageSize", fmt.Sprint(*params.PageSize))
@@ -5772,35 +4841,31 @@ func (s *VendorsService) ListVendorDocuments(ctx context.Context, params *Vendor
 	if err != nil {
 		return nil, err
 	}
-	out := &VendorsListVendorDocumentsResponse{}
+	out := &ResultsPage[VendorDocument]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type VendorsListVendorFindingsResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type VendorsListVendorFindingsParams struct {
-	VendorID         string
+	VendorID         VendorID
 	PageSize         *int
 	PageCursor       *string
-	SecurityReviewID *string
-	DocumentID       *string
+	SecurityReviewID *VendorSecurityReviewID
+	DocumentID       *DocumentID
 }
 
 // ListVendorFindings Lists a vendor's findings.
-func (s *VendorsService) ListVendorFindings(ctx context.Context, params *VendorsListVendorFindingsParams) (*VendorsListVendorFindingsResponse, error) {
+func (s *VendorsService) ListVendorFindings(ctx context.Context, params *VendorsListVendorFindingsParams) (*ResultsPage[VendorFinding], error) {
 	if params == nil {
 		params = &VendorsListVendorFindingsParams{}
 	}
-	path := "/vendors/:vendorId/findings"
-	if params.VendorID == "" {
-		return nil, fmt.Errorf("vendorId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(params.VendorID))
+	path := "/vendors/:vendorId/findings"
+	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(string(params.VendorID)))
 	query := url.Values{}
 	if params.PageSize != nil {
 		query.Set("pageSize", fmt.Sprint(*params.PageSize))
@@ -5818,29 +4883,28 @@ func (s *VendorsService) ListVendorFindings(ctx context.Context, params *Vendors
 	if err != nil {
 		return nil, err
 	}
-	out := &VendorsListVendorFindingsResponse{}
+	out := &ResultsPage[VendorFinding]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type VendorsListVendorsResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type VendorsListVendorsParams struct {
 	PageSize         *int
 	PageCursor       *string
 	Name             *string
-	StatusMatchesAny []string
+	StatusMatchesAny []VendorStatus
 }
 
 // ListVendors List of vendors.
-func (s *VendorsService) ListVendors(ctx context.Context, params *VendorsListVendorsParams) (*VendorsListVendorsResponse, error) {
+func (s *VendorsService) ListVendors(ctx context.Context, params *VendorsListVendorsParams) (*ResultsPage[Vendor], error) {
 	if params == nil {
 		params = &VendorsListVendorsParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/vendors"
 	query := url.Values{}
 	if params.PageSize != nil {
@@ -5853,71 +4917,41 @@ func (s *VendorsService) ListVendors(ctx context.Context, params *VendorsListVen
 		query.Set("name", fmt.Sprint(*params.Name))
 	}
 	for _, v := range params.StatusMatchesAny {
-		query.Add("statusMatchesAny", v)
+		query.Add("statusMatchesAny", string(v))
 	}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &VendorsListVendorsResponse{}
+	out := &ResultsPage[Vendor]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type VendorsSetVendorStatusResponse struct {
-	AccountManagerEmail              string         `json:"accountManagerEmail"`
-	AccountManagerName               string         `json:"accountManagerName"`
-	AdditionalNotes                  string         `json:"additionalNotes"`
-	AuthDetails                      map[string]any `json:"authDetails"`
-	BusinessOwnerUserID              string         `json:"businessOwnerUserId"`
-	Category                         map[string]any `json:"category"`
-	ContractAmount                   map[string]any `json:"contractAmount"`
-	ContractRenewalDate              string         `json:"contractRenewalDate"`
-	ContractStartDate                string         `json:"contractStartDate"`
-	ContractTerminationDate          any            `json:"contractTerminationDate"`
-	CustomFields                     any            `json:"customFields"`
-	ID                               string         `json:"id"`
-	InherentRiskLevel                string         `json:"inherentRiskLevel"`
-	IsRiskAutoScored                 bool           `json:"isRiskAutoScored"`
-	IsVisibleToAuditors              bool           `json:"isVisibleToAuditors"`
-	LastSecurityReviewCompletionDate string         `json:"lastSecurityReviewCompletionDate"`
-	LatestDecision                   map[string]any `json:"latestDecision"`
-	Name                             string         `json:"name"`
-	NextSecurityReviewDueDate        string         `json:"nextSecurityReviewDueDate"`
-	ResidualRiskLevel                string         `json:"residualRiskLevel"`
-	RiskAttributeIDs                 []string       `json:"riskAttributeIds"`
-	SecurityOwnerUserID              string         `json:"securityOwnerUserId"`
-	ServicesProvided                 string         `json:"servicesProvided"`
-	Status                           string         `json:"status"`
-	TagIDentifiers                   any            `json:"tagIdentifiers"`
-	VendorHeadquarters               string         `json:"vendorHeadquarters"`
-	WebsiteURL                       string         `json:"websiteUrl"`
-}
-
 type VendorsSetVendorStatusParams struct {
-	VendorID string
+	VendorID VendorID
 	// FormData maps multipart field names to values.
 	FormData map[string]string
 }
 
 // SetVendorStatus Sets the status of a vendor, which can be MANAGED, ARCHIVED, or IN_PROCUREMENT.
-func (s *VendorsService) SetVendorStatus(ctx context.Context, params *VendorsSetVendorStatusParams) (*VendorsSetVendorStatusResponse, error) {
+func (s *VendorsService) SetVendorStatus(ctx context.Context, params *VendorsSetVendorStatusParams) (*Vendor, error) {
 	if params == nil {
 		params = &VendorsSetVendorStatusParams{}
 	}
-	path := "/vendors/:vendorId/set-status"
-	if params.VendorID == "" {
-		return nil, fmt.Errorf("vendorId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(params.VendorID))
+	path := "/vendors/:vendorId/set-status"
+	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(string(params.VendorID)))
 	query := url.Values{}
 	req, err := s.client.newMultipartRequest(ctx, "POST", path, query, params.FormData)
 	if err != nil {
 		return nil, err
 	}
-	out := &VendorsSetVendorStatusResponse{}
+	out := &Vendor{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -5925,81 +4959,51 @@ func (s *VendorsService) SetVendorStatus(ctx context.Context, params *VendorsSet
 }
 
 type VendorsUpdateVendorByIDRequestBody struct {
-	AccountManagerEmail     string           `json:"accountManagerEmail"`
-	AccountManagerName      string           `json:"accountManagerName"`
-	AdditionalNotes         string           `json:"additionalNotes"`
-	AuthDetails             map[string]any   `json:"authDetails"`
-	BusinessOwnerUserID     string           `json:"businessOwnerUserId"`
-	Category                string           `json:"category"`
-	ContractAmount          map[string]any   `json:"contractAmount"`
-	ContractRenewalDate     string           `json:"contractRenewalDate"`
-	ContractStartDate       string           `json:"contractStartDate"`
-	ContractTerminationDate string           `json:"contractTerminationDate"`
-	CustomFields            []map[string]any `json:"customFields"`
-	FrameworkScope          map[string]any   `json:"frameworkScope"`
-	InherentRiskLevel       string           `json:"inherentRiskLevel"`
-	IsVisibleToAuditors     bool             `json:"isVisibleToAuditors"`
-	Name                    string           `json:"name"`
-	ResidualRiskLevel       string           `json:"residualRiskLevel"`
-	RiskAttributeIDs        []string         `json:"riskAttributeIds"`
-	SecurityOwnerUserID     string           `json:"securityOwnerUserId"`
-	ServicesProvided        string           `json:"servicesProvided"`
-	Status                  string           `json:"status"`
-	VendorHeadquarters      string           `json:"vendorHeadquarters"`
-	WebsiteURL              string           `json:"websiteUrl"`
-}
-
-type VendorsUpdateVendorByIDResponse struct {
-	AccountManagerEmail              string         `json:"accountManagerEmail"`
-	AccountManagerName               string         `json:"accountManagerName"`
-	AdditionalNotes                  string         `json:"additionalNotes"`
-	AuthDetails                      map[string]any `json:"authDetails"`
-	BusinessOwnerUserID              string         `json:"businessOwnerUserId"`
-	Category                         map[string]any `json:"category"`
-	ContractAmount                   map[string]any `json:"contractAmount"`
-	ContractRenewalDate              string         `json:"contractRenewalDate"`
-	ContractStartDate                string         `json:"contractStartDate"`
-	ContractTerminationDate          any            `json:"contractTerminationDate"`
-	CustomFields                     any            `json:"customFields"`
-	ID                               string         `json:"id"`
-	InherentRiskLevel                string         `json:"inherentRiskLevel"`
-	IsRiskAutoScored                 bool           `json:"isRiskAutoScored"`
-	IsVisibleToAuditors              bool           `json:"isVisibleToAuditors"`
-	LastSecurityReviewCompletionDate string         `json:"lastSecurityReviewCompletionDate"`
-	LatestDecision                   map[string]any `json:"latestDecision"`
-	Name                             string         `json:"name"`
-	NextSecurityReviewDueDate        string         `json:"nextSecurityReviewDueDate"`
-	ResidualRiskLevel                string         `json:"residualRiskLevel"`
-	RiskAttributeIDs                 []string       `json:"riskAttributeIds"`
-	SecurityOwnerUserID              string         `json:"securityOwnerUserId"`
-	ServicesProvided                 string         `json:"servicesProvided"`
-	Status                           string         `json:"status"`
-	TagIDentifiers                   any            `json:"tagIdentifiers"`
-	VendorHeadquarters               string         `json:"vendorHeadquarters"`
-	WebsiteURL                       string         `json:"websiteUrl"`
+	AccountManagerEmail     string         `json:"accountManagerEmail"`
+	AccountManagerName      string         `json:"accountManagerName"`
+	AdditionalNotes         string         `json:"additionalNotes"`
+	AuthDetails             map[string]any `json:"authDetails"`
+	BusinessOwnerUserID     string         `json:"businessOwnerUserId"`
+	Category                string         `json:"category"`
+	ContractAmount          map[string]any `json:"contractAmount"`
+	ContractRenewalDate     string         `json:"contractRenewalDate"`
+	ContractStartDate       string         `json:"contractStartDate"`
+	ContractTerminationDate string         `json:"contractTerminationDate"`
+	CustomFields            CustomFields   `json:"customFields"`
+	FrameworkScope          map[string]any `json:"frameworkScope"`
+	InherentRiskLevel       string         `json:"inherentRiskLevel"`
+	IsVisibleToAuditors     bool           `json:"isVisibleToAuditors"`
+	Name                    string         `json:"name"`
+	ResidualRiskLevel       string         `json:"residualRiskLevel"`
+	RiskAttributeIDs        []string       `json:"riskAttributeIds"`
+	SecurityOwnerUserID     string         `json:"securityOwnerUserId"`
+	ServicesProvided        string         `json:"servicesProvided"`
+	Status                  string         `json:"status"`
+	VendorHeadquarters      string         `json:"vendorHeadquarters"`
+	WebsiteURL              string         `json:"websiteUrl"`
 }
 
 type VendorsUpdateVendorByIDParams struct {
-	VendorID string
+	VendorID VendorID
 	Body     *VendorsUpdateVendorByIDRequestBody
 }
 
 // UpdateVendorByID Update vendor.
-func (s *VendorsService) UpdateVendorByID(ctx context.Context, params *VendorsUpdateVendorByIDParams) (*VendorsUpdateVendorByIDResponse, error) {
+func (s *VendorsService) UpdateVendorByID(ctx context.Context, params *VendorsUpdateVendorByIDParams) (*Vendor, error) {
 	if params == nil {
 		params = &VendorsUpdateVendorByIDParams{}
 	}
-	path := "/vendors/:vendorId"
-	if params.VendorID == "" {
-		return nil, fmt.Errorf("vendorId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(params.VendorID))
+	path := "/vendors/:vendorId"
+	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(string(params.VendorID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "PATCH", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &VendorsUpdateVendorByIDResponse{}
+	out := &Vendor{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -6012,42 +5016,29 @@ type VendorsUpdateVendorFindingRequestBody struct {
 	RiskStatus  string         `json:"riskStatus"`
 }
 
-type VendorsUpdateVendorFindingResponse struct {
-	Content          string         `json:"content"`
-	DocumentID       any            `json:"documentId"`
-	ID               string         `json:"id"`
-	Remediation      map[string]any `json:"remediation"`
-	RiskStatus       string         `json:"riskStatus"`
-	SecurityReviewID string         `json:"securityReviewId"`
-	VendorID         string         `json:"vendorId"`
-}
-
 type VendorsUpdateVendorFindingParams struct {
-	VendorID  string
-	FindingID string
+	VendorID  VendorID
+	FindingID VendorFindingID
 	Body      *VendorsUpdateVendorFindingRequestBody
 }
 
 // UpdateVendorFinding Update vendor finding.
-func (s *VendorsService) UpdateVendorFinding(ctx context.Context, params *VendorsUpdateVendorFindingParams) (*VendorsUpdateVendorFindingResponse, error) {
+func (s *VendorsService) UpdateVendorFinding(ctx context.Context, params *VendorsUpdateVendorFindingParams) (*VendorFinding, error) {
 	if params == nil {
 		params = &VendorsUpdateVendorFindingParams{}
 	}
-	path := "/vendors/:vendorId/findings/:findingId"
-	if params.VendorID == "" {
-		return nil, fmt.Errorf("vendorId is required")
-	}
-	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(params.VendorID))
-	if params.FindingID == "" {
-		return nil, fmt.Errorf("findingId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":findingId", url.PathEscape(params.FindingID))
+	path := "/vendors/:vendorId/findings/:findingId"
+	path = strings.ReplaceAll(path, ":vendorId", url.PathEscape(string(params.VendorID)))
+	path = strings.ReplaceAll(path, ":findingId", url.PathEscape(string(params.FindingID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "PATCH", path, query, params.Body)
 	if err != nil {
 		return nil, err
 	}
-	out := &VendorsUpdateVendorFindingResponse{}
+	out := &VendorFinding{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -6076,6 +5067,9 @@ func (s *VulnerabilitiesService) DeactivateVulnerabilityMonitoringForVulnerabili
 	if params == nil {
 		params = &VulnerabilitiesDeactivateVulnerabilityMonitoringForVulnerabilityParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/vulnerabilities/deactivate"
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
@@ -6089,10 +5083,6 @@ func (s *VulnerabilitiesService) DeactivateVulnerabilityMonitoringForVulnerabili
 	return out, nil
 }
 
-type VulnerabilitiesGetVulnerabilitiesResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type VulnerabilitiesGetVulnerabilitiesParams struct {
 	Q                                 *string
 	PageSize                          *int
@@ -6103,17 +5093,20 @@ type VulnerabilitiesGetVulnerabilitiesParams struct {
 	PackageIDentifier                 *string
 	SlaDeadlineAfterDate              *string
 	SlaDeadlineBeforeDate             *string
-	Severity                          *string
-	IntegrationID                     *string
+	Severity                          *VulnerabilitySeverity
+	IntegrationID                     *IntegrationID
 	IncludeVulnerabilitiesWithoutSlas *bool
-	VulnerableAssetID                 *string
+	VulnerableAssetID                 *VulnerableAssetID
 }
 
 // GetVulnerabilities List all vulnerabilities based on selected filters.
-func (s *VulnerabilitiesService) GetVulnerabilities(ctx context.Context, params *VulnerabilitiesGetVulnerabilitiesParams) (*VulnerabilitiesGetVulnerabilitiesResponse, error) {
+func (s *VulnerabilitiesService) GetVulnerabilities(ctx context.Context, params *VulnerabilitiesGetVulnerabilitiesParams) (*ResultsPage[Vulnerability], error) {
 	if params == nil {
 		params = &VulnerabilitiesGetVulnerabilitiesParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/vulnerabilities"
 	query := url.Values{}
 	if params.Q != nil {
@@ -6159,56 +5152,33 @@ func (s *VulnerabilitiesService) GetVulnerabilities(ctx context.Context, params
 	if err != nil {
 		return nil, err
 	}
-	out := &VulnerabilitiesGetVulnerabilitiesResponse{}
+	out := &ResultsPage[Vulnerability]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type VulnerabilitiesGetVulnerabilityByIDResponse struct {
-	CvssSeverityScore  float64        `json:"cvssSeverityScore"`
-	DeactivateMetadata map[string]any `json:"deactivateMetadata"`
-	Description        string         `json:"description"`
-	ExternalURL        string         `json:"externalURL"`
-	FirstDetectedDate  string         `json:"firstDetectedDate"`
-	ID                 string         `json:"id"`
-	IntegrationID      string         `json:"integrationId"`
-	IsFixable          bool           `json:"isFixable"`
-	LastDetectedDate   string         `json:"lastDetectedDate"`
-	Name               string         `json:"name"`
-	PackageIDentifier  string         `json:"packageIdentifier"`
-	RelatedURLs        []string       `json:"relatedUrls"`
-	RelatedVulns       []string       `json:"relatedVulns"`
-	RemediateByDate    string         `json:"remediateByDate"`
-	ScanSource         string         `json:"scanSource"`
-	ScannerScore       float64        `json:"scannerScore"`
-	Severity           string         `json:"severity"`
-	SourceDetectedDate string         `json:"sourceDetectedDate"`
-	TargetID           string         `json:"targetId"`
-	VulnerabilityType  string         `json:"vulnerabilityType"`
-}
-
 type VulnerabilitiesGetVulnerabilityByIDParams struct {
-	VulnerabilityID string
+	VulnerabilityID VulnerabilityID
 }
 
 // GetVulnerabilityByID Gets a vulnerability by an ID.
-func (s *VulnerabilitiesService) GetVulnerabilityByID(ctx context.Context, params *VulnerabilitiesGetVulnerabilityByIDParams) (*VulnerabilitiesGetVulnerabilityByIDResponse, error) {
+func (s *VulnerabilitiesService) GetVulnerabilityByID(ctx context.Context, params *VulnerabilitiesGetVulnerabilityByIDParams) (*Vulnerability, error) {
 	if params == nil {
 		params = &VulnerabilitiesGetVulnerabilityByIDParams{}
 	}
-	path := "/vulnerabilities/:vulnerabilityId"
-	if params.VulnerabilityID == "" {
-		return nil, fmt.Errorf("vulnerabilityId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":vulnerabilityId", url.PathEscape(params.VulnerabilityID))
+	path := "/vulnerabilities/:vulnerabilityId"
+	path = strings.ReplaceAll(path, ":vulnerabilityId", url.PathEscape(string(params.VulnerabilityID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &VulnerabilitiesGetVulnerabilityByIDResponse{}
+	out := &Vulnerability{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -6232,6 +5202,9 @@ func (s *VulnerabilitiesService) ReactivateVulnerabilityMonitoring(ctx context.C
 	if params == nil {
 		params = &VulnerabilitiesReactivateVulnerabilityMonitoringParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/vulnerabilities/reactivate"
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
@@ -6267,6 +5240,9 @@ func (s *VulnerabilityRemediationsService) AcknowledgeSlaMiss(ctx context.Contex
 	if params == nil {
 		params = &VulnerabilityRemediationsAcknowledgeSlaMissParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/vulnerability-remediations/acknowledge-sla-miss"
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "POST", path, query, params.Body)
@@ -6280,25 +5256,24 @@ func (s *VulnerabilityRemediationsService) AcknowledgeSlaMiss(ctx context.Contex
 	return out, nil
 }
 
-type VulnerabilityRemediationsListVulnerabilityRemediationsResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type VulnerabilityRemediationsListVulnerabilityRemediationsParams struct {
 	PageSize             *int
 	PageCursor           *string
-	IntegrationID        *string
-	Severity             *string
+	IntegrationID        *IntegrationID
+	Severity             *VulnerabilitySeverity
 	IsRemediatedOnTime   *bool
 	RemediatedAfterDate  *string
 	RemediatedBeforeDate *string
 }
 
 // ListVulnerabilityRemediations List all vulnerability remediations based on selected filters.
-func (s *VulnerabilityRemediationsService) ListVulnerabilityRemediations(ctx context.Context, params *VulnerabilityRemediationsListVulnerabilityRemediationsParams) (*VulnerabilityRemediationsListVulnerabilityRemediationsResponse, error) {
+func (s *VulnerabilityRemediationsService) ListVulnerabilityRemediations(ctx context.Context, params *VulnerabilityRemediationsListVulnerabilityRemediationsParams) (*ResultsPage[VulnerabilityRemediation], error) {
 	if params == nil {
 		params = &VulnerabilityRemediationsListVulnerabilityRemediationsParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/vulnerability-remediations"
 	query := url.Values{}
 	if params.PageSize != nil {
@@ -6326,7 +5301,7 @@ func (s *VulnerabilityRemediationsService) ListVulnerabilityRemediations(ctx con
 	if err != nil {
 		return nil, err
 	}
-	out := &VulnerabilityRemediationsListVulnerabilityRemediationsResponse{}
+	out := &ResultsPage[VulnerabilityRemediation]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -6338,59 +5313,49 @@ type VulnerableAssetsService struct {
 	client *Client
 }
 
-type VulnerableAssetsGetVulnerableAssetByIDResponse struct {
-	AssetType      string           `json:"assetType"`
-	HasBeenScanned bool             `json:"hasBeenScanned"`
-	ID             string           `json:"id"`
-	ImageScanTag   string           `json:"imageScanTag"`
-	Name           string           `json:"name"`
-	Scanners       []map[string]any `json:"scanners"`
-}
-
 type VulnerableAssetsGetVulnerableAssetByIDParams struct {
-	VulnerableAssetID string
+	VulnerableAssetID VulnerableAssetID
 }
 
 // GetVulnerableAssetByID Gets a vulnerable asset by ID.
-func (s *VulnerableAssetsService) GetVulnerableAssetByID(ctx context.Context, params *VulnerableAssetsGetVulnerableAssetByIDParams) (*VulnerableAssetsGetVulnerableAssetByIDResponse, error) {
+func (s *VulnerableAssetsService) GetVulnerableAssetByID(ctx context.Context, params *VulnerableAssetsGetVulnerableAssetByIDParams) (*VulnerableAsset, error) {
 	if params == nil {
 		params = &VulnerableAssetsGetVulnerableAssetByIDParams{}
 	}
-	path := "/vulnerable-assets/:vulnerableAssetId"
-	if params.VulnerableAssetID == "" {
-		return nil, fmt.Errorf("vulnerableAssetId is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
-	path = strings.ReplaceAll(path, ":vulnerableAssetId", url.PathEscape(params.VulnerableAssetID))
+	path := "/vulnerable-assets/:vulnerableAssetId"
+	path = strings.ReplaceAll(path, ":vulnerableAssetId", url.PathEscape(string(params.VulnerableAssetID)))
 	query := url.Values{}
 	req, err := s.client.newRequest(ctx, "GET", path, query, nil)
 	if err != nil {
 		return nil, err
 	}
-	out := &VulnerableAssetsGetVulnerableAssetByIDResponse{}
+	out := &VulnerableAsset{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-type VulnerableAssetsListAssetsAssociatedWithVulnerabilitiesResponse struct {
-	Results map[string]any `json:"results"`
-}
-
 type VulnerableAssetsListAssetsAssociatedWithVulnerabilitiesParams struct {
 	Q                      *string
 	PageSize               *int
 	PageCursor             *string
-	IntegrationID          *string
-	AssetType              *string
+	IntegrationID          *IntegrationID
+	AssetType              *VulnerableAssetType
 	AssetExternalAccountID *string
 }
 
 // ListAssetsAssociatedWithVulnerabilities List assets that Vanta monitors that are associated with vulnerabilities.
-func (s *VulnerableAssetsService) ListAssetsAssociatedWithVulnerabilities(ctx context.Context, params *VulnerableAssetsListAssetsAssociatedWithVulnerabilitiesParams) (*VulnerableAssetsListAssetsAssociatedWithVulnerabilitiesResponse, error) {
+func (s *VulnerableAssetsService) ListAssetsAssociatedWithVulnerabilities(ctx context.Context, params *VulnerableAssetsListAssetsAssociatedWithVulnerabilitiesParams) (*ResultsPage[VulnerableAsset], error) {
 	if params == nil {
 		params = &VulnerableAssetsListAssetsAssociatedWithVulnerabilitiesParams{}
 	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 	path := "/vulnerable-assets"
 	query := url.Values{}
 	if params.Q != nil {
@@ -6415,7 +5380,7 @@ func (s *VulnerableAssetsService) ListAssetsAssociatedWithVulnerabilities(ctx co
 	if err != nil {
 		return nil, err
 	}
-	out := &VulnerableAssetsListAssetsAssociatedWithVulnerabilitiesResponse{}
+	out := &ResultsPage[VulnerableAsset]{}
 	if err := s.client.doJSON(req, out); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,2128 @@
+// Code generated by vanta-gen. DO NOT EDIT.
+
+// Vanta request validation.
+
+package v1
+
+// Validate checks params before ControlsService.AddControlFromVantaLibrary sends a request.
+func (p *ControlsAddControlFromVantaLibraryParams) Validate() error {
+	if p == nil {
+		p = &ControlsAddControlFromVantaLibraryParams{}
+	}
+	var v validator
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *ControlsAddControlFromVantaLibraryRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before ControlsService.AddControlToDocumentMapping sends a request.
+func (p *ControlsAddControlToDocumentMappingParams) Validate() error {
+	if p == nil {
+		p = &ControlsAddControlToDocumentMappingParams{}
+	}
+	var v validator
+	v.required("controlId", string(p.ControlID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *ControlsAddControlToDocumentMappingRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before ControlsService.AddControlToTestMapping sends a request.
+func (p *ControlsAddControlToTestMappingParams) Validate() error {
+	if p == nil {
+		p = &ControlsAddControlToTestMappingParams{}
+	}
+	var v validator
+	v.required("controlId", string(p.ControlID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *ControlsAddControlToTestMappingRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before ControlsService.CreateCustomControl sends a request.
+func (p *ControlsCreateCustomControlParams) Validate() error {
+	if p == nil {
+		p = &ControlsCreateCustomControlParams{}
+	}
+	var v validator
+	if p.Body == nil {
+		v.add("body", "is required")
+	} else {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *ControlsCreateCustomControlRequestBody) Validate() error {
+	if b == nil {
+		return nil
+	}
+	var v validator
+	v.dateTime("effectiveDate", b.EffectiveDate)
+	v.required("name", b.Name)
+	return v.err()
+}
+
+// Validate checks params before ControlsService.GetControlByID sends a request.
+func (p *ControlsGetControlByIDParams) Validate() error {
+	if p == nil {
+		p = &ControlsGetControlByIDParams{}
+	}
+	var v validator
+	v.required("controlId", string(p.ControlID))
+	return v.err()
+}
+
+// Validate checks params before ControlsService.ListControls sends a request.
+func (p *ControlsListControlsParams) Validate() error {
+	if p == nil {
+		p = &ControlsListControlsParams{}
+	}
+	var v validator
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before ControlsService.ListControlsDocuments sends a request.
+func (p *ControlsListControlsDocumentsParams) Validate() error {
+	if p == nil {
+		p = &ControlsListControlsDocumentsParams{}
+	}
+	var v validator
+	v.required("controlId", string(p.ControlID))
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before ControlsService.ListControlsTests sends a request.
+func (p *ControlsListControlsTestsParams) Validate() error {
+	if p == nil {
+		p = &ControlsListControlsTestsParams{}
+	}
+	var v validator
+	v.required("controlId", string(p.ControlID))
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before ControlsService.ListVantaControlsFromLibrary sends a request.
+func (p *ControlsListVantaControlsFromLibraryParams) Validate() error {
+	if p == nil {
+		p = &ControlsListVantaControlsFromLibraryParams{}
+	}
+	var v validator
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before ControlsService.RemoveControl sends a request.
+func (p *ControlsRemoveControlParams) Validate() error {
+	if p == nil {
+		p = &ControlsRemoveControlParams{}
+	}
+	var v validator
+	v.required("controlId", string(p.ControlID))
+	return v.err()
+}
+
+// Validate checks params before ControlsService.RemoveControlFromDocumentMapping sends a request.
+func (p *ControlsRemoveControlFromDocumentMappingParams) Validate() error {
+	if p == nil {
+		p = &ControlsRemoveControlFromDocumentMappingParams{}
+	}
+	var v validator
+	v.required("controlId", string(p.ControlID))
+	v.required("documentId", string(p.DocumentID))
+	return v.err()
+}
+
+// Validate checks params before ControlsService.RemoveControlFromTestMapping sends a request.
+func (p *ControlsRemoveControlFromTestMappingParams) Validate() error {
+	if p == nil {
+		p = &ControlsRemoveControlFromTestMappingParams{}
+	}
+	var v validator
+	v.required("controlId", string(p.ControlID))
+	v.required("testId", string(p.TestID))
+	return v.err()
+}
+
+// Validate checks params before ControlsService.SetOwnerOfControl sends a request.
+func (p *ControlsSetOwnerOfControlParams) Validate() error {
+	if p == nil {
+		p = &ControlsSetOwnerOfControlParams{}
+	}
+	var v validator
+	v.required("controlId", string(p.ControlID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *ControlsSetOwnerOfControlRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before ControlsService.UpdateControlsMetadata sends a request.
+func (p *ControlsUpdateControlsMetadataParams) Validate() error {
+	if p == nil {
+		p = &ControlsUpdateControlsMetadataParams{}
+	}
+	var v validator
+	v.required("controlId", string(p.ControlID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *ControlsUpdateControlsMetadataRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before DiscoveredVendorsService.AddsDiscoveredVendorToManagedVendorByID sends a request.
+func (p *DiscoveredVendorsAddsDiscoveredVendorToManagedVendorByIDParams) Validate() error {
+	if p == nil {
+		p = &DiscoveredVendorsAddsDiscoveredVendorToManagedVendorByIDParams{}
+	}
+	var v validator
+	v.required("discoveredVendorId", string(p.DiscoveredVendorID))
+	return v.err()
+}
+
+// Validate checks params before DiscoveredVendorsService.ListDiscoveredVendors sends a request.
+func (p *DiscoveredVendorsListDiscoveredVendorsParams) Validate() error {
+	if p == nil {
+		p = &DiscoveredVendorsListDiscoveredVendorsParams{}
+	}
+	var v validator
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before DiscoveredVendorsService.ListOfDiscoveredVendorAccounts sends a request.
+func (p *DiscoveredVendorsListOfDiscoveredVendorAccountsParams) Validate() error {
+	if p == nil {
+		p = &DiscoveredVendorsListOfDiscoveredVendorAccountsParams{}
+	}
+	var v validator
+	v.required("discoveredVendorId", string(p.DiscoveredVendorID))
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before DocumentsService.CreateCustomDocument sends a request.
+func (p *DocumentsCreateCustomDocumentParams) Validate() error {
+	if p == nil {
+		p = &DocumentsCreateCustomDocumentParams{}
+	}
+	var v validator
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *DocumentsCreateCustomDocumentRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before DocumentsService.CreateDocumentLink sends a request.
+func (p *DocumentsCreateDocumentLinkParams) Validate() error {
+	if p == nil {
+		p = &DocumentsCreateDocumentLinkParams{}
+	}
+	var v validator
+	v.required("documentId", string(p.DocumentID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *DocumentsCreateDocumentLinkRequestBody) Validate() error {
+	if b == nil {
+		return nil
+	}
+	var v validator
+	v.dateTime("effectiveDate", b.EffectiveDate)
+	return v.err()
+}
+
+// Validate checks params before DocumentsService.DeleteDocumentByID sends a request.
+func (p *DocumentsDeleteDocumentByIDParams) Validate() error {
+	if p == nil {
+		p = &DocumentsDeleteDocumentByIDParams{}
+	}
+	var v validator
+	v.required("documentId", string(p.DocumentID))
+	return v.err()
+}
+
+// Validate checks params before DocumentsService.DeleteFileForDocument sends a request.
+func (p *DocumentsDeleteFileForDocumentParams) Validate() error {
+	if p == nil {
+		p = &DocumentsDeleteFileForDocumentParams{}
+	}
+	var v validator
+	v.required("documentId", string(p.DocumentID))
+	v.required("uploadedFileId", string(p.UploadedFileID))
+	return v.err()
+}
+
+// Validate checks params before DocumentsService.DownloadFileForDocument sends a request.
+func (p *DocumentsDownloadFileForDocumentParams) Validate() error {
+	if p == nil {
+		p = &DocumentsDownloadFileForDocumentParams{}
+	}
+	var v validator
+	v.required("documentId", string(p.DocumentID))
+	v.required("uploadedFileId", string(p.UploadedFileID))
+	return v.err()
+}
+
+// Validate checks params before DocumentsService.GetDocumentByID sends a request.
+func (p *DocumentsGetDocumentByIDParams) Validate() error {
+	if p == nil {
+		p = &DocumentsGetDocumentByIDParams{}
+	}
+	var v validator
+	v.required("documentId", string(p.DocumentID))
+	return v.err()
+}
+
+// Validate checks params before DocumentsService.ListDocuments sends a request.
+func (p *DocumentsListDocumentsParams) Validate() error {
+	if p == nil {
+		p = &DocumentsListDocumentsParams{}
+	}
+	var v validator
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before DocumentsService.ListDocumentsControls sends a request.
+func (p *DocumentsListDocumentsControlsParams) Validate() error {
+	if p == nil {
+		p = &DocumentsListDocumentsControlsParams{}
+	}
+	var v validator
+	v.required("documentId", string(p.DocumentID))
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before DocumentsService.ListDocumentsLinks sends a request.
+func (p *DocumentsListDocumentsLinksParams) Validate() error {
+	if p == nil {
+		p = &DocumentsListDocumentsLinksParams{}
+	}
+	var v validator
+	v.required("documentId", string(p.DocumentID))
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before DocumentsService.ListDocumentsUploads sends a request.
+func (p *DocumentsListDocumentsUploadsParams) Validate() error {
+	if p == nil {
+		p = &DocumentsListDocumentsUploadsParams{}
+	}
+	var v validator
+	v.required("documentId", string(p.DocumentID))
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before DocumentsService.RemoveDocumentLink sends a request.
+func (p *DocumentsRemoveDocumentLinkParams) Validate() error {
+	if p == nil {
+		p = &DocumentsRemoveDocumentLinkParams{}
+	}
+	var v validator
+	v.required("documentId", string(p.DocumentID))
+	v.required("linkId", string(p.LinkID))
+	return v.err()
+}
+
+// Validate checks params before DocumentsService.SetDocumentOwner sends a request.
+func (p *DocumentsSetDocumentOwnerParams) Validate() error {
+	if p == nil {
+		p = &DocumentsSetDocumentOwnerParams{}
+	}
+	var v validator
+	v.required("documentId", string(p.DocumentID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *DocumentsSetDocumentOwnerRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before DocumentsService.SubmitDocumentCollection sends a request.
+func (p *DocumentsSubmitDocumentCollectionParams) Validate() error {
+	if p == nil {
+		p = &DocumentsSubmitDocumentCollectionParams{}
+	}
+	var v validator
+	v.required("documentId", string(p.DocumentID))
+	return v.err()
+}
+
+// Validate checks params before DocumentsService.UploadFileForDocument sends a request.
+func (p *DocumentsUploadFileForDocumentParams) Validate() error {
+	if p == nil {
+		p = &DocumentsUploadFileForDocumentParams{}
+	}
+	var v validator
+	v.required("documentId", string(p.DocumentID))
+	return v.err()
+}
+
+// Validate checks params before FrameworksService.GetFrameworkByID sends a request.
+func (p *FrameworksGetFrameworkByIDParams) Validate() error {
+	if p == nil {
+		p = &FrameworksGetFrameworkByIDParams{}
+	}
+	var v validator
+	v.required("frameworkId", string(p.FrameworkID))
+	return v.err()
+}
+
+// Validate checks params before FrameworksService.ListAvailableFrameworks sends a request.
+func (p *FrameworksListAvailableFrameworksParams) Validate() error {
+	if p == nil {
+		p = &FrameworksListAvailableFrameworksParams{}
+	}
+	var v validator
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before FrameworksService.ListFrameworksControls sends a request.
+func (p *FrameworksListFrameworksControlsParams) Validate() error {
+	if p == nil {
+		p = &FrameworksListFrameworksControlsParams{}
+	}
+	var v validator
+	v.required("frameworkId", string(p.FrameworkID))
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before GroupsService.AddPeopleToGroup sends a request.
+func (p *GroupsAddPeopleToGroupParams) Validate() error {
+	if p == nil {
+		p = &GroupsAddPeopleToGroupParams{}
+	}
+	var v validator
+	v.required("groupId", string(p.GroupID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *GroupsAddPeopleToGroupRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before GroupsService.AddPersonToGroup sends a request.
+func (p *GroupsAddPersonToGroupParams) Validate() error {
+	if p == nil {
+		p = &GroupsAddPersonToGroupParams{}
+	}
+	var v validator
+	v.required("groupId", string(p.GroupID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *GroupsAddPersonToGroupRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before GroupsService.GetGroupByID sends a request.
+func (p *GroupsGetGroupByIDParams) Validate() error {
+	if p == nil {
+		p = &GroupsGetGroupByIDParams{}
+	}
+	var v validator
+	v.required("groupId", string(p.GroupID))
+	return v.err()
+}
+
+// Validate checks params before GroupsService.ListGroups sends a request.
+func (p *GroupsListGroupsParams) Validate() error {
+	if p == nil {
+		p = &GroupsListGroupsParams{}
+	}
+	var v validator
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before GroupsService.ListPeopleInGroup sends a request.
+func (p *GroupsListPeopleInGroupParams) Validate() error {
+	if p == nil {
+		p = &GroupsListPeopleInGroupParams{}
+	}
+	var v validator
+	v.required("groupId", string(p.GroupID))
+	return v.err()
+}
+
+// Validate checks params before GroupsService.RemovePeopleFromGroup sends a request.
+func (p *GroupsRemovePeopleFromGroupParams) Validate() error {
+	if p == nil {
+		p = &GroupsRemovePeopleFromGroupParams{}
+	}
+	var v validator
+	v.required("groupId", string(p.GroupID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *GroupsRemovePeopleFromGroupRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before GroupsService.RemovePersonFromGroup sends a request.
+func (p *GroupsRemovePersonFromGroupParams) Validate() error {
+	if p == nil {
+		p = &GroupsRemovePersonFromGroupParams{}
+	}
+	var v validator
+	v.required("groupId", string(p.GroupID))
+	v.required("personId", string(p.PersonID))
+	return v.err()
+}
+
+// Validate checks params before IntegrationsService.GetConnectedIntegration sends a request.
+func (p *IntegrationsGetConnectedIntegrationParams) Validate() error {
+	if p == nil {
+		p = &IntegrationsGetConnectedIntegrationParams{}
+	}
+	var v validator
+	v.required("integrationId", string(p.IntegrationID))
+	return v.err()
+}
+
+// Validate checks params before IntegrationsService.GetDetailsForResourceKind sends a request.
+func (p *IntegrationsGetDetailsForResourceKindParams) Validate() error {
+	if p == nil {
+		p = &IntegrationsGetDetailsForResourceKindParams{}
+	}
+	var v validator
+	v.required("integrationId", string(p.IntegrationID))
+	v.required("resourceKind", p.ResourceKind)
+	return v.err()
+}
+
+// Validate checks params before IntegrationsService.GetResourceByID sends a request.
+func (p *IntegrationsGetResourceByIDParams) Validate() error {
+	if p == nil {
+		p = &IntegrationsGetResourceByIDParams{}
+	}
+	var v validator
+	v.required("integrationId", string(p.IntegrationID))
+	v.required("resourceKind", p.ResourceKind)
+	v.required("resourceId", p.ResourceID)
+	return v.err()
+}
+
+// Validate checks params before IntegrationsService.ListConnectedIntegrations sends a request.
+func (p *IntegrationsListConnectedIntegrationsParams) Validate() error {
+	if p == nil {
+		p = &IntegrationsListConnectedIntegrationsParams{}
+	}
+	var v validator
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before IntegrationsService.ListIntegrationResourceKinds sends a request.
+func (p *IntegrationsListIntegrationResourceKindsParams) Validate() error {
+	if p == nil {
+		p = &IntegrationsListIntegrationResourceKindsParams{}
+	}
+	var v validator
+	v.required("integrationId", string(p.IntegrationID))
+	return v.err()
+}
+
+// Validate checks params before IntegrationsService.ListResources sends a request.
+func (p *IntegrationsListResourcesParams) Validate() error {
+	if p == nil {
+		p = &IntegrationsListResourcesParams{}
+	}
+	var v validator
+	v.required("integrationId", string(p.IntegrationID))
+	v.required("resourceKind", p.ResourceKind)
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before IntegrationsService.UpdateResourceMetadata sends a request.
+func (p *IntegrationsUpdateResourceMetadataParams) Validate() error {
+	if p == nil {
+		p = &IntegrationsUpdateResourceMetadataParams{}
+	}
+	var v validator
+	v.required("integrationId", string(p.IntegrationID))
+	v.required("resourceKind", p.ResourceKind)
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *IntegrationsUpdateResourceMetadataRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before IntegrationsService.UpdateResourceMetadataForResourceKindsResources sends a request.
+func (p *IntegrationsUpdateResourceMetadataForResourceKindsResourcesParams) Validate() error {
+	if p == nil {
+		p = &IntegrationsUpdateResourceMetadataForResourceKindsResourcesParams{}
+	}
+	var v validator
+	v.required("integrationId", string(p.IntegrationID))
+	v.required("resourceKind", p.ResourceKind)
+	v.required("resourceId", p.ResourceID)
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *IntegrationsUpdateResourceMetadataForResourceKindsResourcesRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before MonitoredComputersService.GetMonitoredComputerByID sends a request.
+func (p *MonitoredComputersGetMonitoredComputerByIDParams) Validate() error {
+	if p == nil {
+		p = &MonitoredComputersGetMonitoredComputerByIDParams{}
+	}
+	var v validator
+	v.required("computerId", string(p.ComputerID))
+	return v.err()
+}
+
+// Validate checks params before MonitoredComputersService.ListMonitoredComputers sends a request.
+func (p *MonitoredComputersListMonitoredComputersParams) Validate() error {
+	if p == nil {
+		p = &MonitoredComputersListMonitoredComputersParams{}
+	}
+	var v validator
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before OAuthService.CreateToken sends a request.
+func (p *OAuthCreateTokenParams) Validate() error {
+	if p == nil {
+		p = &OAuthCreateTokenParams{}
+	}
+	var v validator
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *OAuthCreateTokenRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before PeopleService.GetPersonByID sends a request.
+func (p *PeopleGetPersonByIDParams) Validate() error {
+	if p == nil {
+		p = &PeopleGetPersonByIDParams{}
+	}
+	var v validator
+	v.required("personId", string(p.PersonID))
+	return v.err()
+}
+
+// Validate checks params before PeopleService.ListPeople sends a request.
+func (p *PeopleListPeopleParams) Validate() error {
+	if p == nil {
+		p = &PeopleListPeopleParams{}
+	}
+	var v validator
+	v.pageSize(p.PageSize)
+	warnEnums(p.TaskTypeMatchesAny)
+	v.requires("taskTypeMatchesAny", len(p.TaskTypeMatchesAny) > 0, "taskStatusMatchesAny", len(p.TaskStatusMatchesAny) > 0)
+	v.requires("taskStatusMatchesAny", len(p.TaskStatusMatchesAny) > 0, "taskTypeMatchesAny", len(p.TaskTypeMatchesAny) > 0)
+	return v.err()
+}
+
+// Validate checks params before PeopleService.MarkAsNotPeople sends a request.
+func (p *PeopleMarkAsNotPeopleParams) Validate() error {
+	if p == nil {
+		p = &PeopleMarkAsNotPeopleParams{}
+	}
+	var v validator
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *PeopleMarkAsNotPeopleRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before PeopleService.MarkAsPeople sends a request.
+func (p *PeopleMarkAsPeopleParams) Validate() error {
+	if p == nil {
+		p = &PeopleMarkAsPeopleParams{}
+	}
+	var v validator
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *PeopleMarkAsPeopleRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before PeopleService.OffboardPeople sends a request.
+func (p *PeopleOffboardPeopleParams) Validate() error {
+	if p == nil {
+		p = &PeopleOffboardPeopleParams{}
+	}
+	var v validator
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *PeopleOffboardPeopleRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before PeopleService.RemoveLeaveInformation sends a request.
+func (p *PeopleRemoveLeaveInformationParams) Validate() error {
+	if p == nil {
+		p = &PeopleRemoveLeaveInformationParams{}
+	}
+	var v validator
+	v.required("personId", string(p.PersonID))
+	return v.err()
+}
+
+// Validate checks params before PeopleService.SetLeaveInformation sends a request.
+func (p *PeopleSetLeaveInformationParams) Validate() error {
+	if p == nil {
+		p = &PeopleSetLeaveInformationParams{}
+	}
+	var v validator
+	v.required("personId", string(p.PersonID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *PeopleSetLeaveInformationRequestBody) Validate() error {
+	if b == nil {
+		return nil
+	}
+	var v validator
+	v.dateTime("endDate", b.EndDate)
+	v.dateTime("startDate", b.StartDate)
+	return v.err()
+}
+
+// Validate checks params before PeopleService.UpdatePersonMetadata sends a request.
+func (p *PeopleUpdatePersonMetadataParams) Validate() error {
+	if p == nil {
+		p = &PeopleUpdatePersonMetadataParams{}
+	}
+	var v validator
+	v.required("personId", string(p.PersonID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *PeopleUpdatePersonMetadataRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before PoliciesService.GetPolicyByID sends a request.
+func (p *PoliciesGetPolicyByIDParams) Validate() error {
+	if p == nil {
+		p = &PoliciesGetPolicyByIDParams{}
+	}
+	var v validator
+	v.required("policyId", string(p.PolicyID))
+	return v.err()
+}
+
+// Validate checks params before PoliciesService.ListPolicies sends a request.
+func (p *PoliciesListPoliciesParams) Validate() error {
+	if p == nil {
+		p = &PoliciesListPoliciesParams{}
+	}
+	var v validator
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before ResourcesService.GetComputers sends a request.
+func (p *ResourcesGetComputersParams) Validate() error {
+	return nil
+}
+
+// Validate checks params before ResourcesService.GetCustomResourceServer sends a request.
+func (p *ResourcesGetCustomResourceServerParams) Validate() error {
+	return nil
+}
+
+// Validate checks params before ResourcesService.GetUserAccounts sends a request.
+func (p *ResourcesGetUserAccountsParams) Validate() error {
+	return nil
+}
+
+// Validate checks params before ResourcesService.SyncCustomResourceServer sends a request.
+func (p *ResourcesSyncCustomResourceServerParams) Validate() error {
+	if p == nil {
+		p = &ResourcesSyncCustomResourceServerParams{}
+	}
+	var v validator
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *ResourcesSyncCustomResourceServerRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before ResourcesService.SyncMacOsComputers sends a request.
+func (p *ResourcesSyncMacOsComputersParams) Validate() error {
+	if p == nil {
+		p = &ResourcesSyncMacOsComputersParams{}
+	}
+	var v validator
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *ResourcesSyncMacOsComputersRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before ResourcesService.SyncUserAccounts sends a request.
+func (p *ResourcesSyncUserAccountsParams) Validate() error {
+	if p == nil {
+		p = &ResourcesSyncUserAccountsParams{}
+	}
+	var v validator
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *ResourcesSyncUserAccountsRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before RiskScenariosService.CancelRiskScenarioApprovalRequest sends a request.
+func (p *RiskScenariosCancelRiskScenarioApprovalRequestParams) Validate() error {
+	if p == nil {
+		p = &RiskScenariosCancelRiskScenarioApprovalRequestParams{}
+	}
+	var v validator
+	v.required("riskScenarioId", string(p.RiskScenarioID))
+	return v.err()
+}
+
+// Validate checks params before RiskScenariosService.CreateRiskScenario sends a request.
+func (p *RiskScenariosCreateRiskScenarioParams) Validate() error {
+	if p == nil {
+		p = &RiskScenariosCreateRiskScenarioParams{}
+	}
+	var v validator
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *RiskScenariosCreateRiskScenarioRequestBody) Validate() error {
+	if b == nil {
+		return nil
+	}
+	var v validator
+	warnEnum(b.Treatment)
+	return v.err()
+}
+
+// Validate checks params before RiskScenariosService.GetRiskScenarioByID sends a request.
+func (p *RiskScenariosGetRiskScenarioByIDParams) Validate() error {
+	if p == nil {
+		p = &RiskScenariosGetRiskScenarioByIDParams{}
+	}
+	var v validator
+	v.required("riskScenarioId", string(p.RiskScenarioID))
+	return v.err()
+}
+
+// Validate checks params before RiskScenariosService.ListRiskScenarios sends a request.
+func (p *RiskScenariosListRiskScenariosParams) Validate() error {
+	if p == nil {
+		p = &RiskScenariosListRiskScenariosParams{}
+	}
+	var v validator
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before RiskScenariosService.SubmitRiskScenarioForApproval sends a request.
+func (p *RiskScenariosSubmitRiskScenarioForApprovalParams) Validate() error {
+	if p == nil {
+		p = &RiskScenariosSubmitRiskScenarioForApprovalParams{}
+	}
+	var v validator
+	v.required("riskScenarioId", string(p.RiskScenarioID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *RiskScenariosSubmitRiskScenarioForApprovalRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before RiskScenariosService.UpdateRiskScenario sends a request.
+func (p *RiskScenariosUpdateRiskScenarioParams) Validate() error {
+	if p == nil {
+		p = &RiskScenariosUpdateRiskScenarioParams{}
+	}
+	var v validator
+	v.required("riskScenarioId", string(p.RiskScenarioID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *RiskScenariosUpdateRiskScenarioRequestBody) Validate() error {
+	if b == nil {
+		return nil
+	}
+	var v validator
+	warnEnum(b.Treatment)
+	return v.err()
+}
+
+// Validate checks params before TestsService.DeactivateTestEntity sends a request.
+func (p *TestsDeactivateTestEntityParams) Validate() error {
+	if p == nil {
+		p = &TestsDeactivateTestEntityParams{}
+	}
+	var v validator
+	v.required("testId", string(p.TestID))
+	v.required("entityId", string(p.EntityID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *TestsDeactivateTestEntityRequestBody) Validate() error {
+	if b == nil {
+		return nil
+	}
+	var v validator
+	v.dateTime("deactivateUntilDate", b.DeactivateUntilDate)
+	return v.err()
+}
+
+// Validate checks params before TestsService.GetTestByID sends a request.
+func (p *TestsGetTestByIDParams) Validate() error {
+	if p == nil {
+		p = &TestsGetTestByIDParams{}
+	}
+	var v validator
+	v.required("testId", string(p.TestID))
+	return v.err()
+}
+
+// Validate checks params before TestsService.GetTestEntitiesByTestID sends a request.
+func (p *TestsGetTestEntitiesByTestIDParams) Validate() error {
+	if p == nil {
+		p = &TestsGetTestEntitiesByTestIDParams{}
+	}
+	var v validator
+	v.required("testId", string(p.TestID))
+	validateEnumPtr(&v, "entityStatus", p.EntityStatus)
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before TestsService.ListTests sends a request.
+func (p *TestsListTestsParams) Validate() error {
+	if p == nil {
+		p = &TestsListTestsParams{}
+	}
+	var v validator
+	v.pageSize(p.PageSize)
+	validateEnumPtr(&v, "statusFilter", p.StatusFilter)
+	return v.err()
+}
+
+// Validate checks params before TestsService.ReactivateTestEntity sends a request.
+func (p *TestsReactivateTestEntityParams) Validate() error {
+	if p == nil {
+		p = &TestsReactivateTestEntityParams{}
+	}
+	var v validator
+	v.required("testId", string(p.TestID))
+	v.required("entityId", string(p.EntityID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.AddTrustCenterControl sends a request.
+func (p *TrustCentersAddTrustCenterControlParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersAddTrustCenterControlParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *TrustCentersAddTrustCenterControlRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before TrustCentersService.AddTrustCenterControlCategory sends a request.
+func (p *TrustCentersAddTrustCenterControlCategoryParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersAddTrustCenterControlCategoryParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *TrustCentersAddTrustCenterControlCategoryRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before TrustCentersService.AddTrustCenterViewer sends a request.
+func (p *TrustCentersAddTrustCenterViewerParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersAddTrustCenterViewerParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *TrustCentersAddTrustCenterViewerRequestBody) Validate() error {
+	if b == nil {
+		return nil
+	}
+	var v validator
+	v.dateTime("expirationDate", b.ExpirationDate)
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.ApproveTrustCenterAccessRequest sends a request.
+func (p *TrustCentersApproveTrustCenterAccessRequestParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersApproveTrustCenterAccessRequestParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("accessRequestId", string(p.AccessRequestID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *TrustCentersApproveTrustCenterAccessRequestRequestBody) Validate() error {
+	if b == nil {
+		return nil
+	}
+	var v validator
+	v.dateTime("expirationDate", b.ExpirationDate)
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.CreateTrustCenterDocument sends a request.
+func (p *TrustCentersCreateTrustCenterDocumentParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersCreateTrustCenterDocumentParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.CreateTrustCenterFaq sends a request.
+func (p *TrustCentersCreateTrustCenterFaqParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersCreateTrustCenterFaqParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *TrustCentersCreateTrustCenterFaqRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before TrustCentersService.CreateTrustCenterSubprocessor sends a request.
+func (p *TrustCentersCreateTrustCenterSubprocessorParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersCreateTrustCenterSubprocessorParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *TrustCentersCreateTrustCenterSubprocessorRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before TrustCentersService.CreateTrustCenterSubscriber sends a request.
+func (p *TrustCentersCreateTrustCenterSubscriberParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersCreateTrustCenterSubscriberParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *TrustCentersCreateTrustCenterSubscriberRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before TrustCentersService.CreateTrustCenterSubscriberGroup sends a request.
+func (p *TrustCentersCreateTrustCenterSubscriberGroupParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersCreateTrustCenterSubscriberGroupParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *TrustCentersCreateTrustCenterSubscriberGroupRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before TrustCentersService.CreateTrustCenterUpdate sends a request.
+func (p *TrustCentersCreateTrustCenterUpdateParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersCreateTrustCenterUpdateParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *TrustCentersCreateTrustCenterUpdateRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before TrustCentersService.DeleteTrustCenterControl sends a request.
+func (p *TrustCentersDeleteTrustCenterControlParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersDeleteTrustCenterControlParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("controlId", string(p.ControlID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.DeleteTrustCenterControlCategory sends a request.
+func (p *TrustCentersDeleteTrustCenterControlCategoryParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersDeleteTrustCenterControlCategoryParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("categoryId", string(p.CategoryID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.DeleteTrustCenterDocument sends a request.
+func (p *TrustCentersDeleteTrustCenterDocumentParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersDeleteTrustCenterDocumentParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("resourceId", p.ResourceID)
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.DeleteTrustCenterFaq sends a request.
+func (p *TrustCentersDeleteTrustCenterFaqParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersDeleteTrustCenterFaqParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("faqId", string(p.FaqID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.DeleteTrustCenterSubprocessor sends a request.
+func (p *TrustCentersDeleteTrustCenterSubprocessorParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersDeleteTrustCenterSubprocessorParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("subprocessorId", string(p.SubprocessorID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.DeleteTrustCenterSubscriber sends a request.
+func (p *TrustCentersDeleteTrustCenterSubscriberParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersDeleteTrustCenterSubscriberParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("subscriberId", string(p.SubscriberID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.DeleteTrustCenterSubscriberGroup sends a request.
+func (p *TrustCentersDeleteTrustCenterSubscriberGroupParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersDeleteTrustCenterSubscriberGroupParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("subscriberGroupId", string(p.SubscriberGroupID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.DeleteTrustCenterUpdate sends a request.
+func (p *TrustCentersDeleteTrustCenterUpdateParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersDeleteTrustCenterUpdateParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("updateId", string(p.UpdateID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.DenyTrustCenterAccessRequest sends a request.
+func (p *TrustCentersDenyTrustCenterAccessRequestParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersDenyTrustCenterAccessRequestParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("accessRequestId", string(p.AccessRequestID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.EditTrustCenterSubscriberGroup sends a request.
+func (p *TrustCentersEditTrustCenterSubscriberGroupParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersEditTrustCenterSubscriberGroupParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("subscriberGroupId", string(p.SubscriberGroupID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *TrustCentersEditTrustCenterSubscriberGroupRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before TrustCentersService.GetTrustCenter sends a request.
+func (p *TrustCentersGetTrustCenterParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersGetTrustCenterParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.GetTrustCenterAccessRequest sends a request.
+func (p *TrustCentersGetTrustCenterAccessRequestParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersGetTrustCenterAccessRequestParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("accessRequestId", string(p.AccessRequestID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.GetTrustCenterControl sends a request.
+func (p *TrustCentersGetTrustCenterControlParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersGetTrustCenterControlParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("controlId", string(p.ControlID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.GetTrustCenterControlCategory sends a request.
+func (p *TrustCentersGetTrustCenterControlCategoryParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersGetTrustCenterControlCategoryParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("categoryId", string(p.CategoryID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.GetTrustCenterDocument sends a request.
+func (p *TrustCentersGetTrustCenterDocumentParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersGetTrustCenterDocumentParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("resourceId", p.ResourceID)
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.GetTrustCenterFaq sends a request.
+func (p *TrustCentersGetTrustCenterFaqParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersGetTrustCenterFaqParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("faqId", string(p.FaqID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.GetTrustCenterSubprocessor sends a request.
+func (p *TrustCentersGetTrustCenterSubprocessorParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersGetTrustCenterSubprocessorParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("subprocessorId", string(p.SubprocessorID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.GetTrustCenterSubscriber sends a request.
+func (p *TrustCentersGetTrustCenterSubscriberParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersGetTrustCenterSubscriberParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("subscriberId", string(p.SubscriberID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.GetTrustCenterSubscriberGroup sends a request.
+func (p *TrustCentersGetTrustCenterSubscriberGroupParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersGetTrustCenterSubscriberGroupParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("subscriberGroupId", string(p.SubscriberGroupID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.GetTrustCenterUpdate sends a request.
+func (p *TrustCentersGetTrustCenterUpdateParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersGetTrustCenterUpdateParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("updateId", string(p.UpdateID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.GetTrustCenterViewer sends a request.
+func (p *TrustCentersGetTrustCenterViewerParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersGetTrustCenterViewerParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("viewerId", string(p.ViewerID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.GetUploadedMediaForTrustCenterDocument sends a request.
+func (p *TrustCentersGetUploadedMediaForTrustCenterDocumentParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersGetUploadedMediaForTrustCenterDocumentParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("resourceId", p.ResourceID)
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.ListHistoricalTrustCenterAccessRequests sends a request.
+func (p *TrustCentersListHistoricalTrustCenterAccessRequestsParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersListHistoricalTrustCenterAccessRequestsParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.ListTrustCenterAccessRequests sends a request.
+func (p *TrustCentersListTrustCenterAccessRequestsParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersListTrustCenterAccessRequestsParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.ListTrustCenterControlCategories sends a request.
+func (p *TrustCentersListTrustCenterControlCategoriesParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersListTrustCenterControlCategoriesParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.ListTrustCenterControls sends a request.
+func (p *TrustCentersListTrustCenterControlsParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersListTrustCenterControlsParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.ListTrustCenterFaqs sends a request.
+func (p *TrustCentersListTrustCenterFaqsParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersListTrustCenterFaqsParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.ListTrustCenterResources sends a request.
+func (p *TrustCentersListTrustCenterResourcesParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersListTrustCenterResourcesParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.ListTrustCenterSubprocessors sends a request.
+func (p *TrustCentersListTrustCenterSubprocessorsParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersListTrustCenterSubprocessorsParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.ListTrustCenterSubscriberGroups sends a request.
+func (p *TrustCentersListTrustCenterSubscriberGroupsParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersListTrustCenterSubscriberGroupsParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.ListTrustCenterSubscribers sends a request.
+func (p *TrustCentersListTrustCenterSubscribersParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersListTrustCenterSubscribersParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.ListTrustCenterUpdates sends a request.
+func (p *TrustCentersListTrustCenterUpdatesParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersListTrustCenterUpdatesParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.ListTrustCenterViewerActivityEvents sends a request.
+func (p *TrustCentersListTrustCenterViewerActivityEventsParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersListTrustCenterViewerActivityEventsParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.pageSize(p.PageSize)
+	v.dateTimePtr("afterDate", p.AfterDate)
+	v.dateTimePtr("beforeDate", p.BeforeDate)
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.ListTrustCenterViewers sends a request.
+func (p *TrustCentersListTrustCenterViewersParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersListTrustCenterViewersParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.RemoveTrustCenterViewer sends a request.
+func (p *TrustCentersRemoveTrustCenterViewerParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersRemoveTrustCenterViewerParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("viewerId", string(p.ViewerID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.SendTrustCenterUpdateNotificationsToAllSubscribers sends a request.
+func (p *TrustCentersSendTrustCenterUpdateNotificationsToAllSubscribersParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersSendTrustCenterUpdateNotificationsToAllSubscribersParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("updateId", string(p.UpdateID))
+	return v.err()
+}
+
+// Validate checks params before TrustCentersService.SendTrustCenterUpdateNotificationsToSpecificSubscribers sends a request.
+func (p *TrustCentersSendTrustCenterUpdateNotificationsToSpecificSubscribersParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersSendTrustCenterUpdateNotificationsToSpecificSubscribersParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("updateId", string(p.UpdateID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *TrustCentersSendTrustCenterUpdateNotificationsToSpecificSubscribersRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before TrustCentersService.SetGroupsForTrustCenterSubscriber sends a request.
+func (p *TrustCentersSetGroupsForTrustCenterSubscriberParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersSetGroupsForTrustCenterSubscriberParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("subscriberId", string(p.SubscriberID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *TrustCentersSetGroupsForTrustCenterSubscriberRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before TrustCentersService.UpdateTrustCenter sends a request.
+func (p *TrustCentersUpdateTrustCenterParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersUpdateTrustCenterParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *TrustCentersUpdateTrustCenterRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before TrustCentersService.UpdateTrustCenterControlCategory sends a request.
+func (p *TrustCentersUpdateTrustCenterControlCategoryParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersUpdateTrustCenterControlCategoryParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("categoryId", string(p.CategoryID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *TrustCentersUpdateTrustCenterControlCategoryRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before TrustCentersService.UpdateTrustCenterDocument sends a request.
+func (p *TrustCentersUpdateTrustCenterDocumentParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersUpdateTrustCenterDocumentParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("resourceId", p.ResourceID)
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *TrustCentersUpdateTrustCenterDocumentRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before TrustCentersService.UpdateTrustCenterFaq sends a request.
+func (p *TrustCentersUpdateTrustCenterFaqParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersUpdateTrustCenterFaqParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("faqId", string(p.FaqID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *TrustCentersUpdateTrustCenterFaqRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before TrustCentersService.UpdateTrustCenterSubprocessor sends a request.
+func (p *TrustCentersUpdateTrustCenterSubprocessorParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersUpdateTrustCenterSubprocessorParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("subprocessorId", string(p.SubprocessorID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *TrustCentersUpdateTrustCenterSubprocessorRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before TrustCentersService.UpdateTrustCenterUpdate sends a request.
+func (p *TrustCentersUpdateTrustCenterUpdateParams) Validate() error {
+	if p == nil {
+		p = &TrustCentersUpdateTrustCenterUpdateParams{}
+	}
+	var v validator
+	v.required("slugId", string(p.SlugID))
+	v.required("updateId", string(p.UpdateID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *TrustCentersUpdateTrustCenterUpdateRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before VendorRiskAttributesService.ListVendorRiskAttributes sends a request.
+func (p *VendorRiskAttributesListVendorRiskAttributesParams) Validate() error {
+	if p == nil {
+		p = &VendorRiskAttributesListVendorRiskAttributesParams{}
+	}
+	var v validator
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before VendorsService.AddDocumentToSecurityReview sends a request.
+func (p *VendorsAddDocumentToSecurityReviewParams) Validate() error {
+	if p == nil {
+		p = &VendorsAddDocumentToSecurityReviewParams{}
+	}
+	var v validator
+	v.required("vendorId", string(p.VendorID))
+	v.required("securityReviewId", string(p.SecurityReviewID))
+	return v.err()
+}
+
+// Validate checks params before VendorsService.AddDocumentToVendor sends a request.
+func (p *VendorsAddDocumentToVendorParams) Validate() error {
+	if p == nil {
+		p = &VendorsAddDocumentToVendorParams{}
+	}
+	var v validator
+	v.required("vendorId", string(p.VendorID))
+	return v.err()
+}
+
+// Validate checks params before VendorsService.AddVendorFinding sends a request.
+func (p *VendorsAddVendorFindingParams) Validate() error {
+	if p == nil {
+		p = &VendorsAddVendorFindingParams{}
+	}
+	var v validator
+	v.required("vendorId", string(p.VendorID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *VendorsAddVendorFindingRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before VendorsService.CreateVendor sends a request.
+func (p *VendorsCreateVendorParams) Validate() error {
+	if p == nil {
+		p = &VendorsCreateVendorParams{}
+	}
+	var v validator
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *VendorsCreateVendorRequestBody) Validate() error {
+	if b == nil {
+		return nil
+	}
+	var v validator
+	v.dateTime("contractRenewalDate", b.ContractRenewalDate)
+	v.dateTime("contractStartDate", b.ContractStartDate)
+	v.dateTime("contractTerminationDate", b.ContractTerminationDate)
+	return v.err()
+}
+
+// Validate checks params before VendorsService.DeleteFindingByID sends a request.
+func (p *VendorsDeleteFindingByIDParams) Validate() error {
+	if p == nil {
+		p = &VendorsDeleteFindingByIDParams{}
+	}
+	var v validator
+	v.required("vendorId", string(p.VendorID))
+	v.required("findingId", string(p.FindingID))
+	return v.err()
+}
+
+// Validate checks params before VendorsService.DeleteSecurityReviewDocumentByID sends a request.
+func (p *VendorsDeleteSecurityReviewDocumentByIDParams) Validate() error {
+	if p == nil {
+		p = &VendorsDeleteSecurityReviewDocumentByIDParams{}
+	}
+	var v validator
+	v.required("vendorId", string(p.VendorID))
+	v.required("securityReviewId", string(p.SecurityReviewID))
+	v.required("documentId", string(p.DocumentID))
+	return v.err()
+}
+
+// Validate checks params before VendorsService.DeleteVendorByID sends a request.
+func (p *VendorsDeleteVendorByIDParams) Validate() error {
+	if p == nil {
+		p = &VendorsDeleteVendorByIDParams{}
+	}
+	var v validator
+	v.required("vendorId", string(p.VendorID))
+	return v.err()
+}
+
+// Validate checks params before VendorsService.GetSecurityReviewByID sends a request.
+func (p *VendorsGetSecurityReviewByIDParams) Validate() error {
+	if p == nil {
+		p = &VendorsGetSecurityReviewByIDParams{}
+	}
+	var v validator
+	v.required("vendorId", string(p.VendorID))
+	v.required("securityReviewId", string(p.SecurityReviewID))
+	return v.err()
+}
+
+// Validate checks params before VendorsService.GetVendorByID sends a request.
+func (p *VendorsGetVendorByIDParams) Validate() error {
+	if p == nil {
+		p = &VendorsGetVendorByIDParams{}
+	}
+	var v validator
+	v.required("vendorId", string(p.VendorID))
+	return v.err()
+}
+
+// Validate checks params before VendorsService.ListSecurityReviewDocuments sends a request.
+func (p *VendorsListSecurityReviewDocumentsParams) Validate() error {
+	if p == nil {
+		p = &VendorsListSecurityReviewDocumentsParams{}
+	}
+	var v validator
+	v.required("vendorId", string(p.VendorID))
+	v.required("securityReviewId", string(p.SecurityReviewID))
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before VendorsService.ListSecurityReviewsByVendorID sends a request.
+func (p *VendorsListSecurityReviewsByVendorIDParams) Validate() error {
+	if p == nil {
+		p = &VendorsListSecurityReviewsByVendorIDParams{}
+	}
+	var v validator
+	v.required("vendorId", string(p.VendorID))
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before VendorsService.ListVendorDocuments sends a request.
+func (p *VendorsListVendorDocumentsParams) Validate() error {
+	if p == nil {
+		p = &VendorsListVendorDocumentsParams{}
+	}
+	var v validator
+	v.required("vendorId", string(p.VendorID))
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before VendorsService.ListVendorFindings sends a request.
+func (p *VendorsListVendorFindingsParams) Validate() error {
+	if p == nil {
+		p = &VendorsListVendorFindingsParams{}
+	}
+	var v validator
+	v.required("vendorId", string(p.VendorID))
+	v.pageSize(p.PageSize)
+	return v.err()
+}
+
+// Validate checks params before VendorsService.ListVendors sends a request.
+func (p *VendorsListVendorsParams) Validate() error {
+	if p == nil {
+		p = &VendorsListVendorsParams{}
+	}
+	var v validator
+	v.pageSize(p.PageSize)
+	warnEnums(p.StatusMatchesAny)
+	return v.err()
+}
+
+// Validate checks params before VendorsService.SetVendorStatus sends a request.
+func (p *VendorsSetVendorStatusParams) Validate() error {
+	if p == nil {
+		p = &VendorsSetVendorStatusParams{}
+	}
+	var v validator
+	v.required("vendorId", string(p.VendorID))
+	return v.err()
+}
+
+// Validate checks params before VendorsService.UpdateVendorByID sends a request.
+func (p *VendorsUpdateVendorByIDParams) Validate() error {
+	if p == nil {
+		p = &VendorsUpdateVendorByIDParams{}
+	}
+	var v validator
+	v.required("vendorId", string(p.VendorID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *VendorsUpdateVendorByIDRequestBody) Validate() error {
+	if b == nil {
+		return nil
+	}
+	var v validator
+	v.dateTime("contractRenewalDate", b.ContractRenewalDate)
+	v.dateTime("contractStartDate", b.ContractStartDate)
+	v.dateTime("contractTerminationDate", b.ContractTerminationDate)
+	return v.err()
+}
+
+// Validate checks params before VendorsService.UpdateVendorFinding sends a request.
+func (p *VendorsUpdateVendorFindingParams) Validate() error {
+	if p == nil {
+		p = &VendorsUpdateVendorFindingParams{}
+	}
+	var v validator
+	v.required("vendorId", string(p.VendorID))
+	v.required("findingId", string(p.FindingID))
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *VendorsUpdateVendorFindingRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before VulnerabilitiesService.DeactivateVulnerabilityMonitoringForVulnerability sends a request.
+func (p *VulnerabilitiesDeactivateVulnerabilityMonitoringForVulnerabilityParams) Validate() error {
+	if p == nil {
+		p = &VulnerabilitiesDeactivateVulnerabilityMonitoringForVulnerabilityParams{}
+	}
+	var v validator
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *VulnerabilitiesDeactivateVulnerabilityMonitoringForVulnerabilityRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before VulnerabilitiesService.GetVulnerabilities sends a request.
+func (p *VulnerabilitiesGetVulnerabilitiesParams) Validate() error {
+	if p == nil {
+		p = &VulnerabilitiesGetVulnerabilitiesParams{}
+	}
+	var v validator
+	v.pageSize(p.PageSize)
+	v.dateTimePtr("slaDeadlineAfterDate", p.SlaDeadlineAfterDate)
+	v.dateTimePtr("slaDeadlineBeforeDate", p.SlaDeadlineBeforeDate)
+	validateEnumPtr(&v, "severity", p.Severity)
+	return v.err()
+}
+
+// Validate checks params before VulnerabilitiesService.GetVulnerabilityByID sends a request.
+func (p *VulnerabilitiesGetVulnerabilityByIDParams) Validate() error {
+	if p == nil {
+		p = &VulnerabilitiesGetVulnerabilityByIDParams{}
+	}
+	var v validator
+	v.required("vulnerabilityId", string(p.VulnerabilityID))
+	return v.err()
+}
+
+// Validate checks params before VulnerabilitiesService.ReactivateVulnerabilityMonitoring sends a request.
+func (p *VulnerabilitiesReactivateVulnerabilityMonitoringParams) Validate() error {
+	if p == nil {
+		p = &VulnerabilitiesReactivateVulnerabilityMonitoringParams{}
+	}
+	var v validator
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *VulnerabilitiesReactivateVulnerabilityMonitoringRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before VulnerabilityRemediationsService.AcknowledgeSlaMiss sends a request.
+func (p *VulnerabilityRemediationsAcknowledgeSlaMissParams) Validate() error {
+	if p == nil {
+		p = &VulnerabilityRemediationsAcknowledgeSlaMissParams{}
+	}
+	var v validator
+	if p.Body != nil {
+		v.nested("body", p.Body.Validate())
+	}
+	return v.err()
+}
+
+// Validate checks the request body fields that can be verified client-side.
+func (b *VulnerabilityRemediationsAcknowledgeSlaMissRequestBody) Validate() error {
+	return nil
+}
+
+// Validate checks params before VulnerabilityRemediationsService.ListVulnerabilityRemediations sends a request.
+func (p *VulnerabilityRemediationsListVulnerabilityRemediationsParams) Validate() error {
+	if p == nil {
+		p = &VulnerabilityRemediationsListVulnerabilityRemediationsParams{}
+	}
+	var v validator
+	v.pageSize(p.PageSize)
+	validateEnumPtr(&v, "severity", p.Severity)
+	v.dateTimePtr("remediatedAfterDate", p.RemediatedAfterDate)
+	v.dateTimePtr("remediatedBeforeDate", p.RemediatedBeforeDate)
+	return v.err()
+}
+
+// Validate checks params before VulnerableAssetsService.GetVulnerableAssetByID sends a request.
+func (p *VulnerableAssetsGetVulnerableAssetByIDParams) Validate() error {
+	if p == nil {
+		p = &VulnerableAssetsGetVulnerableAssetByIDParams{}
+	}
+	var v validator
+	v.required("vulnerableAssetId", string(p.VulnerableAssetID))
+	return v.err()
+}
+
+// Validate checks params before VulnerableAssetsService.ListAssetsAssociatedWithVulnerabilities sends a request.
+func (p *VulnerableAssetsListAssetsAssociatedWithVulnerabilitiesParams) Validate() error {
+	if p == nil {
+		p = &VulnerableAssetsListAssetsAssociatedWithVulnerabilitiesParams{}
+	}
+	var v validator
+	v.pageSize(p.PageSize)
+	validateEnumPtr(&v, "assetType", p.AssetType)
+	return v.err()
+}
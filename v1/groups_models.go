@@ -0,0 +1,8 @@
+package v1
+
+// Group is a people group.
+type Group struct {
+	ID           GroupID `json:"id"`
+	Name         string  `json:"name"`
+	CreationDate string  `json:"creationDate"`
+}
@@ -0,0 +1,90 @@
+package v1
+
+// Entity ID types. Each Vanta entity has its own string type so that an ID
+// for one kind of entity cannot be passed where another is expected.
+// Convert with a plain conversion, for example string(id) or ControlID(s).
+type (
+	// ControlID identifies a control.
+	ControlID string
+
+	// DocumentID identifies a document.
+	DocumentID string
+
+	// DocumentLinkID identifies a link attached to a document.
+	DocumentLinkID string
+
+	// DocumentUploadID identifies a file uploaded to a document.
+	DocumentUploadID string
+
+	// TestID identifies a test.
+	TestID string
+
+	// TestEntityID identifies an entity evaluated by a test.
+	TestEntityID string
+
+	// PersonID identifies a person.
+	PersonID string
+
+	// GroupID identifies a group.
+	GroupID string
+
+	// FrameworkID identifies a framework.
+	FrameworkID string
+
+	// IntegrationID identifies a connected integration.
+	IntegrationID string
+
+	// PolicyID identifies a policy.
+	PolicyID string
+
+	// RiskScenarioID identifies a risk scenario.
+	RiskScenarioID string
+
+	// MonitoredComputerID identifies a monitored computer.
+	MonitoredComputerID string
+
+	// DiscoveredVendorID identifies a discovered vendor.
+	DiscoveredVendorID string
+
+	// VendorID identifies a vendor.
+	VendorID string
+
+	// VendorSecurityReviewID identifies a vendor security review.
+	VendorSecurityReviewID string
+
+	// VendorFindingID identifies a vendor finding.
+	VendorFindingID string
+
+	// VulnerabilityID identifies a vulnerability.
+	VulnerabilityID string
+
+	// VulnerableAssetID identifies a vulnerable asset.
+	VulnerableAssetID string
+
+	// TrustCenterSlug identifies a Trust Center by its slug.
+	TrustCenterSlug string
+
+	// TrustCenterAccessRequestID identifies a Trust Center access request.
+	TrustCenterAccessRequestID string
+
+	// TrustCenterControlCategoryID identifies a Trust Center control category.
+	TrustCenterControlCategoryID string
+
+	// TrustCenterFAQID identifies a Trust Center FAQ.
+	TrustCenterFAQID string
+
+	// TrustCenterSubprocessorID identifies a Trust Center subprocessor.
+	TrustCenterSubprocessorID string
+
+	// TrustCenterSubscriberID identifies a Trust Center subscriber.
+	TrustCenterSubscriberID string
+
+	// TrustCenterSubscriberGroupID identifies a Trust Center subscriber group.
+	TrustCenterSubscriberGroupID string
+
+	// TrustCenterUpdateID identifies a Trust Center update.
+	TrustCenterUpdateID string
+
+	// TrustCenterViewerID identifies a Trust Center viewer.
+	TrustCenterViewerID string
+)
@@ -0,0 +1,417 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ResourceKind is implemented by typed integration resources. Kind returns the
+// Vanta resource kind name used in resource-kinds URLs, such as "S3Bucket".
+type ResourceKind interface {
+	Kind() string
+}
+
+// GenericResource is an integration resource of any kind. It holds the fields
+// common to every kind, and Details keeps every other field as raw JSON.
+// IntegrationsService.ListResources and GetResourceByID return it; the typed
+// kinds below decode those fields instead.
+type GenericResource struct {
+	IntegrationResource
+	Details map[string]json.RawMessage `json:"-"`
+}
+
+// Kind returns the resource kind reported by Vanta.
+func (r GenericResource) Kind() string {
+	return r.ResourceKind
+}
+
+// UnmarshalJSON decodes the common fields and keeps the rest in Details.
+func (r *GenericResource) UnmarshalJSON(data []byte) error {
+	var common IntegrationResource
+	if err := json.Unmarshal(data, &common); err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for name := range jsonFieldsForType(reflect.TypeFor[IntegrationResource]()) {
+		delete(fields, name)
+	}
+	if len(fields) == 0 {
+		fields = nil
+	}
+	r.IntegrationResource = common
+	r.Details = fields
+	return nil
+}
+
+// MarshalJSON writes the common fields and Details as one object.
+func (r GenericResource) MarshalJSON() ([]byte, error) {
+	common, err := json.Marshal(r.IntegrationResource)
+	if err != nil {
+		return nil, err
+	}
+	if len(r.Details) == 0 {
+		return common, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(common, &fields); err != nil {
+		return nil, err
+	}
+	for name, value := range r.Details {
+		if _, ok := fields[name]; !ok {
+			fields[name] = value
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// The resource shapes below are the kind-specific fields of each
+// responseType in the API's examples. Typed kinds embed the shape that
+// matches them; register your own kinds the same way.
+
+// AccountResource is a user account in an integration (responseType
+// "Account").
+type AccountResource struct {
+	IntegrationResource
+	AccountName   string   `json:"accountName"`
+	Roles         []string `json:"roles"`
+	Groups        []string `json:"groups"`
+	IsDeactivated bool     `json:"isDeactivated"`
+	IsMfaEnabled  *bool    `json:"isMfaEnabled"`
+}
+
+// DatabaseResource is a database (responseType "Database").
+type DatabaseResource struct {
+	IntegrationResource
+	Account           string `json:"account"`
+	AreBackupsEnabled bool   `json:"areBackupsEnabled"`
+	IsEncrypted       bool   `json:"isEncrypted"`
+	ContainsEphi      *bool  `json:"containsEphi"`
+	ContainsUserData  *bool  `json:"containsUserData"`
+}
+
+// DeviceResource is a managed device (responseType "Device").
+type DeviceResource struct {
+	IntegrationResource
+	OperatingSystemName string `json:"operatingSystemName"`
+	IsEncrypted         bool   `json:"isEncrypted"`
+	ContainsEphi        *bool  `json:"containsEphi"`
+	ContainsUserData    *bool  `json:"containsUserData"`
+}
+
+// PaaSResource is a platform-as-a-service app (responseType "PaaS").
+type PaaSResource struct {
+	IntegrationResource
+	ContainsEphi     *bool `json:"containsEphi"`
+	ContainsUserData *bool `json:"containsUserData"`
+}
+
+// QueueResource is a message queue (responseType "Queue").
+type QueueResource struct {
+	IntegrationResource
+	Account          string `json:"account"`
+	Region           string `json:"region"`
+	ContainsEphi     *bool  `json:"containsEphi"`
+	ContainsUserData *bool  `json:"containsUserData"`
+}
+
+// StorageBucketResource is an object storage bucket (responseType
+// "StorageBucket").
+type StorageBucketResource struct {
+	IntegrationResource
+	Account          string `json:"account"`
+	Region           string `json:"region"`
+	IsEncrypted      bool   `json:"isEncrypted"`
+	IsVersioned      bool   `json:"isVersioned"`
+	ContainsEphi     *bool  `json:"containsEphi"`
+	ContainsUserData *bool  `json:"containsUserData"`
+}
+
+// ContainerRepositoryResource is a container image repository
+// (responseType "ContainerRepository").
+type ContainerRepositoryResource struct {
+	IntegrationResource
+	Account           string `json:"account"`
+	Region            string `json:"region"`
+	IsAutoscanEnabled bool   `json:"isAutoscanEnabled"`
+}
+
+// CloudResource holds the fields every AWS resource in the API's examples
+// has. It is the shape of kinds the examples do not cover.
+type CloudResource struct {
+	IntegrationResource
+	Account string `json:"account"`
+	Region  string `json:"region"`
+}
+
+// AwsAccount is an AWS IAM user account.
+type AwsAccount struct {
+	AccountResource
+	AwsUserAccountID string         `json:"awsUserAccountId"`
+	AwsAccountNumber string         `json:"awsAccountNumber"`
+	AccessKeys       []AwsAccessKey `json:"accessKeys"`
+}
+
+// AwsAccessKey is an access key of an AwsAccount.
+type AwsAccessKey struct {
+	AccessKeyID string `json:"accessKeyId"`
+	Status      string `json:"status"`
+}
+
+// Kind implements ResourceKind.
+func (AwsAccount) Kind() string { return "AwsAccount" }
+
+// AsanaAccount is an Asana user account.
+type AsanaAccount struct{ AccountResource }
+
+// Kind implements ResourceKind.
+func (AsanaAccount) Kind() string { return "AsanaAccount" }
+
+// S3Bucket is an AWS S3 bucket resource.
+type S3Bucket struct{ StorageBucketResource }
+
+// Kind implements ResourceKind.
+func (S3Bucket) Kind() string { return "S3Bucket" }
+
+// SQSQueue is an AWS SQS queue resource.
+type SQSQueue struct{ QueueResource }
+
+// Kind implements ResourceKind.
+func (SQSQueue) Kind() string { return "SQS" }
+
+// DocumentDBCluster is an AWS DocumentDB cluster resource.
+type DocumentDBCluster struct{ DatabaseResource }
+
+// Kind implements ResourceKind.
+func (DocumentDBCluster) Kind() string { return "DocumentDBCluster" }
+
+// ECRContainerRepository is an AWS ECR repository resource.
+type ECRContainerRepository struct{ ContainerRepositoryResource }
+
+// Kind implements ResourceKind.
+func (ECRContainerRepository) Kind() string { return "ECRContainerRepository" }
+
+// JamfManagedComputer is a computer managed by Jamf.
+type JamfManagedComputer struct{ DeviceResource }
+
+// Kind implements ResourceKind.
+func (JamfManagedComputer) Kind() string { return "JamfManagedComputer" }
+
+// DigitalOceanApp is a DigitalOcean App Platform app.
+type DigitalOceanApp struct{ PaaSResource }
+
+// Kind implements ResourceKind.
+func (DigitalOceanApp) Kind() string { return "DigitalOceanApp" }
+
+// CloudwatchLogGroup is an AWS CloudWatch log group resource. The API's
+// examples do not include one, so it only has the fields of CloudResource.
+type CloudwatchLogGroup struct{ CloudResource }
+
+// Kind implements ResourceKind.
+func (CloudwatchLogGroup) Kind() string { return "CloudwatchLogGroup" }
+
+// EC2Instance is an AWS EC2 instance resource. The API's examples do not
+// include one, so it only has the fields of CloudResource.
+type EC2Instance struct{ CloudResource }
+
+// Kind implements ResourceKind.
+func (EC2Instance) Kind() string { return "EC2Instance" }
+
+var (
+	resourceKindsMu sync.RWMutex
+	resourceKinds   = map[string]reflect.Type{}
+)
+
+func init() {
+	RegisterResourceKind[AsanaAccount]()
+	RegisterResourceKind[AwsAccount]()
+	RegisterResourceKind[CloudwatchLogGroup]()
+	RegisterResourceKind[DigitalOceanApp]()
+	RegisterResourceKind[DocumentDBCluster]()
+	RegisterResourceKind[EC2Instance]()
+	RegisterResourceKind[ECRContainerRepository]()
+	RegisterResourceKind[JamfManagedComputer]()
+	RegisterResourceKind[S3Bucket]()
+	RegisterResourceKind[SQSQueue]()
+}
+
+// RegisterResourceKind adds T to the registry used by DecodeResource and
+// ListResourcesOfKind, replacing any type registered for the same kind. T may
+// be a struct or a pointer to one; DecodeResource returns a pointer to the
+// struct either way.
+func RegisterResourceKind[T ResourceKind]() {
+	kind, t := resourceKindOf[T]()
+	resourceKindsMu.Lock()
+	resourceKinds[kind] = t
+	resourceKindsMu.Unlock()
+}
+
+// resourceKindOf returns the kind name of T and the type it points to, or T
+// itself when it is not a pointer. The name is read from a new value so a
+// pointer T whose Kind has a value receiver is not dereferenced while nil.
+func resourceKindOf[T ResourceKind]() (string, reflect.Type) {
+	t := reflect.TypeFor[T]()
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return reflect.New(t).Interface().(ResourceKind).Kind(), t
+}
+
+// RegisteredResourceKinds returns the sorted names of all registered kinds.
+func RegisteredResourceKinds() []string {
+	resourceKindsMu.RLock()
+	defer resourceKindsMu.RUnlock()
+	kinds := make([]string, 0, len(resourceKinds))
+	for kind := range resourceKinds {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// DecodeResource decodes data into the type registered for kind, or into a
+// GenericResource when the kind is not registered. The returned value is a
+// pointer to the decoded struct.
+func DecodeResource(kind string, data []byte) (ResourceKind, error) {
+	resourceKindsMu.RLock()
+	t, ok := resourceKinds[kind]
+	resourceKindsMu.RUnlock()
+	if !ok {
+		t = reflect.TypeFor[GenericResource]()
+	}
+	out := reflect.New(t).Interface().(ResourceKind)
+	if err := decodeJSONBytes(data, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListResourcesOf lists one page of integration resources of kind T. params
+// may be nil; its IntegrationID and ResourceKind are ignored.
+func ListResourcesOf[T ResourceKind](ctx context.Context, c *Client, integrationID IntegrationID, params *IntegrationsListResourcesParams) (*ResultsPage[T], error) {
+	var p IntegrationsListResourcesParams
+	if params != nil {
+		p = *params
+	}
+	p.IntegrationID = integrationID
+	p.ResourceKind, _ = resourceKindOf[T]()
+	return listIntegrationResources[T](ctx, c, &p)
+}
+
+// AllResourcesOf iterates every integration resource of kind T, following
+// page cursors.
+func AllResourcesOf[T ResourceKind](ctx context.Context, c *Client, integrationID IntegrationID, params *IntegrationsListResourcesParams) iter.Seq2[T, error] {
+	var base IntegrationsListResourcesParams
+	if params != nil {
+		base = *params
+	}
+	return allItems(ctx, base.PageCursor, func(ctx context.Context, cursor *string) (*ResultsPage[T], error) {
+		p := base
+		p.PageCursor = cursor
+		return ListResourcesOf[T](ctx, c, integrationID, &p)
+	})
+}
+
+// GetResourceOf fetches a single integration resource of kind T.
+func GetResourceOf[T ResourceKind](ctx context.Context, c *Client, integrationID IntegrationID, resourceID string) (*T, error) {
+	kind, _ := resourceKindOf[T]()
+	return getIntegrationResource[T](ctx, c, &IntegrationsGetResourceByIDParams{
+		IntegrationID: integrationID,
+		ResourceKind:  kind,
+		ResourceID:    resourceID,
+	})
+}
+
+// ListResourcesOfKind lists one page of resources of a kind named at run
+// time. Each item is decoded as by DecodeResource.
+func ListResourcesOfKind(ctx context.Context, c *Client, params *IntegrationsListResourcesParams) (*ResultsPage[ResourceKind], error) {
+	if params == nil {
+		params = &IntegrationsListResourcesParams{}
+	}
+	raw, err := listIntegrationResources[json.RawMessage](ctx, c, params)
+	if err != nil {
+		return nil, err
+	}
+	out := &ResultsPage[ResourceKind]{}
+	out.Results.PageInfo = raw.Results.PageInfo
+	out.Results.Data = make([]ResourceKind, 0, len(raw.Results.Data))
+	for i, item := range raw.Results.Data {
+		resource, err := DecodeResource(params.ResourceKind, item)
+		if err != nil {
+			return nil, fmt.Errorf("decode resource %d: %w", i, err)
+		}
+		out.Results.Data = append(out.Results.Data, resource)
+	}
+	return out, nil
+}
+
+func listIntegrationResources[T any](ctx context.Context, c *Client, params *IntegrationsListResourcesParams) (*ResultsPage[T], error) {
+	if params == nil {
+		params = &IntegrationsListResourcesParams{}
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	path := "/integrations/:integrationId/resource-kinds/:resourceKind/resources"
+	path = strings.ReplaceAll(path, ":integrationId", url.PathEscape(string(params.IntegrationID)))
+	path = strings.ReplaceAll(path, ":resourceKind", url.PathEscape(params.ResourceKind))
+	query := url.Values{}
+	if params.ConnectionID != nil {
+		query.Set("connectionId", fmt.Sprint(*params.ConnectionID))
+	}
+	if params.HasDescription != nil {
+		query.Set("hasDescription", fmt.Sprint(*params.HasDescription))
+	}
+	if params.HasOwner != nil {
+		query.Set("hasOwner", fmt.Sprint(*params.HasOwner))
+	}
+	if params.IsInScope != nil {
+		query.Set("isInScope", fmt.Sprint(*params.IsInScope))
+	}
+	if params.PageSize != nil {
+		query.Set("pageSize", fmt.Sprint(*params.PageSize))
+	}
+	if params.PageCursor != nil {
+		query.Set("pageCursor", fmt.Sprint(*params.PageCursor))
+	}
+	req, err := c.newRequest(ctx, "GET", path, query, nil)
+	if err != nil {
+		return nil, err
+	}
+	out := &ResultsPage[T]{}
+	if err := c.doJSON(req, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func getIntegrationResource[T any](ctx context.Context, c *Client, params *IntegrationsGetResourceByIDParams) (*T, error) {
+	if params == nil {
+		params = &IntegrationsGetResourceByIDParams{}
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	path := "/integrations/:integrationId/resource-kinds/:resourceKind/resources/:resourceId"
+	path = strings.ReplaceAll(path, ":integrationId", url.PathEscape(string(params.IntegrationID)))
+	path = strings.ReplaceAll(path, ":resourceKind", url.PathEscape(params.ResourceKind))
+	path = strings.ReplaceAll(path, ":resourceId", url.PathEscape(params.ResourceID))
+	req, err := c.newRequest(ctx, "GET", path, url.Values{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	out := new(T)
+	if err := c.doJSON(req, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,209 @@
+package v1
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func integrationResourcesTestClient(t *testing.T, paths *[]string) *Client {
+	t.Helper()
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			*paths = append(*paths, r.URL.Path)
+			kind := strings.Split(r.URL.Path, "/")[5]
+			resource := `{
+				"responseType": "Resource",
+				"resourceKind": "` + kind + `",
+				"resourceId": "r1",
+				"connectionId": "c1",
+				"displayName": "logs",
+				"owner": null,
+				"inScope": true,
+				"description": "audit",
+				"creationDate": "2024-03-06T19:02:25.202Z",
+				"region": "eu-west-1"
+			}`
+			body := `{"results": {"data": [` + resource + `], "pageInfo": {"hasNextPage": false}}}`
+			if strings.HasSuffix(r.URL.Path, "/r1") {
+				body = resource
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}, nil
+		}),
+	}
+	c, err := NewClient(WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	return c
+}
+
+func TestListResourcesOfUsesKindAndKeepsDetails(t *testing.T) {
+	var paths []string
+	c := integrationResourcesTestClient(t, &paths)
+	ctx := context.Background()
+
+	page, err := ListResourcesOf[S3Bucket](ctx, c, "aws", nil)
+	if err != nil {
+		t.Fatalf("ListResourcesOf returned error: %v", err)
+	}
+	if paths[0] != "/v1/integrations/aws/resource-kinds/S3Bucket/resources" {
+		t.Fatalf("path = %q", paths[0])
+	}
+	bucket := page.Results.Data[0]
+	if bucket.ResourceID != "r1" || !bucket.InScope || bucket.Description == nil || *bucket.Description != "audit" {
+		t.Fatalf("unexpected common fields: %+v", bucket.IntegrationResource)
+	}
+	if bucket.Region != "eu-west-1" {
+		t.Fatalf("region = %q", bucket.Region)
+	}
+
+	got, err := GetResourceOf[EC2Instance](ctx, c, "aws", "r1")
+	if err != nil {
+		t.Fatalf("GetResourceOf returned error: %v", err)
+	}
+	if got.Kind() != "EC2Instance" || got.ResourceKind != "EC2Instance" {
+		t.Fatalf("unexpected resource: %+v", got)
+	}
+
+	data, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+	if !strings.Contains(string(data), `"region":"eu-west-1"`) || !strings.Contains(string(data), `"resourceId":"r1"`) {
+		t.Fatalf("marshalled resource = %s", data)
+	}
+}
+
+func TestResourceKindsDecodeCollectionExamples(t *testing.T) {
+	warnings := collectWarnings(t)
+	// The examples use "S3" for the bucket kind that the SDK calls
+	// S3Bucket.
+	aliases := map[string]string{"S3": "S3Bucket"}
+	checked := 0
+	for _, c := range contractCases {
+		if c.Operation != "Integrations.GetResourceByID" {
+			continue
+		}
+		var common IntegrationResource
+		if err := json.Unmarshal([]byte(c.Response), &common); err != nil {
+			t.Fatal(err)
+		}
+		kind := cmp.Or(aliases[common.ResourceKind], common.ResourceKind)
+		resource, err := DecodeResource(kind, []byte(c.Response))
+		if err != nil {
+			t.Fatalf("%s: %v", kind, err)
+		}
+		if _, generic := resource.(*GenericResource); generic && common.ResponseType != "Resource" {
+			t.Errorf("%s (%s) has no typed kind", kind, common.ResponseType)
+		}
+		checked++
+	}
+	if checked == 0 {
+		t.Fatal("no GetResourceByID examples")
+	}
+	if len(*warnings) != 0 {
+		t.Fatalf("typed kinds miss example fields: %q", *warnings)
+	}
+}
+
+func TestListResourcesOfKindFallsBackToGenericResource(t *testing.T) {
+	var paths []string
+	c := integrationResourcesTestClient(t, &paths)
+	ctx := context.Background()
+
+	page, err := ListResourcesOfKind(ctx, c, &IntegrationsListResourcesParams{IntegrationID: "aws", ResourceKind: "CloudwatchLogGroup"})
+	if err != nil {
+		t.Fatalf("ListResourcesOfKind returned error: %v", err)
+	}
+	if _, ok := page.Results.Data[0].(*CloudwatchLogGroup); !ok {
+		t.Fatalf("registered kind decoded as %T", page.Results.Data[0])
+	}
+
+	page, err = ListResourcesOfKind(ctx, c, &IntegrationsListResourcesParams{IntegrationID: "asana", ResourceKind: "AsanaTask"})
+	if err != nil {
+		t.Fatalf("ListResourcesOfKind returned error: %v", err)
+	}
+	generic, ok := page.Results.Data[0].(*GenericResource)
+	if !ok {
+		t.Fatalf("unknown kind decoded as %T", page.Results.Data[0])
+	}
+	if generic.Kind() != "AsanaTask" || generic.Details["region"] == nil {
+		t.Fatalf("unexpected generic resource: %+v", generic)
+	}
+}
+
+type pointerKind struct {
+	IntegrationResource
+	Region string `json:"region"`
+}
+
+func (*pointerKind) Kind() string { return "PointerKind" }
+
+type valueKind struct {
+	IntegrationResource
+	Region string `json:"region"`
+}
+
+func (valueKind) Kind() string { return "ValueKind" }
+
+func TestUserRegisteredPointerResourceKinds(t *testing.T) {
+	RegisterResourceKind[*pointerKind]()
+	RegisterResourceKind[*valueKind]()
+	t.Cleanup(func() {
+		resourceKindsMu.Lock()
+		delete(resourceKinds, "PointerKind")
+		delete(resourceKinds, "ValueKind")
+		resourceKindsMu.Unlock()
+	})
+
+	data := []byte(`{"resourceKind": "PointerKind", "resourceId": "r1", "region": "eu-west-1"}`)
+	decoded, err := DecodeResource("PointerKind", data)
+	if err != nil {
+		t.Fatalf("DecodeResource returned error: %v", err)
+	}
+	if got, ok := decoded.(*pointerKind); !ok || got.Region != "eu-west-1" {
+		t.Fatalf("DecodeResource = %#v, want *pointerKind", decoded)
+	}
+	decoded, err = DecodeResource("ValueKind", data)
+	if err != nil {
+		t.Fatalf("DecodeResource returned error: %v", err)
+	}
+	if got, ok := decoded.(*valueKind); !ok || got.ResourceID != "r1" {
+		t.Fatalf("DecodeResource = %#v, want *valueKind", decoded)
+	}
+
+	var paths []string
+	c := integrationResourcesTestClient(t, &paths)
+	ctx := context.Background()
+	page, err := ListResourcesOf[*valueKind](ctx, c, "custom", nil)
+	if err != nil {
+		t.Fatalf("ListResourcesOf returned error: %v", err)
+	}
+	if got := page.Results.Data[0]; got == nil || got.Region != "eu-west-1" {
+		t.Fatalf("unexpected resource: %+v", got)
+	}
+	got, err := GetResourceOf[*pointerKind](ctx, c, "custom", "r1")
+	if err != nil {
+		t.Fatalf("GetResourceOf returned error: %v", err)
+	}
+	if (*got).Region != "eu-west-1" {
+		t.Fatalf("unexpected resource: %+v", *got)
+	}
+	want := []string{
+		"/v1/integrations/custom/resource-kinds/ValueKind/resources",
+		"/v1/integrations/custom/resource-kinds/PointerKind/resources/r1",
+	}
+	if strings.Join(paths, ",") != strings.Join(want, ",") {
+		t.Fatalf("paths = %v, want %v", paths, want)
+	}
+}
@@ -0,0 +1,48 @@
+package v1
+
+// Integration is a connected integration and its connections.
+type Integration struct {
+	IntegrationID IntegrationID           `json:"integrationId"`
+	DisplayName   string                  `json:"displayName"`
+	ResourceKinds []string                `json:"resourceKinds"`
+	Connections   []IntegrationConnection `json:"connections"`
+}
+
+type IntegrationConnection struct {
+	ConnectionID           string  `json:"connectionId"`
+	IsDisabled             bool    `json:"isDisabled"`
+	ConnectionErrorMessage *string `json:"connectionErrorMessage"`
+}
+
+// IntegrationResource holds the fields common to every integration resource.
+type IntegrationResource struct {
+	ResponseType string  `json:"responseType"`
+	ResourceKind string  `json:"resourceKind"`
+	ResourceID   string  `json:"resourceId"`
+	ConnectionID string  `json:"connectionId"`
+	DisplayName  string  `json:"displayName"`
+	Owner        any     `json:"owner"`
+	InScope      bool    `json:"inScope"`
+	Description  *string `json:"description"`
+	CreationDate string  `json:"creationDate"`
+}
+
+// IntegrationResourceKind describes a resource type (kind) an integration
+// exposes, such as S3Bucket or CloudwatchLogGroup.
+type IntegrationResourceKind struct {
+	IntegrationID        IntegrationID `json:"integrationId"`
+	ResourceKind         string        `json:"resourceKind"`
+	IsScopable           bool          `json:"isScopable"`
+	CanUpdateDescription bool          `json:"canUpdateDescription"`
+	CanUpdateOwner       bool          `json:"canUpdateOwner"`
+}
+
+// IntegrationResourceKindDetails adds resource counts to an
+// IntegrationResourceKind.
+type IntegrationResourceKindDetails struct {
+	IntegrationResourceKind
+	NumResources       int `json:"numResources"`
+	NumInScope         int `json:"numInScope"`
+	NumOwned           int `json:"numOwned"`
+	NumWithDescription int `json:"numWithDescription"`
+}
@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// jwtClaims holds the subset of registered and OAuth claims the SDK reads
+// from JWT-shaped access tokens.
+type jwtClaims struct {
+	Exp   json.Number     `json:"exp"`
+	Sub   string          `json:"sub"`
+	Scope json.RawMessage `json:"scope"`
+	Scp   json.RawMessage `json:"scp"`
+}
+
+// parseJWTClaims decodes the payload segment of a JWT without verifying its
+// signature. It reports false when the token is not JWT-shaped.
+func parseJWTClaims(accessToken string) (jwtClaims, bool) {
+	parts := strings.Split(accessToken, ".")
+	if len(parts) != 3 || parts[1] == "" {
+		return jwtClaims{}, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return jwtClaims{}, false
+	}
+	var claims jwtClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return jwtClaims{}, false
+	}
+	return claims, true
+}
+
+func (c jwtClaims) expiry() time.Time {
+	if c.Exp == "" {
+		return time.Time{}
+	}
+	secs, err := c.Exp.Float64()
+	if err != nil || secs <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(secs), 0)
+}
+
+func (c jwtClaims) scopes() []string {
+	for _, raw := range []json.RawMessage{c.Scope, c.Scp} {
+		if len(raw) == 0 {
+			continue
+		}
+		var s string
+		if json.Unmarshal(raw, &s) == nil {
+			if scopes := strings.Fields(s); len(scopes) > 0 {
+				return scopes
+			}
+			continue
+		}
+		var list []string
+		if json.Unmarshal(raw, &list) == nil && len(list) > 0 {
+			return list
+		}
+	}
+	return nil
+}
+
+// withJWTClaims fills Expiry, Scopes and Subject from the access token's JWT
+// claims when they are not already set. Claims are not verified; they are
+// only used to decide when to refresh.
+func (t Token) withJWTClaims() Token {
+	claims, ok := parseJWTClaims(t.AccessToken)
+	if !ok {
+		return t
+	}
+	if t.Expiry.IsZero() {
+		t.Expiry = claims.expiry()
+	}
+	if len(t.Scopes) == 0 {
+		t.Scopes = claims.scopes()
+	}
+	if t.Subject == "" {
+		t.Subject = claims.Sub
+	}
+	return t
+}
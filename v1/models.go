@@ -0,0 +1,14 @@
+package v1
+
+// OwnerReference identifies the user that owns a control, computer or test.
+type OwnerReference struct {
+	ID           string `json:"id"`
+	EmailAddress string `json:"emailAddress"`
+	DisplayName  string `json:"displayName"`
+}
+
+// UploaderReference identifies who uploaded a file.
+type UploaderReference struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
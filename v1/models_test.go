@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestListControlsReturnsTypedResultsPage(t *testing.T) {
+	resetUnknownFieldWarningsForTest()
+	t.Cleanup(resetUnknownFieldWarningsForTest)
+
+	var warnings []string
+	previous := UnknownFieldWarningf
+	UnknownFieldWarningf = func(format string, args ...any) {
+		warnings = append(warnings, fmt.Sprintf(format, args...))
+	}
+	t.Cleanup(func() {
+		UnknownFieldWarningf = previous
+	})
+
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body: io.NopCloser(strings.NewReader(`{
+					"results": {
+						"data": [{
+							"id": "a2f7e1b9d0c3f4e5a6c7b8d9",
+							"externalId": "CRY-104",
+							"name": "Data encryption utilized",
+							"source": "Vanta",
+							"domains": ["CRYPTOGRAPHIC_PROTECTIONS"],
+							"owner": {"id": "u1", "emailAddress": "owner@example.com", "displayName": "Owner"},
+							"customFields": [{"label": "Region", "value": "EU"}],
+							"creationDate": null,
+							"newControlField": true
+						}],
+						"pageInfo": {"hasNextPage": true, "hasPreviousPage": false, "startCursor": "a", "endCursor": "b"}
+					}
+				}`)),
+			}, nil
+		}),
+	}
+
+	c, err := NewClient(WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	page, err := c.Services.Controls.ListControls(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListControls returned error: %v", err)
+	}
+
+	if len(page.Results.Data) != 1 {
+		t.Fatalf("data len = %d, want 1", len(page.Results.Data))
+	}
+	control := page.Results.Data[0]
+	if control.ExternalID != "CRY-104" || control.Owner == nil || control.Owner.EmailAddress != "owner@example.com" {
+		t.Fatalf("unexpected control: %+v", control)
+	}
+	if len(control.CustomFields) != 1 || control.CustomFields[0].Value != "EU" {
+		t.Fatalf("unexpected custom fields: %+v", control.CustomFields)
+	}
+	if !page.Results.PageInfo.HasNextPage || page.Results.PageInfo.EndCursor != "b" {
+		t.Fatalf("unexpected page info: %+v", page.Results.PageInfo)
+	}
+
+	joined := strings.Join(warnings, "\n")
+	if want := "ResultsPage[Control].results.data[].newControlField"; !strings.Contains(joined, want) {
+		t.Fatalf("warnings %q do not contain %q", joined, want)
+	}
+}
+
+func TestShortGenericName(t *testing.T) {
+	tests := map[string]string{
+		"Control": "Control",
+		"ResultsPage[github.com/richardoc/vanta-sdk-go/v1.Control]": "ResultsPage[Control]",
+		"Pair[time.Time,github.com/x/y.Z]":                          "Pair[Time,Z]",
+	}
+	for in, want := range tests {
+		if got := shortGenericName(in); got != want {
+			t.Fatalf("shortGenericName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestEntityIDTypesRoundTrip(t *testing.T) {
+	var gotPath string
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			gotPath = r.URL.EscapedPath()
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"id": "c1", "name": "Encryption"}`)),
+			}, nil
+		}),
+	}
+	c, err := NewClient(WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	control, err := c.Services.Controls.GetControlByID(context.Background(), &ControlsGetControlByIDParams{ControlID: ControlID("c1")})
+	if err != nil {
+		t.Fatalf("GetControlByID returned error: %v", err)
+	}
+	if gotPath != "/v1/controls/c1" {
+		t.Fatalf("path = %q", gotPath)
+	}
+	// A decoded ID can be passed straight back as a path param.
+	next := &ControlsListControlsTestsParams{ControlID: control.ID}
+	if string(next.ControlID) != "c1" {
+		t.Fatalf("control ID = %q", next.ControlID)
+	}
+}
@@ -0,0 +1,26 @@
+package v1
+
+// MonitoredComputer is a computer reporting to Vanta through an agent or MDM.
+type MonitoredComputer struct {
+	ID                    MonitoredComputerID               `json:"id"`
+	IntegrationID         IntegrationID                     `json:"integrationId"`
+	SerialNumber          string                            `json:"serialNumber"`
+	UDID                  string                            `json:"udid"`
+	LastCheckDate         string                            `json:"lastCheckDate"`
+	Screenlock            *MonitoredComputerCheck           `json:"screenlock"`
+	DiskEncryption        *MonitoredComputerCheck           `json:"diskEncryption"`
+	PasswordManager       *MonitoredComputerCheck           `json:"passwordManager"`
+	AntivirusInstallation *MonitoredComputerCheck           `json:"antivirusInstallation"`
+	OperatingSystem       *MonitoredComputerOperatingSystem `json:"operatingSystem"`
+	Owner                 *OwnerReference                   `json:"owner"`
+}
+
+// MonitoredComputerCheck is the outcome of one computer security check.
+type MonitoredComputerCheck struct {
+	Outcome string `json:"outcome"`
+}
+
+type MonitoredComputerOperatingSystem struct {
+	Type    string `json:"type"`
+	Version string `json:"version"`
+}
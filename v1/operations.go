@@ -0,0 +1,129 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+)
+
+// OAuth scopes required by Vanta API operations.
+const (
+	ScopeAllRead  = "vanta-api.all:read"
+	ScopeAllWrite = "vanta-api.all:write"
+)
+
+// Operation describes one generated endpoint method. The catalog returned by
+// Operations lets tools list, filter and call endpoints from metadata instead
+// of reflecting over Services.
+type Operation struct {
+	// ID is "<Service>.<Method>", for example "Controls.ListControls".
+	ID      string
+	Service string
+	Method  string
+
+	HTTPMethod string
+	// Path is the path template relative to the base URL, with path params
+	// written as ":name".
+	Path        string
+	PathParams  []OperationParam
+	QueryParams []OperationParam
+	// BodyType is the Go type of the params Body field, or "" when the
+	// operation sends no JSON body.
+	BodyType string
+	// Multipart operations send the params FormData field instead of a body.
+	Multipart bool
+	// Paginated operations return a *ResultsPage and take PageSize and
+	// PageCursor params.
+	Paginated bool
+	// ReadOnly operations do not change data in Vanta.
+	ReadOnly bool
+	// Scopes lists the OAuth scopes the operation needs; it is empty for
+	// operations that do not take a bearer token.
+	Scopes []string
+	Doc    string
+
+	fn operationFunc
+}
+
+// OperationParam is a path or query param of an Operation.
+type OperationParam struct {
+	// Name is the name sent on the wire.
+	Name string
+	// Field is the Go field of the params struct that holds the value.
+	Field string
+	// Type is the Go type of Field.
+	Type     string
+	Required bool
+}
+
+type operationFunc struct {
+	newParams func() any
+	// newResult returns a pointer to the method's result type, for decoding
+	// tests.
+	newResult func() any
+	call      func(ctx context.Context, s *Services, params any) (any, bool, error)
+}
+
+// bind ties a catalog entry to the service method it describes.
+func bind[S any, P any, R any](service func(*Services) S, method func(S, context.Context, *P) (R, error)) operationFunc {
+	return operationFunc{
+		newParams: func() any { return new(P) },
+		newResult: func() any { return new(R) },
+		call: func(ctx context.Context, s *Services, params any) (any, bool, error) {
+			var p *P
+			if params != nil {
+				typed, ok := params.(*P)
+				if !ok {
+					return nil, false, nil
+				}
+				p = typed
+			}
+			out, err := method(service(s), ctx, p)
+			if err != nil {
+				return nil, true, err
+			}
+			return out, true, nil
+		},
+	}
+}
+
+// Operations returns the catalog of every generated operation, ordered by
+// service and method. The returned slice is a copy; the param slices it
+// references are shared and must not be modified.
+func Operations() []Operation {
+	return slices.Clone(operations)
+}
+
+// LookupOperation returns the operation with the given ID.
+func LookupOperation(id string) (Operation, bool) {
+	for _, op := range operations {
+		if op.ID == id {
+			return op, true
+		}
+	}
+	return Operation{}, false
+}
+
+// NewParams returns a new, empty params value of the type op's method takes,
+// such as *ControlsListControlsParams. Params fields have no JSON tags, so
+// JSON objects keyed by the wire names ("pageSize", "body") unmarshal into it.
+func (op Operation) NewParams() any {
+	if op.fn.newParams == nil {
+		return nil
+	}
+	return op.fn.newParams()
+}
+
+// Invoke calls op with params, which must be nil or the type op.NewParams
+// returns. The result is the method's typed response, for example a
+// *ResultsPage[Control] for Controls.ListControls.
+func (c *Client) Invoke(ctx context.Context, op Operation, params any) (any, error) {
+	if op.fn.call == nil {
+		return nil, fmt.Errorf("vanta: operation %q is not from the catalog", op.ID)
+	}
+	out, ok, err := op.fn.call(ctx, c.Services, params)
+	if !ok {
+		return nil, fmt.Errorf("vanta: %s takes %T params, got %T", op.ID, op.NewParams(), params)
+	}
+	return out, err
+}
@@ -0,0 +1,133 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestOperationsCoverEveryServiceMethod(t *testing.T) {
+	ids := map[string]bool{}
+	for _, op := range Operations() {
+		if ids[op.ID] {
+			t.Fatalf("duplicate operation %s", op.ID)
+		}
+		ids[op.ID] = true
+	}
+
+	ctxType := reflect.TypeFor[context.Context]()
+	errType := reflect.TypeFor[error]()
+	services := reflect.TypeFor[Services]()
+	for i := range services.NumField() {
+		field := services.Field(i)
+		for m := range field.Type.Methods() {
+			mt := m.Type
+			isEndpoint := mt.NumIn() == 3 && mt.In(1) == ctxType && mt.NumOut() == 2 && mt.Out(1) == errType
+			if !isEndpoint {
+				continue
+			}
+			if id := field.Name + "." + m.Name; !ids[id] {
+				t.Errorf("method %s has no catalog entry", id)
+			}
+		}
+	}
+}
+
+func TestOperationMetadata(t *testing.T) {
+	op, ok := LookupOperation("Controls.ListControls")
+	if !ok {
+		t.Fatal("Controls.ListControls not found")
+	}
+	if op.HTTPMethod != http.MethodGet || op.Path != "/controls" || !op.Paginated || !op.ReadOnly {
+		t.Fatalf("unexpected operation: %+v", op)
+	}
+	if len(op.Scopes) != 1 || op.Scopes[0] != ScopeAllRead {
+		t.Fatalf("scopes = %v, want [%s]", op.Scopes, ScopeAllRead)
+	}
+
+	op, _ = LookupOperation("Controls.GetControlByID")
+	if len(op.PathParams) != 1 || op.PathParams[0] != (OperationParam{Name: "controlId", Field: "ControlID", Type: "ControlID", Required: true}) {
+		t.Fatalf("path params = %+v", op.PathParams)
+	}
+
+	op, _ = LookupOperation("Controls.CreateCustomControl")
+	if op.ReadOnly || op.BodyType != "*ControlsCreateCustomControlRequestBody" || op.Scopes[0] != ScopeAllWrite {
+		t.Fatalf("unexpected operation: %+v", op)
+	}
+
+	op, _ = LookupOperation("OAuth.CreateToken")
+	if len(op.Scopes) != 0 {
+		t.Fatalf("token exchange scopes = %v, want none", op.Scopes)
+	}
+
+	if _, ok := LookupOperation("Controls.Nope"); ok {
+		t.Fatal("LookupOperation found an unknown operation")
+	}
+}
+
+func TestInvoke(t *testing.T) {
+	var gotURL string
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			gotURL = r.URL.String()
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"results": {"data": [{"id": "c1"}], "pageInfo": {}}}`)),
+			}, nil
+		}),
+	}
+	c, err := NewClient(WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	op, _ := LookupOperation("Controls.ListControls")
+	params := op.NewParams()
+	if err := json.Unmarshal([]byte(`{"pageSize": 5, "pageCursor": "abc"}`), params); err != nil {
+		t.Fatalf("unmarshal params: %v", err)
+	}
+	out, err := c.Invoke(context.Background(), op, params)
+	if err != nil {
+		t.Fatalf("Invoke returned error: %v", err)
+	}
+	page, ok := out.(*ResultsPage[Control])
+	if !ok || len(page.Results.Data) != 1 || page.Results.Data[0].ID != "c1" {
+		t.Fatalf("Invoke returned %#v", out)
+	}
+	if !strings.Contains(gotURL, "pageSize=5") || !strings.Contains(gotURL, "pageCursor=abc") {
+		t.Fatalf("request URL = %s, want paging params", gotURL)
+	}
+
+	if _, err := c.Invoke(context.Background(), op, nil); err != nil {
+		t.Fatalf("Invoke with nil params returned error: %v", err)
+	}
+	if _, err := c.Invoke(context.Background(), op, &DocumentsListDocumentsParams{}); err == nil || !strings.Contains(err.Error(), "*v1.ControlsListControlsParams") {
+		t.Fatalf("Invoke with wrong params err = %v", err)
+	}
+	if _, err := c.Invoke(context.Background(), Operation{ID: "Made.Up"}, nil); err == nil {
+		t.Fatal("Invoke of an operation outside the catalog returned nil error")
+	}
+}
+
+func TestInvokeReturnsValidationErrors(t *testing.T) {
+	c, err := NewClient(WithHTTPClient(&http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			t.Fatalf("unexpected request to %s", r.URL)
+			return nil, nil
+		}),
+	}))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	op, _ := LookupOperation("Controls.GetControlByID")
+	out, err := c.Invoke(context.Background(), op, nil)
+	if _, ok := err.(*ValidationError); !ok || out != nil {
+		t.Fatalf("Invoke = %v, %v; want nil and a *ValidationError", out, err)
+	}
+}
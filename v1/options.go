@@ -9,8 +9,11 @@ import (
 type config struct {
 	httpClient  *http.Client
 	baseURL     string
+	authURL     string
 	tokenSource TokenSource
 	userAgent   string
+	tenant      string
+	logf        func(format string, args ...any)
 }
 
 func defaultConfig() *config {
@@ -41,6 +44,18 @@ func WithBaseURL(baseURL string) Option {
 	})
 }
 
+// WithAuthURL overrides the OAuth token URL used by OAuthService.CreateToken.
+// By default it is /oauth/token on the host of the base URL.
+func WithAuthURL(authURL string) Option {
+	return optionFunc(func(cfg *config) error {
+		if authURL == "" {
+			return errors.New("auth URL must not be empty")
+		}
+		cfg.authURL = authURL
+		return nil
+	})
+}
+
 // WithHTTPClient provides a custom HTTP client.
 func WithHTTPClient(client *http.Client) Option {
 	return optionFunc(func(cfg *config) error {
@@ -73,3 +88,27 @@ func WithUserAgent(userAgent string) Option {
 		return nil
 	})
 }
+
+// WithTenant tags every request made by the client with a tenant identifier.
+// The tenant is available to transports via TenantFromContext and is included
+// in request log lines.
+func WithTenant(tenant string) Option {
+	return optionFunc(func(cfg *config) error {
+		if tenant == "" {
+			return errors.New("tenant must not be empty")
+		}
+		cfg.tenant = tenant
+		return nil
+	})
+}
+
+// WithLogf logs one line per API request, including the tenant when set.
+func WithLogf(logf func(format string, args ...any)) Option {
+	return optionFunc(func(cfg *config) error {
+		if logf == nil {
+			return errors.New("logf must not be nil")
+		}
+		cfg.logf = logf
+		return nil
+	})
+}
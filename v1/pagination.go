@@ -1,8 +1,11 @@
 package v1
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"iter"
 )
 
 // PageInfo is the common cursor metadata returned by Vanta list endpoints.
@@ -18,48 +21,343 @@ type ResultsPage[T any] struct {
 	Results struct {
 		Data     []T      `json:"data"`
 		PageInfo PageInfo `json:"pageInfo"`
+		// TotalCount is the number of items across all pages. Only some
+		// list endpoints report it.
+		TotalCount *int `json:"totalCount,omitempty"`
 	} `json:"results"`
 }
 
 // Pager iterates over cursor-paginated endpoints.
+//
+// A pager also tracks a PagerCheckpoint. A page counts as processed once the
+// caller asks for the next one, so the checkpoint (and any CheckpointStore)
+// only ever records pages the caller has finished with.
 type Pager[T any] struct {
 	fetch func(context.Context, string) (*ResultsPage[T], error)
 	next  string
 	done  bool
+
+	store      CheckpointStore
+	checkpoint PagerCheckpoint
+	staged     *PagerCheckpoint
+
+	prefetch   bool
+	prefetched chan pageResult[T]
+
+	// position is the number of pages up to and including the last one
+	// returned. history holds the cursor used to fetch each of them when
+	// WithCursorHistory is set.
+	position    int
+	keepHistory bool
+	history     []string
+}
+
+type pageResult[T any] struct {
+	page *ResultsPage[T]
+	err  error
+}
+
+// PagerOption configures checkpoint tracking for NewPager and ResumePager.
+type PagerOption func(*pagerConfig) error
+
+type pagerConfig struct {
+	operation string
+	params    json.RawMessage
+	store     CheckpointStore
+	prefetch  bool
+	history   bool
+}
+
+// WithPagerOperation records the operation name and filter params in the
+// pager's checkpoint. ResumePager rejects checkpoints taken for a different
+// operation or params.
+func WithPagerOperation(operation string, params any) PagerOption {
+	return func(cfg *pagerConfig) error {
+		cfg.operation = operation
+		if params == nil {
+			cfg.params = nil
+			return nil
+		}
+		raw, err := json.Marshal(params)
+		if err != nil {
+			return fmt.Errorf("marshal pager params: %w", err)
+		}
+		cfg.params = raw
+		return nil
+	}
+}
+
+// WithCheckpointStore saves the pager checkpoint after each processed page.
+func WithCheckpointStore(store CheckpointStore) PagerOption {
+	return func(cfg *pagerConfig) error {
+		cfg.store = store
+		return nil
+	}
+}
+
+// WithPrefetch makes Next start fetching the following page in the background
+// as soon as it returns the current one, overlapping network time with the
+// caller's processing. The background fetch uses the context passed to that
+// Next call, and its error is returned by the following Next.
+func WithPrefetch() PagerOption {
+	return func(cfg *pagerConfig) error {
+		cfg.prefetch = true
+		return nil
+	}
+}
+
+// WithCursorHistory keeps the cursor of every page returned by Next so that
+// Prev can move backwards. Vanta's list endpoints decode startCursor and
+// hasPreviousPage but accept no backward cursor parameter, so Prev re-fetches
+// the earlier page from its remembered cursor. The history costs one cursor
+// string per page.
+func WithCursorHistory() PagerOption {
+	return func(cfg *pagerConfig) error {
+		cfg.history = true
+		return nil
+	}
 }
 
 // NewPager creates a cursor pager with an optional initial cursor.
-func NewPager[T any](initialCursor string, fetch func(context.Context, string) (*ResultsPage[T], error)) *Pager[T] {
+//
+// Invalid options surface as an error from the first call to Next.
+func NewPager[T any](initialCursor string, fetch func(context.Context, string) (*ResultsPage[T], error), opts ...PagerOption) *Pager[T] {
 	if fetch == nil {
 		fetch = func(context.Context, string) (*ResultsPage[T], error) {
 			return nil, fmt.Errorf("pager fetch must not be nil")
 		}
 	}
-	return &Pager[T]{fetch: fetch, next: initialCursor}
+	cfg, err := applyPagerOptions(opts)
+	if err != nil {
+		fetch = func(context.Context, string) (*ResultsPage[T], error) {
+			return nil, err
+		}
+	}
+	return &Pager[T]{
+		fetch:       fetch,
+		next:        initialCursor,
+		store:       cfg.store,
+		prefetch:    cfg.prefetch,
+		keepHistory: cfg.history,
+		checkpoint: PagerCheckpoint{
+			Operation: cfg.operation,
+			Params:    cfg.params,
+			Cursor:    initialCursor,
+		},
+	}
+}
+
+// ResumePager creates a pager that continues from a saved checkpoint. When
+// WithPagerOperation is given, the checkpoint must have been taken for the
+// same operation and params.
+func ResumePager[T any](checkpoint PagerCheckpoint, fetch func(context.Context, string) (*ResultsPage[T], error), opts ...PagerOption) (*Pager[T], error) {
+	cfg, err := applyPagerOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.operation != "" && cfg.operation != checkpoint.Operation {
+		return nil, fmt.Errorf("checkpoint is for operation %q, not %q", checkpoint.Operation, cfg.operation)
+	}
+	if cfg.params != nil && !bytes.Equal(compactJSON(cfg.params), compactJSON(checkpoint.Params)) {
+		return nil, fmt.Errorf("checkpoint params %s do not match %s", checkpoint.Params, cfg.params)
+	}
+
+	p := NewPager(checkpoint.Cursor, fetch)
+	p.store = cfg.store
+	p.prefetch = cfg.prefetch
+	p.keepHistory = cfg.history
+	p.position = checkpoint.PagesSeen
+	p.checkpoint = checkpoint
+	p.done = checkpoint.Done
+	return p, nil
+}
+
+func applyPagerOptions(opts []PagerOption) (pagerConfig, error) {
+	var cfg pagerConfig
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		if err := opt(&cfg); err != nil {
+			return pagerConfig{}, err
+		}
+	}
+	return cfg, nil
+}
+
+// Checkpoint returns the pager state after the last processed page.
+func (p *Pager[T]) Checkpoint() PagerCheckpoint {
+	return p.checkpoint
 }
 
 // Next fetches the next page. Returns false when exhausted.
+//
+// Calling Next marks the previously returned page as processed and saves the
+// checkpoint before anything else is fetched.
 func (p *Pager[T]) Next(ctx context.Context) (*ResultsPage[T], bool, error) {
+	if err := p.commit(ctx); err != nil {
+		return nil, false, err
+	}
 	if p.done {
 		return nil, false, nil
 	}
-	page, err := p.fetch(ctx, p.next)
+	cursor := p.next
+	page, err := p.fetchNext(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := p.advance(cursor, page); err != nil {
+		return nil, false, err
+	}
+	if p.keepHistory {
+		p.history = append(p.history, cursor)
+	}
+	p.position++
+	p.stage(ctx)
+	return page, true, nil
+}
+
+// HasPrev reports whether Prev can return an earlier page.
+func (p *Pager[T]) HasPrev() bool {
+	return len(p.history) > 1
+}
+
+// Prev re-fetches the page before the last one returned by Next or Prev.
+// Returns false when already on the first page. It requires WithCursorHistory,
+// and cannot move before the page a ResumePager started from.
+func (p *Pager[T]) Prev(ctx context.Context) (*ResultsPage[T], bool, error) {
+	if !p.keepHistory {
+		return nil, false, fmt.Errorf("pager Prev requires WithCursorHistory")
+	}
+	if !p.HasPrev() {
+		return nil, false, nil
+	}
+	if err := p.commit(ctx); err != nil {
+		return nil, false, err
+	}
+	cursor := p.history[len(p.history)-2]
+	page, err := p.fetch(ctx, cursor)
 	if err != nil {
 		return nil, false, err
 	}
+	p.prefetched = nil
+	p.done = false
+	if err := p.advance(cursor, page); err != nil {
+		return nil, false, err
+	}
+	p.history = p.history[:len(p.history)-1]
+	p.position--
+	p.stage(ctx)
+	return page, true, nil
+}
+
+// commit marks the last returned page as processed and saves the checkpoint.
+func (p *Pager[T]) commit(ctx context.Context) error {
+	if p.staged == nil {
+		return nil
+	}
+	p.checkpoint = *p.staged
+	p.staged = nil
+	if p.store != nil {
+		if err := p.store.SaveCheckpoint(ctx, p.checkpoint); err != nil {
+			return fmt.Errorf("save pager checkpoint: %w", err)
+		}
+	}
+	return nil
+}
+
+// advance validates page, fetched with cursor, and moves p.next past it.
+func (p *Pager[T]) advance(cursor string, page *ResultsPage[T]) error {
 	if page == nil {
-		return nil, false, fmt.Errorf("pager fetch returned nil page")
+		return fmt.Errorf("pager fetch returned nil page")
 	}
 	if !page.Results.PageInfo.HasNextPage {
 		p.done = true
-	} else {
-		if page.Results.PageInfo.EndCursor == "" {
-			return nil, false, fmt.Errorf("pager fetch returned hasNextPage=true with empty endCursor")
-		}
-		if page.Results.PageInfo.EndCursor == p.next {
-			return nil, false, fmt.Errorf("pager fetch returned repeated endCursor %q with hasNextPage=true", p.next)
+		return nil
+	}
+	if page.Results.PageInfo.EndCursor == "" {
+		return fmt.Errorf("pager fetch returned hasNextPage=true with empty endCursor")
+	}
+	if page.Results.PageInfo.EndCursor == cursor {
+		return fmt.Errorf("pager fetch returned repeated endCursor %q with hasNextPage=true", cursor)
+	}
+	p.next = page.Results.PageInfo.EndCursor
+	return nil
+}
+
+// stage records the checkpoint for the page just returned and starts a
+// prefetch of the following page when enabled.
+func (p *Pager[T]) stage(ctx context.Context) {
+	staged := p.checkpoint
+	staged.Cursor = p.next
+	staged.PagesSeen = p.position
+	staged.Done = p.done
+	p.staged = &staged
+
+	if p.prefetch && !p.done {
+		ch := make(chan pageResult[T], 1)
+		fetch, cursor := p.fetch, p.next
+		go func() {
+			page, err := fetch(ctx, cursor)
+			ch <- pageResult[T]{page: page, err: err}
+		}()
+		p.prefetched = ch
+	}
+}
+
+func (p *Pager[T]) fetchNext(ctx context.Context) (*ResultsPage[T], error) {
+	if p.prefetched == nil {
+		return p.fetch(ctx, p.next)
+	}
+	ch := p.prefetched
+	p.prefetched = nil
+	select {
+	case r := <-ch:
+		return r.page, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// All returns an iterator over every item on the remaining pages. Iteration
+// stops after the first error, which is yielded with a zero item, and breaking
+// out of the loop stops further fetching.
+func (p *Pager[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			page, ok, err := p.Next(ctx)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			if !ok {
+				return
+			}
+			for _, item := range page.Results.Data {
+				if !yield(item, nil) {
+					return
+				}
+			}
 		}
-		p.next = page.Results.PageInfo.EndCursor
 	}
-	return page, true, nil
+}
+
+// allItems backs the generated All* methods. Each iteration starts a fresh
+// pager from initialCursor, and fetch receives nil for the first page when no
+// initial cursor was set.
+func allItems[T any](ctx context.Context, initialCursor *string, fetch func(context.Context, *string) (*ResultsPage[T], error)) iter.Seq2[T, error] {
+	start := ""
+	if initialCursor != nil {
+		start = *initialCursor
+	}
+	return func(yield func(T, error) bool) {
+		pager := NewPager(start, func(ctx context.Context, cursor string) (*ResultsPage[T], error) {
+			if cursor == "" {
+				return fetch(ctx, nil)
+			}
+			return fetch(ctx, &cursor)
+		})
+		pager.All(ctx)(yield)
+	}
 }
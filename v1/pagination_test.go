@@ -3,6 +3,10 @@ package v1
 import (
 	"context"
 	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
 	"testing"
 )
 
@@ -103,3 +107,236 @@ func TestPagerRepeatedCursor(t *testing.T) {
 		t.Fatal("expected error for repeated cursor")
 	}
 }
+
+func TestPagerAll(t *testing.T) {
+	pages := map[string]*ResultsPage[string]{
+		"":         {},
+		"cursor-1": {},
+	}
+	pages[""].Results.Data = []string{"a", "b"}
+	pages[""].Results.PageInfo.HasNextPage = true
+	pages[""].Results.PageInfo.EndCursor = "cursor-1"
+	pages["cursor-1"].Results.Data = []string{"c"}
+
+	pager := NewPager[string]("", func(_ context.Context, cursor string) (*ResultsPage[string], error) {
+		return pages[cursor], nil
+	})
+
+	var got []string
+	for item, err := range pager.All(context.Background()) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, item)
+	}
+	if len(got) != 3 || got[0] != "a" || got[2] != "c" {
+		t.Fatalf("items = %v, want [a b c]", got)
+	}
+}
+
+func TestPagerAllStopsAtFirstError(t *testing.T) {
+	pager := NewPager[string]("cursor-1", func(context.Context, string) (*ResultsPage[string], error) {
+		page := &ResultsPage[string]{}
+		page.Results.PageInfo.HasNextPage = true
+		page.Results.PageInfo.EndCursor = "cursor-1"
+		return page, nil
+	})
+
+	var errs []error
+	for _, err := range pager.All(context.Background()) {
+		errs = append(errs, err)
+	}
+	if len(errs) != 1 || errs[0] == nil {
+		t.Fatalf("errors = %v, want one repeated cursor error", errs)
+	}
+}
+
+func TestGeneratedAllFollowsCursorsAndStopsOnBreak(t *testing.T) {
+	var cursors []string
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			cursor := r.URL.Query().Get("pageCursor")
+			cursors = append(cursors, cursor)
+			body := `{"results":{"data":[{"id":"c1"},{"id":"c2"}],"pageInfo":{"hasNextPage":true,"endCursor":"next-1"}}}`
+			if cursor == "next-1" {
+				body = `{"results":{"data":[{"id":"c3"}],"pageInfo":{"hasNextPage":true,"endCursor":"next-2"}}}`
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}, nil
+		}),
+	}
+	c, err := NewClient(WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	params := &ControlsListControlsParams{PageSize: Ptr(2)}
+	var ids []string
+	for control, err := range c.Services.Controls.AllControls(context.Background(), params) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ids = append(ids, string(control.ID))
+		if control.ID == "c3" {
+			break
+		}
+	}
+
+	if strings.Join(ids, ",") != "c1,c2,c3" {
+		t.Fatalf("ids = %v", ids)
+	}
+	if len(cursors) != 2 || cursors[0] != "" || cursors[1] != "next-1" {
+		t.Fatalf("requested cursors = %q, want [\"\" \"next-1\"]", cursors)
+	}
+	if params.PageCursor != nil {
+		t.Fatalf("caller params were mutated: %+v", params)
+	}
+}
+
+func TestGeneratedAllCanBeRangedConcurrently(t *testing.T) {
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			body := `{"results":{"data":[{"id":"c1"}],"pageInfo":{"hasNextPage":true,"endCursor":"next-1"}}}`
+			if r.URL.Query().Get("pageCursor") == "next-1" {
+				body = `{"results":{"data":[{"id":"c2"}],"pageInfo":{"hasNextPage":false}}}`
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}, nil
+		}),
+	}
+	c, err := NewClient(WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	controls := c.Services.Controls.AllControls(context.Background(), nil)
+	var wg sync.WaitGroup
+	results := make([]string, 4)
+	for i := range results {
+		wg.Go(func() {
+			var ids []string
+			for control, err := range controls {
+				if err != nil {
+					ids = append(ids, err.Error())
+					break
+				}
+				ids = append(ids, string(control.ID))
+			}
+			results[i] = strings.Join(ids, ",")
+		})
+	}
+	wg.Wait()
+	for i, got := range results {
+		if got != "c1,c2" {
+			t.Fatalf("run %d ids = %q, want c1,c2", i, got)
+		}
+	}
+}
+
+func TestPagerPrefetchFetchesNextPageEarly(t *testing.T) {
+	ctx := context.Background()
+	fetched := make(chan string, 3)
+	pager := NewPager("", func(_ context.Context, cursor string) (*ResultsPage[string], error) {
+		fetched <- cursor
+		page := &ResultsPage[string]{}
+		page.Results.Data = []string{"item-" + cursor}
+		if cursor == "" {
+			page.Results.PageInfo.HasNextPage = true
+			page.Results.PageInfo.EndCursor = "c1"
+		}
+		return page, nil
+	}, WithPrefetch())
+
+	page, ok, err := pager.Next(ctx)
+	if err != nil || !ok || page.Results.Data[0] != "item-" {
+		t.Fatalf("first page: ok=%v err=%v page=%+v", ok, err, page)
+	}
+	<-fetched
+	// The second page is requested before Next is called again.
+	if cursor := <-fetched; cursor != "c1" {
+		t.Fatalf("prefetched cursor = %q, want c1", cursor)
+	}
+
+	page, ok, err = pager.Next(ctx)
+	if err != nil || !ok || page.Results.Data[0] != "item-c1" {
+		t.Fatalf("second page: ok=%v err=%v page=%+v", ok, err, page)
+	}
+	if _, ok, err := pager.Next(ctx); ok || err != nil {
+		t.Fatalf("exhausted pager: ok=%v err=%v", ok, err)
+	}
+	if len(fetched) != 0 {
+		t.Fatalf("unexpected extra fetch %q", <-fetched)
+	}
+}
+
+func TestPagerPrevUsesCursorHistory(t *testing.T) {
+	ctx := context.Background()
+	var cursors []string
+	pager := NewPager("", func(_ context.Context, cursor string) (*ResultsPage[string], error) {
+		cursors = append(cursors, cursor)
+		next := map[string]string{"": "c1", "c1": "c2"}[cursor]
+		page := &ResultsPage[string]{}
+		page.Results.Data = []string{"page-" + cursor}
+		page.Results.PageInfo.HasNextPage = next != ""
+		page.Results.PageInfo.EndCursor = next
+		return page, nil
+	}, WithCursorHistory())
+
+	data := func(page *ResultsPage[string], ok bool, err error) string {
+		t.Helper()
+		if err != nil || !ok {
+			t.Fatalf("ok=%v err=%v", ok, err)
+		}
+		return page.Results.Data[0]
+	}
+
+	if _, ok, err := pager.Prev(ctx); ok || err != nil {
+		t.Fatalf("Prev before any page: ok=%v err=%v", ok, err)
+	}
+	if got := data(pager.Next(ctx)); got != "page-" {
+		t.Fatalf("page 1 = %q", got)
+	}
+	if got := data(pager.Next(ctx)); got != "page-c1" {
+		t.Fatalf("page 2 = %q", got)
+	}
+	if got := data(pager.Next(ctx)); got != "page-c2" {
+		t.Fatalf("page 3 = %q", got)
+	}
+	if got := data(pager.Prev(ctx)); got != "page-c1" {
+		t.Fatalf("prev = %q, want page-c1", got)
+	}
+	if got := data(pager.Prev(ctx)); got != "page-" {
+		t.Fatalf("prev = %q, want page-", got)
+	}
+	if pager.HasPrev() {
+		t.Fatal("HasPrev on first page = true")
+	}
+	if got := data(pager.Next(ctx)); got != "page-c1" {
+		t.Fatalf("next after prev = %q, want page-c1", got)
+	}
+	if cp := pager.Checkpoint(); cp.PagesSeen != 1 || cp.Cursor != "c1" {
+		t.Fatalf("checkpoint = %+v, want first page processed", cp)
+	}
+
+	want := []string{"", "c1", "c2", "c1", "", "c1"}
+	if strings.Join(cursors, ",") != strings.Join(want, ",") {
+		t.Fatalf("fetched cursors = %q, want %q", cursors, want)
+	}
+}
+
+func TestPagerPrevRequiresHistory(t *testing.T) {
+	pager := NewPager("", func(context.Context, string) (*ResultsPage[string], error) {
+		return &ResultsPage[string]{}, nil
+	})
+	if _, _, err := pager.Prev(context.Background()); err == nil {
+		t.Fatal("expected error without WithCursorHistory")
+	}
+}
@@ -1,22 +1,40 @@
 package v1
 
+// PersonTaskType is a personnel task type.
+type PersonTaskType string
+
 // PersonTaskType values used for personnel tasks.
 const (
-	PersonTaskTypeAcceptPolicies                 = "ACCEPT_POLICIES"
-	PersonTaskTypeCompleteTrainings              = "COMPLETE_TRAININGS"
-	PersonTaskTypeCompleteCustomTasks            = "COMPLETE_CUSTOM_TASKS"
-	PersonTaskTypeCompleteCustomOffboardingTasks = "COMPLETE_CUSTOM_OFFBOARDING_TASKS"
-	PersonTaskTypeInstallDeviceMonitoring        = "INSTALL_DEVICE_MONITORING"
-	PersonTaskTypeCompleteBackgroundChecks       = "COMPLETE_BACKGROUND_CHECKS"
+	PersonTaskTypeAcceptPolicies                 PersonTaskType = "ACCEPT_POLICIES"
+	PersonTaskTypeCompleteTrainings              PersonTaskType = "COMPLETE_TRAININGS"
+	PersonTaskTypeCompleteCustomTasks            PersonTaskType = "COMPLETE_CUSTOM_TASKS"
+	PersonTaskTypeCompleteCustomOffboardingTasks PersonTaskType = "COMPLETE_CUSTOM_OFFBOARDING_TASKS"
+	PersonTaskTypeInstallDeviceMonitoring        PersonTaskType = "INSTALL_DEVICE_MONITORING"
+	PersonTaskTypeCompleteBackgroundChecks       PersonTaskType = "COMPLETE_BACKGROUND_CHECKS"
 )
 
+// Valid reports whether v is a known PersonTaskType.
+func (v PersonTaskType) Valid() bool {
+	switch v {
+	case PersonTaskTypeAcceptPolicies, PersonTaskTypeCompleteTrainings, PersonTaskTypeCompleteCustomTasks,
+		PersonTaskTypeCompleteCustomOffboardingTasks, PersonTaskTypeInstallDeviceMonitoring, PersonTaskTypeCompleteBackgroundChecks:
+		return true
+	}
+	return false
+}
+
+// UnmarshalJSON accepts values the SDK does not know yet.
+func (v *PersonTaskType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, v)
+}
+
 // Person represents a person-like record returned from people/group endpoints.
 type Person struct {
-	ID           string              `json:"id"`
+	ID           PersonID            `json:"id"`
 	EmailAddress string              `json:"emailAddress"`
 	Employment   PersonEmployment    `json:"employment"`
 	LeaveInfo    *PersonLeaveInfo    `json:"leaveInfo"`
-	GroupIDs     []string            `json:"groupIds"`
+	GroupIDs     []GroupID           `json:"groupIds"`
 	Name         PersonName          `json:"name"`
 	Sources      PersonSources       `json:"sources"`
 	TasksSummary *PersonTasksSummary `json:"tasksSummary"`
@@ -38,6 +56,7 @@ type PersonName struct {
 type PersonLeaveInfo struct {
 	EndDate   string `json:"endDate"`
 	StartDate string `json:"startDate"`
+	Status    string `json:"status"`
 }
 
 type PersonSources struct {
@@ -53,9 +72,9 @@ type PersonEmploymentSources struct {
 }
 
 type PersonSourceRef struct {
-	IntegrationID string `json:"integrationId"`
-	ResourceID    string `json:"resourceId"`
-	Type          string `json:"type"`
+	IntegrationID IntegrationID `json:"integrationId"`
+	ResourceID    string        `json:"resourceId"`
+	Type          string        `json:"type"`
 }
 
 // PersonTasksSummary provides completion and status details for people tasks.
@@ -101,12 +120,45 @@ type PersonNamedReference struct {
 	Name string `json:"name"`
 }
 
-// PeopleListPeopleResponse is the typed response envelope for listing people.
-type PeopleListPeopleResponse struct {
-	Results PeopleListPeopleResults `json:"results"`
+// PeopleMarkAsNotPeopleUpdate is one entry of a mark-as-not-people request.
+type PeopleMarkAsNotPeopleUpdate struct {
+	ID     PersonID `json:"id"`
+	Reason string   `json:"reason"`
+}
+
+// PeopleMarkAsPeopleUpdate is one entry of a mark-as-people request.
+type PeopleMarkAsPeopleUpdate struct {
+	ID PersonID `json:"id"`
+}
+
+// PeopleOffboardPeopleUpdate is one entry of an offboard request.
+type PeopleOffboardPeopleUpdate struct {
+	AcknowledgerID PersonID `json:"acknowledgerId"`
+	ID             PersonID `json:"id"`
+}
+
+// PeopleBulkUpdateResult is the per-person outcome of a bulk people update.
+type PeopleBulkUpdateResult struct {
+	ID      PersonID `json:"id"`
+	Status  string   `json:"status"`
+	Message *string  `json:"message"`
+}
+
+// PeopleUpdatePersonMetadataRequestBody is a partial person update; nil
+// fields are left unchanged.
+type PeopleUpdatePersonMetadataRequestBody struct {
+	Employment *PeopleUpdatePersonMetadataEmployment `json:"employment,omitempty"`
+	Name       *PeopleUpdatePersonMetadataName       `json:"name,omitempty"`
+}
+
+type PeopleUpdatePersonMetadataEmployment struct {
+	EndDate   *string `json:"endDate,omitempty"`
+	JobTitle  *string `json:"jobTitle,omitempty"`
+	StartDate *string `json:"startDate,omitempty"`
+	Status    *string `json:"status,omitempty"`
 }
 
-type PeopleListPeopleResults struct {
-	Data     []Person `json:"data"`
-	PageInfo PageInfo `json:"pageInfo"`
+type PeopleUpdatePersonMetadataName struct {
+	First *string `json:"first,omitempty"`
+	Last  *string `json:"last,omitempty"`
 }
@@ -201,12 +201,11 @@ func TestPeopleBulkMutationRequestsAndResponses(t *testing.T) {
 			t.Fatalf("NewClient returned error: %v", err)
 		}
 
+		updates := []PeopleMarkAsPeopleUpdate{{
+			ID: "65e1efde08e8478f143a8ff9",
+		}}
 		resp, err := client.Services.People.MarkAsPeople(context.Background(), &PeopleMarkAsPeopleParams{
-			Body: &PeopleMarkAsPeopleRequestBody{
-				Updates: []PeopleMarkAsPeopleUpdate{{
-					ID: "65e1efde08e8478f143a8ff9",
-				}},
-			},
+			Body: &PeopleMarkAsPeopleRequestBody{Updates: updates},
 		})
 		if err != nil {
 			t.Fatalf("MarkAsPeople returned error: %v", err)
@@ -222,7 +221,7 @@ func TestPeopleBulkMutationRequestsAndResponses(t *testing.T) {
 		if !reflect.DeepEqual(gotBody, wantBody) {
 			t.Fatalf("request body = %#v, want %#v", gotBody, wantBody)
 		}
-		if len(resp.Results) != 1 || resp.Results[0].Status != "SUCCESS" {
+		if len(resp.Results) != 1 || resp.Results[0].Status != "SUCCESS" || resp.Results[0].ID != updates[0].ID {
 			t.Fatalf("unexpected mark-as-people response: %+v", resp.Results)
 		}
 	})
@@ -444,7 +443,7 @@ func TestUnknownFieldWarningsForPeopleModels(t *testing.T) {
 		}
 	}`
 
-	var resp PeopleListPeopleResponse
+	var resp ResultsPage[Person]
 	if err := decodeJSONBytes([]byte(payload), &resp); err != nil {
 		t.Fatalf("unmarshal list people response: %v", err)
 	}
@@ -454,10 +453,10 @@ func TestUnknownFieldWarningsForPeopleModels(t *testing.T) {
 
 	joined := strings.Join(warnings, "\n")
 	for _, want := range []string{
-		"PeopleListPeopleResponse.results.data[].unexpectedPersonField",
-		"PeopleListPeopleResponse.results.data[].employment.unexpectedEmploymentField",
-		"PeopleListPeopleResponse.results.data[].tasksSummary.details.newTaskType",
-		"PeopleListPeopleResponse.results.data[].tasksSummary.details.completeCustomTasks.unexpectedTaskField",
+		"ResultsPage[Person].results.data[].unexpectedPersonField",
+		"ResultsPage[Person].results.data[].employment.unexpectedEmploymentField",
+		"ResultsPage[Person].results.data[].tasksSummary.details.newTaskType",
+		"ResultsPage[Person].results.data[].tasksSummary.details.completeCustomTasks.unexpectedTaskField",
 	} {
 		if !strings.Contains(joined, want) {
 			t.Fatalf("warnings %q do not contain %q", joined, want)
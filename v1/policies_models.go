@@ -0,0 +1,15 @@
+package v1
+
+// Policy is a policy and the status of its latest version.
+type Policy struct {
+	ID             PolicyID             `json:"id"`
+	Name           string               `json:"name"`
+	Description    string               `json:"description"`
+	Status         string               `json:"status"`
+	ApprovedAtDate *string              `json:"approvedAtDate"`
+	LatestVersion  *PolicyLatestVersion `json:"latestVersion"`
+}
+
+type PolicyLatestVersion struct {
+	Status string `json:"status"`
+}
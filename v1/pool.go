@@ -0,0 +1,284 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const defaultPoolIdleTimeout = 30 * time.Minute
+
+type tenantContextKey struct{}
+
+// ContextWithTenant returns a context tagged with a tenant identifier.
+func ContextWithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantContextKey{}, tenant)
+}
+
+// TenantFromContext returns the tenant identifier set by ContextWithTenant or
+// by a client created with WithTenant.
+func TenantFromContext(ctx context.Context) (string, bool) {
+	tenant, ok := ctx.Value(tenantContextKey{}).(string)
+	return tenant, ok && tenant != ""
+}
+
+// TenantCredentials describes how to authenticate as one tenant.
+//
+// When TokenSource is nil, ClientID and ClientSecret are exchanged with the
+// OAuth client credentials flow, at the tenant client's auth URL and through
+// its HTTP client.
+type TenantCredentials struct {
+	ClientID     string
+	ClientSecret string
+	Scope        string
+	TokenSource  TokenSource
+	// Options are applied after ClientPoolConfig.Options for this tenant only.
+	Options []Option
+}
+
+// CredentialsProvider resolves credentials for a tenant.
+type CredentialsProvider interface {
+	TenantCredentials(ctx context.Context, tenant string) (TenantCredentials, error)
+}
+
+// CredentialsProviderFunc adapts a function to CredentialsProvider.
+type CredentialsProviderFunc func(ctx context.Context, tenant string) (TenantCredentials, error)
+
+// TenantCredentials calls f.
+func (f CredentialsProviderFunc) TenantCredentials(ctx context.Context, tenant string) (TenantCredentials, error) {
+	return f(ctx, tenant)
+}
+
+// ClientPoolConfig configures a ClientPool.
+type ClientPoolConfig struct {
+	Credentials CredentialsProvider
+	// Options are applied to every tenant client.
+	Options []Option
+	// IdleTimeout evicts clients that have sent no request for this long.
+	// Defaults to 30m.
+	IdleTimeout time.Duration
+
+	now func() time.Time
+}
+
+// ClientPool lazily creates and caches one Client per tenant.
+//
+// Each tenant gets its own Client and token source, so token caches are never
+// shared between tenants. Clients are tagged with WithTenant. Every request a
+// client sends counts as a use, so clients held by long-running callers stay
+// cached; clients idle for longer than the idle timeout, with no request in
+// flight, are closed and evicted on the next pool access.
+type ClientPool struct {
+	credentials CredentialsProvider
+	options     []Option
+	idleTimeout time.Duration
+	now         func() time.Time
+
+	mu      sync.Mutex
+	clients map[string]*pooledClient
+	closed  bool
+}
+
+// pooledClient is a cached client. lastUsed and inFlight are guarded by the
+// pool's mutex.
+type pooledClient struct {
+	client   *Client
+	lastUsed time.Time
+	inFlight int
+}
+
+// NewClientPool builds an empty client pool.
+func NewClientPool(cfg ClientPoolConfig) (*ClientPool, error) {
+	if cfg.Credentials == nil {
+		return nil, fmt.Errorf("credentials provider must not be nil")
+	}
+	if cfg.IdleTimeout <= 0 {
+		cfg.IdleTimeout = defaultPoolIdleTimeout
+	}
+	if cfg.now == nil {
+		cfg.now = time.Now
+	}
+	return &ClientPool{
+		credentials: cfg.Credentials,
+		options:     append([]Option(nil), cfg.Options...),
+		idleTimeout: cfg.IdleTimeout,
+		now:         cfg.now,
+		clients:     map[string]*pooledClient{},
+	}, nil
+}
+
+// Client returns the cached client for tenant, creating it on first use.
+func (p *ClientPool) Client(ctx context.Context, tenant string) (*Client, error) {
+	if tenant == "" {
+		return nil, fmt.Errorf("tenant must not be empty")
+	}
+	if err := p.EvictIdle(ctx); err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil, fmt.Errorf("client pool is closed")
+	}
+	if entry, ok := p.clients[tenant]; ok {
+		entry.lastUsed = p.now()
+		p.mu.Unlock()
+		return entry.client, nil
+	}
+	p.mu.Unlock()
+
+	created := &pooledClient{}
+	client, err := p.newTenantClient(ctx, tenant, created)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return nil, fmt.Errorf("client pool is closed")
+	}
+	if entry, ok := p.clients[tenant]; ok {
+		// Another caller created the client concurrently; ours has not
+		// fetched a token yet so it can be dropped.
+		entry.lastUsed = p.now()
+		return entry.client, nil
+	}
+	created.client = client
+	created.lastUsed = p.now()
+	p.clients[tenant] = created
+	return client, nil
+}
+
+// newTenantClient builds the client for tenant. Its HTTP client reports each
+// request to entry.
+func (p *ClientPool) newTenantClient(ctx context.Context, tenant string, entry *pooledClient) (*Client, error) {
+	creds, err := p.credentials.TenantCredentials(ctx, tenant)
+	if err != nil {
+		return nil, fmt.Errorf("resolve credentials for tenant %q: %w", tenant, err)
+	}
+
+	opts := make([]Option, 0, len(p.options)+len(creds.Options)+2)
+	opts = append(opts, p.options...)
+	opts = append(opts, creds.Options...)
+	if creds.TokenSource != nil {
+		opts = append(opts, WithTokenSource(creds.TokenSource))
+	}
+	opts = append(opts, WithTenant(tenant))
+	client, err := NewClient(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("tenant %q: %w", tenant, err)
+	}
+
+	httpClient := *client.httpClient
+	base := httpClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	httpClient.Transport = &poolTransport{base: base, pool: p, entry: entry}
+	client.httpClient = &httpClient
+
+	if creds.TokenSource == nil {
+		// Options may point the client at another host or transport; the
+		// token exchange must follow them.
+		source, err := NewOAuthClientCredentialsTokenSource(OAuthClientCredentialsConfig{
+			ClientID:     creds.ClientID,
+			ClientSecret: creds.ClientSecret,
+			Scope:        creds.Scope,
+			AuthURL:      client.authURL,
+			HTTPClient:   client.httpClient,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("tenant %q: %w", tenant, err)
+		}
+		client.tokenSource = source
+	}
+	return client, nil
+}
+
+// poolTransport marks a pooled client as in use while it sends a request.
+type poolTransport struct {
+	base  http.RoundTripper
+	pool  *ClientPool
+	entry *pooledClient
+}
+
+func (t *poolTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.pool.mu.Lock()
+	t.entry.inFlight++
+	t.entry.lastUsed = t.pool.now()
+	t.pool.mu.Unlock()
+	defer func() {
+		t.pool.mu.Lock()
+		t.entry.inFlight--
+		t.entry.lastUsed = t.pool.now()
+		t.pool.mu.Unlock()
+	}()
+	return t.base.RoundTrip(req)
+}
+
+// Evict closes and removes the client for tenant, if cached.
+func (p *ClientPool) Evict(ctx context.Context, tenant string) error {
+	p.mu.Lock()
+	entry, ok := p.clients[tenant]
+	delete(p.clients, tenant)
+	p.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+	return entry.client.Close(ctx)
+}
+
+// EvictIdle closes and removes clients that have sent no request for longer
+// than the pool's idle timeout. Clients with a request in flight are kept.
+func (p *ClientPool) EvictIdle(ctx context.Context) error {
+	cutoff := p.now().Add(-p.idleTimeout)
+
+	p.mu.Lock()
+	var idle []*Client
+	for tenant, entry := range p.clients {
+		if entry.inFlight == 0 && entry.lastUsed.Before(cutoff) {
+			idle = append(idle, entry.client)
+			delete(p.clients, tenant)
+		}
+	}
+	p.mu.Unlock()
+
+	return closeClients(ctx, idle)
+}
+
+// Len returns the number of cached tenant clients.
+func (p *ClientPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.clients)
+}
+
+// Close closes every cached client. Later calls to Client return an error.
+func (p *ClientPool) Close(ctx context.Context) error {
+	p.mu.Lock()
+	p.closed = true
+	clients := make([]*Client, 0, len(p.clients))
+	for _, entry := range p.clients {
+		clients = append(clients, entry.client)
+	}
+	p.clients = map[string]*pooledClient{}
+	p.mu.Unlock()
+
+	return closeClients(ctx, clients)
+}
+
+func closeClients(ctx context.Context, clients []*Client) error {
+	var errs []error
+	for _, c := range clients {
+		if err := c.Close(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("tenant %q: %w", c.Tenant(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
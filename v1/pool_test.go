@@ -0,0 +1,210 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClientPoolIsolatesTenants(t *testing.T) {
+	var authHeaders []string
+	var tenants []string
+	var logs []string
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			authHeaders = append(authHeaders, r.Header.Get(headerAuthorization))
+			tenant, _ := TenantFromContext(r.Context())
+			tenants = append(tenants, tenant)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"results":{"data":[],"pageInfo":{}}}`)),
+			}, nil
+		}),
+	}
+
+	var resolved []string
+	pool, err := NewClientPool(ClientPoolConfig{
+		Credentials: CredentialsProviderFunc(func(_ context.Context, tenant string) (TenantCredentials, error) {
+			resolved = append(resolved, tenant)
+			return TenantCredentials{TokenSource: StaticTokenSource("token-" + tenant)}, nil
+		}),
+		Options: []Option{
+			WithHTTPClient(httpClient),
+			WithLogf(func(format string, args ...any) { logs = append(logs, fmt.Sprintf(format, args...)) }),
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewClientPool returned error: %v", err)
+	}
+
+	ctx := context.Background()
+	for _, tenant := range []string{"acme", "globex", "acme"} {
+		c, err := pool.Client(ctx, tenant)
+		if err != nil {
+			t.Fatalf("Client(%q) returned error: %v", tenant, err)
+		}
+		if c.Tenant() != tenant {
+			t.Fatalf("client tenant = %q, want %q", c.Tenant(), tenant)
+		}
+		if _, err := c.Services.People.ListPeople(ctx, nil); err != nil {
+			t.Fatalf("ListPeople returned error: %v", err)
+		}
+	}
+
+	if got := strings.Join(resolved, ","); got != "acme,globex" {
+		t.Fatalf("resolved tenants = %s, want acme,globex", got)
+	}
+	if pool.Len() != 2 {
+		t.Fatalf("pool size = %d, want 2", pool.Len())
+	}
+	wantAuth := []string{"Bearer token-acme", "Bearer token-globex", "Bearer token-acme"}
+	for i, want := range wantAuth {
+		if authHeaders[i] != want {
+			t.Fatalf("request %d authorization = %q, want %q", i, authHeaders[i], want)
+		}
+	}
+	if got := strings.Join(tenants, ","); got != "acme,globex,acme" {
+		t.Fatalf("request tenants = %s", got)
+	}
+	if len(logs) != 3 || !strings.Contains(logs[1], "tenant=globex") {
+		t.Fatalf("unexpected logs: %q", logs)
+	}
+}
+
+func TestClientPoolEvictsIdleClients(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	pool, err := NewClientPool(ClientPoolConfig{
+		Credentials: CredentialsProviderFunc(func(_ context.Context, tenant string) (TenantCredentials, error) {
+			return TenantCredentials{TokenSource: StaticTokenSource("token")}, nil
+		}),
+		IdleTimeout: time.Minute,
+		now:         func() time.Time { return now },
+	})
+	if err != nil {
+		t.Fatalf("NewClientPool returned error: %v", err)
+	}
+
+	ctx := context.Background()
+	first, err := pool.Client(ctx, "acme")
+	if err != nil {
+		t.Fatalf("Client returned error: %v", err)
+	}
+	now = now.Add(2 * time.Minute)
+	if _, err := pool.Client(ctx, "globex"); err != nil {
+		t.Fatalf("Client returned error: %v", err)
+	}
+	if pool.Len() != 1 {
+		t.Fatalf("pool size = %d, want 1 after idle eviction", pool.Len())
+	}
+	second, err := pool.Client(ctx, "acme")
+	if err != nil {
+		t.Fatalf("Client returned error: %v", err)
+	}
+	if first == second {
+		t.Fatal("expected a new client after eviction")
+	}
+
+	if err := pool.Close(ctx); err != nil {
+		t.Fatalf("Close returned error: %v", err)
+	}
+	if _, err := pool.Client(ctx, "acme"); err == nil {
+		t.Fatal("expected error from closed pool")
+	}
+}
+
+func TestClientPoolKeepsClientsInUse(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var pool *ClientPool
+	var lenDuringRequest int
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			// A slow request outlives the idle timeout.
+			now = now.Add(2 * time.Minute)
+			if err := pool.EvictIdle(r.Context()); err != nil {
+				return nil, err
+			}
+			lenDuringRequest = pool.Len()
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"results":{"data":[],"pageInfo":{}}}`)),
+			}, nil
+		}),
+	}
+	revoked := 0
+	var err error
+	pool, err = NewClientPool(ClientPoolConfig{
+		Credentials: CredentialsProviderFunc(func(context.Context, string) (TenantCredentials, error) {
+			return TenantCredentials{TokenSource: &revokeCounter{revoked: &revoked}}, nil
+		}),
+		Options:     []Option{WithHTTPClient(httpClient)},
+		IdleTimeout: time.Minute,
+		now:         func() time.Time { return now },
+	})
+	if err != nil {
+		t.Fatalf("NewClientPool returned error: %v", err)
+	}
+
+	ctx := context.Background()
+	client, err := pool.Client(ctx, "acme")
+	if err != nil {
+		t.Fatalf("Client returned error: %v", err)
+	}
+	// The caller holds on to the client and keeps sending requests without
+	// going back to the pool.
+	for i := range 2 {
+		if _, err := client.Services.People.ListPeople(ctx, nil); err != nil {
+			t.Fatalf("request %d: %v", i, err)
+		}
+		if lenDuringRequest != 1 {
+			t.Fatalf("request %d: pool size during request = %d, want 1", i, lenDuringRequest)
+		}
+		if err := pool.EvictIdle(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if pool.Len() != 1 || revoked != 0 {
+			t.Fatalf("request %d: pool size = %d, revoked %d; want the client kept", i, pool.Len(), revoked)
+		}
+	}
+
+	now = now.Add(2 * time.Minute)
+	if err := pool.EvictIdle(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if pool.Len() != 0 || revoked != 1 {
+		t.Fatalf("pool size = %d, revoked %d; want the idle client closed", pool.Len(), revoked)
+	}
+}
+
+type revokeCounter struct{ revoked *int }
+
+func (s *revokeCounter) Token(context.Context) (Token, error) {
+	return Token{AccessToken: "token", TokenType: "Bearer"}, nil
+}
+
+func (s *revokeCounter) Revoke(context.Context) error {
+	*s.revoked++
+	return nil
+}
+
+func TestClientPoolCredentialsError(t *testing.T) {
+	pool, err := NewClientPool(ClientPoolConfig{
+		Credentials: CredentialsProviderFunc(func(context.Context, string) (TenantCredentials, error) {
+			return TenantCredentials{}, fmt.Errorf("no such tenant")
+		}),
+	})
+	if err != nil {
+		t.Fatalf("NewClientPool returned error: %v", err)
+	}
+	_, err = pool.Client(context.Background(), "acme")
+	if err == nil || !strings.Contains(err.Error(), `tenant "acme"`) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
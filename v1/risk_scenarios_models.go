@@ -0,0 +1,48 @@
+package v1
+
+// RiskScenario is a risk register entry.
+type RiskScenario struct {
+	RiskID             RiskScenarioID `json:"riskId"`
+	Description        string         `json:"description"`
+	IsSensitive        bool           `json:"isSensitive"`
+	Likelihood         int            `json:"likelihood"`
+	Impact             int            `json:"impact"`
+	ResidualLikelihood int            `json:"residualLikelihood"`
+	ResidualImpact     int            `json:"residualImpact"`
+	Categories         []string       `json:"categories"`
+	CIACategories      []string       `json:"ciaCategories"`
+	Treatment          RiskTreatment  `json:"treatment"`
+	Owner              any            `json:"owner"`
+	Note               *string        `json:"note"`
+	RiskRegister       string         `json:"riskRegister"`
+	CustomFields       CustomFields   `json:"customFields"`
+	IsArchived         bool           `json:"isArchived"`
+	ReviewStatus       string         `json:"reviewStatus"`
+	RequiredApprovers  []any          `json:"requiredApprovers"`
+	Type               string         `json:"type"`
+}
+
+// RiskTreatment is how a risk scenario is treated.
+type RiskTreatment string
+
+// RiskTreatment values.
+const (
+	RiskTreatmentAccept   RiskTreatment = "Accept"
+	RiskTreatmentMitigate RiskTreatment = "Mitigate"
+	RiskTreatmentTransfer RiskTreatment = "Transfer"
+	RiskTreatmentAvoid    RiskTreatment = "Avoid"
+)
+
+// Valid reports whether v is a known RiskTreatment.
+func (v RiskTreatment) Valid() bool {
+	switch v {
+	case RiskTreatmentAccept, RiskTreatmentMitigate, RiskTreatmentTransfer, RiskTreatmentAvoid:
+		return true
+	}
+	return false
+}
+
+// UnmarshalJSON accepts values the SDK does not know yet.
+func (v *RiskTreatment) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, v)
+}
@@ -0,0 +1,97 @@
+package v1
+
+// Test is an automated Vanta test returned from tests and controls endpoints.
+type Test struct {
+	ID                     TestID                     `json:"id"`
+	Name                   string                     `json:"name"`
+	LastTestRunDate        string                     `json:"lastTestRunDate"`
+	LatestFlipDate         *string                    `json:"latestFlipDate"`
+	Description            string                     `json:"description"`
+	FailureDescription     string                     `json:"failureDescription"`
+	RemediationDescription string                     `json:"remediationDescription"`
+	Version                TestVersion                `json:"version"`
+	Category               string                     `json:"category"`
+	Integrations           []string                   `json:"integrations"`
+	Status                 TestStatus                 `json:"status"`
+	DeactivatedStatusInfo  *TestDeactivatedStatusInfo `json:"deactivatedStatusInfo"`
+	RemediationStatusInfo  *TestRemediationStatusInfo `json:"remediationStatusInfo"`
+	Owner                  *OwnerReference            `json:"owner"`
+}
+
+type TestVersion struct {
+	Major int `json:"major"`
+	Minor int `json:"minor"`
+}
+
+type TestDeactivatedStatusInfo struct {
+	IsDeactivated     bool    `json:"isDeactivated"`
+	DeactivatedReason *string `json:"deactivatedReason"`
+	LastUpdatedDate   *string `json:"lastUpdatedDate"`
+}
+
+type TestRemediationStatusInfo struct {
+	Status                 string  `json:"status"`
+	SoonestRemediateByDate *string `json:"soonestRemediateByDate"`
+	ItemCount              int     `json:"itemCount"`
+}
+
+// TestEntity is a resource evaluated by a test.
+type TestEntity struct {
+	ID                TestEntityID     `json:"id"`
+	EntityStatus      TestEntityStatus `json:"entityStatus"`
+	DisplayName       string           `json:"displayName"`
+	ResponseType      string           `json:"responseType"`
+	DeactivatedReason *string          `json:"deactivatedReason"`
+	LastUpdatedDate   string           `json:"lastUpdatedDate"`
+	CreatedDate       string           `json:"createdDate"`
+}
+
+// TestStatus is the outcome of a test.
+type TestStatus string
+
+// TestStatus values.
+const (
+	TestStatusOK             TestStatus = "OK"
+	TestStatusDeactivated    TestStatus = "DEACTIVATED"
+	TestStatusNeedsAttention TestStatus = "NEEDS_ATTENTION"
+	TestStatusInProgress     TestStatus = "IN_PROGRESS"
+	TestStatusInvalid        TestStatus = "INVALID"
+	TestStatusNotApplicable  TestStatus = "NOT_APPLICABLE"
+)
+
+// Valid reports whether v is a known TestStatus.
+func (v TestStatus) Valid() bool {
+	switch v {
+	case TestStatusOK, TestStatusDeactivated, TestStatusNeedsAttention, TestStatusInProgress, TestStatusInvalid, TestStatusNotApplicable:
+		return true
+	}
+	return false
+}
+
+// UnmarshalJSON accepts values the SDK does not know yet.
+func (v *TestStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, v)
+}
+
+// TestEntityStatus is the outcome of a test for one entity.
+type TestEntityStatus string
+
+// TestEntityStatus values.
+const (
+	TestEntityStatusFailing     TestEntityStatus = "FAILING"
+	TestEntityStatusDeactivated TestEntityStatus = "DEACTIVATED"
+)
+
+// Valid reports whether v is a known TestEntityStatus.
+func (v TestEntityStatus) Valid() bool {
+	switch v {
+	case TestEntityStatusFailing, TestEntityStatusDeactivated:
+		return true
+	}
+	return false
+}
+
+// UnmarshalJSON accepts values the SDK does not know yet.
+func (v *TestEntityStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, v)
+}
@@ -0,0 +1,95 @@
+package v1
+
+// TrustCenterAccessRequest is a pending or historical Trust Center access
+// request.
+type TrustCenterAccessRequest struct {
+	ID                 TrustCenterAccessRequestID `json:"id"`
+	Email              string                     `json:"email"`
+	Name               string                     `json:"name"`
+	CompanyName        string                     `json:"companyName"`
+	Reason             string                     `json:"reason"`
+	RequestedResources any                        `json:"requestedResources"`
+	AccessLevel        string                     `json:"accessLevel"`
+	CreationDate       string                     `json:"creationDate"`
+	UpdatedDate        string                     `json:"updatedDate"`
+	Outcome            string                     `json:"outcome"`
+}
+
+// TrustCenterControl is a control published on a Trust Center.
+type TrustCenterControl struct {
+	ID          ControlID                       `json:"id"`
+	Name        string                          `json:"name"`
+	Description string                          `json:"description"`
+	Categories  []TrustCenterControlCategoryRef `json:"categories"`
+}
+
+type TrustCenterControlCategoryRef struct {
+	ID   TrustCenterControlCategoryID `json:"id"`
+	Name string                       `json:"name"`
+}
+
+// TrustCenterSubscriberGroup is a named group of Trust Center subscribers.
+type TrustCenterSubscriberGroup struct {
+	ID            TrustCenterSubscriberGroupID `json:"id"`
+	Name          string                       `json:"name"`
+	SubscriberIDs []TrustCenterSubscriberID    `json:"subscriberIds"`
+	CreationDate  string                       `json:"creationDate"`
+}
+
+// TrustCenterSubscriber is a Trust Center update subscriber.
+type TrustCenterSubscriber struct {
+	ID              TrustCenterSubscriberID `json:"id"`
+	Email           string                  `json:"email"`
+	IsEmailVerified bool                    `json:"isEmailVerified"`
+	CreationDate    string                  `json:"creationDate"`
+}
+
+// TrustCenterUpdate is an update posted to a Trust Center.
+type TrustCenterUpdate struct {
+	ID             TrustCenterUpdateID `json:"id"`
+	Title          string              `json:"title"`
+	Description    string              `json:"description"`
+	Category       string              `json:"category"`
+	CreationDate   string              `json:"creationDate"`
+	UpdatedDate    string              `json:"updatedDate"`
+	VisibilityType string              `json:"visibilityType"`
+	NotifiedEmails []string            `json:"notifiedEmails"`
+}
+
+// TrustCenterViewerActivityEvent is a single viewer action on a Trust Center.
+type TrustCenterViewerActivityEvent struct {
+	ID          string                                 `json:"id"`
+	Date        string                                 `json:"date"`
+	EventType   string                                 `json:"eventType"`
+	Details     *TrustCenterViewerActivityEventDetails `json:"details"`
+	ViewerEmail string                                 `json:"viewerEmail"`
+	ViewerID    TrustCenterViewerID                    `json:"viewerId"`
+	CountryCode string                                 `json:"countryCode"`
+	City        string                                 `json:"city"`
+}
+
+type TrustCenterViewerActivityEventDetails struct {
+	Page string `json:"page"`
+}
+
+// TrustCenterViewer is a person granted access to a Trust Center.
+type TrustCenterViewer struct {
+	ID                          TrustCenterViewerID                           `json:"id"`
+	Email                       string                                        `json:"email"`
+	Name                        string                                        `json:"name"`
+	CompanyName                 string                                        `json:"companyName"`
+	ResourceIDs                 []string                                      `json:"resourceIds"`
+	AccessLevel                 string                                        `json:"accessLevel"`
+	NDAInfo                     any                                           `json:"ndaInfo"`
+	ExternalServiceAssociations []TrustCenterViewerExternalServiceAssociation `json:"externalServiceAssociations"`
+	CreationDate                string                                        `json:"creationDate"`
+	UpdatedDate                 string                                        `json:"updatedDate"`
+	ExpirationDate              *string                                       `json:"expirationDate"`
+	AddedByUser                 any                                           `json:"addedByUser"`
+}
+
+type TrustCenterViewerExternalServiceAssociation struct {
+	Service    string `json:"service"`
+	ID         string `json:"id"`
+	ObjectType string `json:"objectType"`
+}
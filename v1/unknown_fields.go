@@ -6,6 +6,7 @@ import (
 	"log"
 	"maps"
 	"reflect"
+	"regexp"
 	"strings"
 	"sync"
 )
@@ -18,9 +19,30 @@ var UnknownFieldWarningf = log.Printf
 var (
 	unknownFieldWarningsMu   sync.Mutex
 	unknownFieldWarningsSeen = map[string]struct{}{}
+	// unknownFieldWarningsOrder holds the seen keys oldest first, for
+	// eviction.
+	unknownFieldWarningsOrder []string
 )
 
-var jsonRawMessageType = reflect.TypeFor[json.RawMessage]()
+const (
+	// maxUnknownFieldWarnings caps the warnings remembered for
+	// deduplication. Map keys and enum values are left out of warning keys,
+	// but unknown field names are still chosen by the server; past the cap
+	// the oldest keys are forgotten, so they may be reported again.
+	maxUnknownFieldWarnings = 1024
+	// maxUnknownFieldDepth is how deep the walker follows a response. Models
+	// nest far less deeply; the limit keeps recursive types from following
+	// adversarially nested input.
+	maxUnknownFieldDepth = 64
+)
+
+// jsonFieldsCache maps a struct type to its jsonFieldsForType result.
+var jsonFieldsCache sync.Map
+
+var (
+	jsonRawMessageType  = reflect.TypeFor[json.RawMessage]()
+	jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+)
 
 func decodeJSONBytes(data []byte, out any) error {
 	if err := json.Unmarshal(data, out); err != nil {
@@ -49,33 +71,51 @@ func warnUnknownJSONFields(data []byte, out any) {
 	if rootName == "" {
 		rootName = "response"
 	}
-	walkUnknownFields(derefType(t), raw, rootName)
+	walkUnknownFields(derefType(t), raw, rootName, 0)
 }
 
 func warnUnknownField(path string) {
+	warnOnce(path, "vanta-sdk-go: unknown response field detected: %s", path)
+}
+
+// warnOnce calls UnknownFieldWarningf the first time key is seen. It
+// remembers the last maxUnknownFieldWarnings keys.
+func warnOnce(key, format string, args ...any) {
 	unknownFieldWarningsMu.Lock()
-	if _, seen := unknownFieldWarningsSeen[path]; seen {
+	if _, seen := unknownFieldWarningsSeen[key]; seen {
 		unknownFieldWarningsMu.Unlock()
 		return
 	}
-	unknownFieldWarningsSeen[path] = struct{}{}
+	if len(unknownFieldWarningsOrder) >= maxUnknownFieldWarnings {
+		delete(unknownFieldWarningsSeen, unknownFieldWarningsOrder[0])
+		unknownFieldWarningsOrder = unknownFieldWarningsOrder[1:]
+	}
+	unknownFieldWarningsSeen[key] = struct{}{}
+	unknownFieldWarningsOrder = append(unknownFieldWarningsOrder, key)
 	warnf := UnknownFieldWarningf
 	unknownFieldWarningsMu.Unlock()
 
 	if warnf != nil {
-		warnf("vanta-sdk-go: unknown response field detected: %s", path)
+		warnf(format, args...)
 	}
 }
 
-func walkUnknownFields(t reflect.Type, raw any, path string) {
+// walkUnknownFields warns about the fields of raw that t does not declare.
+// It visits each value of raw at most once and stops below
+// maxUnknownFieldDepth, so its work is bounded by the size of the input.
+func walkUnknownFields(t reflect.Type, raw any, path string, depth int) {
 	t = derefType(t)
-	if t == nil || raw == nil {
+	if t == nil || raw == nil || depth > maxUnknownFieldDepth {
 		return
 	}
 
 	if t == jsonRawMessageType || isByteSliceType(t) {
 		return
 	}
+	// Types with their own UnmarshalJSON decide what to keep.
+	if reflect.PointerTo(t).Implements(jsonUnmarshalerType) {
+		return
+	}
 
 	switch t.Kind() {
 	case reflect.Struct:
@@ -94,7 +134,7 @@ func walkUnknownFields(t reflect.Type, raw any, path string) {
 			if !ok {
 				continue
 			}
-			walkUnknownFields(fieldType, value, path+"."+key)
+			walkUnknownFields(fieldType, value, path+"."+key, depth+1)
 		}
 	case reflect.Slice, reflect.Array:
 		items, ok := raw.([]any)
@@ -102,7 +142,7 @@ func walkUnknownFields(t reflect.Type, raw any, path string) {
 			return
 		}
 		for _, item := range items {
-			walkUnknownFields(t.Elem(), item, path+"[]")
+			walkUnknownFields(t.Elem(), item, path+"[]", depth+1)
 		}
 	case reflect.Map:
 		if t.Key().Kind() != reflect.String {
@@ -115,13 +155,21 @@ func walkUnknownFields(t reflect.Type, raw any, path string) {
 		if !ok {
 			return
 		}
-		for key, value := range obj {
-			walkUnknownFields(t.Elem(), value, path+"."+key)
+		// Map keys are data, not schema: keep them out of the path so
+		// warnings stay bounded by the models.
+		for _, value := range obj {
+			walkUnknownFields(t.Elem(), value, path+".{key}", depth+1)
 		}
 	}
 }
 
+// jsonFieldsForType returns the JSON field names of struct type t, including
+// those of embedded structs, and their types. Results are cached per type and
+// shared, so callers must not modify them.
 func jsonFieldsForType(t reflect.Type) map[string]reflect.Type {
+	if cached, ok := jsonFieldsCache.Load(t); ok {
+		return cached.(map[string]reflect.Type)
+	}
 	fields := map[string]reflect.Type{}
 	for field := range t.Fields() {
 		if field.PkgPath != "" && !field.Anonymous {
@@ -142,6 +190,7 @@ func jsonFieldsForType(t reflect.Type) map[string]reflect.Type {
 		}
 		fields[name] = field.Type
 	}
+	jsonFieldsCache.Store(t, fields)
 	return fields
 }
 
@@ -172,7 +221,7 @@ func typePathName(t reflect.Type) string {
 		return ""
 	}
 	if name := t.Name(); name != "" {
-		return name
+		return shortGenericName(name)
 	}
 	switch t.Kind() {
 	case reflect.Struct:
@@ -186,6 +235,18 @@ func typePathName(t reflect.Type) string {
 	}
 }
 
+// shortGenericName strips package paths from generic type arguments, so
+// "ResultsPage[github.com/x/v1.Control]" becomes "ResultsPage[Control]".
+func shortGenericName(name string) string {
+	open := strings.IndexByte(name, '[')
+	if open < 0 {
+		return name
+	}
+	return name[:open] + qualifiedTypeArg.ReplaceAllString(name[open:], "")
+}
+
+var qualifiedTypeArg = regexp.MustCompile(`[^\[\],* ]*\.`)
+
 func isByteSliceType(t reflect.Type) bool {
 	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
 }
@@ -193,5 +254,6 @@ func isByteSliceType(t reflect.Type) bool {
 func resetUnknownFieldWarningsForTest() {
 	unknownFieldWarningsMu.Lock()
 	unknownFieldWarningsSeen = map[string]struct{}{}
+	unknownFieldWarningsOrder = nil
 	unknownFieldWarningsMu.Unlock()
 }
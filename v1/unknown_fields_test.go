@@ -0,0 +1,170 @@
+package v1
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// responseTypes returns a constructor for every distinct result type in the
+// operation catalog, and the index of each operation's type.
+func responseTypes() ([]func() any, map[string]int) {
+	var types []func() any
+	index := map[string]int{}
+	byType := map[reflect.Type]int{}
+	for _, op := range operations {
+		t := reflect.TypeOf(op.fn.newResult())
+		i, ok := byType[t]
+		if !ok {
+			i = len(types)
+			byType[t] = i
+			types = append(types, op.fn.newResult)
+		}
+		index[op.ID] = i
+	}
+	return types, index
+}
+
+// FuzzDecodeResponse decodes arbitrary bodies into every generated response
+// type with unknown-field warnings on. Decoding may fail but must not panic,
+// and the warning dedupe set must stay within its cap however many distinct
+// keys the inputs carry. The seed corpus is the collection's example
+// responses.
+func FuzzDecodeResponse(f *testing.F) {
+	types, index := responseTypes()
+	for _, c := range contractCases {
+		if c.Response != "" {
+			f.Add(uint(index[c.Operation]), []byte(c.Response))
+		}
+	}
+	f.Add(uint(0), []byte(strings.Repeat("[", 5000)+strings.Repeat("]", 5000)))
+	f.Add(uint(0), []byte(`{"results":{"data":[{"a":{"b":{"c":{}}}}],"pageInfo":{"x":1}}}`))
+
+	prevWarnf := UnknownFieldWarningf
+	f.Cleanup(func() {
+		UnknownFieldWarningf = prevWarnf
+		resetUnknownFieldWarningsForTest()
+	})
+	UnknownFieldWarningf = func(string, ...any) {}
+
+	f.Fuzz(func(t *testing.T, n uint, data []byte) {
+		out := types[n%uint(len(types))]()
+		_ = decodeJSONBytes(data, out)
+
+		unknownFieldWarningsMu.Lock()
+		seen := len(unknownFieldWarningsSeen)
+		unknownFieldWarningsMu.Unlock()
+		if seen > maxUnknownFieldWarnings {
+			t.Fatalf("dedupe set has %d entries, cap is %d", seen, maxUnknownFieldWarnings)
+		}
+	})
+}
+
+type nestedModel struct {
+	Name  string        `json:"name"`
+	Child *nestedModel  `json:"child"`
+	Items []nestedModel `json:"items"`
+}
+
+func collectWarnings(t *testing.T) *[]string {
+	t.Helper()
+	resetUnknownFieldWarningsForTest()
+	prevWarnf := UnknownFieldWarningf
+	t.Cleanup(func() {
+		UnknownFieldWarningf = prevWarnf
+		resetUnknownFieldWarningsForTest()
+	})
+	var warnings []string
+	UnknownFieldWarningf = func(format string, args ...any) {
+		warnings = append(warnings, fmt.Sprintf(format, args...))
+	}
+	return &warnings
+}
+
+func TestUnknownFieldWalkDepthIsBounded(t *testing.T) {
+	warnings := collectWarnings(t)
+
+	// Every level of a recursive model carries an unknown field.
+	const levels = 2000
+	var b strings.Builder
+	for range levels {
+		b.WriteString(`{"extra":1,"child":`)
+	}
+	b.WriteString("null")
+	b.WriteString(strings.Repeat("}", levels))
+
+	var out nestedModel
+	if err := decodeJSONBytes([]byte(b.String()), &out); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(*warnings), maxUnknownFieldDepth+1; got != want {
+		t.Fatalf("got %d warnings, want one per level down to depth %d (%d)", got, maxUnknownFieldDepth, want)
+	}
+}
+
+func TestUnknownFieldWarningsIgnoreMapKeys(t *testing.T) {
+	warnings := collectWarnings(t)
+
+	var b strings.Builder
+	b.WriteString("{")
+	for i := range 3 * maxUnknownFieldWarnings {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		fmt.Fprintf(&b, `"key-%d":{"extra":true}`, i)
+	}
+	b.WriteString("}")
+
+	var out map[string]nestedModel
+	if err := decodeJSONBytes([]byte(b.String()), &out); err != nil {
+		t.Fatal(err)
+	}
+	if len(*warnings) != 1 || !strings.Contains((*warnings)[0], ".{key}.extra") {
+		t.Fatalf("warnings = %v, want one for the map value's extra field", *warnings)
+	}
+}
+
+func TestUnknownFieldWarningsEvictOldest(t *testing.T) {
+	warnings := collectWarnings(t)
+
+	// Unknown field names are chosen by the server, so a response can make
+	// every warning distinct.
+	var b strings.Builder
+	b.WriteString(`{"name":"n"`)
+	for i := range 3 * maxUnknownFieldWarnings {
+		fmt.Fprintf(&b, `,"extra-%d":true`, i)
+	}
+	b.WriteString("}")
+	var out nestedModel
+	if err := decodeJSONBytes([]byte(b.String()), &out); err != nil {
+		t.Fatal(err)
+	}
+	unknownFieldWarningsMu.Lock()
+	seen := len(unknownFieldWarningsSeen)
+	unknownFieldWarningsMu.Unlock()
+	if seen != maxUnknownFieldWarnings {
+		t.Fatalf("dedupe set has %d entries, want %d", seen, maxUnknownFieldWarnings)
+	}
+
+	// Later drift elsewhere is still reported.
+	*warnings = nil
+	var vulns []Vulnerability
+	if err := decodeJSONBytes([]byte(`[{"newField":1,"severity":"EXTREME"},{"severity":"WORSE"}]`), &vulns); err != nil {
+		t.Fatal(err)
+	}
+	if len(*warnings) != 2 {
+		t.Fatalf("warnings = %v, want the new field and one enum warning", *warnings)
+	}
+}
+
+func TestJSONFieldsForTypeIsCached(t *testing.T) {
+	typ := reflect.TypeFor[nestedModel]()
+	first := jsonFieldsForType(typ)
+	if len(first) != 3 {
+		t.Fatalf("fields = %v", first)
+	}
+	if reflect.ValueOf(jsonFieldsForType(typ)).Pointer() != reflect.ValueOf(first).Pointer() {
+		t.Fatal("second call built a new map")
+	}
+}
@@ -0,0 +1,156 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const maxPageSize = 100
+
+// FieldError is a single request field that failed client-side validation.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e FieldError) Error() string {
+	return e.Field + " " + e.Message
+}
+
+// ValidationError lists every request field that failed client-side
+// validation. Generated methods return it before sending the request.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	if e == nil {
+		return "<nil>"
+	}
+	msgs := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		msgs = append(msgs, f.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// validator collects field errors for the generated Validate methods.
+type validator struct {
+	fields []FieldError
+}
+
+func (v *validator) add(field, format string, args ...any) {
+	v.fields = append(v.fields, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) required(field, value string) {
+	if value == "" {
+		v.add(field, "is required")
+	}
+}
+
+func (v *validator) pageSize(size *int) {
+	if size != nil && (*size < 1 || *size > maxPageSize) {
+		v.add("pageSize", "must be between 1 and %d, got %d", maxPageSize, *size)
+	}
+}
+
+// dateTime accepts an empty value or an RFC 3339 timestamp.
+func (v *validator) dateTime(field, value string) {
+	if value == "" {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339Nano, value); err != nil {
+		v.add(field, "must be an RFC 3339 timestamp, got %q", value)
+	}
+}
+
+func (v *validator) dateTimePtr(field string, value *string) {
+	if value != nil {
+		v.dateTime(field, *value)
+	}
+}
+
+// oneOf reports a non-empty value outside allowed. It covers enums from the
+// API description that have no named type in this package.
+func (v *validator) oneOf(field, value string, allowed ...string) {
+	if value != "" && !slices.Contains(allowed, value) {
+		v.add(field, "has invalid value %q", value)
+	}
+}
+
+// requires reports field when it is set without the field it depends on.
+func (v *validator) requires(field string, set bool, other string, otherSet bool) {
+	if set && !otherSet {
+		v.add(field, "requires %s", other)
+	}
+}
+
+// nested merges the errors of a nested Validate call under prefix.
+func (v *validator) nested(prefix string, err error) {
+	if err == nil {
+		return
+	}
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		v.add(prefix, "%v", err)
+		return
+	}
+	for _, f := range verr.Fields {
+		v.fields = append(v.fields, FieldError{Field: prefix + "." + f.Field, Message: f.Message})
+	}
+}
+
+func (v *validator) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: v.fields}
+}
+
+// validateEnum, validateEnumPtr and validateEnums reject values the SDK does
+// not know. The generator only uses them for enums whose values the source
+// documents in full; other enums go through warnEnum and friends.
+func validateEnum[E enum](v *validator, field string, value E) {
+	if value != "" && !value.Valid() {
+		v.add(field, "has invalid value %q", string(value))
+	}
+}
+
+func validateEnumPtr[E enum](v *validator, field string, value *E) {
+	if value != nil {
+		if *value == "" || !(*value).Valid() {
+			v.add(field, "has invalid value %q", string(*value))
+		}
+	}
+}
+
+func validateEnums[E enum](v *validator, field string, values []E) {
+	for _, value := range values {
+		if !value.Valid() {
+			v.add(field, "has invalid value %q", string(value))
+		}
+	}
+}
+
+// warnEnum, warnEnumPtr and warnEnums report unknown values the way decoding
+// does and let the request through, for enums whose values the source only
+// gives examples of.
+func warnEnum[E enum](value E) {
+	warnUnknownEnum(value)
+}
+
+func warnEnumPtr[E enum](value *E) {
+	if value != nil {
+		warnUnknownEnum(*value)
+	}
+}
+
+func warnEnums[E enum](values []E) {
+	for _, value := range values {
+		warnUnknownEnum(value)
+	}
+}
@@ -0,0 +1,81 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestGeneratedMethodsReturnValidationErrorBeforeRequest(t *testing.T) {
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			t.Fatalf("unexpected request to %s", r.URL)
+			return nil, nil
+		}),
+	}
+	c, err := NewClient(WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	ctx := context.Background()
+
+	severity := VulnerabilitySeverity("SEVERE")
+	_, err = c.Services.Vulnerabilities.GetVulnerabilities(ctx, &VulnerabilitiesGetVulnerabilitiesParams{
+		PageSize:             Ptr(500),
+		SlaDeadlineAfterDate: Ptr("yesterday"),
+		Severity:             &severity,
+	})
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected *ValidationError, got %T: %v", err, err)
+	}
+	got := map[string]bool{}
+	for _, f := range verr.Fields {
+		got[f.Field] = true
+	}
+	for _, field := range []string{"pageSize", "slaDeadlineAfterDate", "severity"} {
+		if !got[field] {
+			t.Fatalf("missing %s in %v", field, verr)
+		}
+	}
+
+	_, err = c.Services.Controls.CreateCustomControl(ctx, &ControlsCreateCustomControlParams{
+		Body: &ControlsCreateCustomControlRequestBody{EffectiveDate: "2024-01-01T00:00:00Z"},
+	})
+	if err == nil || err.Error() != "body.name is required" {
+		t.Fatalf("CreateCustomControl error = %v, want body.name is required", err)
+	}
+
+	_, err = c.Services.People.ListPeople(ctx, &PeopleListPeopleParams{
+		TaskTypeMatchesAny: []PersonTaskType{PersonTaskTypeAcceptPolicies},
+	})
+	if err == nil || err.Error() != "taskTypeMatchesAny requires taskStatusMatchesAny" {
+		t.Fatalf("ListPeople error = %v", err)
+	}
+
+	if _, err := c.Services.Documents.GetDocumentByID(ctx, nil); err == nil || err.Error() != "documentId is required" {
+		t.Fatalf("GetDocumentByID error = %v, want documentId is required", err)
+	}
+}
+
+func TestValidateAcceptsValidParams(t *testing.T) {
+	params := &VulnerabilitiesGetVulnerabilitiesParams{
+		PageSize:              Ptr(100),
+		SlaDeadlineBeforeDate: Ptr("2024-03-06T19:02:25.202Z"),
+	}
+	if err := params.Validate(); err != nil {
+		t.Fatalf("Validate returned error: %v", err)
+	}
+}
+
+func TestValidatorOneOf(t *testing.T) {
+	var v validator
+	v.oneOf("kind", "", "SMALL", "LARGE")
+	v.oneOf("kind", "SMALL", "SMALL", "LARGE")
+	v.oneOf("kind", "HUGE", "SMALL", "LARGE")
+	err := v.err()
+	if err == nil || err.Error() != `kind has invalid value "HUGE"` {
+		t.Fatalf("err = %v, want one invalid value error", err)
+	}
+}
@@ -0,0 +1,2024 @@
+// Code generated by vanta-gen. DO NOT EDIT.
+
+// Mock Vanta services.
+
+package vantamock
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+
+	vanta "github.com/richardoc/vanta-sdk-go/v1"
+)
+
+// Services holds one mock per Vanta service.
+type Services struct {
+	Controls                  *ControlsAPI
+	DiscoveredVendors         *DiscoveredVendorsAPI
+	Documents                 *DocumentsAPI
+	Frameworks                *FrameworksAPI
+	Groups                    *GroupsAPI
+	Integrations              *IntegrationsAPI
+	MonitoredComputers        *MonitoredComputersAPI
+	OAuth                     *OAuthAPI
+	People                    *PeopleAPI
+	Policies                  *PoliciesAPI
+	Resources                 *ResourcesAPI
+	RiskScenarios             *RiskScenariosAPI
+	Tests                     *TestsAPI
+	TrustCenters              *TrustCentersAPI
+	VendorRiskAttributes      *VendorRiskAttributesAPI
+	Vendors                   *VendorsAPI
+	Vulnerabilities           *VulnerabilitiesAPI
+	VulnerabilityRemediations *VulnerabilityRemediationsAPI
+	VulnerableAssets          *VulnerableAssetsAPI
+}
+
+// NewServices returns a mock for every service.
+func NewServices() *Services {
+	return &Services{
+		Controls:                  &ControlsAPI{},
+		DiscoveredVendors:         &DiscoveredVendorsAPI{},
+		Documents:                 &DocumentsAPI{},
+		Frameworks:                &FrameworksAPI{},
+		Groups:                    &GroupsAPI{},
+		Integrations:              &IntegrationsAPI{},
+		MonitoredComputers:        &MonitoredComputersAPI{},
+		OAuth:                     &OAuthAPI{},
+		People:                    &PeopleAPI{},
+		Policies:                  &PoliciesAPI{},
+		Resources:                 &ResourcesAPI{},
+		RiskScenarios:             &RiskScenariosAPI{},
+		Tests:                     &TestsAPI{},
+		TrustCenters:              &TrustCentersAPI{},
+		VendorRiskAttributes:      &VendorRiskAttributesAPI{},
+		Vendors:                   &VendorsAPI{},
+		Vulnerabilities:           &VulnerabilitiesAPI{},
+		VulnerabilityRemediations: &VulnerabilityRemediationsAPI{},
+		VulnerableAssets:          &VulnerableAssetsAPI{},
+	}
+}
+
+// API returns a *vanta.Services backed by the mocks.
+func (s *Services) API() *vanta.Services {
+	return &vanta.Services{
+		Controls:                  s.Controls,
+		DiscoveredVendors:         s.DiscoveredVendors,
+		Documents:                 s.Documents,
+		Frameworks:                s.Frameworks,
+		Groups:                    s.Groups,
+		Integrations:              s.Integrations,
+		MonitoredComputers:        s.MonitoredComputers,
+		OAuth:                     s.OAuth,
+		People:                    s.People,
+		Policies:                  s.Policies,
+		Resources:                 s.Resources,
+		RiskScenarios:             s.RiskScenarios,
+		Tests:                     s.Tests,
+		TrustCenters:              s.TrustCenters,
+		VendorRiskAttributes:      s.VendorRiskAttributes,
+		Vendors:                   s.Vendors,
+		Vulnerabilities:           s.Vulnerabilities,
+		VulnerabilityRemediations: s.VulnerabilityRemediations,
+		VulnerableAssets:          s.VulnerableAssets,
+	}
+}
+
+// ControlsAPI is a mock vanta.ControlsAPI.
+type ControlsAPI struct {
+	Recorder
+
+	AddControlFromVantaLibraryFunc       func(ctx context.Context, params *vanta.ControlsAddControlFromVantaLibraryParams) (*vanta.Control, error)
+	AddControlToDocumentMappingFunc      func(ctx context.Context, params *vanta.ControlsAddControlToDocumentMappingParams) (*vanta.ControlsAddControlToDocumentMappingResponse, error)
+	AddControlToTestMappingFunc          func(ctx context.Context, params *vanta.ControlsAddControlToTestMappingParams) (*vanta.ControlsAddControlToTestMappingResponse, error)
+	CreateCustomControlFunc              func(ctx context.Context, params *vanta.ControlsCreateCustomControlParams) (*vanta.Control, error)
+	GetControlByIDFunc                   func(ctx context.Context, params *vanta.ControlsGetControlByIDParams) (*vanta.Control, error)
+	ListControlsFunc                     func(ctx context.Context, params *vanta.ControlsListControlsParams) (*vanta.ResultsPage[vanta.Control], error)
+	ListControlsDocumentsFunc            func(ctx context.Context, params *vanta.ControlsListControlsDocumentsParams) (*vanta.ResultsPage[vanta.Document], error)
+	ListControlsTestsFunc                func(ctx context.Context, params *vanta.ControlsListControlsTestsParams) (*vanta.ResultsPage[vanta.Test], error)
+	ListVantaControlsFromLibraryFunc     func(ctx context.Context, params *vanta.ControlsListVantaControlsFromLibraryParams) (*vanta.ResultsPage[vanta.Control], error)
+	RemoveControlFunc                    func(ctx context.Context, params *vanta.ControlsRemoveControlParams) (json.RawMessage, error)
+	RemoveControlFromDocumentMappingFunc func(ctx context.Context, params *vanta.ControlsRemoveControlFromDocumentMappingParams) (json.RawMessage, error)
+	RemoveControlFromTestMappingFunc     func(ctx context.Context, params *vanta.ControlsRemoveControlFromTestMappingParams) (json.RawMessage, error)
+	SetOwnerOfControlFunc                func(ctx context.Context, params *vanta.ControlsSetOwnerOfControlParams) (*vanta.Control, error)
+	UpdateControlsMetadataFunc           func(ctx context.Context, params *vanta.ControlsUpdateControlsMetadataParams) (*vanta.Control, error)
+}
+
+var _ vanta.ControlsAPI = (*ControlsAPI)(nil)
+
+// AddControlFromVantaLibrary calls AddControlFromVantaLibraryFunc.
+func (m *ControlsAPI) AddControlFromVantaLibrary(ctx context.Context, params *vanta.ControlsAddControlFromVantaLibraryParams) (*vanta.Control, error) {
+	m.record("AddControlFromVantaLibrary", params)
+	if m.AddControlFromVantaLibraryFunc == nil {
+		return nil, notConfigured("ControlsAPI", "AddControlFromVantaLibrary")
+	}
+	return m.AddControlFromVantaLibraryFunc(ctx, params)
+}
+
+// AddControlToDocumentMapping calls AddControlToDocumentMappingFunc.
+func (m *ControlsAPI) AddControlToDocumentMapping(ctx context.Context, params *vanta.ControlsAddControlToDocumentMappingParams) (*vanta.ControlsAddControlToDocumentMappingResponse, error) {
+	m.record("AddControlToDocumentMapping", params)
+	if m.AddControlToDocumentMappingFunc == nil {
+		return nil, notConfigured("ControlsAPI", "AddControlToDocumentMapping")
+	}
+	return m.AddControlToDocumentMappingFunc(ctx, params)
+}
+
+// AddControlToTestMapping calls AddControlToTestMappingFunc.
+func (m *ControlsAPI) AddControlToTestMapping(ctx context.Context, params *vanta.ControlsAddControlToTestMappingParams) (*vanta.ControlsAddControlToTestMappingResponse, error) {
+	m.record("AddControlToTestMapping", params)
+	if m.AddControlToTestMappingFunc == nil {
+		return nil, notConfigured("ControlsAPI", "AddControlToTestMapping")
+	}
+	return m.AddControlToTestMappingFunc(ctx, params)
+}
+
+// CreateCustomControl calls CreateCustomControlFunc.
+func (m *ControlsAPI) CreateCustomControl(ctx context.Context, params *vanta.ControlsCreateCustomControlParams) (*vanta.Control, error) {
+	m.record("CreateCustomControl", params)
+	if m.CreateCustomControlFunc == nil {
+		return nil, notConfigured("ControlsAPI", "CreateCustomControl")
+	}
+	return m.CreateCustomControlFunc(ctx, params)
+}
+
+// GetControlByID calls GetControlByIDFunc.
+func (m *ControlsAPI) GetControlByID(ctx context.Context, params *vanta.ControlsGetControlByIDParams) (*vanta.Control, error) {
+	m.record("GetControlByID", params)
+	if m.GetControlByIDFunc == nil {
+		return nil, notConfigured("ControlsAPI", "GetControlByID")
+	}
+	return m.GetControlByIDFunc(ctx, params)
+}
+
+// ListControls calls ListControlsFunc.
+func (m *ControlsAPI) ListControls(ctx context.Context, params *vanta.ControlsListControlsParams) (*vanta.ResultsPage[vanta.Control], error) {
+	m.record("ListControls", params)
+	if m.ListControlsFunc == nil {
+		return nil, notConfigured("ControlsAPI", "ListControls")
+	}
+	return m.ListControlsFunc(ctx, params)
+}
+
+// AllControls pages through ListControls.
+func (m *ControlsAPI) AllControls(ctx context.Context, params *vanta.ControlsListControlsParams) iter.Seq2[vanta.Control, error] {
+	return allItems(ctx, params, func(p *vanta.ControlsListControlsParams) **string { return &p.PageCursor }, m.ListControls)
+}
+
+// ListControlsDocuments calls ListControlsDocumentsFunc.
+func (m *ControlsAPI) ListControlsDocuments(ctx context.Context, params *vanta.ControlsListControlsDocumentsParams) (*vanta.ResultsPage[vanta.Document], error) {
+	m.record("ListControlsDocuments", params)
+	if m.ListControlsDocumentsFunc == nil {
+		return nil, notConfigured("ControlsAPI", "ListControlsDocuments")
+	}
+	return m.ListControlsDocumentsFunc(ctx, params)
+}
+
+// AllControlsDocuments pages through ListControlsDocuments.
+func (m *ControlsAPI) AllControlsDocuments(ctx context.Context, params *vanta.ControlsListControlsDocumentsParams) iter.Seq2[vanta.Document, error] {
+	return allItems(ctx, params, func(p *vanta.ControlsListControlsDocumentsParams) **string { return &p.PageCursor }, m.ListControlsDocuments)
+}
+
+// ListControlsTests calls ListControlsTestsFunc.
+func (m *ControlsAPI) ListControlsTests(ctx context.Context, params *vanta.ControlsListControlsTestsParams) (*vanta.ResultsPage[vanta.Test], error) {
+	m.record("ListControlsTests", params)
+	if m.ListControlsTestsFunc == nil {
+		return nil, notConfigured("ControlsAPI", "ListControlsTests")
+	}
+	return m.ListControlsTestsFunc(ctx, params)
+}
+
+// AllControlsTests pages through ListControlsTests.
+func (m *ControlsAPI) AllControlsTests(ctx context.Context, params *vanta.ControlsListControlsTestsParams) iter.Seq2[vanta.Test, error] {
+	return allItems(ctx, params, func(p *vanta.ControlsListControlsTestsParams) **string { return &p.PageCursor }, m.ListControlsTests)
+}
+
+// ListVantaControlsFromLibrary calls ListVantaControlsFromLibraryFunc.
+func (m *ControlsAPI) ListVantaControlsFromLibrary(ctx context.Context, params *vanta.ControlsListVantaControlsFromLibraryParams) (*vanta.ResultsPage[vanta.Control], error) {
+	m.record("ListVantaControlsFromLibrary", params)
+	if m.ListVantaControlsFromLibraryFunc == nil {
+		return nil, notConfigured("ControlsAPI", "ListVantaControlsFromLibrary")
+	}
+	return m.ListVantaControlsFromLibraryFunc(ctx, params)
+}
+
+// AllVantaControlsFromLibrary pages through ListVantaControlsFromLibrary.
+func (m *ControlsAPI) AllVantaControlsFromLibrary(ctx context.Context, params *vanta.ControlsListVantaControlsFromLibraryParams) iter.Seq2[vanta.Control, error] {
+	return allItems(ctx, params, func(p *vanta.ControlsListVantaControlsFromLibraryParams) **string { return &p.PageCursor }, m.ListVantaControlsFromLibrary)
+}
+
+// RemoveControl calls RemoveControlFunc.
+func (m *ControlsAPI) RemoveControl(ctx context.Context, params *vanta.ControlsRemoveControlParams) (json.RawMessage, error) {
+	m.record("RemoveControl", params)
+	if m.RemoveControlFunc == nil {
+		return nil, notConfigured("ControlsAPI", "RemoveControl")
+	}
+	return m.RemoveControlFunc(ctx, params)
+}
+
+// RemoveControlFromDocumentMapping calls RemoveControlFromDocumentMappingFunc.
+func (m *ControlsAPI) RemoveControlFromDocumentMapping(ctx context.Context, params *vanta.ControlsRemoveControlFromDocumentMappingParams) (json.RawMessage, error) {
+	m.record("RemoveControlFromDocumentMapping", params)
+	if m.RemoveControlFromDocumentMappingFunc == nil {
+		return nil, notConfigured("ControlsAPI", "RemoveControlFromDocumentMapping")
+	}
+	return m.RemoveControlFromDocumentMappingFunc(ctx, params)
+}
+
+// RemoveControlFromTestMapping calls RemoveControlFromTestMappingFunc.
+func (m *ControlsAPI) RemoveControlFromTestMapping(ctx context.Context, params *vanta.ControlsRemoveControlFromTestMappingParams) (json.RawMessage, error) {
+	m.record("RemoveControlFromTestMapping", params)
+	if m.RemoveControlFromTestMappingFunc == nil {
+		return nil, notConfigured("ControlsAPI", "RemoveControlFromTestMapping")
+	}
+	return m.RemoveControlFromTestMappingFunc(ctx, params)
+}
+
+// SetOwnerOfControl calls SetOwnerOfControlFunc.
+func (m *ControlsAPI) SetOwnerOfControl(ctx context.Context, params *vanta.ControlsSetOwnerOfControlParams) (*vanta.Control, error) {
+	m.record("SetOwnerOfControl", params)
+	if m.SetOwnerOfControlFunc == nil {
+		return nil, notConfigured("ControlsAPI", "SetOwnerOfControl")
+	}
+	return m.SetOwnerOfControlFunc(ctx, params)
+}
+
+// UpdateControlsMetadata calls UpdateControlsMetadataFunc.
+func (m *ControlsAPI) UpdateControlsMetadata(ctx context.Context, params *vanta.ControlsUpdateControlsMetadataParams) (*vanta.Control, error) {
+	m.record("UpdateControlsMetadata", params)
+	if m.UpdateControlsMetadataFunc == nil {
+		return nil, notConfigured("ControlsAPI", "UpdateControlsMetadata")
+	}
+	return m.UpdateControlsMetadataFunc(ctx, params)
+}
+
+// DiscoveredVendorsAPI is a mock vanta.DiscoveredVendorsAPI.
+type DiscoveredVendorsAPI struct {
+	Recorder
+
+	AddsDiscoveredVendorToManagedVendorByIDFunc func(ctx context.Context, params *vanta.DiscoveredVendorsAddsDiscoveredVendorToManagedVendorByIDParams) (*vanta.Vendor, error)
+	ListDiscoveredVendorsFunc                   func(ctx context.Context, params *vanta.DiscoveredVendorsListDiscoveredVendorsParams) (*vanta.ResultsPage[vanta.DiscoveredVendor], error)
+	ListOfDiscoveredVendorAccountsFunc          func(ctx context.Context, params *vanta.DiscoveredVendorsListOfDiscoveredVendorAccountsParams) (*vanta.ResultsPage[vanta.DiscoveredVendorAccount], error)
+}
+
+var _ vanta.DiscoveredVendorsAPI = (*DiscoveredVendorsAPI)(nil)
+
+// AddsDiscoveredVendorToManagedVendorByID calls AddsDiscoveredVendorToManagedVendorByIDFunc.
+func (m *DiscoveredVendorsAPI) AddsDiscoveredVendorToManagedVendorByID(ctx context.Context, params *vanta.DiscoveredVendorsAddsDiscoveredVendorToManagedVendorByIDParams) (*vanta.Vendor, error) {
+	m.record("AddsDiscoveredVendorToManagedVendorByID", params)
+	if m.AddsDiscoveredVendorToManagedVendorByIDFunc == nil {
+		return nil, notConfigured("DiscoveredVendorsAPI", "AddsDiscoveredVendorToManagedVendorByID")
+	}
+	return m.AddsDiscoveredVendorToManagedVendorByIDFunc(ctx, params)
+}
+
+// ListDiscoveredVendors calls ListDiscoveredVendorsFunc.
+func (m *DiscoveredVendorsAPI) ListDiscoveredVendors(ctx context.Context, params *vanta.DiscoveredVendorsListDiscoveredVendorsParams) (*vanta.ResultsPage[vanta.DiscoveredVendor], error) {
+	m.record("ListDiscoveredVendors", params)
+	if m.ListDiscoveredVendorsFunc == nil {
+		return nil, notConfigured("DiscoveredVendorsAPI", "ListDiscoveredVendors")
+	}
+	return m.ListDiscoveredVendorsFunc(ctx, params)
+}
+
+// AllDiscoveredVendors pages through ListDiscoveredVendors.
+func (m *DiscoveredVendorsAPI) AllDiscoveredVendors(ctx context.Context, params *vanta.DiscoveredVendorsListDiscoveredVendorsParams) iter.Seq2[vanta.DiscoveredVendor, error] {
+	return allItems(ctx, params, func(p *vanta.DiscoveredVendorsListDiscoveredVendorsParams) **string { return &p.PageCursor }, m.ListDiscoveredVendors)
+}
+
+// ListOfDiscoveredVendorAccounts calls ListOfDiscoveredVendorAccountsFunc.
+func (m *DiscoveredVendorsAPI) ListOfDiscoveredVendorAccounts(ctx context.Context, params *vanta.DiscoveredVendorsListOfDiscoveredVendorAccountsParams) (*vanta.ResultsPage[vanta.DiscoveredVendorAccount], error) {
+	m.record("ListOfDiscoveredVendorAccounts", params)
+	if m.ListOfDiscoveredVendorAccountsFunc == nil {
+		return nil, notConfigured("DiscoveredVendorsAPI", "ListOfDiscoveredVendorAccounts")
+	}
+	return m.ListOfDiscoveredVendorAccountsFunc(ctx, params)
+}
+
+// AllDiscoveredVendorAccounts pages through ListOfDiscoveredVendorAccounts.
+func (m *DiscoveredVendorsAPI) AllDiscoveredVendorAccounts(ctx context.Context, params *vanta.DiscoveredVendorsListOfDiscoveredVendorAccountsParams) iter.Seq2[vanta.DiscoveredVendorAccount, error] {
+	return allItems(ctx, params, func(p *vanta.DiscoveredVendorsListOfDiscoveredVendorAccountsParams) **string { return &p.PageCursor }, m.ListOfDiscoveredVendorAccounts)
+}
+
+// DocumentsAPI is a mock vanta.DocumentsAPI.
+type DocumentsAPI struct {
+	Recorder
+
+	CreateCustomDocumentFunc     func(ctx context.Context, params *vanta.DocumentsCreateCustomDocumentParams) (*vanta.Document, error)
+	CreateDocumentLinkFunc       func(ctx context.Context, params *vanta.DocumentsCreateDocumentLinkParams) (*vanta.DocumentLink, error)
+	DeleteDocumentByIDFunc       func(ctx context.Context, params *vanta.DocumentsDeleteDocumentByIDParams) (json.RawMessage, error)
+	DeleteFileForDocumentFunc    func(ctx context.Context, params *vanta.DocumentsDeleteFileForDocumentParams) (json.RawMessage, error)
+	DownloadFileForDocumentFunc  func(ctx context.Context, params *vanta.DocumentsDownloadFileForDocumentParams) (*vanta.DocumentsDownloadFileForDocumentResponse, error)
+	GetDocumentByIDFunc          func(ctx context.Context, params *vanta.DocumentsGetDocumentByIDParams) (*vanta.Document, error)
+	ListDocumentsFunc            func(ctx context.Context, params *vanta.DocumentsListDocumentsParams) (*vanta.ResultsPage[vanta.Document], error)
+	ListDocumentsControlsFunc    func(ctx context.Context, params *vanta.DocumentsListDocumentsControlsParams) (*vanta.ResultsPage[vanta.Control], error)
+	ListDocumentsLinksFunc       func(ctx context.Context, params *vanta.DocumentsListDocumentsLinksParams) (*vanta.ResultsPage[vanta.DocumentLink], error)
+	ListDocumentsUploadsFunc     func(ctx context.Context, params *vanta.DocumentsListDocumentsUploadsParams) (*vanta.ResultsPage[vanta.DocumentUpload], error)
+	RemoveDocumentLinkFunc       func(ctx context.Context, params *vanta.DocumentsRemoveDocumentLinkParams) (json.RawMessage, error)
+	SetDocumentOwnerFunc         func(ctx context.Context, params *vanta.DocumentsSetDocumentOwnerParams) (*vanta.Document, error)
+	SubmitDocumentCollectionFunc func(ctx context.Context, params *vanta.DocumentsSubmitDocumentCollectionParams) (json.RawMessage, error)
+	UploadFileForDocumentFunc    func(ctx context.Context, params *vanta.DocumentsUploadFileForDocumentParams) (*vanta.DocumentUpload, error)
+}
+
+var _ vanta.DocumentsAPI = (*DocumentsAPI)(nil)
+
+// CreateCustomDocument calls CreateCustomDocumentFunc.
+func (m *DocumentsAPI) CreateCustomDocument(ctx context.Context, params *vanta.DocumentsCreateCustomDocumentParams) (*vanta.Document, error) {
+	m.record("CreateCustomDocument", params)
+	if m.CreateCustomDocumentFunc == nil {
+		return nil, notConfigured("DocumentsAPI", "CreateCustomDocument")
+	}
+	return m.CreateCustomDocumentFunc(ctx, params)
+}
+
+// CreateDocumentLink calls CreateDocumentLinkFunc.
+func (m *DocumentsAPI) CreateDocumentLink(ctx context.Context, params *vanta.DocumentsCreateDocumentLinkParams) (*vanta.DocumentLink, error) {
+	m.record("CreateDocumentLink", params)
+	if m.CreateDocumentLinkFunc == nil {
+		return nil, notConfigured("DocumentsAPI", "CreateDocumentLink")
+	}
+	return m.CreateDocumentLinkFunc(ctx, params)
+}
+
+// DeleteDocumentByID calls DeleteDocumentByIDFunc.
+func (m *DocumentsAPI) DeleteDocumentByID(ctx context.Context, params *vanta.DocumentsDeleteDocumentByIDParams) (json.RawMessage, error) {
+	m.record("DeleteDocumentByID", params)
+	if m.DeleteDocumentByIDFunc == nil {
+		return nil, notConfigured("DocumentsAPI", "DeleteDocumentByID")
+	}
+	return m.DeleteDocumentByIDFunc(ctx, params)
+}
+
+// DeleteFileForDocument calls DeleteFileForDocumentFunc.
+func (m *DocumentsAPI) DeleteFileForDocument(ctx context.Context, params *vanta.DocumentsDeleteFileForDocumentParams) (json.RawMessage, error) {
+	m.record("DeleteFileForDocument", params)
+	if m.DeleteFileForDocumentFunc == nil {
+		return nil, notConfigured("DocumentsAPI", "DeleteFileForDocument")
+	}
+	return m.DeleteFileForDocumentFunc(ctx, params)
+}
+
+// DownloadFileForDocument calls DownloadFileForDocumentFunc.
+func (m *DocumentsAPI) DownloadFileForDocument(ctx context.Context, params *vanta.DocumentsDownloadFileForDocumentParams) (*vanta.DocumentsDownloadFileForDocumentResponse, error) {
+	m.record("DownloadFileForDocument", params)
+	if m.DownloadFileForDocumentFunc == nil {
+		return nil, notConfigured("DocumentsAPI", "DownloadFileForDocument")
+	}
+	return m.DownloadFileForDocumentFunc(ctx, params)
+}
+
+// GetDocumentByID calls GetDocumentByIDFunc.
+func (m *DocumentsAPI) GetDocumentByID(ctx context.Context, params *vanta.DocumentsGetDocumentByIDParams) (*vanta.Document, error) {
+	m.record("GetDocumentByID", params)
+	if m.GetDocumentByIDFunc == nil {
+		return nil, notConfigured("DocumentsAPI", "GetDocumentByID")
+	}
+	return m.GetDocumentByIDFunc(ctx, params)
+}
+
+// ListDocuments calls ListDocumentsFunc.
+func (m *DocumentsAPI) ListDocuments(ctx context.Context, params *vanta.DocumentsListDocumentsParams) (*vanta.ResultsPage[vanta.Document], error) {
+	m.record("ListDocuments", params)
+	if m.ListDocumentsFunc == nil {
+		return nil, notConfigured("DocumentsAPI", "ListDocuments")
+	}
+	return m.ListDocumentsFunc(ctx, params)
+}
+
+// AllDocuments pages through ListDocuments.
+func (m *DocumentsAPI) AllDocuments(ctx context.Context, params *vanta.DocumentsListDocumentsParams) iter.Seq2[vanta.Document, error] {
+	return allItems(ctx, params, func(p *vanta.DocumentsListDocumentsParams) **string { return &p.PageCursor }, m.ListDocuments)
+}
+
+// ListDocumentsControls calls ListDocumentsControlsFunc.
+func (m *DocumentsAPI) ListDocumentsControls(ctx context.Context, params *vanta.DocumentsListDocumentsControlsParams) (*vanta.ResultsPage[vanta.Control], error) {
+	m.record("ListDocumentsControls", params)
+	if m.ListDocumentsControlsFunc == nil {
+		return nil, notConfigured("DocumentsAPI", "ListDocumentsControls")
+	}
+	return m.ListDocumentsControlsFunc(ctx, params)
+}
+
+// AllDocumentsControls pages through ListDocumentsControls.
+func (m *DocumentsAPI) AllDocumentsControls(ctx context.Context, params *vanta.DocumentsListDocumentsControlsParams) iter.Seq2[vanta.Control, error] {
+	return allItems(ctx, params, func(p *vanta.DocumentsListDocumentsControlsParams) **string { return &p.PageCursor }, m.ListDocumentsControls)
+}
+
+// ListDocumentsLinks calls ListDocumentsLinksFunc.
+func (m *DocumentsAPI) ListDocumentsLinks(ctx context.Context, params *vanta.DocumentsListDocumentsLinksParams) (*vanta.ResultsPage[vanta.DocumentLink], error) {
+	m.record("ListDocumentsLinks", params)
+	if m.ListDocumentsLinksFunc == nil {
+		return nil, notConfigured("DocumentsAPI", "ListDocumentsLinks")
+	}
+	return m.ListDocumentsLinksFunc(ctx, params)
+}
+
+// AllDocumentsLinks pages through ListDocumentsLinks.
+func (m *DocumentsAPI) AllDocumentsLinks(ctx context.Context, params *vanta.DocumentsListDocumentsLinksParams) iter.Seq2[vanta.DocumentLink, error] {
+	return allItems(ctx, params, func(p *vanta.DocumentsListDocumentsLinksParams) **string { return &p.PageCursor }, m.ListDocumentsLinks)
+}
+
+// ListDocumentsUploads calls ListDocumentsUploadsFunc.
+func (m *DocumentsAPI) ListDocumentsUploads(ctx context.Context, params *vanta.DocumentsListDocumentsUploadsParams) (*vanta.ResultsPage[vanta.DocumentUpload], error) {
+	m.record("ListDocumentsUploads", params)
+	if m.ListDocumentsUploadsFunc == nil {
+		return nil, notConfigured("DocumentsAPI", "ListDocumentsUploads")
+	}
+	return m.ListDocumentsUploadsFunc(ctx, params)
+}
+
+// AllDocumentsUploads pages through ListDocumentsUploads.
+func (m *DocumentsAPI) AllDocumentsUploads(ctx context.Context, params *vanta.DocumentsListDocumentsUploadsParams) iter.Seq2[vanta.DocumentUpload, error] {
+	return allItems(ctx, params, func(p *vanta.DocumentsListDocumentsUploadsParams) **string { return &p.PageCursor }, m.ListDocumentsUploads)
+}
+
+// RemoveDocumentLink calls RemoveDocumentLinkFunc.
+func (m *DocumentsAPI) RemoveDocumentLink(ctx context.Context, params *vanta.DocumentsRemoveDocumentLinkParams) (json.RawMessage, error) {
+	m.record("RemoveDocumentLink", params)
+	if m.RemoveDocumentLinkFunc == nil {
+		return nil, notConfigured("DocumentsAPI", "RemoveDocumentLink")
+	}
+	return m.RemoveDocumentLinkFunc(ctx, params)
+}
+
+// SetDocumentOwner calls SetDocumentOwnerFunc.
+func (m *DocumentsAPI) SetDocumentOwner(ctx context.Context, params *vanta.DocumentsSetDocumentOwnerParams) (*vanta.Document, error) {
+	m.record("SetDocumentOwner", params)
+	if m.SetDocumentOwnerFunc == nil {
+		return nil, notConfigured("DocumentsAPI", "SetDocumentOwner")
+	}
+	return m.SetDocumentOwnerFunc(ctx, params)
+}
+
+// SubmitDocumentCollection calls SubmitDocumentCollectionFunc.
+func (m *DocumentsAPI) SubmitDocumentCollection(ctx context.Context, params *vanta.DocumentsSubmitDocumentCollectionParams) (json.RawMessage, error) {
+	m.record("SubmitDocumentCollection", params)
+	if m.SubmitDocumentCollectionFunc == nil {
+		return nil, notConfigured("DocumentsAPI", "SubmitDocumentCollection")
+	}
+	return m.SubmitDocumentCollectionFunc(ctx, params)
+}
+
+// UploadFileForDocument calls UploadFileForDocumentFunc.
+func (m *DocumentsAPI) UploadFileForDocument(ctx context.Context, params *vanta.DocumentsUploadFileForDocumentParams) (*vanta.DocumentUpload, error) {
+	m.record("UploadFileForDocument", params)
+	if m.UploadFileForDocumentFunc == nil {
+		return nil, notConfigured("DocumentsAPI", "UploadFileForDocument")
+	}
+	return m.UploadFileForDocumentFunc(ctx, params)
+}
+
+// FrameworksAPI is a mock vanta.FrameworksAPI.
+type FrameworksAPI struct {
+	Recorder
+
+	GetFrameworkByIDFunc        func(ctx context.Context, params *vanta.FrameworksGetFrameworkByIDParams) (*vanta.Framework, error)
+	ListAvailableFrameworksFunc func(ctx context.Context, params *vanta.FrameworksListAvailableFrameworksParams) (*vanta.ResultsPage[vanta.Framework], error)
+	ListFrameworksControlsFunc  func(ctx context.Context, params *vanta.FrameworksListFrameworksControlsParams) (*vanta.ResultsPage[vanta.Control], error)
+}
+
+var _ vanta.FrameworksAPI = (*FrameworksAPI)(nil)
+
+// GetFrameworkByID calls GetFrameworkByIDFunc.
+func (m *FrameworksAPI) GetFrameworkByID(ctx context.Context, params *vanta.FrameworksGetFrameworkByIDParams) (*vanta.Framework, error) {
+	m.record("GetFrameworkByID", params)
+	if m.GetFrameworkByIDFunc == nil {
+		return nil, notConfigured("FrameworksAPI", "GetFrameworkByID")
+	}
+	return m.GetFrameworkByIDFunc(ctx, params)
+}
+
+// ListAvailableFrameworks calls ListAvailableFrameworksFunc.
+func (m *FrameworksAPI) ListAvailableFrameworks(ctx context.Context, params *vanta.FrameworksListAvailableFrameworksParams) (*vanta.ResultsPage[vanta.Framework], error) {
+	m.record("ListAvailableFrameworks", params)
+	if m.ListAvailableFrameworksFunc == nil {
+		return nil, notConfigured("FrameworksAPI", "ListAvailableFrameworks")
+	}
+	return m.ListAvailableFrameworksFunc(ctx, params)
+}
+
+// AllAvailableFrameworks pages through ListAvailableFrameworks.
+func (m *FrameworksAPI) AllAvailableFrameworks(ctx context.Context, params *vanta.FrameworksListAvailableFrameworksParams) iter.Seq2[vanta.Framework, error] {
+	return allItems(ctx, params, func(p *vanta.FrameworksListAvailableFrameworksParams) **string { return &p.PageCursor }, m.ListAvailableFrameworks)
+}
+
+// ListFrameworksControls calls ListFrameworksControlsFunc.
+func (m *FrameworksAPI) ListFrameworksControls(ctx context.Context, params *vanta.FrameworksListFrameworksControlsParams) (*vanta.ResultsPage[vanta.Control], error) {
+	m.record("ListFrameworksControls", params)
+	if m.ListFrameworksControlsFunc == nil {
+		return nil, notConfigured("FrameworksAPI", "ListFrameworksControls")
+	}
+	return m.ListFrameworksControlsFunc(ctx, params)
+}
+
+// AllFrameworksControls pages through ListFrameworksControls.
+func (m *FrameworksAPI) AllFrameworksControls(ctx context.Context, params *vanta.FrameworksListFrameworksControlsParams) iter.Seq2[vanta.Control, error] {
+	return allItems(ctx, params, func(p *vanta.FrameworksListFrameworksControlsParams) **string { return &p.PageCursor }, m.ListFrameworksControls)
+}
+
+// GroupsAPI is a mock vanta.GroupsAPI.
+type GroupsAPI struct {
+	Recorder
+
+	AddPeopleToGroupFunc      func(ctx context.Context, params *vanta.GroupsAddPeopleToGroupParams) (*vanta.GroupsAddPeopleToGroupResponse, error)
+	AddPersonToGroupFunc      func(ctx context.Context, params *vanta.GroupsAddPersonToGroupParams) (*vanta.Person, error)
+	GetGroupByIDFunc          func(ctx context.Context, params *vanta.GroupsGetGroupByIDParams) (*vanta.Group, error)
+	ListGroupsFunc            func(ctx context.Context, params *vanta.GroupsListGroupsParams) (*vanta.ResultsPage[vanta.Group], error)
+	ListPeopleInGroupFunc     func(ctx context.Context, params *vanta.GroupsListPeopleInGroupParams) ([]vanta.Person, error)
+	RemovePeopleFromGroupFunc func(ctx context.Context, params *vanta.GroupsRemovePeopleFromGroupParams) (*vanta.GroupsRemovePeopleFromGroupResponse, error)
+	RemovePersonFromGroupFunc func(ctx context.Context, params *vanta.GroupsRemovePersonFromGroupParams) (*vanta.Person, error)
+}
+
+var _ vanta.GroupsAPI = (*GroupsAPI)(nil)
+
+// AddPeopleToGroup calls AddPeopleToGroupFunc.
+func (m *GroupsAPI) AddPeopleToGroup(ctx context.Context, params *vanta.GroupsAddPeopleToGroupParams) (*vanta.GroupsAddPeopleToGroupResponse, error) {
+	m.record("AddPeopleToGroup", params)
+	if m.AddPeopleToGroupFunc == nil {
+		return nil, notConfigured("GroupsAPI", "AddPeopleToGroup")
+	}
+	return m.AddPeopleToGroupFunc(ctx, params)
+}
+
+// AddPersonToGroup calls AddPersonToGroupFunc.
+func (m *GroupsAPI) AddPersonToGroup(ctx context.Context, params *vanta.GroupsAddPersonToGroupParams) (*vanta.Person, error) {
+	m.record("AddPersonToGroup", params)
+	if m.AddPersonToGroupFunc == nil {
+		return nil, notConfigured("GroupsAPI", "AddPersonToGroup")
+	}
+	return m.AddPersonToGroupFunc(ctx, params)
+}
+
+// GetGroupByID calls GetGroupByIDFunc.
+func (m *GroupsAPI) GetGroupByID(ctx context.Context, params *vanta.GroupsGetGroupByIDParams) (*vanta.Group, error) {
+	m.record("GetGroupByID", params)
+	if m.GetGroupByIDFunc == nil {
+		return nil, notConfigured("GroupsAPI", "GetGroupByID")
+	}
+	return m.GetGroupByIDFunc(ctx, params)
+}
+
+// ListGroups calls ListGroupsFunc.
+func (m *GroupsAPI) ListGroups(ctx context.Context, params *vanta.GroupsListGroupsParams) (*vanta.ResultsPage[vanta.Group], error) {
+	m.record("ListGroups", params)
+	if m.ListGroupsFunc == nil {
+		return nil, notConfigured("GroupsAPI", "ListGroups")
+	}
+	return m.ListGroupsFunc(ctx, params)
+}
+
+// AllGroups pages through ListGroups.
+func (m *GroupsAPI) AllGroups(ctx context.Context, params *vanta.GroupsListGroupsParams) iter.Seq2[vanta.Group, error] {
+	return allItems(ctx, params, func(p *vanta.GroupsListGroupsParams) **string { return &p.PageCursor }, m.ListGroups)
+}
+
+// ListPeopleInGroup calls ListPeopleInGroupFunc.
+func (m *GroupsAPI) ListPeopleInGroup(ctx context.Context, params *vanta.GroupsListPeopleInGroupParams) ([]vanta.Person, error) {
+	m.record("ListPeopleInGroup", params)
+	if m.ListPeopleInGroupFunc == nil {
+		return nil, notConfigured("GroupsAPI", "ListPeopleInGroup")
+	}
+	return m.ListPeopleInGroupFunc(ctx, params)
+}
+
+// RemovePeopleFromGroup calls RemovePeopleFromGroupFunc.
+func (m *GroupsAPI) RemovePeopleFromGroup(ctx context.Context, params *vanta.GroupsRemovePeopleFromGroupParams) (*vanta.GroupsRemovePeopleFromGroupResponse, error) {
+	m.record("RemovePeopleFromGroup", params)
+	if m.RemovePeopleFromGroupFunc == nil {
+		return nil, notConfigured("GroupsAPI", "RemovePeopleFromGroup")
+	}
+	return m.RemovePeopleFromGroupFunc(ctx, params)
+}
+
+// RemovePersonFromGroup calls RemovePersonFromGroupFunc.
+func (m *GroupsAPI) RemovePersonFromGroup(ctx context.Context, params *vanta.GroupsRemovePersonFromGroupParams) (*vanta.Person, error) {
+	m.record("RemovePersonFromGroup", params)
+	if m.RemovePersonFromGroupFunc == nil {
+		return nil, notConfigured("GroupsAPI", "RemovePersonFromGroup")
+	}
+	return m.RemovePersonFromGroupFunc(ctx, params)
+}
+
+// IntegrationsAPI is a mock vanta.IntegrationsAPI.
+type IntegrationsAPI struct {
+	Recorder
+
+	GetConnectedIntegrationFunc                         func(ctx context.Context, params *vanta.IntegrationsGetConnectedIntegrationParams) (*vanta.Integration, error)
+	GetDetailsForResourceKindFunc                       func(ctx context.Context, params *vanta.IntegrationsGetDetailsForResourceKindParams) (*vanta.IntegrationResourceKindDetails, error)
+	GetResourceByIDFunc                                 func(ctx context.Context, params *vanta.IntegrationsGetResourceByIDParams) (*vanta.GenericResource, error)
+	ListConnectedIntegrationsFunc                       func(ctx context.Context, params *vanta.IntegrationsListConnectedIntegrationsParams) (*vanta.ResultsPage[vanta.Integration], error)
+	ListIntegrationResourceKindsFunc                    func(ctx context.Context, params *vanta.IntegrationsListIntegrationResourceKindsParams) ([]vanta.IntegrationResourceKind, error)
+	ListResourcesFunc                                   func(ctx context.Context, params *vanta.IntegrationsListResourcesParams) (*vanta.ResultsPage[vanta.GenericResource], error)
+	UpdateResourceMetadataFunc                          func(ctx context.Context, params *vanta.IntegrationsUpdateResourceMetadataParams) (*vanta.IntegrationsUpdateResourceMetadataResponse, error)
+	UpdateResourceMetadataForResourceKindsResourcesFunc func(ctx context.Context, params *vanta.IntegrationsUpdateResourceMetadataForResourceKindsResourcesParams) (json.RawMessage, error)
+}
+
+var _ vanta.IntegrationsAPI = (*IntegrationsAPI)(nil)
+
+// GetConnectedIntegration calls GetConnectedIntegrationFunc.
+func (m *IntegrationsAPI) GetConnectedIntegration(ctx context.Context, params *vanta.IntegrationsGetConnectedIntegrationParams) (*vanta.Integration, error) {
+	m.record("GetConnectedIntegration", params)
+	if m.GetConnectedIntegrationFunc == nil {
+		return nil, notConfigured("IntegrationsAPI", "GetConnectedIntegration")
+	}
+	return m.GetConnectedIntegrationFunc(ctx, params)
+}
+
+// GetDetailsForResourceKind calls GetDetailsForResourceKindFunc.
+func (m *IntegrationsAPI) GetDetailsForResourceKind(ctx context.Context, params *vanta.IntegrationsGetDetailsForResourceKindParams) (*vanta.IntegrationResourceKindDetails, error) {
+	m.record("GetDetailsForResourceKind", params)
+	if m.GetDetailsForResourceKindFunc == nil {
+		return nil, notConfigured("IntegrationsAPI", "GetDetailsForResourceKind")
+	}
+	return m.GetDetailsForResourceKindFunc(ctx, params)
+}
+
+// GetResourceByID calls GetResourceByIDFunc.
+func (m *IntegrationsAPI) GetResourceByID(ctx context.Context, params *vanta.IntegrationsGetResourceByIDParams) (*vanta.GenericResource, error) {
+	m.record("GetResourceByID", params)
+	if m.GetResourceByIDFunc == nil {
+		return nil, notConfigured("IntegrationsAPI", "GetResourceByID")
+	}
+	return m.GetResourceByIDFunc(ctx, params)
+}
+
+// ListConnectedIntegrations calls ListConnectedIntegrationsFunc.
+func (m *IntegrationsAPI) ListConnectedIntegrations(ctx context.Context, params *vanta.IntegrationsListConnectedIntegrationsParams) (*vanta.ResultsPage[vanta.Integration], error) {
+	m.record("ListConnectedIntegrations", params)
+	if m.ListConnectedIntegrationsFunc == nil {
+		return nil, notConfigured("IntegrationsAPI", "ListConnectedIntegrations")
+	}
+	return m.ListConnectedIntegrationsFunc(ctx, params)
+}
+
+// AllConnectedIntegrations pages through ListConnectedIntegrations.
+func (m *IntegrationsAPI) AllConnectedIntegrations(ctx context.Context, params *vanta.IntegrationsListConnectedIntegrationsParams) iter.Seq2[vanta.Integration, error] {
+	return allItems(ctx, params, func(p *vanta.IntegrationsListConnectedIntegrationsParams) **string { return &p.PageCursor }, m.ListConnectedIntegrations)
+}
+
+// ListIntegrationResourceKinds calls ListIntegrationResourceKindsFunc.
+func (m *IntegrationsAPI) ListIntegrationResourceKinds(ctx context.Context, params *vanta.IntegrationsListIntegrationResourceKindsParams) ([]vanta.IntegrationResourceKind, error) {
+	m.record("ListIntegrationResourceKinds", params)
+	if m.ListIntegrationResourceKindsFunc == nil {
+		return nil, notConfigured("IntegrationsAPI", "ListIntegrationResourceKinds")
+	}
+	return m.ListIntegrationResourceKindsFunc(ctx, params)
+}
+
+// ListResources calls ListResourcesFunc.
+func (m *IntegrationsAPI) ListResources(ctx context.Context, params *vanta.IntegrationsListResourcesParams) (*vanta.ResultsPage[vanta.GenericResource], error) {
+	m.record("ListResources", params)
+	if m.ListResourcesFunc == nil {
+		return nil, notConfigured("IntegrationsAPI", "ListResources")
+	}
+	return m.ListResourcesFunc(ctx, params)
+}
+
+// AllResources pages through ListResources.
+func (m *IntegrationsAPI) AllResources(ctx context.Context, params *vanta.IntegrationsListResourcesParams) iter.Seq2[vanta.GenericResource, error] {
+	return allItems(ctx, params, func(p *vanta.IntegrationsListResourcesParams) **string { return &p.PageCursor }, m.ListResources)
+}
+
+// UpdateResourceMetadata calls UpdateResourceMetadataFunc.
+func (m *IntegrationsAPI) UpdateResourceMetadata(ctx context.Context, params *vanta.IntegrationsUpdateResourceMetadataParams) (*vanta.IntegrationsUpdateResourceMetadataResponse, error) {
+	m.record("UpdateResourceMetadata", params)
+	if m.UpdateResourceMetadataFunc == nil {
+		return nil, notConfigured("IntegrationsAPI", "UpdateResourceMetadata")
+	}
+	return m.UpdateResourceMetadataFunc(ctx, params)
+}
+
+// UpdateResourceMetadataForResourceKindsResources calls UpdateResourceMetadataForResourceKindsResourcesFunc.
+func (m *IntegrationsAPI) UpdateResourceMetadataForResourceKindsResources(ctx context.Context, params *vanta.IntegrationsUpdateResourceMetadataForResourceKindsResourcesParams) (json.RawMessage, error) {
+	m.record("UpdateResourceMetadataForResourceKindsResources", params)
+	if m.UpdateResourceMetadataForResourceKindsResourcesFunc == nil {
+		return nil, notConfigured("IntegrationsAPI", "UpdateResourceMetadataForResourceKindsResources")
+	}
+	return m.UpdateResourceMetadataForResourceKindsResourcesFunc(ctx, params)
+}
+
+// MonitoredComputersAPI is a mock vanta.MonitoredComputersAPI.
+type MonitoredComputersAPI struct {
+	Recorder
+
+	GetMonitoredComputerByIDFunc func(ctx context.Context, params *vanta.MonitoredComputersGetMonitoredComputerByIDParams) (*vanta.MonitoredComputer, error)
+	ListMonitoredComputersFunc   func(ctx context.Context, params *vanta.MonitoredComputersListMonitoredComputersParams) (*vanta.ResultsPage[vanta.MonitoredComputer], error)
+}
+
+var _ vanta.MonitoredComputersAPI = (*MonitoredComputersAPI)(nil)
+
+// GetMonitoredComputerByID calls GetMonitoredComputerByIDFunc.
+func (m *MonitoredComputersAPI) GetMonitoredComputerByID(ctx context.Context, params *vanta.MonitoredComputersGetMonitoredComputerByIDParams) (*vanta.MonitoredComputer, error) {
+	m.record("GetMonitoredComputerByID", params)
+	if m.GetMonitoredComputerByIDFunc == nil {
+		return nil, notConfigured("MonitoredComputersAPI", "GetMonitoredComputerByID")
+	}
+	return m.GetMonitoredComputerByIDFunc(ctx, params)
+}
+
+// ListMonitoredComputers calls ListMonitoredComputersFunc.
+func (m *MonitoredComputersAPI) ListMonitoredComputers(ctx context.Context, params *vanta.MonitoredComputersListMonitoredComputersParams) (*vanta.ResultsPage[vanta.MonitoredComputer], error) {
+	m.record("ListMonitoredComputers", params)
+	if m.ListMonitoredComputersFunc == nil {
+		return nil, notConfigured("MonitoredComputersAPI", "ListMonitoredComputers")
+	}
+	return m.ListMonitoredComputersFunc(ctx, params)
+}
+
+// AllMonitoredComputers pages through ListMonitoredComputers.
+func (m *MonitoredComputersAPI) AllMonitoredComputers(ctx context.Context, params *vanta.MonitoredComputersListMonitoredComputersParams) iter.Seq2[vanta.MonitoredComputer, error] {
+	return allItems(ctx, params, func(p *vanta.MonitoredComputersListMonitoredComputersParams) **string { return &p.PageCursor }, m.ListMonitoredComputers)
+}
+
+// OAuthAPI is a mock vanta.OAuthAPI.
+type OAuthAPI struct {
+	Recorder
+
+	CreateTokenFunc func(ctx context.Context, params *vanta.OAuthCreateTokenParams) (*vanta.OAuthTokenResponse, error)
+}
+
+var _ vanta.OAuthAPI = (*OAuthAPI)(nil)
+
+// CreateToken calls CreateTokenFunc.
+func (m *OAuthAPI) CreateToken(ctx context.Context, params *vanta.OAuthCreateTokenParams) (*vanta.OAuthTokenResponse, error) {
+	m.record("CreateToken", params)
+	if m.CreateTokenFunc == nil {
+		return nil, notConfigured("OAuthAPI", "CreateToken")
+	}
+	return m.CreateTokenFunc(ctx, params)
+}
+
+// PeopleAPI is a mock vanta.PeopleAPI.
+type PeopleAPI struct {
+	Recorder
+
+	GetPersonByIDFunc          func(ctx context.Context, params *vanta.PeopleGetPersonByIDParams) (*vanta.Person, error)
+	ListPeopleFunc             func(ctx context.Context, params *vanta.PeopleListPeopleParams) (*vanta.ResultsPage[vanta.Person], error)
+	MarkAsNotPeopleFunc        func(ctx context.Context, params *vanta.PeopleMarkAsNotPeopleParams) (*vanta.PeopleMarkAsNotPeopleResponse, error)
+	MarkAsPeopleFunc           func(ctx context.Context, params *vanta.PeopleMarkAsPeopleParams) (*vanta.PeopleMarkAsPeopleResponse, error)
+	OffboardPeopleFunc         func(ctx context.Context, params *vanta.PeopleOffboardPeopleParams) (*vanta.PeopleOffboardPeopleResponse, error)
+	RemoveLeaveInformationFunc func(ctx context.Context, params *vanta.PeopleRemoveLeaveInformationParams) (*vanta.Person, error)
+	SetLeaveInformationFunc    func(ctx context.Context, params *vanta.PeopleSetLeaveInformationParams) (*vanta.Person, error)
+	UpdatePersonMetadataFunc   func(ctx context.Context, params *vanta.PeopleUpdatePersonMetadataParams) (*vanta.Person, error)
+}
+
+var _ vanta.PeopleAPI = (*PeopleAPI)(nil)
+
+// GetPersonByID calls GetPersonByIDFunc.
+func (m *PeopleAPI) GetPersonByID(ctx context.Context, params *vanta.PeopleGetPersonByIDParams) (*vanta.Person, error) {
+	m.record("GetPersonByID", params)
+	if m.GetPersonByIDFunc == nil {
+		return nil, notConfigured("PeopleAPI", "GetPersonByID")
+	}
+	return m.GetPersonByIDFunc(ctx, params)
+}
+
+// ListPeople calls ListPeopleFunc.
+func (m *PeopleAPI) ListPeople(ctx context.Context, params *vanta.PeopleListPeopleParams) (*vanta.ResultsPage[vanta.Person], error) {
+	m.record("ListPeople", params)
+	if m.ListPeopleFunc == nil {
+		return nil, notConfigured("PeopleAPI", "ListPeople")
+	}
+	return m.ListPeopleFunc(ctx, params)
+}
+
+// AllPeople pages through ListPeople.
+func (m *PeopleAPI) AllPeople(ctx context.Context, params *vanta.PeopleListPeopleParams) iter.Seq2[vanta.Person, error] {
+	return allItems(ctx, params, func(p *vanta.PeopleListPeopleParams) **string { return &p.PageCursor }, m.ListPeople)
+}
+
+// MarkAsNotPeople calls MarkAsNotPeopleFunc.
+func (m *PeopleAPI) MarkAsNotPeople(ctx context.Context, params *vanta.PeopleMarkAsNotPeopleParams) (*vanta.PeopleMarkAsNotPeopleResponse, error) {
+	m.record("MarkAsNotPeople", params)
+	if m.MarkAsNotPeopleFunc == nil {
+		return nil, notConfigured("PeopleAPI", "MarkAsNotPeople")
+	}
+	return m.MarkAsNotPeopleFunc(ctx, params)
+}
+
+// MarkAsPeople calls MarkAsPeopleFunc.
+func (m *PeopleAPI) MarkAsPeople(ctx context.Context, params *vanta.PeopleMarkAsPeopleParams) (*vanta.PeopleMarkAsPeopleResponse, error) {
+	m.record("MarkAsPeople", params)
+	if m.MarkAsPeopleFunc == nil {
+		return nil, notConfigured("PeopleAPI", "MarkAsPeople")
+	}
+	return m.MarkAsPeopleFunc(ctx, params)
+}
+
+// OffboardPeople calls OffboardPeopleFunc.
+func (m *PeopleAPI) OffboardPeople(ctx context.Context, params *vanta.PeopleOffboardPeopleParams) (*vanta.PeopleOffboardPeopleResponse, error) {
+	m.record("OffboardPeople", params)
+	if m.OffboardPeopleFunc == nil {
+		return nil, notConfigured("PeopleAPI", "OffboardPeople")
+	}
+	return m.OffboardPeopleFunc(ctx, params)
+}
+
+// RemoveLeaveInformation calls RemoveLeaveInformationFunc.
+func (m *PeopleAPI) RemoveLeaveInformation(ctx context.Context, params *vanta.PeopleRemoveLeaveInformationParams) (*vanta.Person, error) {
+	m.record("RemoveLeaveInformation", params)
+	if m.RemoveLeaveInformationFunc == nil {
+		return nil, notConfigured("PeopleAPI", "RemoveLeaveInformation")
+	}
+	return m.RemoveLeaveInformationFunc(ctx, params)
+}
+
+// SetLeaveInformation calls SetLeaveInformationFunc.
+func (m *PeopleAPI) SetLeaveInformation(ctx context.Context, params *vanta.PeopleSetLeaveInformationParams) (*vanta.Person, error) {
+	m.record("SetLeaveInformation", params)
+	if m.SetLeaveInformationFunc == nil {
+		return nil, notConfigured("PeopleAPI", "SetLeaveInformation")
+	}
+	return m.SetLeaveInformationFunc(ctx, params)
+}
+
+// UpdatePersonMetadata calls UpdatePersonMetadataFunc.
+func (m *PeopleAPI) UpdatePersonMetadata(ctx context.Context, params *vanta.PeopleUpdatePersonMetadataParams) (*vanta.Person, error) {
+	m.record("UpdatePersonMetadata", params)
+	if m.UpdatePersonMetadataFunc == nil {
+		return nil, notConfigured("PeopleAPI", "UpdatePersonMetadata")
+	}
+	return m.UpdatePersonMetadataFunc(ctx, params)
+}
+
+// PoliciesAPI is a mock vanta.PoliciesAPI.
+type PoliciesAPI struct {
+	Recorder
+
+	GetPolicyByIDFunc func(ctx context.Context, params *vanta.PoliciesGetPolicyByIDParams) (*vanta.Policy, error)
+	ListPoliciesFunc  func(ctx context.Context, params *vanta.PoliciesListPoliciesParams) (*vanta.ResultsPage[vanta.Policy], error)
+}
+
+var _ vanta.PoliciesAPI = (*PoliciesAPI)(nil)
+
+// GetPolicyByID calls GetPolicyByIDFunc.
+func (m *PoliciesAPI) GetPolicyByID(ctx context.Context, params *vanta.PoliciesGetPolicyByIDParams) (*vanta.Policy, error) {
+	m.record("GetPolicyByID", params)
+	if m.GetPolicyByIDFunc == nil {
+		return nil, notConfigured("PoliciesAPI", "GetPolicyByID")
+	}
+	return m.GetPolicyByIDFunc(ctx, params)
+}
+
+// ListPolicies calls ListPoliciesFunc.
+func (m *PoliciesAPI) ListPolicies(ctx context.Context, params *vanta.PoliciesListPoliciesParams) (*vanta.ResultsPage[vanta.Policy], error) {
+	m.record("ListPolicies", params)
+	if m.ListPoliciesFunc == nil {
+		return nil, notConfigured("PoliciesAPI", "ListPolicies")
+	}
+	return m.ListPoliciesFunc(ctx, params)
+}
+
+// AllPolicies pages through ListPolicies.
+func (m *PoliciesAPI) AllPolicies(ctx context.Context, params *vanta.PoliciesListPoliciesParams) iter.Seq2[vanta.Policy, error] {
+	return allItems(ctx, params, func(p *vanta.PoliciesListPoliciesParams) **string { return &p.PageCursor }, m.ListPolicies)
+}
+
+// ResourcesAPI is a mock vanta.ResourcesAPI.
+type ResourcesAPI struct {
+	Recorder
+
+	GetComputersFunc             func(ctx context.Context, params *vanta.ResourcesGetComputersParams) (json.RawMessage, error)
+	GetCustomResourceServerFunc  func(ctx context.Context, params *vanta.ResourcesGetCustomResourceServerParams) (json.RawMessage, error)
+	GetUserAccountsFunc          func(ctx context.Context, params *vanta.ResourcesGetUserAccountsParams) (json.RawMessage, error)
+	SyncCustomResourceServerFunc func(ctx context.Context, params *vanta.ResourcesSyncCustomResourceServerParams) (json.RawMessage, error)
+	SyncMacOsComputersFunc       func(ctx context.Context, params *vanta.ResourcesSyncMacOsComputersParams) (json.RawMessage, error)
+	SyncUserAccountsFunc         func(ctx context.Context, params *vanta.ResourcesSyncUserAccountsParams) (json.RawMessage, error)
+}
+
+var _ vanta.ResourcesAPI = (*ResourcesAPI)(nil)
+
+// GetComputers calls GetComputersFunc.
+func (m *ResourcesAPI) GetComputers(ctx context.Context, params *vanta.ResourcesGetComputersParams) (json.RawMessage, error) {
+	m.record("GetComputers", params)
+	if m.GetComputersFunc == nil {
+		return nil, notConfigured("ResourcesAPI", "GetComputers")
+	}
+	return m.GetComputersFunc(ctx, params)
+}
+
+// GetCustomResourceServer calls GetCustomResourceServerFunc.
+func (m *ResourcesAPI) GetCustomResourceServer(ctx context.Context, params *vanta.ResourcesGetCustomResourceServerParams) (json.RawMessage, error) {
+	m.record("GetCustomResourceServer", params)
+	if m.GetCustomResourceServerFunc == nil {
+		return nil, notConfigured("ResourcesAPI", "GetCustomResourceServer")
+	}
+	return m.GetCustomResourceServerFunc(ctx, params)
+}
+
+// GetUserAccounts calls GetUserAccountsFunc.
+func (m *ResourcesAPI) GetUserAccounts(ctx context.Context, params *vanta.ResourcesGetUserAccountsParams) (json.RawMessage, error) {
+	m.record("GetUserAccounts", params)
+	if m.GetUserAccountsFunc == nil {
+		return nil, notConfigured("ResourcesAPI", "GetUserAccounts")
+	}
+	return m.GetUserAccountsFunc(ctx, params)
+}
+
+// SyncCustomResourceServer calls SyncCustomResourceServerFunc.
+func (m *ResourcesAPI) SyncCustomResourceServer(ctx context.Context, params *vanta.ResourcesSyncCustomResourceServerParams) (json.RawMessage, error) {
+	m.record("SyncCustomResourceServer", params)
+	if m.SyncCustomResourceServerFunc == nil {
+		return nil, notConfigured("ResourcesAPI", "SyncCustomResourceServer")
+	}
+	return m.SyncCustomResourceServerFunc(ctx, params)
+}
+
+// SyncMacOsComputers calls SyncMacOsComputersFunc.
+func (m *ResourcesAPI) SyncMacOsComputers(ctx context.Context, params *vanta.ResourcesSyncMacOsComputersParams) (json.RawMessage, error) {
+	m.record("SyncMacOsComputers", params)
+	if m.SyncMacOsComputersFunc == nil {
+		return nil, notConfigured("ResourcesAPI", "SyncMacOsComputers")
+	}
+	return m.SyncMacOsComputersFunc(ctx, params)
+}
+
+// SyncUserAccounts calls SyncUserAccountsFunc.
+func (m *ResourcesAPI) SyncUserAccounts(ctx context.Context, params *vanta.ResourcesSyncUserAccountsParams) (json.RawMessage, error) {
+	m.record("SyncUserAccounts", params)
+	if m.SyncUserAccountsFunc == nil {
+		return nil, notConfigured("ResourcesAPI", "SyncUserAccounts")
+	}
+	return m.SyncUserAccountsFunc(ctx, params)
+}
+
+// RiskScenariosAPI is a mock vanta.RiskScenariosAPI.
+type RiskScenariosAPI struct {
+	Recorder
+
+	CancelRiskScenarioApprovalRequestFunc func(ctx context.Context, params *vanta.RiskScenariosCancelRiskScenarioApprovalRequestParams) (*vanta.RiskScenario, error)
+	CreateRiskScenarioFunc                func(ctx context.Context, params *vanta.RiskScenariosCreateRiskScenarioParams) (*vanta.RiskScenario, error)
+	GetRiskScenarioByIDFunc               func(ctx context.Context, params *vanta.RiskScenariosGetRiskScenarioByIDParams) (*vanta.RiskScenario, error)
+	ListRiskScenariosFunc                 func(ctx context.Context, params *vanta.RiskScenariosListRiskScenariosParams) (*vanta.ResultsPage[vanta.RiskScenario], error)
+	SubmitRiskScenarioForApprovalFunc     func(ctx context.Context, params *vanta.RiskScenariosSubmitRiskScenarioForApprovalParams) (*vanta.RiskScenario, error)
+	UpdateRiskScenarioFunc                func(ctx context.Context, params *vanta.RiskScenariosUpdateRiskScenarioParams) (*vanta.RiskScenario, error)
+}
+
+var _ vanta.RiskScenariosAPI = (*RiskScenariosAPI)(nil)
+
+// CancelRiskScenarioApprovalRequest calls CancelRiskScenarioApprovalRequestFunc.
+func (m *RiskScenariosAPI) CancelRiskScenarioApprovalRequest(ctx context.Context, params *vanta.RiskScenariosCancelRiskScenarioApprovalRequestParams) (*vanta.RiskScenario, error) {
+	m.record("CancelRiskScenarioApprovalRequest", params)
+	if m.CancelRiskScenarioApprovalRequestFunc == nil {
+		return nil, notConfigured("RiskScenariosAPI", "CancelRiskScenarioApprovalRequest")
+	}
+	return m.CancelRiskScenarioApprovalRequestFunc(ctx, params)
+}
+
+// CreateRiskScenario calls CreateRiskScenarioFunc.
+func (m *RiskScenariosAPI) CreateRiskScenario(ctx context.Context, params *vanta.RiskScenariosCreateRiskScenarioParams) (*vanta.RiskScenario, error) {
+	m.record("CreateRiskScenario", params)
+	if m.CreateRiskScenarioFunc == nil {
+		return nil, notConfigured("RiskScenariosAPI", "CreateRiskScenario")
+	}
+	return m.CreateRiskScenarioFunc(ctx, params)
+}
+
+// GetRiskScenarioByID calls GetRiskScenarioByIDFunc.
+func (m *RiskScenariosAPI) GetRiskScenarioByID(ctx context.Context, params *vanta.RiskScenariosGetRiskScenarioByIDParams) (*vanta.RiskScenario, error) {
+	m.record("GetRiskScenarioByID", params)
+	if m.GetRiskScenarioByIDFunc == nil {
+		return nil, notConfigured("RiskScenariosAPI", "GetRiskScenarioByID")
+	}
+	return m.GetRiskScenarioByIDFunc(ctx, params)
+}
+
+// ListRiskScenarios calls ListRiskScenariosFunc.
+func (m *RiskScenariosAPI) ListRiskScenarios(ctx context.Context, params *vanta.RiskScenariosListRiskScenariosParams) (*vanta.ResultsPage[vanta.RiskScenario], error) {
+	m.record("ListRiskScenarios", params)
+	if m.ListRiskScenariosFunc == nil {
+		return nil, notConfigured("RiskScenariosAPI", "ListRiskScenarios")
+	}
+	return m.ListRiskScenariosFunc(ctx, params)
+}
+
+// AllRiskScenarios pages through ListRiskScenarios.
+func (m *RiskScenariosAPI) AllRiskScenarios(ctx context.Context, params *vanta.RiskScenariosListRiskScenariosParams) iter.Seq2[vanta.RiskScenario, error] {
+	return allItems(ctx, params, func(p *vanta.RiskScenariosListRiskScenariosParams) **string { return &p.PageCursor }, m.ListRiskScenarios)
+}
+
+// SubmitRiskScenarioForApproval calls SubmitRiskScenarioForApprovalFunc.
+func (m *RiskScenariosAPI) SubmitRiskScenarioForApproval(ctx context.Context, params *vanta.RiskScenariosSubmitRiskScenarioForApprovalParams) (*vanta.RiskScenario, error) {
+	m.record("SubmitRiskScenarioForApproval", params)
+	if m.SubmitRiskScenarioForApprovalFunc == nil {
+		return nil, notConfigured("RiskScenariosAPI", "SubmitRiskScenarioForApproval")
+	}
+	return m.SubmitRiskScenarioForApprovalFunc(ctx, params)
+}
+
+// UpdateRiskScenario calls UpdateRiskScenarioFunc.
+func (m *RiskScenariosAPI) UpdateRiskScenario(ctx context.Context, params *vanta.RiskScenariosUpdateRiskScenarioParams) (*vanta.RiskScenario, error) {
+	m.record("UpdateRiskScenario", params)
+	if m.UpdateRiskScenarioFunc == nil {
+		return nil, notConfigured("RiskScenariosAPI", "UpdateRiskScenario")
+	}
+	return m.UpdateRiskScenarioFunc(ctx, params)
+}
+
+// TestsAPI is a mock vanta.TestsAPI.
+type TestsAPI struct {
+	Recorder
+
+	DeactivateTestEntityFunc    func(ctx context.Context, params *vanta.TestsDeactivateTestEntityParams) (json.RawMessage, error)
+	GetTestByIDFunc             func(ctx context.Context, params *vanta.TestsGetTestByIDParams) (*vanta.Test, error)
+	GetTestEntitiesByTestIDFunc func(ctx context.Context, params *vanta.TestsGetTestEntitiesByTestIDParams) (*vanta.ResultsPage[vanta.TestEntity], error)
+	ListTestsFunc               func(ctx context.Context, params *vanta.TestsListTestsParams) (*vanta.ResultsPage[vanta.Test], error)
+	ReactivateTestEntityFunc    func(ctx context.Context, params *vanta.TestsReactivateTestEntityParams) (json.RawMessage, error)
+}
+
+var _ vanta.TestsAPI = (*TestsAPI)(nil)
+
+// DeactivateTestEntity calls DeactivateTestEntityFunc.
+func (m *TestsAPI) DeactivateTestEntity(ctx context.Context, params *vanta.TestsDeactivateTestEntityParams) (json.RawMessage, error) {
+	m.record("DeactivateTestEntity", params)
+	if m.DeactivateTestEntityFunc == nil {
+		return nil, notConfigured("TestsAPI", "DeactivateTestEntity")
+	}
+	return m.DeactivateTestEntityFunc(ctx, params)
+}
+
+// GetTestByID calls GetTestByIDFunc.
+func (m *TestsAPI) GetTestByID(ctx context.Context, params *vanta.TestsGetTestByIDParams) (*vanta.Test, error) {
+	m.record("GetTestByID", params)
+	if m.GetTestByIDFunc == nil {
+		return nil, notConfigured("TestsAPI", "GetTestByID")
+	}
+	return m.GetTestByIDFunc(ctx, params)
+}
+
+// GetTestEntitiesByTestID calls GetTestEntitiesByTestIDFunc.
+func (m *TestsAPI) GetTestEntitiesByTestID(ctx context.Context, params *vanta.TestsGetTestEntitiesByTestIDParams) (*vanta.ResultsPage[vanta.TestEntity], error) {
+	m.record("GetTestEntitiesByTestID", params)
+	if m.GetTestEntitiesByTestIDFunc == nil {
+		return nil, notConfigured("TestsAPI", "GetTestEntitiesByTestID")
+	}
+	return m.GetTestEntitiesByTestIDFunc(ctx, params)
+}
+
+// AllTestEntitiesByTestID pages through GetTestEntitiesByTestID.
+func (m *TestsAPI) AllTestEntitiesByTestID(ctx context.Context, params *vanta.TestsGetTestEntitiesByTestIDParams) iter.Seq2[vanta.TestEntity, error] {
+	return allItems(ctx, params, func(p *vanta.TestsGetTestEntitiesByTestIDParams) **string { return &p.PageCursor }, m.GetTestEntitiesByTestID)
+}
+
+// ListTests calls ListTestsFunc.
+func (m *TestsAPI) ListTests(ctx context.Context, params *vanta.TestsListTestsParams) (*vanta.ResultsPage[vanta.Test], error) {
+	m.record("ListTests", params)
+	if m.ListTestsFunc == nil {
+		return nil, notConfigured("TestsAPI", "ListTests")
+	}
+	return m.ListTestsFunc(ctx, params)
+}
+
+// AllTests pages through ListTests.
+func (m *TestsAPI) AllTests(ctx context.Context, params *vanta.TestsListTestsParams) iter.Seq2[vanta.Test, error] {
+	return allItems(ctx, params, func(p *vanta.TestsListTestsParams) **string { return &p.PageCursor }, m.ListTests)
+}
+
+// ReactivateTestEntity calls ReactivateTestEntityFunc.
+func (m *TestsAPI) ReactivateTestEntity(ctx context.Context, params *vanta.TestsReactivateTestEntityParams) (json.RawMessage, error) {
+	m.record("ReactivateTestEntity", params)
+	if m.ReactivateTestEntityFunc == nil {
+		return nil, notConfigured("TestsAPI", "ReactivateTestEntity")
+	}
+	return m.ReactivateTestEntityFunc(ctx, params)
+}
+
+// TrustCentersAPI is a mock vanta.TrustCentersAPI.
+type TrustCentersAPI struct {
+	Recorder
+
+	AddTrustCenterControlFunc                                   func(ctx context.Context, params *vanta.TrustCentersAddTrustCenterControlParams) (*vanta.TrustCenterControl, error)
+	AddTrustCenterControlCategoryFunc                           func(ctx context.Context, params *vanta.TrustCentersAddTrustCenterControlCategoryParams) (*vanta.TrustCenterControlCategoryRef, error)
+	AddTrustCenterViewerFunc                                    func(ctx context.Context, params *vanta.TrustCentersAddTrustCenterViewerParams) (*vanta.TrustCenterViewer, error)
+	ApproveTrustCenterAccessRequestFunc                         func(ctx context.Context, params *vanta.TrustCentersApproveTrustCenterAccessRequestParams) (json.RawMessage, error)
+	CreateTrustCenterDocumentFunc                               func(ctx context.Context, params *vanta.TrustCentersCreateTrustCenterDocumentParams) (*vanta.TrustCentersCreateTrustCenterDocumentResponse, error)
+	CreateTrustCenterFaqFunc                                    func(ctx context.Context, params *vanta.TrustCentersCreateTrustCenterFaqParams) (*vanta.TrustCentersCreateTrustCenterFaqResponse, error)
+	CreateTrustCenterSubprocessorFunc                           func(ctx context.Context, params *vanta.TrustCentersCreateTrustCenterSubprocessorParams) (*vanta.TrustCentersCreateTrustCenterSubprocessorResponse, error)
+	CreateTrustCenterSubscriberFunc                             func(ctx context.Context, params *vanta.TrustCentersCreateTrustCenterSubscriberParams) (*vanta.TrustCenterSubscriber, error)
+	CreateTrustCenterSubscriberGroupFunc                        func(ctx context.Context, params *vanta.TrustCentersCreateTrustCenterSubscriberGroupParams) (*vanta.TrustCenterSubscriberGroup, error)
+	CreateTrustCenterUpdateFunc                                 func(ctx context.Context, params *vanta.TrustCentersCreateTrustCenterUpdateParams) (*vanta.TrustCenterUpdate, error)
+	DeleteTrustCenterControlFunc                                func(ctx context.Context, params *vanta.TrustCentersDeleteTrustCenterControlParams) (json.RawMessage, error)
+	DeleteTrustCenterControlCategoryFunc                        func(ctx context.Context, params *vanta.TrustCentersDeleteTrustCenterControlCategoryParams) (json.RawMessage, error)
+	DeleteTrustCenterDocumentFunc                               func(ctx context.Context, params *vanta.TrustCentersDeleteTrustCenterDocumentParams) (json.RawMessage, error)
+	DeleteTrustCenterFaqFunc                                    func(ctx context.Context, params *vanta.TrustCentersDeleteTrustCenterFaqParams) (json.RawMessage, error)
+	DeleteTrustCenterSubprocessorFunc                           func(ctx context.Context, params *vanta.TrustCentersDeleteTrustCenterSubprocessorParams) (json.RawMessage, error)
+	DeleteTrustCenterSubscriberFunc                             func(ctx context.Context, params *vanta.TrustCentersDeleteTrustCenterSubscriberParams) (json.RawMessage, error)
+	DeleteTrustCenterSubscriberGroupFunc                        func(ctx context.Context, params *vanta.TrustCentersDeleteTrustCenterSubscriberGroupParams) (json.RawMessage, error)
+	DeleteTrustCenterUpdateFunc                                 func(ctx context.Context, params *vanta.TrustCentersDeleteTrustCenterUpdateParams) (json.RawMessage, error)
+	DenyTrustCenterAccessRequestFunc                            func(ctx context.Context, params *vanta.TrustCentersDenyTrustCenterAccessRequestParams) (json.RawMessage, error)
+	EditTrustCenterSubscriberGroupFunc                          func(ctx context.Context, params *vanta.TrustCentersEditTrustCenterSubscriberGroupParams) (*vanta.TrustCenterSubscriberGroup, error)
+	GetTrustCenterFunc                                          func(ctx context.Context, params *vanta.TrustCentersGetTrustCenterParams) (*vanta.TrustCentersGetTrustCenterResponse, error)
+	GetTrustCenterAccessRequestFunc                             func(ctx context.Context, params *vanta.TrustCentersGetTrustCenterAccessRequestParams) (*vanta.TrustCenterAccessRequest, error)
+	GetTrustCenterControlFunc                                   func(ctx context.Context, params *vanta.TrustCentersGetTrustCenterControlParams) (*vanta.TrustCenterControl, error)
+	GetTrustCenterControlCategoryFunc                           func(ctx context.Context, params *vanta.TrustCentersGetTrustCenterControlCategoryParams) (*vanta.TrustCenterControlCategoryRef, error)
+	GetTrustCenterDocumentFunc                                  func(ctx context.Context, params *vanta.TrustCentersGetTrustCenterDocumentParams) (*vanta.TrustCentersGetTrustCenterDocumentResponse, error)
+	GetTrustCenterFaqFunc                                       func(ctx context.Context, params *vanta.TrustCentersGetTrustCenterFaqParams) (*vanta.TrustCentersGetTrustCenterFaqResponse, error)
+	GetTrustCenterSubprocessorFunc                              func(ctx context.Context, params *vanta.TrustCentersGetTrustCenterSubprocessorParams) (*vanta.TrustCentersGetTrustCenterSubprocessorResponse, error)
+	GetTrustCenterSubscriberFunc                                func(ctx context.Context, params *vanta.TrustCentersGetTrustCenterSubscriberParams) (*vanta.TrustCenterSubscriber, error)
+	GetTrustCenterSubscriberGroupFunc                           func(ctx context.Context, params *vanta.TrustCentersGetTrustCenterSubscriberGroupParams) (*vanta.TrustCenterSubscriberGroup, error)
+	GetTrustCenterUpdateFunc                                    func(ctx context.Context, params *vanta.TrustCentersGetTrustCenterUpdateParams) (*vanta.TrustCenterUpdate, error)
+	GetTrustCenterViewerFunc                                    func(ctx context.Context, params *vanta.TrustCentersGetTrustCenterViewerParams) (*vanta.TrustCenterViewer, error)
+	GetUploadedMediaForTrustCenterDocumentFunc                  func(ctx context.Context, params *vanta.TrustCentersGetUploadedMediaForTrustCenterDocumentParams) (*vanta.TrustCentersGetUploadedMediaForTrustCenterDocumentResponse, error)
+	ListHistoricalTrustCenterAccessRequestsFunc                 func(ctx context.Context, params *vanta.TrustCentersListHistoricalTrustCenterAccessRequestsParams) (*vanta.ResultsPage[vanta.TrustCenterAccessRequest], error)
+	ListTrustCenterAccessRequestsFunc                           func(ctx context.Context, params *vanta.TrustCentersListTrustCenterAccessRequestsParams) (*vanta.ResultsPage[vanta.TrustCenterAccessRequest], error)
+	ListTrustCenterControlCategoriesFunc                        func(ctx context.Context, params *vanta.TrustCentersListTrustCenterControlCategoriesParams) (*vanta.TrustCentersListTrustCenterControlCategoriesResponse, error)
+	ListTrustCenterControlsFunc                                 func(ctx context.Context, params *vanta.TrustCentersListTrustCenterControlsParams) (*vanta.ResultsPage[vanta.TrustCenterControl], error)
+	ListTrustCenterFaqsFunc                                     func(ctx context.Context, params *vanta.TrustCentersListTrustCenterFaqsParams) (*vanta.TrustCentersListTrustCenterFaqsResponse, error)
+	ListTrustCenterResourcesFunc                                func(ctx context.Context, params *vanta.TrustCentersListTrustCenterResourcesParams) (*vanta.TrustCentersListTrustCenterResourcesResponse, error)
+	ListTrustCenterSubprocessorsFunc                            func(ctx context.Context, params *vanta.TrustCentersListTrustCenterSubprocessorsParams) (*vanta.TrustCentersListTrustCenterSubprocessorsResponse, error)
+	ListTrustCenterSubscriberGroupsFunc                         func(ctx context.Context, params *vanta.TrustCentersListTrustCenterSubscriberGroupsParams) (*vanta.ResultsPage[vanta.TrustCenterSubscriberGroup], error)
+	ListTrustCenterSubscribersFunc                              func(ctx context.Context, params *vanta.TrustCentersListTrustCenterSubscribersParams) (*vanta.ResultsPage[vanta.TrustCenterSubscriber], error)
+	ListTrustCenterUpdatesFunc                                  func(ctx context.Context, params *vanta.TrustCentersListTrustCenterUpdatesParams) (*vanta.ResultsPage[vanta.TrustCenterUpdate], error)
+	ListTrustCenterViewerActivityEventsFunc                     func(ctx context.Context, params *vanta.TrustCentersListTrustCenterViewerActivityEventsParams) (*vanta.ResultsPage[vanta.TrustCenterViewerActivityEvent], error)
+	ListTrustCenterViewersFunc                                  func(ctx context.Context, params *vanta.TrustCentersListTrustCenterViewersParams) (*vanta.ResultsPage[vanta.TrustCenterViewer], error)
+	RemoveTrustCenterViewerFunc                                 func(ctx context.Context, params *vanta.TrustCentersRemoveTrustCenterViewerParams) (json.RawMessage, error)
+	SendTrustCenterUpdateNotificationsToAllSubscribersFunc      func(ctx context.Context, params *vanta.TrustCentersSendTrustCenterUpdateNotificationsToAllSubscribersParams) (json.RawMessage, error)
+	SendTrustCenterUpdateNotificationsToSpecificSubscribersFunc func(ctx context.Context, params *vanta.TrustCentersSendTrustCenterUpdateNotificationsToSpecificSubscribersParams) (json.RawMessage, error)
+	SetGroupsForTrustCenterSubscriberFunc                       func(ctx context.Context, params *vanta.TrustCentersSetGroupsForTrustCenterSubscriberParams) (*vanta.TrustCenterSubscriber, error)
+	UpdateTrustCenterFunc                                       func(ctx context.Context, params *vanta.TrustCentersUpdateTrustCenterParams) (*vanta.TrustCentersUpdateTrustCenterResponse, error)
+	UpdateTrustCenterControlCategoryFunc                        func(ctx context.Context, params *vanta.TrustCentersUpdateTrustCenterControlCategoryParams) (*vanta.TrustCenterControlCategoryRef, error)
+	UpdateTrustCenterDocumentFunc                               func(ctx context.Context, params *vanta.TrustCentersUpdateTrustCenterDocumentParams) (*vanta.TrustCentersUpdateTrustCenterDocumentResponse, error)
+	UpdateTrustCenterFaqFunc                                    func(ctx context.Context, params *vanta.TrustCentersUpdateTrustCenterFaqParams) (*vanta.TrustCentersUpdateTrustCenterFaqResponse, error)
+	UpdateTrustCenterSubprocessorFunc                           func(ctx context.Context, params *vanta.TrustCentersUpdateTrustCenterSubprocessorParams) (*vanta.TrustCentersUpdateTrustCenterSubprocessorResponse, error)
+	UpdateTrustCenterUpdateFunc                                 func(ctx context.Context, params *vanta.TrustCentersUpdateTrustCenterUpdateParams) (*vanta.TrustCenterUpdate, error)
+}
+
+var _ vanta.TrustCentersAPI = (*TrustCentersAPI)(nil)
+
+// AddTrustCenterControl calls AddTrustCenterControlFunc.
+func (m *TrustCentersAPI) AddTrustCenterControl(ctx context.Context, params *vanta.TrustCentersAddTrustCenterControlParams) (*vanta.TrustCenterControl, error) {
+	m.record("AddTrustCenterControl", params)
+	if m.AddTrustCenterControlFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "AddTrustCenterControl")
+	}
+	return m.AddTrustCenterControlFunc(ctx, params)
+}
+
+// AddTrustCenterControlCategory calls AddTrustCenterControlCategoryFunc.
+func (m *TrustCentersAPI) AddTrustCenterControlCategory(ctx context.Context, params *vanta.TrustCentersAddTrustCenterControlCategoryParams) (*vanta.TrustCenterControlCategoryRef, error) {
+	m.record("AddTrustCenterControlCategory", params)
+	if m.AddTrustCenterControlCategoryFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "AddTrustCenterControlCategory")
+	}
+	return m.AddTrustCenterControlCategoryFunc(ctx, params)
+}
+
+// AddTrustCenterViewer calls AddTrustCenterViewerFunc.
+func (m *TrustCentersAPI) AddTrustCenterViewer(ctx context.Context, params *vanta.TrustCentersAddTrustCenterViewerParams) (*vanta.TrustCenterViewer, error) {
+	m.record("AddTrustCenterViewer", params)
+	if m.AddTrustCenterViewerFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "AddTrustCenterViewer")
+	}
+	return m.AddTrustCenterViewerFunc(ctx, params)
+}
+
+// ApproveTrustCenterAccessRequest calls ApproveTrustCenterAccessRequestFunc.
+func (m *TrustCentersAPI) ApproveTrustCenterAccessRequest(ctx context.Context, params *vanta.TrustCentersApproveTrustCenterAccessRequestParams) (json.RawMessage, error) {
+	m.record("ApproveTrustCenterAccessRequest", params)
+	if m.ApproveTrustCenterAccessRequestFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "ApproveTrustCenterAccessRequest")
+	}
+	return m.ApproveTrustCenterAccessRequestFunc(ctx, params)
+}
+
+// CreateTrustCenterDocument calls CreateTrustCenterDocumentFunc.
+func (m *TrustCentersAPI) CreateTrustCenterDocument(ctx context.Context, params *vanta.TrustCentersCreateTrustCenterDocumentParams) (*vanta.TrustCentersCreateTrustCenterDocumentResponse, error) {
+	m.record("CreateTrustCenterDocument", params)
+	if m.CreateTrustCenterDocumentFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "CreateTrustCenterDocument")
+	}
+	return m.CreateTrustCenterDocumentFunc(ctx, params)
+}
+
+// CreateTrustCenterFaq calls CreateTrustCenterFaqFunc.
+func (m *TrustCentersAPI) CreateTrustCenterFaq(ctx context.Context, params *vanta.TrustCentersCreateTrustCenterFaqParams) (*vanta.TrustCentersCreateTrustCenterFaqResponse, error) {
+	m.record("CreateTrustCenterFaq", params)
+	if m.CreateTrustCenterFaqFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "CreateTrustCenterFaq")
+	}
+	return m.CreateTrustCenterFaqFunc(ctx, params)
+}
+
+// CreateTrustCenterSubprocessor calls CreateTrustCenterSubprocessorFunc.
+func (m *TrustCentersAPI) CreateTrustCenterSubprocessor(ctx context.Context, params *vanta.TrustCentersCreateTrustCenterSubprocessorParams) (*vanta.TrustCentersCreateTrustCenterSubprocessorResponse, error) {
+	m.record("CreateTrustCenterSubprocessor", params)
+	if m.CreateTrustCenterSubprocessorFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "CreateTrustCenterSubprocessor")
+	}
+	return m.CreateTrustCenterSubprocessorFunc(ctx, params)
+}
+
+// CreateTrustCenterSubscriber calls CreateTrustCenterSubscriberFunc.
+func (m *TrustCentersAPI) CreateTrustCenterSubscriber(ctx context.Context, params *vanta.TrustCentersCreateTrustCenterSubscriberParams) (*vanta.TrustCenterSubscriber, error) {
+	m.record("CreateTrustCenterSubscriber", params)
+	if m.CreateTrustCenterSubscriberFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "CreateTrustCenterSubscriber")
+	}
+	return m.CreateTrustCenterSubscriberFunc(ctx, params)
+}
+
+// CreateTrustCenterSubscriberGroup calls CreateTrustCenterSubscriberGroupFunc.
+func (m *TrustCentersAPI) CreateTrustCenterSubscriberGroup(ctx context.Context, params *vanta.TrustCentersCreateTrustCenterSubscriberGroupParams) (*vanta.TrustCenterSubscriberGroup, error) {
+	m.record("CreateTrustCenterSubscriberGroup", params)
+	if m.CreateTrustCenterSubscriberGroupFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "CreateTrustCenterSubscriberGroup")
+	}
+	return m.CreateTrustCenterSubscriberGroupFunc(ctx, params)
+}
+
+// CreateTrustCenterUpdate calls CreateTrustCenterUpdateFunc.
+func (m *TrustCentersAPI) CreateTrustCenterUpdate(ctx context.Context, params *vanta.TrustCentersCreateTrustCenterUpdateParams) (*vanta.TrustCenterUpdate, error) {
+	m.record("CreateTrustCenterUpdate", params)
+	if m.CreateTrustCenterUpdateFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "CreateTrustCenterUpdate")
+	}
+	return m.CreateTrustCenterUpdateFunc(ctx, params)
+}
+
+// DeleteTrustCenterControl calls DeleteTrustCenterControlFunc.
+func (m *TrustCentersAPI) DeleteTrustCenterControl(ctx context.Context, params *vanta.TrustCentersDeleteTrustCenterControlParams) (json.RawMessage, error) {
+	m.record("DeleteTrustCenterControl", params)
+	if m.DeleteTrustCenterControlFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "DeleteTrustCenterControl")
+	}
+	return m.DeleteTrustCenterControlFunc(ctx, params)
+}
+
+// DeleteTrustCenterControlCategory calls DeleteTrustCenterControlCategoryFunc.
+func (m *TrustCentersAPI) DeleteTrustCenterControlCategory(ctx context.Context, params *vanta.TrustCentersDeleteTrustCenterControlCategoryParams) (json.RawMessage, error) {
+	m.record("DeleteTrustCenterControlCategory", params)
+	if m.DeleteTrustCenterControlCategoryFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "DeleteTrustCenterControlCategory")
+	}
+	return m.DeleteTrustCenterControlCategoryFunc(ctx, params)
+}
+
+// DeleteTrustCenterDocument calls DeleteTrustCenterDocumentFunc.
+func (m *TrustCentersAPI) DeleteTrustCenterDocument(ctx context.Context, params *vanta.TrustCentersDeleteTrustCenterDocumentParams) (json.RawMessage, error) {
+	m.record("DeleteTrustCenterDocument", params)
+	if m.DeleteTrustCenterDocumentFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "DeleteTrustCenterDocument")
+	}
+	return m.DeleteTrustCenterDocumentFunc(ctx, params)
+}
+
+// DeleteTrustCenterFaq calls DeleteTrustCenterFaqFunc.
+func (m *TrustCentersAPI) DeleteTrustCenterFaq(ctx context.Context, params *vanta.TrustCentersDeleteTrustCenterFaqParams) (json.RawMessage, error) {
+	m.record("DeleteTrustCenterFaq", params)
+	if m.DeleteTrustCenterFaqFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "DeleteTrustCenterFaq")
+	}
+	return m.DeleteTrustCenterFaqFunc(ctx, params)
+}
+
+// DeleteTrustCenterSubprocessor calls DeleteTrustCenterSubprocessorFunc.
+func (m *TrustCentersAPI) DeleteTrustCenterSubprocessor(ctx context.Context, params *vanta.TrustCentersDeleteTrustCenterSubprocessorParams) (json.RawMessage, error) {
+	m.record("DeleteTrustCenterSubprocessor", params)
+	if m.DeleteTrustCenterSubprocessorFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "DeleteTrustCenterSubprocessor")
+	}
+	return m.DeleteTrustCenterSubprocessorFunc(ctx, params)
+}
+
+// DeleteTrustCenterSubscriber calls DeleteTrustCenterSubscriberFunc.
+func (m *TrustCentersAPI) DeleteTrustCenterSubscriber(ctx context.Context, params *vanta.TrustCentersDeleteTrustCenterSubscriberParams) (json.RawMessage, error) {
+	m.record("DeleteTrustCenterSubscriber", params)
+	if m.DeleteTrustCenterSubscriberFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "DeleteTrustCenterSubscriber")
+	}
+	return m.DeleteTrustCenterSubscriberFunc(ctx, params)
+}
+
+// DeleteTrustCenterSubscriberGroup calls DeleteTrustCenterSubscriberGroupFunc.
+func (m *TrustCentersAPI) DeleteTrustCenterSubscriberGroup(ctx context.Context, params *vanta.TrustCentersDeleteTrustCenterSubscriberGroupParams) (json.RawMessage, error) {
+	m.record("DeleteTrustCenterSubscriberGroup", params)
+	if m.DeleteTrustCenterSubscriberGroupFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "DeleteTrustCenterSubscriberGroup")
+	}
+	return m.DeleteTrustCenterSubscriberGroupFunc(ctx, params)
+}
+
+// DeleteTrustCenterUpdate calls DeleteTrustCenterUpdateFunc.
+func (m *TrustCentersAPI) DeleteTrustCenterUpdate(ctx context.Context, params *vanta.TrustCentersDeleteTrustCenterUpdateParams) (json.RawMessage, error) {
+	m.record("DeleteTrustCenterUpdate", params)
+	if m.DeleteTrustCenterUpdateFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "DeleteTrustCenterUpdate")
+	}
+	return m.DeleteTrustCenterUpdateFunc(ctx, params)
+}
+
+// DenyTrustCenterAccessRequest calls DenyTrustCenterAccessRequestFunc.
+func (m *TrustCentersAPI) DenyTrustCenterAccessRequest(ctx context.Context, params *vanta.TrustCentersDenyTrustCenterAccessRequestParams) (json.RawMessage, error) {
+	m.record("DenyTrustCenterAccessRequest", params)
+	if m.DenyTrustCenterAccessRequestFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "DenyTrustCenterAccessRequest")
+	}
+	return m.DenyTrustCenterAccessRequestFunc(ctx, params)
+}
+
+// EditTrustCenterSubscriberGroup calls EditTrustCenterSubscriberGroupFunc.
+func (m *TrustCentersAPI) EditTrustCenterSubscriberGroup(ctx context.Context, params *vanta.TrustCentersEditTrustCenterSubscriberGroupParams) (*vanta.TrustCenterSubscriberGroup, error) {
+	m.record("EditTrustCenterSubscriberGroup", params)
+	if m.EditTrustCenterSubscriberGroupFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "EditTrustCenterSubscriberGroup")
+	}
+	return m.EditTrustCenterSubscriberGroupFunc(ctx, params)
+}
+
+// GetTrustCenter calls GetTrustCenterFunc.
+func (m *TrustCentersAPI) GetTrustCenter(ctx context.Context, params *vanta.TrustCentersGetTrustCenterParams) (*vanta.TrustCentersGetTrustCenterResponse, error) {
+	m.record("GetTrustCenter", params)
+	if m.GetTrustCenterFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "GetTrustCenter")
+	}
+	return m.GetTrustCenterFunc(ctx, params)
+}
+
+// GetTrustCenterAccessRequest calls GetTrustCenterAccessRequestFunc.
+func (m *TrustCentersAPI) GetTrustCenterAccessRequest(ctx context.Context, params *vanta.TrustCentersGetTrustCenterAccessRequestParams) (*vanta.TrustCenterAccessRequest, error) {
+	m.record("GetTrustCenterAccessRequest", params)
+	if m.GetTrustCenterAccessRequestFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "GetTrustCenterAccessRequest")
+	}
+	return m.GetTrustCenterAccessRequestFunc(ctx, params)
+}
+
+// GetTrustCenterControl calls GetTrustCenterControlFunc.
+func (m *TrustCentersAPI) GetTrustCenterControl(ctx context.Context, params *vanta.TrustCentersGetTrustCenterControlParams) (*vanta.TrustCenterControl, error) {
+	m.record("GetTrustCenterControl", params)
+	if m.GetTrustCenterControlFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "GetTrustCenterControl")
+	}
+	return m.GetTrustCenterControlFunc(ctx, params)
+}
+
+// GetTrustCenterControlCategory calls GetTrustCenterControlCategoryFunc.
+func (m *TrustCentersAPI) GetTrustCenterControlCategory(ctx context.Context, params *vanta.TrustCentersGetTrustCenterControlCategoryParams) (*vanta.TrustCenterControlCategoryRef, error) {
+	m.record("GetTrustCenterControlCategory", params)
+	if m.GetTrustCenterControlCategoryFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "GetTrustCenterControlCategory")
+	}
+	return m.GetTrustCenterControlCategoryFunc(ctx, params)
+}
+
+// GetTrustCenterDocument calls GetTrustCenterDocumentFunc.
+func (m *TrustCentersAPI) GetTrustCenterDocument(ctx context.Context, params *vanta.TrustCentersGetTrustCenterDocumentParams) (*vanta.TrustCentersGetTrustCenterDocumentResponse, error) {
+	m.record("GetTrustCenterDocument", params)
+	if m.GetTrustCenterDocumentFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "GetTrustCenterDocument")
+	}
+	return m.GetTrustCenterDocumentFunc(ctx, params)
+}
+
+// GetTrustCenterFaq calls GetTrustCenterFaqFunc.
+func (m *TrustCentersAPI) GetTrustCenterFaq(ctx context.Context, params *vanta.TrustCentersGetTrustCenterFaqParams) (*vanta.TrustCentersGetTrustCenterFaqResponse, error) {
+	m.record("GetTrustCenterFaq", params)
+	if m.GetTrustCenterFaqFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "GetTrustCenterFaq")
+	}
+	return m.GetTrustCenterFaqFunc(ctx, params)
+}
+
+// GetTrustCenterSubprocessor calls GetTrustCenterSubprocessorFunc.
+func (m *TrustCentersAPI) GetTrustCenterSubprocessor(ctx context.Context, params *vanta.TrustCentersGetTrustCenterSubprocessorParams) (*vanta.TrustCentersGetTrustCenterSubprocessorResponse, error) {
+	m.record("GetTrustCenterSubprocessor", params)
+	if m.GetTrustCenterSubprocessorFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "GetTrustCenterSubprocessor")
+	}
+	return m.GetTrustCenterSubprocessorFunc(ctx, params)
+}
+
+// GetTrustCenterSubscriber calls GetTrustCenterSubscriberFunc.
+func (m *TrustCentersAPI) GetTrustCenterSubscriber(ctx context.Context, params *vanta.TrustCentersGetTrustCenterSubscriberParams) (*vanta.TrustCenterSubscriber, error) {
+	m.record("GetTrustCenterSubscriber", params)
+	if m.GetTrustCenterSubscriberFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "GetTrustCenterSubscriber")
+	}
+	return m.GetTrustCenterSubscriberFunc(ctx, params)
+}
+
+// GetTrustCenterSubscriberGroup calls GetTrustCenterSubscriberGroupFunc.
+func (m *TrustCentersAPI) GetTrustCenterSubscriberGroup(ctx context.Context, params *vanta.TrustCentersGetTrustCenterSubscriberGroupParams) (*vanta.TrustCenterSubscriberGroup, error) {
+	m.record("GetTrustCenterSubscriberGroup", params)
+	if m.GetTrustCenterSubscriberGroupFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "GetTrustCenterSubscriberGroup")
+	}
+	return m.GetTrustCenterSubscriberGroupFunc(ctx, params)
+}
+
+// GetTrustCenterUpdate calls GetTrustCenterUpdateFunc.
+func (m *TrustCentersAPI) GetTrustCenterUpdate(ctx context.Context, params *vanta.TrustCentersGetTrustCenterUpdateParams) (*vanta.TrustCenterUpdate, error) {
+	m.record("GetTrustCenterUpdate", params)
+	if m.GetTrustCenterUpdateFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "GetTrustCenterUpdate")
+	}
+	return m.GetTrustCenterUpdateFunc(ctx, params)
+}
+
+// GetTrustCenterViewer calls GetTrustCenterViewerFunc.
+func (m *TrustCentersAPI) GetTrustCenterViewer(ctx context.Context, params *vanta.TrustCentersGetTrustCenterViewerParams) (*vanta.TrustCenterViewer, error) {
+	m.record("GetTrustCenterViewer", params)
+	if m.GetTrustCenterViewerFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "GetTrustCenterViewer")
+	}
+	return m.GetTrustCenterViewerFunc(ctx, params)
+}
+
+// GetUploadedMediaForTrustCenterDocument calls GetUploadedMediaForTrustCenterDocumentFunc.
+func (m *TrustCentersAPI) GetUploadedMediaForTrustCenterDocument(ctx context.Context, params *vanta.TrustCentersGetUploadedMediaForTrustCenterDocumentParams) (*vanta.TrustCentersGetUploadedMediaForTrustCenterDocumentResponse, error) {
+	m.record("GetUploadedMediaForTrustCenterDocument", params)
+	if m.GetUploadedMediaForTrustCenterDocumentFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "GetUploadedMediaForTrustCenterDocument")
+	}
+	return m.GetUploadedMediaForTrustCenterDocumentFunc(ctx, params)
+}
+
+// ListHistoricalTrustCenterAccessRequests calls ListHistoricalTrustCenterAccessRequestsFunc.
+func (m *TrustCentersAPI) ListHistoricalTrustCenterAccessRequests(ctx context.Context, params *vanta.TrustCentersListHistoricalTrustCenterAccessRequestsParams) (*vanta.ResultsPage[vanta.TrustCenterAccessRequest], error) {
+	m.record("ListHistoricalTrustCenterAccessRequests", params)
+	if m.ListHistoricalTrustCenterAccessRequestsFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "ListHistoricalTrustCenterAccessRequests")
+	}
+	return m.ListHistoricalTrustCenterAccessRequestsFunc(ctx, params)
+}
+
+// AllHistoricalTrustCenterAccessRequests pages through ListHistoricalTrustCenterAccessRequests.
+func (m *TrustCentersAPI) AllHistoricalTrustCenterAccessRequests(ctx context.Context, params *vanta.TrustCentersListHistoricalTrustCenterAccessRequestsParams) iter.Seq2[vanta.TrustCenterAccessRequest, error] {
+	return allItems(ctx, params, func(p *vanta.TrustCentersListHistoricalTrustCenterAccessRequestsParams) **string {
+		return &p.PageCursor
+	}, m.ListHistoricalTrustCenterAccessRequests)
+}
+
+// ListTrustCenterAccessRequests calls ListTrustCenterAccessRequestsFunc.
+func (m *TrustCentersAPI) ListTrustCenterAccessRequests(ctx context.Context, params *vanta.TrustCentersListTrustCenterAccessRequestsParams) (*vanta.ResultsPage[vanta.TrustCenterAccessRequest], error) {
+	m.record("ListTrustCenterAccessRequests", params)
+	if m.ListTrustCenterAccessRequestsFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "ListTrustCenterAccessRequests")
+	}
+	return m.ListTrustCenterAccessRequestsFunc(ctx, params)
+}
+
+// AllTrustCenterAccessRequests pages through ListTrustCenterAccessRequests.
+func (m *TrustCentersAPI) AllTrustCenterAccessRequests(ctx context.Context, params *vanta.TrustCentersListTrustCenterAccessRequestsParams) iter.Seq2[vanta.TrustCenterAccessRequest, error] {
+	return allItems(ctx, params, func(p *vanta.TrustCentersListTrustCenterAccessRequestsParams) **string { return &p.PageCursor }, m.ListTrustCenterAccessRequests)
+}
+
+// ListTrustCenterControlCategories calls ListTrustCenterControlCategoriesFunc.
+func (m *TrustCentersAPI) ListTrustCenterControlCategories(ctx context.Context, params *vanta.TrustCentersListTrustCenterControlCategoriesParams) (*vanta.TrustCentersListTrustCenterControlCategoriesResponse, error) {
+	m.record("ListTrustCenterControlCategories", params)
+	if m.ListTrustCenterControlCategoriesFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "ListTrustCenterControlCategories")
+	}
+	return m.ListTrustCenterControlCategoriesFunc(ctx, params)
+}
+
+// ListTrustCenterControls calls ListTrustCenterControlsFunc.
+func (m *TrustCentersAPI) ListTrustCenterControls(ctx context.Context, params *vanta.TrustCentersListTrustCenterControlsParams) (*vanta.ResultsPage[vanta.TrustCenterControl], error) {
+	m.record("ListTrustCenterControls", params)
+	if m.ListTrustCenterControlsFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "ListTrustCenterControls")
+	}
+	return m.ListTrustCenterControlsFunc(ctx, params)
+}
+
+// AllTrustCenterControls pages through ListTrustCenterControls.
+func (m *TrustCentersAPI) AllTrustCenterControls(ctx context.Context, params *vanta.TrustCentersListTrustCenterControlsParams) iter.Seq2[vanta.TrustCenterControl, error] {
+	return allItems(ctx, params, func(p *vanta.TrustCentersListTrustCenterControlsParams) **string { return &p.PageCursor }, m.ListTrustCenterControls)
+}
+
+// ListTrustCenterFaqs calls ListTrustCenterFaqsFunc.
+func (m *TrustCentersAPI) ListTrustCenterFaqs(ctx context.Context, params *vanta.TrustCentersListTrustCenterFaqsParams) (*vanta.TrustCentersListTrustCenterFaqsResponse, error) {
+	m.record("ListTrustCenterFaqs", params)
+	if m.ListTrustCenterFaqsFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "ListTrustCenterFaqs")
+	}
+	return m.ListTrustCenterFaqsFunc(ctx, params)
+}
+
+// ListTrustCenterResources calls ListTrustCenterResourcesFunc.
+func (m *TrustCentersAPI) ListTrustCenterResources(ctx context.Context, params *vanta.TrustCentersListTrustCenterResourcesParams) (*vanta.TrustCentersListTrustCenterResourcesResponse, error) {
+	m.record("ListTrustCenterResources", params)
+	if m.ListTrustCenterResourcesFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "ListTrustCenterResources")
+	}
+	return m.ListTrustCenterResourcesFunc(ctx, params)
+}
+
+// ListTrustCenterSubprocessors calls ListTrustCenterSubprocessorsFunc.
+func (m *TrustCentersAPI) ListTrustCenterSubprocessors(ctx context.Context, params *vanta.TrustCentersListTrustCenterSubprocessorsParams) (*vanta.TrustCentersListTrustCenterSubprocessorsResponse, error) {
+	m.record("ListTrustCenterSubprocessors", params)
+	if m.ListTrustCenterSubprocessorsFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "ListTrustCenterSubprocessors")
+	}
+	return m.ListTrustCenterSubprocessorsFunc(ctx, params)
+}
+
+// ListTrustCenterSubscriberGroups calls ListTrustCenterSubscriberGroupsFunc.
+func (m *TrustCentersAPI) ListTrustCenterSubscriberGroups(ctx context.Context, params *vanta.TrustCentersListTrustCenterSubscriberGroupsParams) (*vanta.ResultsPage[vanta.TrustCenterSubscriberGroup], error) {
+	m.record("ListTrustCenterSubscriberGroups", params)
+	if m.ListTrustCenterSubscriberGroupsFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "ListTrustCenterSubscriberGroups")
+	}
+	return m.ListTrustCenterSubscriberGroupsFunc(ctx, params)
+}
+
+// AllTrustCenterSubscriberGroups pages through ListTrustCenterSubscriberGroups.
+func (m *TrustCentersAPI) AllTrustCenterSubscriberGroups(ctx context.Context, params *vanta.TrustCentersListTrustCenterSubscriberGroupsParams) iter.Seq2[vanta.TrustCenterSubscriberGroup, error] {
+	return allItems(ctx, params, func(p *vanta.TrustCentersListTrustCenterSubscriberGroupsParams) **string { return &p.PageCursor }, m.ListTrustCenterSubscriberGroups)
+}
+
+// ListTrustCenterSubscribers calls ListTrustCenterSubscribersFunc.
+func (m *TrustCentersAPI) ListTrustCenterSubscribers(ctx context.Context, params *vanta.TrustCentersListTrustCenterSubscribersParams) (*vanta.ResultsPage[vanta.TrustCenterSubscriber], error) {
+	m.record("ListTrustCenterSubscribers", params)
+	if m.ListTrustCenterSubscribersFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "ListTrustCenterSubscribers")
+	}
+	return m.ListTrustCenterSubscribersFunc(ctx, params)
+}
+
+// AllTrustCenterSubscribers pages through ListTrustCenterSubscribers.
+func (m *TrustCentersAPI) AllTrustCenterSubscribers(ctx context.Context, params *vanta.TrustCentersListTrustCenterSubscribersParams) iter.Seq2[vanta.TrustCenterSubscriber, error] {
+	return allItems(ctx, params, func(p *vanta.TrustCentersListTrustCenterSubscribersParams) **string { return &p.PageCursor }, m.ListTrustCenterSubscribers)
+}
+
+// ListTrustCenterUpdates calls ListTrustCenterUpdatesFunc.
+func (m *TrustCentersAPI) ListTrustCenterUpdates(ctx context.Context, params *vanta.TrustCentersListTrustCenterUpdatesParams) (*vanta.ResultsPage[vanta.TrustCenterUpdate], error) {
+	m.record("ListTrustCenterUpdates", params)
+	if m.ListTrustCenterUpdatesFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "ListTrustCenterUpdates")
+	}
+	return m.ListTrustCenterUpdatesFunc(ctx, params)
+}
+
+// AllTrustCenterUpdates pages through ListTrustCenterUpdates.
+func (m *TrustCentersAPI) AllTrustCenterUpdates(ctx context.Context, params *vanta.TrustCentersListTrustCenterUpdatesParams) iter.Seq2[vanta.TrustCenterUpdate, error] {
+	return allItems(ctx, params, func(p *vanta.TrustCentersListTrustCenterUpdatesParams) **string { return &p.PageCursor }, m.ListTrustCenterUpdates)
+}
+
+// ListTrustCenterViewerActivityEvents calls ListTrustCenterViewerActivityEventsFunc.
+func (m *TrustCentersAPI) ListTrustCenterViewerActivityEvents(ctx context.Context, params *vanta.TrustCentersListTrustCenterViewerActivityEventsParams) (*vanta.ResultsPage[vanta.TrustCenterViewerActivityEvent], error) {
+	m.record("ListTrustCenterViewerActivityEvents", params)
+	if m.ListTrustCenterViewerActivityEventsFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "ListTrustCenterViewerActivityEvents")
+	}
+	return m.ListTrustCenterViewerActivityEventsFunc(ctx, params)
+}
+
+// AllTrustCenterViewerActivityEvents pages through ListTrustCenterViewerActivityEvents.
+func (m *TrustCentersAPI) AllTrustCenterViewerActivityEvents(ctx context.Context, params *vanta.TrustCentersListTrustCenterViewerActivityEventsParams) iter.Seq2[vanta.TrustCenterViewerActivityEvent, error] {
+	return allItems(ctx, params, func(p *vanta.TrustCentersListTrustCenterViewerActivityEventsParams) **string { return &p.PageCursor }, m.ListTrustCenterViewerActivityEvents)
+}
+
+// ListTrustCenterViewers calls ListTrustCenterViewersFunc.
+func (m *TrustCentersAPI) ListTrustCenterViewers(ctx context.Context, params *vanta.TrustCentersListTrustCenterViewersParams) (*vanta.ResultsPage[vanta.TrustCenterViewer], error) {
+	m.record("ListTrustCenterViewers", params)
+	if m.ListTrustCenterViewersFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "ListTrustCenterViewers")
+	}
+	return m.ListTrustCenterViewersFunc(ctx, params)
+}
+
+// AllTrustCenterViewers pages through ListTrustCenterViewers.
+func (m *TrustCentersAPI) AllTrustCenterViewers(ctx context.Context, params *vanta.TrustCentersListTrustCenterViewersParams) iter.Seq2[vanta.TrustCenterViewer, error] {
+	return allItems(ctx, params, func(p *vanta.TrustCentersListTrustCenterViewersParams) **string { return &p.PageCursor }, m.ListTrustCenterViewers)
+}
+
+// RemoveTrustCenterViewer calls RemoveTrustCenterViewerFunc.
+func (m *TrustCentersAPI) RemoveTrustCenterViewer(ctx context.Context, params *vanta.TrustCentersRemoveTrustCenterViewerParams) (json.RawMessage, error) {
+	m.record("RemoveTrustCenterViewer", params)
+	if m.RemoveTrustCenterViewerFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "RemoveTrustCenterViewer")
+	}
+	return m.RemoveTrustCenterViewerFunc(ctx, params)
+}
+
+// SendTrustCenterUpdateNotificationsToAllSubscribers calls SendTrustCenterUpdateNotificationsToAllSubscribersFunc.
+func (m *TrustCentersAPI) SendTrustCenterUpdateNotificationsToAllSubscribers(ctx context.Context, params *vanta.TrustCentersSendTrustCenterUpdateNotificationsToAllSubscribersParams) (json.RawMessage, error) {
+	m.record("SendTrustCenterUpdateNotificationsToAllSubscribers", params)
+	if m.SendTrustCenterUpdateNotificationsToAllSubscribersFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "SendTrustCenterUpdateNotificationsToAllSubscribers")
+	}
+	return m.SendTrustCenterUpdateNotificationsToAllSubscribersFunc(ctx, params)
+}
+
+// SendTrustCenterUpdateNotificationsToSpecificSubscribers calls SendTrustCenterUpdateNotificationsToSpecificSubscribersFunc.
+func (m *TrustCentersAPI) SendTrustCenterUpdateNotificationsToSpecificSubscribers(ctx context.Context, params *vanta.TrustCentersSendTrustCenterUpdateNotificationsToSpecificSubscribersParams) (json.RawMessage, error) {
+	m.record("SendTrustCenterUpdateNotificationsToSpecificSubscribers", params)
+	if m.SendTrustCenterUpdateNotificationsToSpecificSubscribersFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "SendTrustCenterUpdateNotificationsToSpecificSubscribers")
+	}
+	return m.SendTrustCenterUpdateNotificationsToSpecificSubscribersFunc(ctx, params)
+}
+
+// SetGroupsForTrustCenterSubscriber calls SetGroupsForTrustCenterSubscriberFunc.
+func (m *TrustCentersAPI) SetGroupsForTrustCenterSubscriber(ctx context.Context, params *vanta.TrustCentersSetGroupsForTrustCenterSubscriberParams) (*vanta.TrustCenterSubscriber, error) {
+	m.record("SetGroupsForTrustCenterSubscriber", params)
+	if m.SetGroupsForTrustCenterSubscriberFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "SetGroupsForTrustCenterSubscriber")
+	}
+	return m.SetGroupsForTrustCenterSubscriberFunc(ctx, params)
+}
+
+// UpdateTrustCenter calls UpdateTrustCenterFunc.
+func (m *TrustCentersAPI) UpdateTrustCenter(ctx context.Context, params *vanta.TrustCentersUpdateTrustCenterParams) (*vanta.TrustCentersUpdateTrustCenterResponse, error) {
+	m.record("UpdateTrustCenter", params)
+	if m.UpdateTrustCenterFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "UpdateTrustCenter")
+	}
+	return m.UpdateTrustCenterFunc(ctx, params)
+}
+
+// UpdateTrustCenterControlCategory calls UpdateTrustCenterControlCategoryFunc.
+func (m *TrustCentersAPI) UpdateTrustCenterControlCategory(ctx context.Context, params *vanta.TrustCentersUpdateTrustCenterControlCategoryParams) (*vanta.TrustCenterControlCategoryRef, error) {
+	m.record("UpdateTrustCenterControlCategory", params)
+	if m.UpdateTrustCenterControlCategoryFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "UpdateTrustCenterControlCategory")
+	}
+	return m.UpdateTrustCenterControlCategoryFunc(ctx, params)
+}
+
+// UpdateTrustCenterDocument calls UpdateTrustCenterDocumentFunc.
+func (m *TrustCentersAPI) UpdateTrustCenterDocument(ctx context.Context, params *vanta.TrustCentersUpdateTrustCenterDocumentParams) (*vanta.TrustCentersUpdateTrustCenterDocumentResponse, error) {
+	m.record("UpdateTrustCenterDocument", params)
+	if m.UpdateTrustCenterDocumentFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "UpdateTrustCenterDocument")
+	}
+	return m.UpdateTrustCenterDocumentFunc(ctx, params)
+}
+
+// UpdateTrustCenterFaq calls UpdateTrustCenterFaqFunc.
+func (m *TrustCentersAPI) UpdateTrustCenterFaq(ctx context.Context, params *vanta.TrustCentersUpdateTrustCenterFaqParams) (*vanta.TrustCentersUpdateTrustCenterFaqResponse, error) {
+	m.record("UpdateTrustCenterFaq", params)
+	if m.UpdateTrustCenterFaqFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "UpdateTrustCenterFaq")
+	}
+	return m.UpdateTrustCenterFaqFunc(ctx, params)
+}
+
+// UpdateTrustCenterSubprocessor calls UpdateTrustCenterSubprocessorFunc.
+func (m *TrustCentersAPI) UpdateTrustCenterSubprocessor(ctx context.Context, params *vanta.TrustCentersUpdateTrustCenterSubprocessorParams) (*vanta.TrustCentersUpdateTrustCenterSubprocessorResponse, error) {
+	m.record("UpdateTrustCenterSubprocessor", params)
+	if m.UpdateTrustCenterSubprocessorFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "UpdateTrustCenterSubprocessor")
+	}
+	return m.UpdateTrustCenterSubprocessorFunc(ctx, params)
+}
+
+// UpdateTrustCenterUpdate calls UpdateTrustCenterUpdateFunc.
+func (m *TrustCentersAPI) UpdateTrustCenterUpdate(ctx context.Context, params *vanta.TrustCentersUpdateTrustCenterUpdateParams) (*vanta.TrustCenterUpdate, error) {
+	m.record("UpdateTrustCenterUpdate", params)
+	if m.UpdateTrustCenterUpdateFunc == nil {
+		return nil, notConfigured("TrustCentersAPI", "UpdateTrustCenterUpdate")
+	}
+	return m.UpdateTrustCenterUpdateFunc(ctx, params)
+}
+
+// VendorRiskAttributesAPI is a mock vanta.VendorRiskAttributesAPI.
+type VendorRiskAttributesAPI struct {
+	Recorder
+
+	ListVendorRiskAttributesFunc func(ctx context.Context, params *vanta.VendorRiskAttributesListVendorRiskAttributesParams) (*vanta.ResultsPage[vanta.VendorRiskAttribute], error)
+}
+
+var _ vanta.VendorRiskAttributesAPI = (*VendorRiskAttributesAPI)(nil)
+
+// ListVendorRiskAttributes calls ListVendorRiskAttributesFunc.
+func (m *VendorRiskAttributesAPI) ListVendorRiskAttributes(ctx context.Context, params *vanta.VendorRiskAttributesListVendorRiskAttributesParams) (*vanta.ResultsPage[vanta.VendorRiskAttribute], error) {
+	m.record("ListVendorRiskAttributes", params)
+	if m.ListVendorRiskAttributesFunc == nil {
+		return nil, notConfigured("VendorRiskAttributesAPI", "ListVendorRiskAttributes")
+	}
+	return m.ListVendorRiskAttributesFunc(ctx, params)
+}
+
+// AllVendorRiskAttributes pages through ListVendorRiskAttributes.
+func (m *VendorRiskAttributesAPI) AllVendorRiskAttributes(ctx context.Context, params *vanta.VendorRiskAttributesListVendorRiskAttributesParams) iter.Seq2[vanta.VendorRiskAttribute, error] {
+	return allItems(ctx, params, func(p *vanta.VendorRiskAttributesListVendorRiskAttributesParams) **string { return &p.PageCursor }, m.ListVendorRiskAttributes)
+}
+
+// VendorsAPI is a mock vanta.VendorsAPI.
+type VendorsAPI struct {
+	Recorder
+
+	AddDocumentToSecurityReviewFunc      func(ctx context.Context, params *vanta.VendorsAddDocumentToSecurityReviewParams) (*vanta.VendorDocument, error)
+	AddDocumentToVendorFunc              func(ctx context.Context, params *vanta.VendorsAddDocumentToVendorParams) (*vanta.VendorDocument, error)
+	AddVendorFindingFunc                 func(ctx context.Context, params *vanta.VendorsAddVendorFindingParams) (*vanta.VendorFinding, error)
+	CreateVendorFunc                     func(ctx context.Context, params *vanta.VendorsCreateVendorParams) (*vanta.Vendor, error)
+	DeleteFindingByIDFunc                func(ctx context.Context, params *vanta.VendorsDeleteFindingByIDParams) (json.RawMessage, error)
+	DeleteSecurityReviewDocumentByIDFunc func(ctx context.Context, params *vanta.VendorsDeleteSecurityReviewDocumentByIDParams) (json.RawMessage, error)
+	DeleteVendorByIDFunc                 func(ctx context.Context, params *vanta.VendorsDeleteVendorByIDParams) (json.RawMessage, error)
+	GetSecurityReviewByIDFunc            func(ctx context.Context, params *vanta.VendorsGetSecurityReviewByIDParams) (*vanta.VendorSecurityReview, error)
+	GetVendorByIDFunc                    func(ctx context.Context, params *vanta.VendorsGetVendorByIDParams) (*vanta.Vendor, error)
+	ListSecurityReviewDocumentsFunc      func(ctx context.Context, params *vanta.VendorsListSecurityReviewDocumentsParams) (*vanta.ResultsPage[vanta.VendorDocument], error)
+	ListSecurityReviewsByVendorIDFunc    func(ctx context.Context, params *vanta.VendorsListSecurityReviewsByVendorIDParams) (*vanta.ResultsPage[vanta.VendorSecurityReview], error)
+	ListVendorDocumentsFunc              func(ctx context.Context, params *vanta.VendorsListVendorDocumentsParams) (*vanta.ResultsPage[vanta.VendorDocument], error)
+	ListVendorFindingsFunc               func(ctx context.Context, params *vanta.VendorsListVendorFindingsParams) (*vanta.ResultsPage[vanta.VendorFinding], error)
+	ListVendorsFunc                      func(ctx context.Context, params *vanta.VendorsListVendorsParams) (*vanta.ResultsPage[vanta.Vendor], error)
+	SetVendorStatusFunc                  func(ctx context.Context, params *vanta.VendorsSetVendorStatusParams) (*vanta.Vendor, error)
+	UpdateVendorByIDFunc                 func(ctx context.Context, params *vanta.VendorsUpdateVendorByIDParams) (*vanta.Vendor, error)
+	UpdateVendorFindingFunc              func(ctx context.Context, params *vanta.VendorsUpdateVendorFindingParams) (*vanta.VendorFinding, error)
+}
+
+var _ vanta.VendorsAPI = (*VendorsAPI)(nil)
+
+// AddDocumentToSecurityReview calls AddDocumentToSecurityReviewFunc.
+func (m *VendorsAPI) AddDocumentToSecurityReview(ctx context.Context, params *vanta.VendorsAddDocumentToSecurityReviewParams) (*vanta.VendorDocument, error) {
+	m.record("AddDocumentToSecurityReview", params)
+	if m.AddDocumentToSecurityReviewFunc == nil {
+		return nil, notConfigured("VendorsAPI", "AddDocumentToSecurityReview")
+	}
+	return m.AddDocumentToSecurityReviewFunc(ctx, params)
+}
+
+// AddDocumentToVendor calls AddDocumentToVendorFunc.
+func (m *VendorsAPI) AddDocumentToVendor(ctx context.Context, params *vanta.VendorsAddDocumentToVendorParams) (*vanta.VendorDocument, error) {
+	m.record("AddDocumentToVendor", params)
+	if m.AddDocumentToVendorFunc == nil {
+		return nil, notConfigured("VendorsAPI", "AddDocumentToVendor")
+	}
+	return m.AddDocumentToVendorFunc(ctx, params)
+}
+
+// AddVendorFinding calls AddVendorFindingFunc.
+func (m *VendorsAPI) AddVendorFinding(ctx context.Context, params *vanta.VendorsAddVendorFindingParams) (*vanta.VendorFinding, error) {
+	m.record("AddVendorFinding", params)
+	if m.AddVendorFindingFunc == nil {
+		return nil, notConfigured("VendorsAPI", "AddVendorFinding")
+	}
+	return m.AddVendorFindingFunc(ctx, params)
+}
+
+// CreateVendor calls CreateVendorFunc.
+func (m *VendorsAPI) CreateVendor(ctx context.Context, params *vanta.VendorsCreateVendorParams) (*vanta.Vendor, error) {
+	m.record("CreateVendor", params)
+	if m.CreateVendorFunc == nil {
+		return nil, notConfigured("VendorsAPI", "CreateVendor")
+	}
+	return m.CreateVendorFunc(ctx, params)
+}
+
+// DeleteFindingByID calls DeleteFindingByIDFunc.
+func (m *VendorsAPI) DeleteFindingByID(ctx context.Context, params *vanta.VendorsDeleteFindingByIDParams) (json.RawMessage, error) {
+	m.record("DeleteFindingByID", params)
+	if m.DeleteFindingByIDFunc == nil {
+		return nil, notConfigured("VendorsAPI", "DeleteFindingByID")
+	}
+	return m.DeleteFindingByIDFunc(ctx, params)
+}
+
+// DeleteSecurityReviewDocumentByID calls DeleteSecurityReviewDocumentByIDFunc.
+func (m *VendorsAPI) DeleteSecurityReviewDocumentByID(ctx context.Context, params *vanta.VendorsDeleteSecurityReviewDocumentByIDParams) (json.RawMessage, error) {
+	m.record("DeleteSecurityReviewDocumentByID", params)
+	if m.DeleteSecurityReviewDocumentByIDFunc == nil {
+		return nil, notConfigured("VendorsAPI", "DeleteSecurityReviewDocumentByID")
+	}
+	return m.DeleteSecurityReviewDocumentByIDFunc(ctx, params)
+}
+
+// DeleteVendorByID calls DeleteVendorByIDFunc.
+func (m *VendorsAPI) DeleteVendorByID(ctx context.Context, params *vanta.VendorsDeleteVendorByIDParams) (json.RawMessage, error) {
+	m.record("DeleteVendorByID", params)
+	if m.DeleteVendorByIDFunc == nil {
+		return nil, notConfigured("VendorsAPI", "DeleteVendorByID")
+	}
+	return m.DeleteVendorByIDFunc(ctx, params)
+}
+
+// GetSecurityReviewByID calls GetSecurityReviewByIDFunc.
+func (m *VendorsAPI) GetSecurityReviewByID(ctx context.Context, params *vanta.VendorsGetSecurityReviewByIDParams) (*vanta.VendorSecurityReview, error) {
+	m.record("GetSecurityReviewByID", params)
+	if m.GetSecurityReviewByIDFunc == nil {
+		return nil, notConfigured("VendorsAPI", "GetSecurityReviewByID")
+	}
+	return m.GetSecurityReviewByIDFunc(ctx, params)
+}
+
+// GetVendorByID calls GetVendorByIDFunc.
+func (m *VendorsAPI) GetVendorByID(ctx context.Context, params *vanta.VendorsGetVendorByIDParams) (*vanta.Vendor, error) {
+	m.record("GetVendorByID", params)
+	if m.GetVendorByIDFunc == nil {
+		return nil, notConfigured("VendorsAPI", "GetVendorByID")
+	}
+	return m.GetVendorByIDFunc(ctx, params)
+}
+
+// ListSecurityReviewDocuments calls ListSecurityReviewDocumentsFunc.
+func (m *VendorsAPI) ListSecurityReviewDocuments(ctx context.Context, params *vanta.VendorsListSecurityReviewDocumentsParams) (*vanta.ResultsPage[vanta.VendorDocument], error) {
+	m.record("ListSecurityReviewDocuments", params)
+	if m.ListSecurityReviewDocumentsFunc == nil {
+		return nil, notConfigured("VendorsAPI", "ListSecurityReviewDocuments")
+	}
+	return m.ListSecurityReviewDocumentsFunc(ctx, params)
+}
+
+// AllSecurityReviewDocuments pages through ListSecurityReviewDocuments.
+func (m *VendorsAPI) AllSecurityReviewDocuments(ctx context.Context, params *vanta.VendorsListSecurityReviewDocumentsParams) iter.Seq2[vanta.VendorDocument, error] {
+	return allItems(ctx, params, func(p *vanta.VendorsListSecurityReviewDocumentsParams) **string { return &p.PageCursor }, m.ListSecurityReviewDocuments)
+}
+
+// ListSecurityReviewsByVendorID calls ListSecurityReviewsByVendorIDFunc.
+func (m *VendorsAPI) ListSecurityReviewsByVendorID(ctx context.Context, params *vanta.VendorsListSecurityReviewsByVendorIDParams) (*vanta.ResultsPage[vanta.VendorSecurityReview], error) {
+	m.record("ListSecurityReviewsByVendorID", params)
+	if m.ListSecurityReviewsByVendorIDFunc == nil {
+		return nil, notConfigured("VendorsAPI", "ListSecurityReviewsByVendorID")
+	}
+	return m.ListSecurityReviewsByVendorIDFunc(ctx, params)
+}
+
+// AllSecurityReviewsByVendorID pages through ListSecurityReviewsByVendorID.
+func (m *VendorsAPI) AllSecurityReviewsByVendorID(ctx context.Context, params *vanta.VendorsListSecurityReviewsByVendorIDParams) iter.Seq2[vanta.VendorSecurityReview, error] {
+	return allItems(ctx, params, func(p *vanta.VendorsListSecurityReviewsByVendorIDParams) **string { return &p.PageCursor }, m.ListSecurityReviewsByVendorID)
+}
+
+// ListVendorDocuments calls ListVendorDocumentsFunc.
+func (m *VendorsAPI) ListVendorDocuments(ctx context.Context, params *vanta.VendorsListVendorDocumentsParams) (*vanta.ResultsPage[vanta.VendorDocument], error) {
+	m.record("ListVendorDocuments", params)
+	if m.ListVendorDocumentsFunc == nil {
+		return nil, notConfigured("VendorsAPI", "ListVendorDocuments")
+	}
+	return m.ListVendorDocumentsFunc(ctx, params)
+}
+
+// AllVendorDocuments pages through ListVendorDocuments.
+func (m *VendorsAPI) AllVendorDocuments(ctx context.Context, params *vanta.VendorsListVendorDocumentsParams) iter.Seq2[vanta.VendorDocument, error] {
+	return allItems(ctx, params, func(p *vanta.VendorsListVendorDocumentsParams) **string { return &p.PageCursor }, m.ListVendorDocuments)
+}
+
+// ListVendorFindings calls ListVendorFindingsFunc.
+func (m *VendorsAPI) ListVendorFindings(ctx context.Context, params *vanta.VendorsListVendorFindingsParams) (*vanta.ResultsPage[vanta.VendorFinding], error) {
+	m.record("ListVendorFindings", params)
+	if m.ListVendorFindingsFunc == nil {
+		return nil, notConfigured("VendorsAPI", "ListVendorFindings")
+	}
+	return m.ListVendorFindingsFunc(ctx, params)
+}
+
+// AllVendorFindings pages through ListVendorFindings.
+func (m *VendorsAPI) AllVendorFindings(ctx context.Context, params *vanta.VendorsListVendorFindingsParams) iter.Seq2[vanta.VendorFinding, error] {
+	return allItems(ctx, params, func(p *vanta.VendorsListVendorFindingsParams) **string { return &p.PageCursor }, m.ListVendorFindings)
+}
+
+// ListVendors calls ListVendorsFunc.
+func (m *VendorsAPI) ListVendors(ctx context.Context, params *vanta.VendorsListVendorsParams) (*vanta.ResultsPage[vanta.Vendor], error) {
+	m.record("ListVendors", params)
+	if m.ListVendorsFunc == nil {
+		return nil, notConfigured("VendorsAPI", "ListVendors")
+	}
+	return m.ListVendorsFunc(ctx, params)
+}
+
+// AllVendors pages through ListVendors.
+func (m *VendorsAPI) AllVendors(ctx context.Context, params *vanta.VendorsListVendorsParams) iter.Seq2[vanta.Vendor, error] {
+	return allItems(ctx, params, func(p *vanta.VendorsListVendorsParams) **string { return &p.PageCursor }, m.ListVendors)
+}
+
+// SetVendorStatus calls SetVendorStatusFunc.
+func (m *VendorsAPI) SetVendorStatus(ctx context.Context, params *vanta.VendorsSetVendorStatusParams) (*vanta.Vendor, error) {
+	m.record("SetVendorStatus", params)
+	if m.SetVendorStatusFunc == nil {
+		return nil, notConfigured("VendorsAPI", "SetVendorStatus")
+	}
+	return m.SetVendorStatusFunc(ctx, params)
+}
+
+// UpdateVendorByID calls UpdateVendorByIDFunc.
+func (m *VendorsAPI) UpdateVendorByID(ctx context.Context, params *vanta.VendorsUpdateVendorByIDParams) (*vanta.Vendor, error) {
+	m.record("UpdateVendorByID", params)
+	if m.UpdateVendorByIDFunc == nil {
+		return nil, notConfigured("VendorsAPI", "UpdateVendorByID")
+	}
+	return m.UpdateVendorByIDFunc(ctx, params)
+}
+
+// UpdateVendorFinding calls UpdateVendorFindingFunc.
+func (m *VendorsAPI) UpdateVendorFinding(ctx context.Context, params *vanta.VendorsUpdateVendorFindingParams) (*vanta.VendorFinding, error) {
+	m.record("UpdateVendorFinding", params)
+	if m.UpdateVendorFindingFunc == nil {
+		return nil, notConfigured("VendorsAPI", "UpdateVendorFinding")
+	}
+	return m.UpdateVendorFindingFunc(ctx, params)
+}
+
+// VulnerabilitiesAPI is a mock vanta.VulnerabilitiesAPI.
+type VulnerabilitiesAPI struct {
+	Recorder
+
+	DeactivateVulnerabilityMonitoringForVulnerabilityFunc func(ctx context.Context, params *vanta.VulnerabilitiesDeactivateVulnerabilityMonitoringForVulnerabilityParams) (*vanta.VulnerabilitiesDeactivateVulnerabilityMonitoringForVulnerabilityResponse, error)
+	GetVulnerabilitiesFunc                                func(ctx context.Context, params *vanta.VulnerabilitiesGetVulnerabilitiesParams) (*vanta.ResultsPage[vanta.Vulnerability], error)
+	GetVulnerabilityByIDFunc                              func(ctx context.Context, params *vanta.VulnerabilitiesGetVulnerabilityByIDParams) (*vanta.Vulnerability, error)
+	ReactivateVulnerabilityMonitoringFunc                 func(ctx context.Context, params *vanta.VulnerabilitiesReactivateVulnerabilityMonitoringParams) (*vanta.VulnerabilitiesReactivateVulnerabilityMonitoringResponse, error)
+}
+
+var _ vanta.VulnerabilitiesAPI = (*VulnerabilitiesAPI)(nil)
+
+// DeactivateVulnerabilityMonitoringForVulnerability calls DeactivateVulnerabilityMonitoringForVulnerabilityFunc.
+func (m *VulnerabilitiesAPI) DeactivateVulnerabilityMonitoringForVulnerability(ctx context.Context, params *vanta.VulnerabilitiesDeactivateVulnerabilityMonitoringForVulnerabilityParams) (*vanta.VulnerabilitiesDeactivateVulnerabilityMonitoringForVulnerabilityResponse, error) {
+	m.record("DeactivateVulnerabilityMonitoringForVulnerability", params)
+	if m.DeactivateVulnerabilityMonitoringForVulnerabilityFunc == nil {
+		return nil, notConfigured("VulnerabilitiesAPI", "DeactivateVulnerabilityMonitoringForVulnerability")
+	}
+	return m.DeactivateVulnerabilityMonitoringForVulnerabilityFunc(ctx, params)
+}
+
+// GetVulnerabilities calls GetVulnerabilitiesFunc.
+func (m *VulnerabilitiesAPI) GetVulnerabilities(ctx context.Context, params *vanta.VulnerabilitiesGetVulnerabilitiesParams) (*vanta.ResultsPage[vanta.Vulnerability], error) {
+	m.record("GetVulnerabilities", params)
+	if m.GetVulnerabilitiesFunc == nil {
+		return nil, notConfigured("VulnerabilitiesAPI", "GetVulnerabilities")
+	}
+	return m.GetVulnerabilitiesFunc(ctx, params)
+}
+
+// AllVulnerabilities pages through GetVulnerabilities.
+func (m *VulnerabilitiesAPI) AllVulnerabilities(ctx context.Context, params *vanta.VulnerabilitiesGetVulnerabilitiesParams) iter.Seq2[vanta.Vulnerability, error] {
+	return allItems(ctx, params, func(p *vanta.VulnerabilitiesGetVulnerabilitiesParams) **string { return &p.PageCursor }, m.GetVulnerabilities)
+}
+
+// GetVulnerabilityByID calls GetVulnerabilityByIDFunc.
+func (m *VulnerabilitiesAPI) GetVulnerabilityByID(ctx context.Context, params *vanta.VulnerabilitiesGetVulnerabilityByIDParams) (*vanta.Vulnerability, error) {
+	m.record("GetVulnerabilityByID", params)
+	if m.GetVulnerabilityByIDFunc == nil {
+		return nil, notConfigured("VulnerabilitiesAPI", "GetVulnerabilityByID")
+	}
+	return m.GetVulnerabilityByIDFunc(ctx, params)
+}
+
+// ReactivateVulnerabilityMonitoring calls ReactivateVulnerabilityMonitoringFunc.
+func (m *VulnerabilitiesAPI) ReactivateVulnerabilityMonitoring(ctx context.Context, params *vanta.VulnerabilitiesReactivateVulnerabilityMonitoringParams) (*vanta.VulnerabilitiesReactivateVulnerabilityMonitoringResponse, error) {
+	m.record("ReactivateVulnerabilityMonitoring", params)
+	if m.ReactivateVulnerabilityMonitoringFunc == nil {
+		return nil, notConfigured("VulnerabilitiesAPI", "ReactivateVulnerabilityMonitoring")
+	}
+	return m.ReactivateVulnerabilityMonitoringFunc(ctx, params)
+}
+
+// VulnerabilityRemediationsAPI is a mock vanta.VulnerabilityRemediationsAPI.
+type VulnerabilityRemediationsAPI struct {
+	Recorder
+
+	AcknowledgeSlaMissFunc            func(ctx context.Context, params *vanta.VulnerabilityRemediationsAcknowledgeSlaMissParams) (*vanta.VulnerabilityRemediationsAcknowledgeSlaMissResponse, error)
+	ListVulnerabilityRemediationsFunc func(ctx context.Context, params *vanta.VulnerabilityRemediationsListVulnerabilityRemediationsParams) (*vanta.ResultsPage[vanta.VulnerabilityRemediation], error)
+}
+
+var _ vanta.VulnerabilityRemediationsAPI = (*VulnerabilityRemediationsAPI)(nil)
+
+// AcknowledgeSlaMiss calls AcknowledgeSlaMissFunc.
+func (m *VulnerabilityRemediationsAPI) AcknowledgeSlaMiss(ctx context.Context, params *vanta.VulnerabilityRemediationsAcknowledgeSlaMissParams) (*vanta.VulnerabilityRemediationsAcknowledgeSlaMissResponse, error) {
+	m.record("AcknowledgeSlaMiss", params)
+	if m.AcknowledgeSlaMissFunc == nil {
+		return nil, notConfigured("VulnerabilityRemediationsAPI", "AcknowledgeSlaMiss")
+	}
+	return m.AcknowledgeSlaMissFunc(ctx, params)
+}
+
+// ListVulnerabilityRemediations calls ListVulnerabilityRemediationsFunc.
+func (m *VulnerabilityRemediationsAPI) ListVulnerabilityRemediations(ctx context.Context, params *vanta.VulnerabilityRemediationsListVulnerabilityRemediationsParams) (*vanta.ResultsPage[vanta.VulnerabilityRemediation], error) {
+	m.record("ListVulnerabilityRemediations", params)
+	if m.ListVulnerabilityRemediationsFunc == nil {
+		return nil, notConfigured("VulnerabilityRemediationsAPI", "ListVulnerabilityRemediations")
+	}
+	return m.ListVulnerabilityRemediationsFunc(ctx, params)
+}
+
+// AllVulnerabilityRemediations pages through ListVulnerabilityRemediations.
+func (m *VulnerabilityRemediationsAPI) AllVulnerabilityRemediations(ctx context.Context, params *vanta.VulnerabilityRemediationsListVulnerabilityRemediationsParams) iter.Seq2[vanta.VulnerabilityRemediation, error] {
+	return allItems(ctx, params, func(p *vanta.VulnerabilityRemediationsListVulnerabilityRemediationsParams) **string {
+		return &p.PageCursor
+	}, m.ListVulnerabilityRemediations)
+}
+
+// VulnerableAssetsAPI is a mock vanta.VulnerableAssetsAPI.
+type VulnerableAssetsAPI struct {
+	Recorder
+
+	GetVulnerableAssetByIDFunc                  func(ctx context.Context, params *vanta.VulnerableAssetsGetVulnerableAssetByIDParams) (*vanta.VulnerableAsset, error)
+	ListAssetsAssociatedWithVulnerabilitiesFunc func(ctx context.Context, params *vanta.VulnerableAssetsListAssetsAssociatedWithVulnerabilitiesParams) (*vanta.ResultsPage[vanta.VulnerableAsset], error)
+}
+
+var _ vanta.VulnerableAssetsAPI = (*VulnerableAssetsAPI)(nil)
+
+// GetVulnerableAssetByID calls GetVulnerableAssetByIDFunc.
+func (m *VulnerableAssetsAPI) GetVulnerableAssetByID(ctx context.Context, params *vanta.VulnerableAssetsGetVulnerableAssetByIDParams) (*vanta.VulnerableAsset, error) {
+	m.record("GetVulnerableAssetByID", params)
+	if m.GetVulnerableAssetByIDFunc == nil {
+		return nil, notConfigured("VulnerableAssetsAPI", "GetVulnerableAssetByID")
+	}
+	return m.GetVulnerableAssetByIDFunc(ctx, params)
+}
+
+// ListAssetsAssociatedWithVulnerabilities calls ListAssetsAssociatedWithVulnerabilitiesFunc.
+func (m *VulnerableAssetsAPI) ListAssetsAssociatedWithVulnerabilities(ctx context.Context, params *vanta.VulnerableAssetsListAssetsAssociatedWithVulnerabilitiesParams) (*vanta.ResultsPage[vanta.VulnerableAsset], error) {
+	m.record("ListAssetsAssociatedWithVulnerabilities", params)
+	if m.ListAssetsAssociatedWithVulnerabilitiesFunc == nil {
+		return nil, notConfigured("VulnerableAssetsAPI", "ListAssetsAssociatedWithVulnerabilities")
+	}
+	return m.ListAssetsAssociatedWithVulnerabilitiesFunc(ctx, params)
+}
+
+// AllAssetsAssociatedWithVulnerabilities pages through ListAssetsAssociatedWithVulnerabilities.
+func (m *VulnerableAssetsAPI) AllAssetsAssociatedWithVulnerabilities(ctx context.Context, params *vanta.VulnerableAssetsListAssetsAssociatedWithVulnerabilitiesParams) iter.Seq2[vanta.VulnerableAsset, error] {
+	return allItems(ctx, params, func(p *vanta.VulnerableAssetsListAssetsAssociatedWithVulnerabilitiesParams) **string {
+		return &p.PageCursor
+	}, m.ListAssetsAssociatedWithVulnerabilities)
+}
@@ -0,0 +1,114 @@
+// Package vantamock provides mock implementations of the Vanta service
+// interfaces for unit tests.
+//
+// Each mock has a <Method>Func field per method. Set it to control the
+// response; Return builds one that hands back a canned value. Methods without
+// a Func return an error wrapping ErrNotConfigured. Every call is recorded:
+//
+//	mocks := vantamock.NewServices()
+//	mocks.Controls.ListControlsFunc = vantamock.Return[vanta.ControlsListControlsParams](page, nil)
+//	services := mocks.API() // *vanta.Services backed by the mocks
+//	// ... exercise code that takes *vanta.Services ...
+//	calls := mocks.Controls.CallsTo("ListControls")
+//
+// All* iterators page through the mock's list method, so they see the same
+// canned responses and record one call per page.
+package vantamock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"sync"
+
+	vanta "github.com/richardoc/vanta-sdk-go/v1"
+)
+
+// ErrNotConfigured is returned by mock methods whose Func field is not set.
+var ErrNotConfigured = errors.New("vantamock: method not configured")
+
+// Call is one recorded mock call. Params is the params pointer the method
+// received, which may be nil.
+type Call struct {
+	Method string
+	Params any
+}
+
+// Recorder records mock calls. It is safe for concurrent use.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns every recorded call in order.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.calls)
+}
+
+// CallsTo returns the recorded calls to method.
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []Call
+	for _, c := range r.calls {
+		if c.Method == method {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// Reset forgets every recorded call.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
+
+func (r *Recorder) record(method string, params any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Params: params})
+}
+
+// Return returns a method func that always responds with out and err. P is
+// the method's params type:
+//
+//	m.GetControlByIDFunc = vantamock.Return[vanta.ControlsGetControlByIDParams](control, nil)
+func Return[P any, R any](out R, err error) func(context.Context, *P) (R, error) {
+	return func(context.Context, *P) (R, error) {
+		return out, err
+	}
+}
+
+func notConfigured(mock, method string) error {
+	return fmt.Errorf("%w: %s.%s", ErrNotConfigured, mock, method)
+}
+
+// allItems backs the generated All* methods, following page cursors through
+// list. cursor returns the address of the params PageCursor field.
+func allItems[T any, P any](ctx context.Context, params *P, cursor func(*P) **string, list func(context.Context, *P) (*vanta.ResultsPage[T], error)) iter.Seq2[T, error] {
+	var base P
+	if params != nil {
+		base = *params
+	}
+	start := ""
+	if c := *cursor(&base); c != nil {
+		start = *c
+	}
+	return func(yield func(T, error) bool) {
+		pager := vanta.NewPager(start, func(ctx context.Context, next string) (*vanta.ResultsPage[T], error) {
+			p := base
+			*cursor(&p) = nil
+			if next != "" {
+				*cursor(&p) = &next
+			}
+			return list(ctx, &p)
+		})
+		pager.All(ctx)(yield)
+	}
+}
@@ -0,0 +1,96 @@
+package vantamock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	vanta "github.com/richardoc/vanta-sdk-go/v1"
+)
+
+func TestMockReturnsCannedResponseAndRecordsCalls(t *testing.T) {
+	mocks := NewServices()
+	control := &vanta.Control{ID: "c1"}
+	mocks.Controls.GetControlByIDFunc = Return[vanta.ControlsGetControlByIDParams](control, nil)
+
+	services := mocks.API()
+	params := &vanta.ControlsGetControlByIDParams{ControlID: "c1"}
+	got, err := services.Controls.GetControlByID(context.Background(), params)
+	if err != nil || got != control {
+		t.Fatalf("GetControlByID = %v, %v; want the canned control", got, err)
+	}
+
+	calls := mocks.Controls.CallsTo("GetControlByID")
+	if len(calls) != 1 || calls[0].Params != params {
+		t.Fatalf("calls = %+v, want one call with params", calls)
+	}
+	mocks.Controls.Reset()
+	if len(mocks.Controls.Calls()) != 0 {
+		t.Fatal("Reset kept calls")
+	}
+}
+
+func TestMockWithoutFuncReturnsErrNotConfigured(t *testing.T) {
+	mocks := NewServices()
+	_, err := mocks.Vendors.ListVendors(context.Background(), nil)
+	if !errors.Is(err, ErrNotConfigured) || err.Error() != "vantamock: method not configured: VendorsAPI.ListVendors" {
+		t.Fatalf("err = %v, want ErrNotConfigured", err)
+	}
+	if len(mocks.Vendors.Calls()) != 1 {
+		t.Fatal("unconfigured call was not recorded")
+	}
+}
+
+func TestMockIteratorPagesThroughListFunc(t *testing.T) {
+	mocks := NewServices()
+	mocks.Controls.ListControlsFunc = func(_ context.Context, params *vanta.ControlsListControlsParams) (*vanta.ResultsPage[vanta.Control], error) {
+		page := &vanta.ResultsPage[vanta.Control]{}
+		if params.PageCursor == nil {
+			page.Results.Data = []vanta.Control{{ID: "c1"}}
+			page.Results.PageInfo = vanta.PageInfo{HasNextPage: true, EndCursor: "next"}
+		} else {
+			page.Results.Data = []vanta.Control{{ID: "c2"}}
+		}
+		return page, nil
+	}
+
+	var ids []vanta.ControlID
+	for control, err := range mocks.API().Controls.AllControls(context.Background(), &vanta.ControlsListControlsParams{PageSize: vanta.Ptr(1)}) {
+		if err != nil {
+			t.Fatalf("AllControls yielded error: %v", err)
+		}
+		ids = append(ids, control.ID)
+	}
+	if len(ids) != 2 || ids[0] != "c1" || ids[1] != "c2" {
+		t.Fatalf("ids = %v, want [c1 c2]", ids)
+	}
+	calls := mocks.Controls.CallsTo("ListControls")
+	if len(calls) != 2 {
+		t.Fatalf("ListControls calls = %d, want 2", len(calls))
+	}
+	second := calls[1].Params.(*vanta.ControlsListControlsParams)
+	if second.PageCursor == nil || *second.PageCursor != "next" || *second.PageSize != 1 {
+		t.Fatalf("second page params = %+v", second)
+	}
+	if first := calls[0].Params.(*vanta.ControlsListControlsParams); first.PageCursor != nil {
+		t.Fatalf("first page cursor = %v, want nil", *first.PageCursor)
+	}
+}
+
+func TestInvokeUsesServiceInterfaces(t *testing.T) {
+	mocks := NewServices()
+	mocks.Groups.ListGroupsFunc = Return[vanta.GroupsListGroupsParams](&vanta.ResultsPage[vanta.Group]{}, nil)
+	c, err := vanta.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	c.Services = mocks.API()
+
+	op, _ := vanta.LookupOperation("Groups.ListGroups")
+	if _, err := c.Invoke(context.Background(), op, nil); err != nil {
+		t.Fatalf("Invoke returned error: %v", err)
+	}
+	if len(mocks.Groups.CallsTo("ListGroups")) != 1 {
+		t.Fatal("Invoke did not reach the mock")
+	}
+}
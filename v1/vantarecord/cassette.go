@@ -0,0 +1,233 @@
+package vantarecord
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+const cassetteVersion = 1
+
+// Redacted replaces secret header values and JSON fields in cassettes.
+const Redacted = "REDACTED"
+
+// Cassette is the file format: the interactions of one session, in the order
+// they happened.
+type Cassette struct {
+	Version      int           `json:"version"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is one recorded request and the response it got.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the part of a request replay matches on, plus its
+// redacted headers for reference.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	// Query is the encoded query with keys sorted.
+	Query  string      `json:"query,omitempty"`
+	Header http.Header `json:"header,omitempty"`
+	Body
+}
+
+// RecordedResponse is a recorded response.
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body
+}
+
+// Body holds a normalized body. JSON bodies are kept as JSON, with object
+// keys sorted and secret fields redacted, so cassettes diff cleanly.
+// Multipart bodies are kept as their parts, sorted, because the SDK picks a
+// new boundary for every request. Other bodies are kept as text.
+type Body struct {
+	JSON  json.RawMessage `json:"json,omitempty"`
+	Parts []Part          `json:"parts,omitempty"`
+	Text  string          `json:"text,omitempty"`
+}
+
+// Part is one part of a multipart body.
+type Part struct {
+	Name     string `json:"name"`
+	Filename string `json:"filename,omitempty"`
+	Value    string `json:"value"`
+}
+
+func (b Body) bytes() []byte {
+	if len(b.JSON) > 0 {
+		return b.JSON
+	}
+	if len(b.Parts) > 0 {
+		// Parts are sorted, so their encoding is canonical.
+		data, _ := json.Marshal(b.Parts)
+		return data
+	}
+	return []byte(b.Text)
+}
+
+func sortParts(parts []Part) {
+	slices.SortFunc(parts, func(a, b Part) int {
+		return cmp.Or(strings.Compare(a.Name, b.Name), strings.Compare(a.Filename, b.Filename), strings.Compare(a.Value, b.Value))
+	})
+}
+
+// LoadCassette reads a cassette file.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("vantarecord: read cassette: %w", err)
+	}
+	var c Cassette
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("vantarecord: decode cassette %s: %w", path, err)
+	}
+	if c.Version != cassetteVersion {
+		return nil, fmt.Errorf("vantarecord: cassette %s has version %d, want %d", path, c.Version, cassetteVersion)
+	}
+	// Save indents JSON bodies; compact them again so they compare equal to
+	// normalized request bodies.
+	for i := range c.Interactions {
+		in := &c.Interactions[i]
+		for _, b := range []*Body{&in.Request.Body, &in.Response.Body} {
+			if len(b.JSON) == 0 {
+				continue
+			}
+			var buf bytes.Buffer
+			if err := json.Compact(&buf, b.JSON); err != nil {
+				return nil, fmt.Errorf("vantarecord: cassette %s interaction %d: %w", path, i, err)
+			}
+			b.JSON = buf.Bytes()
+		}
+	}
+	return &c, nil
+}
+
+// Save writes the cassette to path, creating its directory.
+func (c *Cassette) Save(path string) error {
+	c.Version = cassetteVersion
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("vantarecord: encode cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("vantarecord: create cassette dir: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("vantarecord: write cassette: %w", err)
+	}
+	return nil
+}
+
+// redactor normalizes bodies and strips secrets.
+type redactor struct {
+	headers []string
+	fields  []string
+}
+
+func (r *redactor) header(h http.Header) http.Header {
+	if len(h) == 0 {
+		return nil
+	}
+	out := h.Clone()
+	for name := range out {
+		if slices.ContainsFunc(r.headers, func(s string) bool { return strings.EqualFold(s, name) }) {
+			out[name] = []string{Redacted}
+		}
+	}
+	return out
+}
+
+// body normalizes data, sent with the given Content-Type. JSON is re-encoded
+// with sorted keys and secret fields redacted, multipart bodies are split
+// into sorted parts with secret fields redacted, and anything else is kept as
+// text.
+func (r *redactor) body(data []byte, contentType string) Body {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return Body{}
+	}
+	if parts, ok := r.parts(data, contentType); ok {
+		return Body{Parts: parts}
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err == nil && !dec.More() {
+		if encoded, err := json.Marshal(r.value(v)); err == nil {
+			return Body{JSON: encoded}
+		}
+	}
+	return Body{Text: string(data)}
+}
+
+// parts parses a multipart body.
+func (r *redactor) parts(data []byte, contentType string) ([]Part, bool) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || !strings.HasPrefix(mediaType, "multipart/") || params["boundary"] == "" {
+		return nil, false
+	}
+	reader := multipart.NewReader(bytes.NewReader(data), params["boundary"])
+	var parts []Part
+	for {
+		p, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, false
+		}
+		value, err := io.ReadAll(p)
+		if err != nil {
+			return nil, false
+		}
+		part := Part{Name: p.FormName(), Filename: p.FileName(), Value: string(value)}
+		if slices.Contains(r.fields, part.Name) {
+			part.Value = Redacted
+		}
+		parts = append(parts, part)
+	}
+	sortParts(parts)
+	return parts, true
+}
+
+func (r *redactor) value(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, sub := range v {
+			if slices.Contains(r.fields, k) {
+				v[k] = Redacted
+				continue
+			}
+			v[k] = r.value(sub)
+		}
+	case []any:
+		for i, sub := range v {
+			v[i] = r.value(sub)
+		}
+	}
+	return v
+}
+
+// canonicalQuery encodes a raw query with its keys sorted.
+func canonicalQuery(raw string) string {
+	values, err := url.ParseQuery(raw)
+	if err != nil {
+		return raw
+	}
+	return values.Encode()
+}
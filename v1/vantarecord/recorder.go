@@ -0,0 +1,282 @@
+// Package vantarecord records Vanta API sessions to cassette files and replays
+// them, so tests can run deterministically against responses captured once
+// from a real tenant.
+//
+// A Recorder is an http.RoundTripper. In ModeRecord it sends requests to the
+// real transport and keeps each request/response pair; Save writes them to the
+// cassette with secrets redacted and JSON bodies normalized. In ModeReplay it
+// answers from the cassette, matching on method, path, query and body (the
+// parts of a multipart upload, whatever its boundary), and fails any request
+// it has no recording for:
+//
+//	rec, err := vantarecord.New("testdata/controls.json", vantarecord.ModeReplay)
+//	client, err := vanta.NewClient(
+//		vanta.WithHTTPClient(rec.Client()),
+//		vanta.WithTokenSource(vanta.StaticTokenSource("replay")),
+//	)
+//
+// Recorded interactions are replayed in order and each is used once, so a
+// session that lists the same page twice needs two recordings.
+package vantarecord
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// ErrNoRecording is returned in ModeReplay for a request the cassette has no
+// unused interaction for.
+var ErrNoRecording = errors.New("vantarecord: no recording matches request")
+
+// Mode selects whether a Recorder records or replays.
+type Mode int
+
+const (
+	// ModeReplay serves responses from the cassette and never touches the
+	// network.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests to the real transport and records them.
+	ModeRecord
+)
+
+func (m Mode) String() string {
+	switch m {
+	case ModeReplay:
+		return "replay"
+	case ModeRecord:
+		return "record"
+	}
+	return fmt.Sprintf("Mode(%d)", int(m))
+}
+
+// DefaultRedactedHeaders are the headers whose values are replaced with
+// Redacted in cassettes.
+var DefaultRedactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "Proxy-Authorization"}
+
+// DefaultRedactedFields are the JSON fields, at any depth of a request or
+// response body, whose values are replaced with Redacted in cassettes.
+var DefaultRedactedFields = []string{"access_token", "refresh_token", "client_id", "client_secret", "token"}
+
+// Option configures a Recorder.
+type Option func(*Recorder)
+
+// WithTransport sets the transport ModeRecord sends requests to. The default
+// is http.DefaultTransport.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(r *Recorder) {
+		r.transport = rt
+	}
+}
+
+// WithRedactedHeaders adds headers to redact, on top of
+// DefaultRedactedHeaders.
+func WithRedactedHeaders(names ...string) Option {
+	return func(r *Recorder) {
+		r.redact.headers = append(r.redact.headers, names...)
+	}
+}
+
+// WithRedactedFields adds JSON fields to redact, on top of
+// DefaultRedactedFields.
+func WithRedactedFields(names ...string) Option {
+	return func(r *Recorder) {
+		r.redact.fields = append(r.redact.fields, names...)
+	}
+}
+
+// Recorder records or replays HTTP interactions. It is safe for concurrent
+// use.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	redact    redactor
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// New returns a Recorder for the cassette at path. ModeReplay loads the
+// cassette now; ModeRecord starts an empty one that Save writes.
+func New(path string, mode Mode, opts ...Option) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: http.DefaultTransport,
+		redact: redactor{
+			headers: slices.Clone(DefaultRedactedHeaders),
+			fields:  slices.Clone(DefaultRedactedFields),
+		},
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(r)
+		}
+	}
+	switch mode {
+	case ModeReplay:
+		c, err := LoadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = *c
+		for i := range r.cassette.Interactions {
+			// Normalize hand-edited request bodies the way live ones are.
+			req := &r.cassette.Interactions[i].Request
+			if len(req.Parts) > 0 {
+				sortParts(req.Parts)
+				continue
+			}
+			req.Body = r.redact.body(req.bytes(), "")
+		}
+		r.used = make([]bool, len(c.Interactions))
+	case ModeRecord:
+		if r.transport == nil {
+			return nil, errors.New("vantarecord: record mode needs a transport")
+		}
+	default:
+		return nil, fmt.Errorf("vantarecord: unknown mode %d", int(mode))
+	}
+	return r, nil
+}
+
+// Mode returns the recorder's mode.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Client returns an HTTP client that uses the recorder as its transport.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Save writes the recorded interactions to the cassette. It does nothing in
+// ModeReplay.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cassette.Save(r.path)
+}
+
+// Unused returns the replay interactions no request has matched yet, which
+// usually means the code under test made fewer calls than the recorded
+// session.
+func (r *Recorder) Unused() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []Interaction
+	for i, used := range r.used {
+		if !used {
+			out = append(out, r.cassette.Interactions[i])
+		}
+	}
+	return out
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("vantarecord: read request body: %w", err)
+		}
+	}
+	recorded := RecordedRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  canonicalQuery(req.URL.RawQuery),
+		Header: r.redact.header(req.Header),
+		Body:   r.redact.body(body, req.Header.Get("Content-Type")),
+	}
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+	return r.record(req, body, recorded)
+}
+
+func (r *Recorder) record(req *http.Request, body []byte, recorded RecordedRequest) (*http.Response, error) {
+	out := req.Clone(req.Context())
+	out.Body = io.NopCloser(bytes.NewReader(body))
+	out.ContentLength = int64(len(body))
+	resp, err := r.transport.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("vantarecord: read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     r.responseHeader(resp.Header),
+			Body:       r.redact.body(respBody, resp.Header.Get("Content-Type")),
+		},
+	})
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, in := range r.cassette.Interactions {
+		if r.used[i] || !matches(in.Request, recorded) {
+			continue
+		}
+		r.used[i] = true
+		body := in.Response.bytes()
+		return &http.Response{
+			StatusCode:    in.Response.StatusCode,
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        in.Response.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	desc := recorded.Method + " " + recorded.Path
+	if recorded.Query != "" {
+		desc += "?" + recorded.Query
+	}
+	if b := recorded.bytes(); len(b) > 0 {
+		desc += " body " + strings.TrimSpace(string(b))
+	}
+	return nil, fmt.Errorf("%w: %s (cassette %s)", ErrNoRecording, desc, r.path)
+}
+
+// responseHeader is the redacted header to record. Content-Length is dropped
+// because normalizing the body can change its length.
+func (r *Recorder) responseHeader(h http.Header) http.Header {
+	out := r.redact.header(h)
+	delete(out, "Content-Length")
+	return out
+}
+
+func matches(recorded, req RecordedRequest) bool {
+	return recorded.Method == req.Method &&
+		recorded.Path == req.Path &&
+		recorded.Query == req.Query &&
+		bytes.Equal(recorded.bytes(), req.bytes())
+}
@@ -0,0 +1,199 @@
+package vantarecord
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	vanta "github.com/richardoc/vanta-sdk-go/v1"
+	"github.com/richardoc/vanta-sdk-go/v1/vantatest"
+)
+
+// session is the code under test: it authenticates, pages through controls
+// and creates a vendor.
+func session(t *testing.T, client *vanta.Client) ([]vanta.ControlID, vanta.VendorID) {
+	t.Helper()
+	ctx := context.Background()
+	pageSize := 1
+	var ids []vanta.ControlID
+	for control, err := range client.Services.Controls.AllControls(ctx, &vanta.ControlsListControlsParams{PageSize: &pageSize}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, control.ID)
+	}
+	vendor, err := client.Services.Vendors.CreateVendor(ctx, &vanta.VendorsCreateVendorParams{Body: &vanta.VendorsCreateVendorRequestBody{Name: "Acme"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ids, vendor.ID
+}
+
+func oauthClient(t *testing.T, rec *Recorder, baseURL, tokenURL, id, secret string) *vanta.Client {
+	t.Helper()
+	source, err := vanta.NewOAuthClientCredentialsTokenSource(vanta.OAuthClientCredentialsConfig{
+		ClientID:     id,
+		ClientSecret: secret,
+		AuthURL:      tokenURL,
+		HTTPClient:   rec.Client(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := vanta.NewClient(vanta.WithBaseURL(baseURL), vanta.WithHTTPClient(rec.Client()), vanta.WithTokenSource(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestRecordThenReplay(t *testing.T) {
+	srv := vantatest.NewServer()
+	defer srv.Close()
+	if err := srv.Seed(vantatest.Controls, vanta.Control{ID: "c1", Name: "MFA"}, vanta.Control{ID: "c2", Name: "Backups"}); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "cassettes", "session.json")
+
+	rec, err := New(path, ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recordedIDs, recordedVendor := session(t, oauthClient(t, rec, srv.BaseURL(), srv.TokenURL(), vantatest.DefaultClientID, vantatest.DefaultClientSecret))
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{vantatest.DefaultClientID, vantatest.DefaultClientSecret, "vantatest-token-"} {
+		if strings.Contains(string(data), secret) {
+			t.Fatalf("cassette contains secret %q:\n%s", secret, data)
+		}
+	}
+
+	srv.Close()
+	replay, err := New(path, ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The replay client uses other credentials and hosts: the cassette has
+	// redacted the secrets, and matching ignores the host.
+	client := oauthClient(t, replay, "http://replay.invalid/v1", "http://replay.invalid/oauth/token", "other-id", "other-secret")
+	ids, vendor := session(t, client)
+	if strings.Join(asStrings(ids), ",") != strings.Join(asStrings(recordedIDs), ",") || vendor != recordedVendor {
+		t.Fatalf("replay = %v %s, recorded %v %s", ids, vendor, recordedIDs, recordedVendor)
+	}
+	if unused := replay.Unused(); len(unused) != 0 {
+		t.Fatalf("unused interactions: %+v", unused)
+	}
+
+	_, err = client.Services.Controls.GetControlByID(context.Background(), &vanta.ControlsGetControlByIDParams{ControlID: "c1"})
+	if !errors.Is(err, ErrNoRecording) || !strings.Contains(err.Error(), "GET /v1/controls/c1") {
+		t.Fatalf("unrecorded request err = %v, want ErrNoRecording naming the request", err)
+	}
+}
+
+func TestReplayMatchesBodyAndQuery(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	c := &Cassette{Interactions: []Interaction{{
+		Request: RecordedRequest{
+			Method: "POST",
+			Path:   "/v1/vendors",
+			Query:  "a=1&b=2",
+			// Hand-written with unsorted keys and spacing.
+			Body: Body{JSON: []byte(`{"websiteUrl": "https://acme.test", "name": "Acme"}`)},
+		},
+		Response: RecordedResponse{StatusCode: 200, Body: Body{JSON: []byte(`{"id":"v1","name":"Acme"}`)}},
+	}}}
+	if err := c.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	rec, err := New(path, ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := rec.Client()
+
+	resp, err := client.Post("http://x/v1/vendors?b=2&a=1", "application/json", strings.NewReader(`{"name":"Other"}`))
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("request with a different body matched")
+	}
+	resp, err = client.Post("http://x/v1/vendors?b=2&a=1", "application/json", strings.NewReader(`{"name":"Acme","websiteUrl":"https://acme.test"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != 200 {
+		t.Fatalf("status = %d", resp.StatusCode)
+	}
+}
+
+func TestRecordThenReplayUpload(t *testing.T) {
+	// The fake server has no upload route, so echo the parsed form instead.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(vanta.DocumentUpload{ID: "u1", Title: r.FormValue("title")})
+	}))
+	defer srv.Close()
+	path := filepath.Join(t.TempDir(), "upload.json")
+	params := &vanta.DocumentsUploadFileForDocumentParams{
+		DocumentID: "d1",
+		FormData:   map[string]string{"title": "Policy", "description": "Signed", "effectiveAtDate": "2024-01-01"},
+	}
+	upload := func(rec *Recorder, baseURL string) *vanta.DocumentUpload {
+		t.Helper()
+		client, err := vanta.NewClient(vanta.WithBaseURL(baseURL), vanta.WithHTTPClient(rec.Client()), vanta.WithTokenSource(vanta.StaticTokenSource("token")))
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := client.Services.Documents.UploadFileForDocument(context.Background(), params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return out
+	}
+
+	rec, err := New(path, ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorded := upload(rec, srv.URL+"/v1")
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	srv.Close()
+	replay, err := New(path, ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The replayed request has a new boundary and, as the form is a map,
+	// its parts may come in another order.
+	if got := upload(replay, "http://replay.invalid/v1"); got.ID != recorded.ID || got.Title != "Policy" {
+		t.Fatalf("replay = %+v, recorded %+v", got, recorded)
+	}
+	if unused := replay.Unused(); len(unused) != 0 {
+		t.Fatalf("unused interactions: %+v", unused)
+	}
+}
+
+func asStrings(ids []vanta.ControlID) []string {
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = string(id)
+	}
+	return out
+}
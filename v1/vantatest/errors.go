@@ -0,0 +1,73 @@
+package vantatest
+
+import (
+	"net/http"
+	"slices"
+)
+
+// InjectedError makes the server answer matching requests with an error
+// instead of handling them.
+type InjectedError struct {
+	// Method matches the request method; empty matches any.
+	Method string
+	// Path matches either the route pattern, such as "/controls/:controlId",
+	// or the literal path, such as "/controls/c1". API paths are relative to
+	// the base URL; the token endpoint is "/oauth/token". Empty matches any.
+	Path string
+
+	Status int
+	// Body is the response body. When empty, a JSON error naming the status
+	// is sent.
+	Body   string
+	Header http.Header
+	// Times is how many requests fail before the error is removed; 0 fails
+	// every matching request until ClearErrors.
+	Times int
+}
+
+// InjectError adds an error. Errors are checked in the order they were added,
+// before authentication.
+func (s *Server) InjectError(e InjectedError) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errors = append(s.errors, &e)
+}
+
+// ClearErrors removes every injected error.
+func (s *Server) ClearErrors() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errors = nil
+}
+
+// injectedError writes the first injected error matching the request and
+// reports whether it did.
+func (s *Server) injectedError(w http.ResponseWriter, method, path string, rt *route) bool {
+	for i, e := range s.errors {
+		if e.Method != "" && e.Method != method {
+			continue
+		}
+		if e.Path != "" && e.Path != path && (rt == nil || e.Path != rt.pattern) {
+			continue
+		}
+		if e.Times > 0 {
+			e.Times--
+			if e.Times == 0 {
+				s.errors = slices.Delete(s.errors, i, i+1)
+			}
+		}
+		for k, values := range e.Header {
+			for _, v := range values {
+				w.Header().Add(k, v)
+			}
+		}
+		if e.Body == "" {
+			writeError(w, e.Status, "InjectedError", http.StatusText(e.Status))
+			return true
+		}
+		w.WriteHeader(e.Status)
+		_, _ = w.Write([]byte(e.Body))
+		return true
+	}
+	return false
+}
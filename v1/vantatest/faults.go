@@ -0,0 +1,374 @@
+package vantatest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	vanta "github.com/richardoc/vanta-sdk-go/v1"
+)
+
+// FaultKind is a kind of failure FaultTransport injects.
+type FaultKind int
+
+const (
+	// FaultLatency delays the request by Fault.Latency, then lets later
+	// faults apply or sends it on.
+	FaultLatency FaultKind = iota + 1
+	// FaultConnectionReset fails the request with a connection reset
+	// (syscall.ECONNRESET) before it is sent.
+	FaultConnectionReset
+	// FaultRateLimit answers 429 with a Retry-After header of
+	// Fault.RetryAfter, one second by default.
+	FaultRateLimit
+	// FaultServerError answers Fault.Status, 503 by default. Use Times for a
+	// burst of consecutive errors.
+	FaultServerError
+	// FaultTruncatedBody sends the request and cuts the response body in
+	// half; reading past the cut fails with io.ErrUnexpectedEOF.
+	FaultTruncatedBody
+	// FaultMalformedJSON sends the request and cuts the response body in
+	// half, so it reads cleanly but does not decode. An empty body is
+	// replaced with malformedJSON instead; note that the SDK does not read
+	// the body of 204 responses at all.
+	FaultMalformedJSON
+	// FaultCursorError answers 400 for an invalid pageCursor. It only
+	// applies to requests that carry a cursor, that is, after the first
+	// page of a listing.
+	FaultCursorError
+)
+
+func (k FaultKind) String() string {
+	switch k {
+	case FaultLatency:
+		return "latency"
+	case FaultConnectionReset:
+		return "connection reset"
+	case FaultRateLimit:
+		return "rate limit"
+	case FaultServerError:
+		return "server error"
+	case FaultTruncatedBody:
+		return "truncated body"
+	case FaultMalformedJSON:
+		return "malformed JSON"
+	case FaultCursorError:
+		return "cursor error"
+	}
+	return fmt.Sprintf("FaultKind(%d)", int(k))
+}
+
+// Fault is a failure to inject into matching requests.
+type Fault struct {
+	Kind FaultKind
+
+	// Operation matches the catalog operation ID, such as
+	// "Controls.ListControls". Empty matches any.
+	Operation string
+	// Method matches the request method; empty matches any.
+	Method string
+	// Path matches either an operation's path pattern, such as
+	// "/controls/:controlId", or a literal path, such as "/controls/c1",
+	// against the end of the request path, so it does not depend on the
+	// base URL. Empty matches any.
+	Path string
+
+	// Probability is the chance that a matching request gets the fault,
+	// drawn from the transport's seeded source. 0 means every request.
+	Probability float64
+	// Skip lets the first Skip matching requests through, for example to
+	// fail the third page of a listing.
+	Skip int
+	// Times is how many requests get the fault before it stops applying; 0
+	// means no limit.
+	Times int
+
+	// Latency is the delay for FaultLatency.
+	Latency time.Duration
+	// RetryAfter is the Retry-After for FaultRateLimit, rounded up to whole
+	// seconds.
+	RetryAfter time.Duration
+	// Status is the status for FaultServerError.
+	Status int
+}
+
+// malformedJSON is the body FaultMalformedJSON sends for responses that had
+// none: an object that is never closed.
+const malformedJSON = `{"results":`
+
+// FaultEvent records a fault FaultTransport injected.
+type FaultEvent struct {
+	Kind      FaultKind
+	Operation string
+	Method    string
+	Path      string
+}
+
+// FaultTransport is an http.RoundTripper that injects faults into requests
+// before, or instead of, sending them to an underlying transport, so code
+// built on the SDK can be tested against flaky networks and outages:
+//
+//	faults := vantatest.NewFaultTransport(srv.Client().Transport, 1,
+//		vantatest.Fault{Kind: vantatest.FaultRateLimit, Operation: "Controls.ListControls", Times: 2},
+//		vantatest.Fault{Kind: vantatest.FaultCursorError, Probability: 0.5},
+//	)
+//	client, err := srv.NewClient(vanta.WithHTTPClient(faults.Client()))
+//
+// Faults are checked in the order they were added and the first one that
+// fires decides the outcome, except FaultLatency, which delays the request
+// and keeps checking. Probabilities are drawn from a source seeded with the
+// transport's seed, so a sequence of requests gets the same faults on every
+// run; concurrent requests draw in whatever order they arrive.
+//
+// It is safe for concurrent use.
+type FaultTransport struct {
+	base http.RoundTripper
+
+	mu     sync.Mutex
+	rng    *rand.Rand
+	faults []*faultState
+	events []FaultEvent
+}
+
+type faultState struct {
+	Fault
+	seen  int
+	fired int
+}
+
+// NewFaultTransport returns a transport that sends requests to base, or
+// http.DefaultTransport if base is nil, after injecting faults. seed makes
+// Probability draws reproducible.
+func NewFaultTransport(base http.RoundTripper, seed uint64, faults ...Fault) *FaultTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	t := &FaultTransport{
+		base: base,
+		rng:  rand.New(rand.NewPCG(seed, seed)),
+	}
+	for _, f := range faults {
+		t.Add(f)
+	}
+	return t
+}
+
+// Add adds a fault after the existing ones.
+func (t *FaultTransport) Add(f Fault) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.faults = append(t.faults, &faultState{Fault: f})
+}
+
+// Clear removes every fault. The event log is kept.
+func (t *FaultTransport) Clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.faults = nil
+}
+
+// Events returns the faults injected so far, in order.
+func (t *FaultTransport) Events() []FaultEvent {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return slices.Clone(t.events)
+}
+
+// Client returns an HTTP client that uses the transport.
+func (t *FaultTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *FaultTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	op := operationFor(req.Method, req.URL.Path)
+	for _, f := range t.fire(req, op) {
+		switch f.Kind {
+		case FaultLatency:
+			if err := sleep(req.Context(), f.Latency); err != nil {
+				closeBody(req)
+				return nil, err
+			}
+		case FaultConnectionReset:
+			closeBody(req)
+			return nil, &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+		case FaultRateLimit:
+			closeBody(req)
+			retryAfter := f.RetryAfter
+			if retryAfter <= 0 {
+				retryAfter = time.Second
+			}
+			resp := faultResponse(req, http.StatusTooManyRequests, "TooManyRequests", "rate limit exceeded")
+			resp.Header.Set("Retry-After", strconv.Itoa(int((retryAfter+time.Second-1)/time.Second)))
+			return resp, nil
+		case FaultServerError:
+			closeBody(req)
+			status := f.Status
+			if status == 0 {
+				status = http.StatusServiceUnavailable
+			}
+			return faultResponse(req, status, "ServerError", http.StatusText(status)), nil
+		case FaultCursorError:
+			closeBody(req)
+			return faultResponse(req, http.StatusBadRequest, "BadRequest", "invalid pageCursor"), nil
+		case FaultTruncatedBody, FaultMalformedJSON:
+			resp, err := t.base.RoundTrip(req)
+			if err != nil {
+				return nil, err
+			}
+			body, err := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			body = body[:len(body)/2]
+			if f.Kind == FaultMalformedJSON && len(body) == 0 {
+				body = []byte(malformedJSON)
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+			resp.ContentLength = int64(len(body))
+			resp.Header.Del("Content-Length")
+			if f.Kind == FaultTruncatedBody {
+				resp.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), errReader{io.ErrUnexpectedEOF}))
+				resp.ContentLength = -1
+			}
+			return resp, nil
+		}
+	}
+	return t.base.RoundTrip(req)
+}
+
+// fire returns the faults that apply to req, stopping at the first one that
+// replaces the response, and records them.
+func (t *FaultTransport) fire(req *http.Request, op string) []Fault {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var out []Fault
+	for _, f := range t.faults {
+		if !f.matches(req, op) {
+			continue
+		}
+		if f.Times > 0 && f.fired >= f.Times {
+			continue
+		}
+		f.seen++
+		if f.seen <= f.Skip {
+			continue
+		}
+		if f.Probability > 0 && t.rng.Float64() >= f.Probability {
+			continue
+		}
+		f.fired++
+		out = append(out, f.Fault)
+		t.events = append(t.events, FaultEvent{Kind: f.Kind, Operation: op, Method: req.Method, Path: req.URL.Path})
+		if f.Kind != FaultLatency {
+			break
+		}
+	}
+	return out
+}
+
+func (f *faultState) matches(req *http.Request, op string) bool {
+	if f.Method != "" && f.Method != req.Method {
+		return false
+	}
+	if f.Operation != "" && f.Operation != op {
+		return false
+	}
+	if f.Path != "" {
+		segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+		if _, ok := matchSuffix(f.Path, segments); !ok {
+			return false
+		}
+	}
+	if f.Kind == FaultCursorError && req.URL.Query().Get("pageCursor") == "" {
+		return false
+	}
+	return true
+}
+
+// operationFor returns the ID of the catalog operation whose path pattern
+// matches the end of path, or "" if none does. Longer patterns win, then
+// patterns with more literal segments, so "/controls/controls-library" is
+// not taken for "/controls/:controlId".
+func operationFor(method, path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	best, bestLen, bestLiteral := "", 0, 0
+	for _, op := range vanta.Operations() {
+		if op.HTTPMethod != method {
+			continue
+		}
+		n, ok := matchSuffix(op.Path, segments)
+		if !ok {
+			continue
+		}
+		literal := n - strings.Count(op.Path, ":")
+		if n > bestLen || n == bestLen && literal > bestLiteral {
+			best, bestLen, bestLiteral = op.ID, n, literal
+		}
+	}
+	return best
+}
+
+// matchSuffix matches pattern against the last segments of a path and
+// returns how many segments it covered.
+func matchSuffix(pattern string, segments []string) (int, bool) {
+	n := len(strings.Split(strings.Trim(pattern, "/"), "/"))
+	if n > len(segments) {
+		return 0, false
+	}
+	_, ok := matchPattern(pattern, segments[len(segments)-n:])
+	return n, ok
+}
+
+func faultResponse(req *http.Request, status int, name, message string) *http.Response {
+	body, _ := json.Marshal(map[string]string{"name": name, "message": message})
+	return &http.Response{
+		StatusCode:    status,
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// closeBody closes the body of a request that is not sent, as a transport
+// must.
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
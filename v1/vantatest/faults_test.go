@@ -0,0 +1,213 @@
+package vantatest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	vanta "github.com/richardoc/vanta-sdk-go/v1"
+)
+
+func newFaultClient(t *testing.T, seed uint64, faults ...Fault) (*FaultTransport, *vanta.Client) {
+	t.Helper()
+	srv, _ := newFixtureServer(t)
+	ft := NewFaultTransport(srv.Client().Transport, seed, faults...)
+	client, err := srv.NewClient(vanta.WithHTTPClient(ft.Client()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ft, client
+}
+
+func TestFaultTransportServerErrorBurst(t *testing.T) {
+	ft, client := newFaultClient(t, 1, Fault{Kind: FaultServerError, Operation: "Controls.ListControls", Times: 2})
+	ctx := context.Background()
+
+	if _, err := client.Services.Controls.GetControlByID(ctx, &vanta.ControlsGetControlByIDParams{ControlID: "control-1"}); err != nil {
+		t.Fatalf("other operation failed: %v", err)
+	}
+	for i := range 2 {
+		if _, err := client.Services.Controls.ListControls(ctx, nil); statusOf(err) != http.StatusServiceUnavailable {
+			t.Fatalf("request %d err = %v, want 503", i, err)
+		}
+	}
+	if _, err := client.Services.Controls.ListControls(ctx, nil); err != nil {
+		t.Fatalf("after burst: %v", err)
+	}
+	events := ft.Events()
+	if len(events) != 2 || events[0].Operation != "Controls.ListControls" || events[0].Kind != FaultServerError {
+		t.Fatalf("events = %+v", events)
+	}
+}
+
+func TestFaultTransportRateLimit(t *testing.T) {
+	ft := NewFaultTransport(roundTripOK{}, 1, Fault{Kind: FaultRateLimit, Path: "/controls/:controlId", RetryAfter: 1500 * time.Millisecond})
+	resp, err := ft.Client().Get("http://vanta.test/v1/controls/c1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusTooManyRequests || resp.Header.Get("Retry-After") != "2" {
+		t.Fatalf("response = %d Retry-After %q, want 429 and 2", resp.StatusCode, resp.Header.Get("Retry-After"))
+	}
+	resp, err = ft.Client().Get("http://vanta.test/v1/controls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unmatched path status = %d", resp.StatusCode)
+	}
+}
+
+func TestFaultTransportCursorErrorMidPagination(t *testing.T) {
+	_, client := newFaultClient(t, 1, Fault{Kind: FaultCursorError, Operation: "Controls.ListControls", Skip: 1})
+	pageSize := 1
+	var ids []vanta.ControlID
+	var err error
+	for control, iterErr := range client.Services.Controls.AllControls(context.Background(), &vanta.ControlsListControlsParams{PageSize: &pageSize}) {
+		if iterErr != nil {
+			err = iterErr
+			break
+		}
+		ids = append(ids, control.ID)
+	}
+	// The first page carries no cursor and the second is skipped, so the
+	// third page fails.
+	if len(ids) != 2 || statusOf(err) != http.StatusBadRequest {
+		t.Fatalf("got %v, err %v; want two controls then 400", ids, err)
+	}
+}
+
+func TestFaultTransportBrokenConnections(t *testing.T) {
+	tests := []struct {
+		kind  FaultKind
+		check func(error) bool
+	}{
+		{FaultConnectionReset, func(err error) bool { return errors.Is(err, syscall.ECONNRESET) }},
+		{FaultTruncatedBody, func(err error) bool { return errors.Is(err, io.ErrUnexpectedEOF) }},
+		{FaultMalformedJSON, func(err error) bool { return err != nil && !errors.Is(err, io.ErrUnexpectedEOF) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind.String(), func(t *testing.T) {
+			_, client := newFaultClient(t, 1, Fault{Kind: tt.kind, Method: http.MethodGet})
+			_, err := client.Services.Controls.ListControls(context.Background(), nil)
+			if !tt.check(err) {
+				t.Fatalf("err = %v", err)
+			}
+		})
+	}
+}
+
+func TestFaultTransportLatencyHonoursContext(t *testing.T) {
+	_, client := newFaultClient(t, 1,
+		Fault{Kind: FaultLatency, Latency: time.Hour},
+		Fault{Kind: FaultServerError},
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := client.Services.Controls.ListControls(ctx, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want deadline exceeded", err)
+	}
+}
+
+func TestFaultTransportIsDeterministic(t *testing.T) {
+	run := func(seed uint64) []int {
+		ft := NewFaultTransport(roundTripOK{}, seed, Fault{Kind: FaultServerError, Probability: 0.5})
+		var failed []int
+		for i := range 32 {
+			resp, err := ft.Client().Get("http://vanta.test/v1/controls")
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				failed = append(failed, i)
+			}
+		}
+		return failed
+	}
+	first, second := run(42), run(42)
+	if !slices.Equal(first, second) {
+		t.Fatalf("same seed failed %v, then %v", first, second)
+	}
+	if len(first) == 0 || len(first) == 32 {
+		t.Fatalf("probability 0.5 failed %d of 32 requests", len(first))
+	}
+	if slices.Equal(first, run(7)) {
+		t.Fatalf("seeds 42 and 7 failed the same requests %v", first)
+	}
+}
+
+func TestOperationFor(t *testing.T) {
+	tests := map[string]string{
+		"GET /v1/controls":                     "Controls.ListControls",
+		"GET /v1/controls/c1":                  "Controls.GetControlByID",
+		"GET /v1/controls/controls-library":    "Controls.ListVantaControlsFromLibrary",
+		"GET /v1/controls/c1/documents":        "Controls.ListControlsDocuments",
+		"POST /v1/controls":                    "Controls.CreateCustomControl",
+		"POST /oauth/token":                    "OAuth.CreateToken",
+		"GET /v1/no-such-endpoint/for-testing": "",
+	}
+	for in, want := range tests {
+		method, path, _ := strings.Cut(in, " ")
+		if got := operationFor(method, path); got != want {
+			t.Errorf("operationFor(%s) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+type roundTripOK struct{}
+
+func (roundTripOK) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: http.NoBody, Request: req}, nil
+}
+
+func TestFaultTransportLatencyClosesBodyOnCancel(t *testing.T) {
+	ft := NewFaultTransport(roundTripOK{}, 1, Fault{Kind: FaultLatency, Latency: time.Hour})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	body := &trackedBody{Reader: strings.NewReader(`{}`)}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "http://vanta.test/v1/controls", body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ft.RoundTrip(req); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want canceled", err)
+	}
+	if !body.closed {
+		t.Fatal("request body was not closed")
+	}
+}
+
+func TestFaultTransportMalformedJSONOnEmptyBody(t *testing.T) {
+	ft := NewFaultTransport(roundTripOK{}, 1, Fault{Kind: FaultMalformedJSON})
+	resp, err := ft.Client().Get("http://vanta.test/v1/controls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != malformedJSON {
+		t.Fatalf("body = %q, want %q", data, malformedJSON)
+	}
+}
+
+type trackedBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *trackedBody) Close() error {
+	b.closed = true
+	return nil
+}
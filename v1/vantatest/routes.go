@@ -0,0 +1,561 @@
+package vantatest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	vanta "github.com/richardoc/vanta-sdk-go/v1"
+)
+
+const (
+	defaultPageSize = 10
+	maxPageSize     = 100
+)
+
+type route struct {
+	method string
+	// pattern is the path template relative to the base URL, with path
+	// params written as ":name" as in the operation catalog.
+	pattern string
+	handle  func(w http.ResponseWriter, r *http.Request, params map[string]string)
+}
+
+// match returns the route for method and path, and the path params.
+func (s *Server) match(method, path string) (*route, map[string]string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := range s.routes {
+		rt := &s.routes[i]
+		if rt.method != method {
+			continue
+		}
+		if params, ok := matchPattern(rt.pattern, segments); ok {
+			return rt, params
+		}
+	}
+	return nil, nil
+}
+
+func matchPattern(pattern string, segments []string) (map[string]string, bool) {
+	parts := strings.Split(strings.Trim(pattern, "/"), "/")
+	if len(parts) != len(segments) {
+		return nil, false
+	}
+	params := map[string]string{}
+	for i, part := range parts {
+		if name, ok := strings.CutPrefix(part, ":"); ok {
+			if segments[i] == "" {
+				return nil, false
+			}
+			params[name] = segments[i]
+			continue
+		}
+		if part != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+type listKind int
+
+const (
+	noList listKind = iota
+	// pagedList responds with the results.data/pageInfo envelope.
+	pagedList
+	// plainList responds with {"results": [...]}.
+	plainList
+)
+
+// resource describes a collection served with the usual list, get, create,
+// update and delete endpoints.
+type resource struct {
+	// path is the collection path template, such as "/trust-centers/:slugId/faqs".
+	path string
+	// param names the item ID path param.
+	param string
+	list  listKind
+	// createStatus is the status of a successful POST to path; 0 when the
+	// collection cannot be created into.
+	createStatus int
+	update       bool
+	remove       bool
+	// build fills in fields of a created item that Vanta sets itself.
+	build func(item object)
+	// normalize turns a create or update body into the shape of the item,
+	// where the two differ.
+	normalize func(body object)
+}
+
+var resources = []resource{
+	{path: "/controls", param: "controlId", list: pagedList, createStatus: http.StatusCreated, update: true, remove: true, build: buildControl, normalize: normalizeControl},
+	{path: "/documents", param: "documentId", list: pagedList, createStatus: http.StatusOK, remove: true},
+	{path: "/people", param: "personId", list: pagedList, update: true},
+	{path: "/groups", param: "groupId", list: pagedList},
+	{path: "/vendors", param: "vendorId", list: pagedList, createStatus: http.StatusOK, update: true, remove: true, normalize: normalizeVendor},
+	{path: "/risk-scenarios", param: "riskScenarioId", list: pagedList, createStatus: http.StatusOK, update: true},
+	{path: "/vulnerabilities", param: "vulnerabilityId", list: pagedList},
+	{path: "/trust-centers", param: "slugId", update: true},
+	{path: "/trust-centers/:slugId/faqs", param: "faqId", list: plainList, createStatus: http.StatusCreated, update: true, remove: true},
+	{path: "/trust-centers/:slugId/subprocessors", param: "subprocessorId", list: plainList, createStatus: http.StatusOK, update: true, remove: true},
+	{path: "/trust-centers/:slugId/updates", param: "updateId", list: pagedList, createStatus: http.StatusOK, update: true, remove: true},
+	{path: "/trust-centers/:slugId/subscribers", param: "subscriberId", list: pagedList, createStatus: http.StatusOK, remove: true},
+	{path: "/trust-centers/:slugId/subscriber-groups", param: "subscriberGroupId", list: pagedList, createStatus: http.StatusOK, update: true, remove: true},
+	{path: "/trust-centers/:slugId/viewers", param: "viewerId", list: pagedList, createStatus: http.StatusOK, remove: true},
+	{path: "/trust-centers/:slugId/control-categories", param: "categoryId", list: plainList, createStatus: http.StatusOK, update: true, remove: true},
+}
+
+// apiRoutes lists the routes the server answers. Routes that are more
+// specific than a resource's item route come first.
+func (s *Server) apiRoutes() []route {
+	routes := []route{
+		{http.MethodPost, "/controls/:controlId/add-document-to-control", s.addDocumentToControl},
+		{http.MethodGet, "/controls/:controlId/documents", s.listControlDocuments},
+		{http.MethodDelete, "/controls/:controlId/documents/:documentId", s.removeDocumentFromControl},
+		{http.MethodGet, "/documents/:documentId/controls", s.listDocumentControls},
+		{http.MethodGet, "/groups/:groupId/people", s.listGroupPeople},
+		{http.MethodPost, "/groups/:groupId/people", s.addPersonToGroup},
+		{http.MethodDelete, "/groups/:groupId/people/:personId", s.removePersonFromGroup},
+		{http.MethodPost, "/vulnerabilities/deactivate", s.setVulnerabilityMonitoring(false)},
+		{http.MethodPost, "/vulnerabilities/reactivate", s.setVulnerabilityMonitoring(true)},
+	}
+	for _, res := range resources {
+		item := res.path + "/:" + res.param
+		if res.list != noList {
+			routes = append(routes, route{http.MethodGet, res.path, s.listResource(res)})
+		}
+		if res.createStatus != 0 {
+			routes = append(routes, route{http.MethodPost, res.path, s.createResource(res)})
+		}
+		routes = append(routes, route{http.MethodGet, item, s.getResource(res)})
+		if res.update {
+			routes = append(routes, route{http.MethodPatch, item, s.updateResource(res)})
+		}
+		if res.remove {
+			routes = append(routes, route{http.MethodDelete, item, s.deleteResource(res)})
+		}
+	}
+	return routes
+}
+
+// collectionOf returns the collection a request to res refers to, and false
+// after writing a 404 when the trust center it is nested under is missing.
+func (s *Server) collectionOf(w http.ResponseWriter, res resource, params map[string]string) (Collection, bool) {
+	slug, nested := params["slugId"]
+	if !nested || res.param == "slugId" {
+		return Collection(strings.TrimPrefix(res.path, "/")), true
+	}
+	if _, ok := s.store.get(TrustCenters, slug); !ok {
+		notFound(w, TrustCenters, slug)
+		return "", false
+	}
+	return Collection(strings.Replace(strings.TrimPrefix(res.path, "/"), ":slugId", slug, 1)), true
+}
+
+func (s *Server) listResource(res resource) func(http.ResponseWriter, *http.Request, map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		c, ok := s.collectionOf(w, res, params)
+		if !ok {
+			return
+		}
+		items := s.store.list(c)
+		if res.list == plainList {
+			writeJSON(w, http.StatusOK, map[string]any{"results": nonNil(items)})
+			return
+		}
+		writePage(w, r, items)
+	}
+}
+
+func (s *Server) getResource(res resource) func(http.ResponseWriter, *http.Request, map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		c, ok := s.collectionOf(w, res, params)
+		if !ok {
+			return
+		}
+		item, ok := s.store.get(c, params[res.param])
+		if !ok {
+			notFound(w, c, params[res.param])
+			return
+		}
+		writeJSON(w, http.StatusOK, item)
+	}
+}
+
+func (s *Server) createResource(res resource) func(http.ResponseWriter, *http.Request, map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		c, ok := s.collectionOf(w, res, params)
+		if !ok {
+			return
+		}
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		delete(body, c.idKey())
+		if res.normalize != nil {
+			res.normalize(body)
+		}
+		if res.build != nil {
+			res.build(body)
+		}
+		writeJSON(w, res.createStatus, s.store.put(c, body))
+	}
+}
+
+func (s *Server) updateResource(res resource) func(http.ResponseWriter, *http.Request, map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		c, ok := s.collectionOf(w, res, params)
+		if !ok {
+			return
+		}
+		item, ok := s.store.get(c, params[res.param])
+		if !ok {
+			notFound(w, c, params[res.param])
+			return
+		}
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+		delete(body, c.idKey())
+		if res.normalize != nil {
+			res.normalize(body)
+		}
+		merge(item, body)
+		writeJSON(w, http.StatusOK, item)
+	}
+}
+
+func (s *Server) deleteResource(res resource) func(http.ResponseWriter, *http.Request, map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		c, ok := s.collectionOf(w, res, params)
+		if !ok {
+			return
+		}
+		id := params[res.param]
+		if !s.store.delete(c, id) {
+			notFound(w, c, id)
+			return
+		}
+		switch c {
+		case Controls:
+			delete(s.store.documentLinks, id)
+		case Documents:
+			for control, docs := range s.store.documentLinks {
+				s.store.documentLinks[control] = slices.DeleteFunc(docs, func(d string) bool { return d == id })
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// buildControl marks created controls as custom.
+func buildControl(item object) {
+	item["source"] = "Custom"
+}
+
+// normalizeControl turns the single domain of a control request into the
+// domains list and drops request-only fields.
+func normalizeControl(body object) {
+	if domain, ok := body["domain"]; ok {
+		delete(body, "domain")
+		body["domains"] = []any{domain}
+	}
+	delete(body, "effectiveDate")
+	delete(body, "sections")
+}
+
+// normalizeVendor turns the category name of a vendor request into the
+// category object vendors have.
+func normalizeVendor(body object) {
+	category, ok := body["category"].(string)
+	if !ok {
+		return
+	}
+	if category == "" {
+		body["category"] = nil
+		return
+	}
+	body["category"] = object{"displayName": category}
+}
+
+func (s *Server) addDocumentToControl(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	control, ok := s.lookup(w, Controls, params["controlId"])
+	if !ok {
+		return
+	}
+	var body vanta.ControlsAddControlToDocumentMappingRequestBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+	document, ok := s.lookup(w, Documents, string(body.DocumentID))
+	if !ok {
+		return
+	}
+	links := s.store.documentLinks[params["controlId"]]
+	if !slices.Contains(links, string(body.DocumentID)) {
+		s.store.documentLinks[params["controlId"]] = append(links, string(body.DocumentID))
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"control": control, "document": document})
+}
+
+func (s *Server) listControlDocuments(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	if _, ok := s.lookup(w, Controls, params["controlId"]); !ok {
+		return
+	}
+	links := s.store.documentLinks[params["controlId"]]
+	var items []object
+	for _, doc := range s.store.list(Documents) {
+		if slices.Contains(links, doc["id"].(string)) {
+			items = append(items, doc)
+		}
+	}
+	writePage(w, r, items)
+}
+
+func (s *Server) removeDocumentFromControl(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	controlID, documentID := params["controlId"], params["documentId"]
+	if _, ok := s.lookup(w, Controls, controlID); !ok {
+		return
+	}
+	links := s.store.documentLinks[controlID]
+	if !slices.Contains(links, documentID) {
+		notFound(w, Collection("controls/"+controlID+"/documents"), documentID)
+		return
+	}
+	s.store.documentLinks[controlID] = slices.DeleteFunc(links, func(d string) bool { return d == documentID })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listDocumentControls(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	documentID := params["documentId"]
+	if _, ok := s.lookup(w, Documents, documentID); !ok {
+		return
+	}
+	var items []object
+	for _, control := range s.store.list(Controls) {
+		if slices.Contains(s.store.documentLinks[control["id"].(string)], documentID) {
+			items = append(items, control)
+		}
+	}
+	writePage(w, r, items)
+}
+
+// Group membership is kept on the people themselves, in their groupIds.
+
+func (s *Server) listGroupPeople(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	groupID := params["groupId"]
+	if _, ok := s.lookup(w, Groups, groupID); !ok {
+		return
+	}
+	items := []object{}
+	for _, person := range s.store.list(People) {
+		if slices.Contains(groupIDs(person), groupID) {
+			items = append(items, person)
+		}
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func (s *Server) addPersonToGroup(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	groupID := params["groupId"]
+	if _, ok := s.lookup(w, Groups, groupID); !ok {
+		return
+	}
+	var body vanta.GroupsAddPersonToGroupRequestBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+	person, ok := s.lookup(w, People, body.ID)
+	if !ok {
+		return
+	}
+	if ids := groupIDs(person); !slices.Contains(ids, groupID) {
+		person["groupIds"] = append(toAny(ids), groupID)
+	}
+	writeJSON(w, http.StatusOK, person)
+}
+
+func (s *Server) removePersonFromGroup(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	groupID, personID := params["groupId"], params["personId"]
+	if _, ok := s.lookup(w, Groups, groupID); !ok {
+		return
+	}
+	person, ok := s.lookup(w, People, personID)
+	if !ok {
+		return
+	}
+	ids := groupIDs(person)
+	if !slices.Contains(ids, groupID) {
+		notFound(w, Collection("groups/"+groupID+"/people"), personID)
+		return
+	}
+	person["groupIds"] = toAny(slices.DeleteFunc(ids, func(id string) bool { return id == groupID }))
+	writeJSON(w, http.StatusOK, person)
+}
+
+func groupIDs(person object) []string {
+	raw, _ := person["groupIds"].([]any)
+	ids := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if id, ok := v.(string); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func toAny(ids []string) []any {
+	out := make([]any, len(ids))
+	for i, id := range ids {
+		out[i] = id
+	}
+	return out
+}
+
+// setVulnerabilityMonitoring handles the deactivate and reactivate endpoints,
+// which report a status per requested vulnerability.
+func (s *Server) setVulnerabilityMonitoring(active bool) func(http.ResponseWriter, *http.Request, map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		var body struct {
+			Updates []struct {
+				ID                  string  `json:"id"`
+				DeactivateReason    string  `json:"deactivateReason"`
+				DeactivateUntilDate *string `json:"deactivateUntilDate"`
+			} `json:"updates"`
+		}
+		if !readJSON(w, r, &body) {
+			return
+		}
+		results := []map[string]string{}
+		for _, u := range body.Updates {
+			vuln, ok := s.store.get(Vulnerabilities, u.ID)
+			if !ok {
+				results = append(results, map[string]string{"id": u.ID, "status": "ERROR", "message": "Invalid Input"})
+				continue
+			}
+			if active {
+				vuln["deactivateMetadata"] = nil
+			} else {
+				vuln["deactivateMetadata"] = object{
+					"deactivatedBy":                 "vantatest",
+					"deactivatedOnDate":             time.Now().UTC().Format(time.RFC3339),
+					"deactivationReason":            u.DeactivateReason,
+					"deactivatedUntilDate":          u.DeactivateUntilDate,
+					"isVulnDeactivatedIndefinitely": u.DeactivateUntilDate == nil,
+				}
+			}
+			results = append(results, map[string]string{"id": u.ID, "status": "SUCCESS"})
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"results": results})
+	}
+}
+
+// lookup returns the item with id in c, writing a 404 when there is none.
+func (s *Server) lookup(w http.ResponseWriter, c Collection, id string) (object, bool) {
+	item, ok := s.store.get(c, id)
+	if !ok {
+		notFound(w, c, id)
+	}
+	return item, ok
+}
+
+// writePage writes items as one page of Vanta's cursor envelope, honouring
+// the pageSize and pageCursor query params. Cursors encode an offset into
+// items.
+func writePage(w http.ResponseWriter, r *http.Request, items []object) {
+	query := r.URL.Query()
+	size := defaultPageSize
+	if v := query.Get("pageSize"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxPageSize {
+			writeError(w, http.StatusBadRequest, "BadRequest", fmt.Sprintf("pageSize must be between 1 and %d", maxPageSize))
+			return
+		}
+		size = n
+	}
+	start := 0
+	if cursor := query.Get("pageCursor"); cursor != "" {
+		n, ok := decodeCursor(cursor)
+		if !ok || n > len(items) {
+			writeError(w, http.StatusBadRequest, "BadRequest", "invalid pageCursor")
+			return
+		}
+		start = n
+	}
+	end := min(start+size, len(items))
+	writeJSON(w, http.StatusOK, map[string]any{
+		"results": map[string]any{
+			"data": nonNil(items[start:end]),
+			"pageInfo": vanta.PageInfo{
+				HasNextPage:     end < len(items),
+				HasPreviousPage: start > 0,
+				StartCursor:     encodeCursor(start),
+				EndCursor:       encodeCursor(end),
+			},
+		},
+	})
+}
+
+func encodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("offset:" + strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, bool) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, false
+	}
+	v, ok := strings.CutPrefix(string(raw), "offset:")
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.Atoi(v)
+	return n, err == nil && n >= 0
+}
+
+func nonNil(items []object) []object {
+	if items == nil {
+		return []object{}
+	}
+	return items
+}
+
+// readObject decodes the request body as a JSON object. An empty body is an
+// empty object.
+func readObject(w http.ResponseWriter, r *http.Request) (object, bool) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return nil, false
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return object{}, true
+	}
+	obj, err := toObject(json.RawMessage(data))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", "request body must be a JSON object")
+		return nil, false
+	}
+	return obj, true
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", "invalid request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+func notFound(w http.ResponseWriter, c Collection, id string) {
+	writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("%s %q not found", c, id))
+}
@@ -0,0 +1,318 @@
+// Package vantatest runs an in-memory fake of the Vanta API for integration
+// tests that exercise the real client code paths without network access.
+//
+// The fake keeps state for controls, documents, people, groups, vendors, risk
+// scenarios, vulnerabilities and trust center entities: items can be listed
+// with page cursors, created, updated and deleted, and later requests see the
+// changes. It also serves the OAuth token endpoint, where, as in Vanta, issuing
+// a token invalidates the one issued before it:
+//
+//	srv := vantatest.NewServer()
+//	defer srv.Close()
+//	if err := srv.Seed(vantatest.Controls, vanta.Control{ID: "c1", Name: "MFA"}); err != nil {
+//		t.Fatal(err)
+//	}
+//	client, err := srv.NewClient()
+//	// ... exercise code that takes a *vanta.Client ...
+//
+// InjectError makes matching requests fail, and LoadFixtures seeds the fake
+// from a JSON file. FaultTransport injects network-level faults, such as
+// latency, resets and truncated bodies, on the client side.
+package vantatest
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	vanta "github.com/richardoc/vanta-sdk-go/v1"
+)
+
+// Default client credentials accepted by the token endpoint.
+const (
+	DefaultClientID     = "vantatest-client-id"
+	DefaultClientSecret = "vantatest-client-secret"
+)
+
+const (
+	apiPrefix       = "/v1"
+	tokenPath       = "/oauth/token"
+	revokePath      = "/oauth/revoke"
+	defaultTokenTTL = time.Hour
+)
+
+// Server is a fake Vanta API backed by an httptest.Server. It is safe for
+// concurrent use.
+type Server struct {
+	*httptest.Server
+
+	clientID     string
+	clientSecret string
+	tokenTTL     time.Duration
+
+	mu       sync.Mutex
+	store    *store
+	token    *issuedToken
+	tokens   int
+	errors   []*InjectedError
+	requests []Request
+	routes   []route
+}
+
+// Request is a request the server received.
+type Request struct {
+	Method string
+	// Path is the request path, including the /v1 prefix for API calls.
+	Path  string
+	Query url.Values
+}
+
+type issuedToken struct {
+	value   string
+	scopes  []string
+	expires time.Time
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithCredentials sets the client ID and secret the token endpoint accepts.
+func WithCredentials(clientID, clientSecret string) Option {
+	return func(s *Server) {
+		s.clientID = clientID
+		s.clientSecret = clientSecret
+	}
+}
+
+// WithTokenTTL sets how long issued tokens stay valid. The default is an hour.
+func WithTokenTTL(ttl time.Duration) Option {
+	return func(s *Server) {
+		s.tokenTTL = ttl
+	}
+}
+
+// NewServer starts a fake Vanta API. Call Close when done.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		clientID:     DefaultClientID,
+		clientSecret: DefaultClientSecret,
+		tokenTTL:     defaultTokenTTL,
+		store:        newStore(),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(s)
+		}
+	}
+	s.routes = s.apiRoutes()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// BaseURL is the API base URL to pass to vanta.WithBaseURL.
+func (s *Server) BaseURL() string {
+	return s.URL + apiPrefix
+}
+
+// TokenURL is the OAuth token URL to pass to vanta.WithAuthURL or
+// OAuthClientCredentialsConfig.AuthURL.
+func (s *Server) TokenURL() string {
+	return s.URL + tokenPath
+}
+
+// RevokeURL is the token revocation URL for OAuthClientCredentialsConfig.
+func (s *Server) RevokeURL() string {
+	return s.URL + revokePath
+}
+
+// NewClient returns a client for the server that authenticates with the
+// server's client credentials and both API scopes. opts are applied after the
+// defaults, so they can replace the token source or HTTP client.
+//
+// Each client fetches its own token, which invalidates the token of any
+// client created before it, as Vanta does for one set of credentials.
+func (s *Server) NewClient(opts ...vanta.Option) (*vanta.Client, error) {
+	source, err := vanta.NewOAuthClientCredentialsTokenSource(vanta.OAuthClientCredentialsConfig{
+		ClientID:     s.clientID,
+		ClientSecret: s.clientSecret,
+		Scope:        vanta.ScopeAllRead + " " + vanta.ScopeAllWrite,
+		AuthURL:      s.TokenURL(),
+		RevokeURL:    s.RevokeURL(),
+		HTTPClient:   s.Client(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	base := []vanta.Option{
+		vanta.WithBaseURL(s.BaseURL()),
+		vanta.WithAuthURL(s.TokenURL()),
+		vanta.WithHTTPClient(s.Client()),
+		vanta.WithTokenSource(source),
+	}
+	return vanta.NewClient(append(base, opts...)...)
+}
+
+// IssueToken issues a token with the given scopes, or both API scopes when
+// none are given, as the token endpoint would. It invalidates the previous
+// token. Use it with vanta.StaticTokenSource.
+func (s *Server) IssueToken(scopes ...string) string {
+	if len(scopes) == 0 {
+		scopes = []string{vanta.ScopeAllRead, vanta.ScopeAllWrite}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.issueToken(scopes).value
+}
+
+// Requests returns every request the server received, in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.requests)
+}
+
+func (s *Server) issueToken(scopes []string) *issuedToken {
+	s.tokens++
+	s.token = &issuedToken{
+		value:   fmt.Sprintf("vantatest-token-%d", s.tokens),
+		scopes:  scopes,
+		expires: time.Now().Add(s.tokenTTL),
+	}
+	return s.token
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path, Query: r.URL.Query()})
+
+	path, isAPI := strings.CutPrefix(r.URL.Path, apiPrefix)
+	if !isAPI || path == "" {
+		path = r.URL.Path
+	}
+	var rt *route
+	var params map[string]string
+	if isAPI {
+		rt, params = s.match(r.Method, path)
+	}
+	if s.injectedError(w, r.Method, path, rt) {
+		return
+	}
+
+	switch {
+	case r.URL.Path == tokenPath && r.Method == http.MethodPost:
+		s.handleToken(w, r)
+		return
+	case r.URL.Path == revokePath && r.Method == http.MethodPost:
+		s.handleRevoke(w, r)
+		return
+	case rt == nil:
+		writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("no route for %s %s", r.Method, r.URL.Path))
+		return
+	}
+
+	if !s.authorize(w, r) {
+		return
+	}
+	rt.handle(w, r, params)
+}
+
+// authorize checks the bearer token and that it carries the scope the request
+// needs.
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request) bool {
+	value, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	tok := s.token
+	if !ok || tok == nil || subtle.ConstantTimeCompare([]byte(value), []byte(tok.value)) != 1 {
+		writeError(w, http.StatusUnauthorized, "Unauthorized", "invalid or revoked access token")
+		return false
+	}
+	if time.Now().After(tok.expires) {
+		writeError(w, http.StatusUnauthorized, "Unauthorized", "access token expired")
+		return false
+	}
+	need := vanta.ScopeAllWrite
+	if r.Method == http.MethodGet {
+		need = vanta.ScopeAllRead
+	}
+	if !slices.Contains(tok.scopes, need) && !slices.Contains(tok.scopes, vanta.ScopeAllWrite) {
+		writeError(w, http.StatusForbidden, "Forbidden", "access token lacks scope "+need)
+		return false
+	}
+	return true
+}
+
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	var body vanta.OAuthCreateTokenRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request", "error_description": err.Error()})
+		return
+	}
+	if body.GrantType != "client_credentials" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+	if !s.validCredentials(body.ClientID, body.ClientSecret) {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+	scopes := strings.Fields(body.Scope)
+	for _, scope := range scopes {
+		if scope != vanta.ScopeAllRead && scope != vanta.ScopeAllWrite {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_scope", "error_description": scope})
+			return
+		}
+	}
+	if len(scopes) == 0 {
+		scopes = []string{vanta.ScopeAllRead, vanta.ScopeAllWrite}
+	}
+	tok := s.issueToken(scopes)
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": tok.value,
+		"token_type":   "Bearer",
+		"expires_in":   int(s.tokenTTL / time.Second),
+		"scope":        strings.Join(scopes, " "),
+	})
+}
+
+func (s *Server) handleRevoke(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		ClientID     string `json:"client_id"`
+		ClientSecret string `json:"client_secret"`
+		Token        string `json:"token"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request", "error_description": err.Error()})
+		return
+	}
+	if !s.validCredentials(body.ClientID, body.ClientSecret) {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+	if s.token != nil && s.token.value == body.Token {
+		s.token = nil
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) validCredentials(id, secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(id), []byte(s.clientID)) == 1 &&
+		subtle.ConstantTimeCompare([]byte(secret), []byte(s.clientSecret)) == 1
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, name, message string) {
+	writeJSON(w, status, map[string]string{"name": name, "message": message})
+}
@@ -0,0 +1,248 @@
+package vantatest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+
+	vanta "github.com/richardoc/vanta-sdk-go/v1"
+)
+
+func newFixtureServer(t *testing.T) (*Server, *vanta.Client) {
+	t.Helper()
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+	if err := srv.LoadFixtures("testdata/fixtures.json"); err != nil {
+		t.Fatal(err)
+	}
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, client
+}
+
+func statusOf(err error) int {
+	var apiErr *vanta.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+func TestServerPaginatesSeededItems(t *testing.T) {
+	_, client := newFixtureServer(t)
+	ctx := context.Background()
+
+	pageSize := 2
+	page, err := client.Services.Controls.ListControls(ctx, &vanta.ControlsListControlsParams{PageSize: &pageSize})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Results.Data) != 2 || !page.Results.PageInfo.HasNextPage || page.Results.PageInfo.HasPreviousPage {
+		t.Fatalf("first page = %+v", page.Results)
+	}
+
+	var ids []vanta.ControlID
+	for control, err := range client.Services.Controls.AllControls(ctx, &vanta.ControlsListControlsParams{PageSize: &pageSize}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, control.ID)
+	}
+	if want := []vanta.ControlID{"control-1", "control-2", "control-3"}; !slices.Equal(ids, want) {
+		t.Fatalf("ids = %v, want %v", ids, want)
+	}
+
+	bad := "not-a-cursor"
+	_, err = client.Services.Controls.ListControls(ctx, &vanta.ControlsListControlsParams{PageCursor: &bad})
+	if statusOf(err) != http.StatusBadRequest {
+		t.Fatalf("bad cursor err = %v, want 400", err)
+	}
+}
+
+func TestServerCreateUpdateDelete(t *testing.T) {
+	srv, client := newFixtureServer(t)
+	ctx := context.Background()
+	controls := client.Services.Controls
+
+	created, err := controls.CreateCustomControl(ctx, &vanta.ControlsCreateCustomControlParams{Body: &vanta.ControlsCreateCustomControlRequestBody{
+		ExternalID:  "CUS-1",
+		Name:        "Custom control",
+		Description: "Tracked by hand.",
+		Domain:      "PHYSICAL_&_ENVIRONMENTAL_SECURITY",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ID == "" || created.Source != "Custom" || !slices.Equal(created.Domains, []string{"PHYSICAL_&_ENVIRONMENTAL_SECURITY"}) {
+		t.Fatalf("created = %+v", created)
+	}
+
+	updated, err := controls.UpdateControlsMetadata(ctx, &vanta.ControlsUpdateControlsMetadataParams{
+		ControlID: created.ID,
+		Body:      &vanta.ControlsUpdateControlsMetadataRequestBody{ExternalID: "CUS-1", Name: "Renamed", Note: "reviewed"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Name != "Renamed" || updated.Note != "reviewed" {
+		t.Fatalf("updated = %+v", updated)
+	}
+	stored, err := Item[vanta.Control](srv, Controls, string(created.ID))
+	if err != nil || stored.Name != "Renamed" {
+		t.Fatalf("stored = %+v, %v", stored, err)
+	}
+
+	if _, err := controls.RemoveControl(ctx, &vanta.ControlsRemoveControlParams{ControlID: created.ID}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = controls.GetControlByID(ctx, &vanta.ControlsGetControlByIDParams{ControlID: created.ID})
+	if statusOf(err) != http.StatusNotFound {
+		t.Fatalf("get after delete err = %v, want 404", err)
+	}
+	if _, err := Item[vanta.Control](srv, Controls, string(created.ID)); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Item err = %v, want ErrNotFound", err)
+	}
+	if n := srv.Count(Controls); n != 3 {
+		t.Fatalf("Count = %d, want 3", n)
+	}
+}
+
+func TestServerRelationships(t *testing.T) {
+	_, client := newFixtureServer(t)
+	ctx := context.Background()
+
+	if _, err := client.Services.Controls.AddControlToDocumentMapping(ctx, &vanta.ControlsAddControlToDocumentMappingParams{
+		ControlID: "control-1",
+		Body:      &vanta.ControlsAddControlToDocumentMappingRequestBody{DocumentID: "document-1"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	docs, err := client.Services.Controls.ListControlsDocuments(ctx, &vanta.ControlsListControlsDocumentsParams{ControlID: "control-1"})
+	if err != nil || len(docs.Results.Data) != 1 || docs.Results.Data[0].ID != "document-1" {
+		t.Fatalf("control documents = %+v, %v", docs, err)
+	}
+
+	if _, err := client.Services.Groups.AddPersonToGroup(ctx, &vanta.GroupsAddPersonToGroupParams{
+		GroupID: "group-1",
+		Body:    &vanta.GroupsAddPersonToGroupRequestBody{ID: "person-1"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	members, err := client.Services.Groups.ListPeopleInGroup(ctx, &vanta.GroupsListPeopleInGroupParams{GroupID: "group-1"})
+	if err != nil || len(members) != 2 {
+		t.Fatalf("members = %+v, %v", members, err)
+	}
+
+	faqs, err := client.Services.TrustCenters.ListTrustCenterFaqs(ctx, &vanta.TrustCentersListTrustCenterFaqsParams{SlugID: "acme"})
+	if err != nil || len(faqs.Results) != 1 {
+		t.Fatalf("faqs = %+v, %v", faqs, err)
+	}
+	_, err = client.Services.TrustCenters.ListTrustCenterFaqs(ctx, &vanta.TrustCentersListTrustCenterFaqsParams{SlugID: "missing"})
+	if statusOf(err) != http.StatusNotFound {
+		t.Fatalf("missing trust center err = %v, want 404", err)
+	}
+}
+
+func TestServerTokenInvalidatesPreviousToken(t *testing.T) {
+	srv, first := newFixtureServer(t)
+	ctx := context.Background()
+
+	if _, err := first.Services.Groups.GetGroupByID(ctx, &vanta.GroupsGetGroupByIDParams{GroupID: "group-1"}); err != nil {
+		t.Fatal(err)
+	}
+	second, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := second.Services.Groups.GetGroupByID(ctx, &vanta.GroupsGetGroupByIDParams{GroupID: "group-1"}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = first.Services.Groups.GetGroupByID(ctx, &vanta.GroupsGetGroupByIDParams{GroupID: "group-1"})
+	if statusOf(err) != http.StatusUnauthorized {
+		t.Fatalf("first client err = %v, want 401 after a new token was issued", err)
+	}
+
+	readOnly, err := srv.NewClient(vanta.WithTokenSource(vanta.StaticTokenSource(srv.IssueToken(vanta.ScopeAllRead))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = readOnly.Services.Vendors.CreateVendor(ctx, &vanta.VendorsCreateVendorParams{Body: &vanta.VendorsCreateVendorRequestBody{Name: "Acme"}})
+	if statusOf(err) != http.StatusForbidden {
+		t.Fatalf("read-only create err = %v, want 403", err)
+	}
+}
+
+func TestServerInjectedErrors(t *testing.T) {
+	srv, client := newFixtureServer(t)
+	ctx := context.Background()
+
+	srv.InjectError(InjectedError{
+		Method: http.MethodGet,
+		Path:   "/vulnerabilities/:vulnerabilityId",
+		Status: http.StatusTooManyRequests,
+		Header: http.Header{"Retry-After": {"1"}},
+		Times:  1,
+	})
+	params := &vanta.VulnerabilitiesGetVulnerabilityByIDParams{VulnerabilityID: "vuln-1"}
+	if _, err := client.Services.Vulnerabilities.GetVulnerabilityByID(ctx, params); statusOf(err) != http.StatusTooManyRequests {
+		t.Fatalf("first err = %v, want 429", err)
+	}
+	if _, err := client.Services.Vulnerabilities.GetVulnerabilityByID(ctx, params); err != nil {
+		t.Fatalf("second err = %v, want the injected error to be used up", err)
+	}
+
+	srv.InjectError(InjectedError{Path: "/oauth/token", Status: http.StatusServiceUnavailable})
+	other, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := other.Services.Vulnerabilities.GetVulnerabilityByID(ctx, params); statusOf(err) != http.StatusServiceUnavailable {
+		t.Fatalf("token err = %v, want 503", err)
+	}
+	srv.ClearErrors()
+	if _, err := other.Services.Vulnerabilities.GetVulnerabilityByID(ctx, params); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClientPoolClientCredentialsTenant(t *testing.T) {
+	srv, _ := newFixtureServer(t)
+	var tokenRequests int
+	httpClient := &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		if r.URL.Path == tokenPath {
+			tokenRequests++
+		}
+		return srv.Client().Transport.RoundTrip(r)
+	})}
+	pool, err := vanta.NewClientPool(vanta.ClientPoolConfig{
+		Credentials: vanta.CredentialsProviderFunc(func(context.Context, string) (vanta.TenantCredentials, error) {
+			return vanta.TenantCredentials{ClientID: DefaultClientID, ClientSecret: DefaultClientSecret, Scope: vanta.ScopeAllRead}, nil
+		}),
+		// The token exchange must use the pool's auth URL and HTTP client.
+		Options: []vanta.Option{vanta.WithBaseURL(srv.BaseURL()), vanta.WithAuthURL(srv.TokenURL()), vanta.WithHTTPClient(httpClient)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	defer pool.Close(ctx)
+
+	client, err := pool.Client(ctx, "acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Services.Groups.GetGroupByID(ctx, &vanta.GroupsGetGroupByIDParams{GroupID: "group-1"}); err != nil {
+		t.Fatal(err)
+	}
+	if tokenRequests != 1 {
+		t.Fatalf("token requests through the pool's HTTP client = %d, want 1", tokenRequests)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
@@ -0,0 +1,282 @@
+package vantatest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+)
+
+// ErrNotFound is returned by Item when the collection has no item with the
+// requested ID.
+var ErrNotFound = errors.New("vantatest: item not found")
+
+// Collection names a set of items the server keeps. It is the item path
+// relative to the API base URL, so items nested under a trust center have the
+// trust center slug in their collection name.
+type Collection string
+
+// Top-level collections.
+const (
+	Controls        Collection = "controls"
+	Documents       Collection = "documents"
+	People          Collection = "people"
+	Groups          Collection = "groups"
+	Vendors         Collection = "vendors"
+	RiskScenarios   Collection = "risk-scenarios"
+	Vulnerabilities Collection = "vulnerabilities"
+	// TrustCenters items are keyed by their slug, stored in the id field.
+	TrustCenters Collection = "trust-centers"
+)
+
+// TrustCenterFAQs is the collection of FAQs on the trust center slug.
+func TrustCenterFAQs(slug string) Collection { return trustCenterChild(slug, "faqs") }
+
+// TrustCenterSubprocessors is the collection of subprocessors on the trust
+// center slug.
+func TrustCenterSubprocessors(slug string) Collection {
+	return trustCenterChild(slug, "subprocessors")
+}
+
+// TrustCenterUpdates is the collection of updates on the trust center slug.
+func TrustCenterUpdates(slug string) Collection { return trustCenterChild(slug, "updates") }
+
+// TrustCenterSubscribers is the collection of subscribers on the trust center
+// slug.
+func TrustCenterSubscribers(slug string) Collection {
+	return trustCenterChild(slug, "subscribers")
+}
+
+// TrustCenterSubscriberGroups is the collection of subscriber groups on the
+// trust center slug.
+func TrustCenterSubscriberGroups(slug string) Collection {
+	return trustCenterChild(slug, "subscriber-groups")
+}
+
+// TrustCenterViewers is the collection of viewers on the trust center slug.
+func TrustCenterViewers(slug string) Collection { return trustCenterChild(slug, "viewers") }
+
+// TrustCenterControlCategories is the collection of control categories on the
+// trust center slug.
+func TrustCenterControlCategories(slug string) Collection {
+	return trustCenterChild(slug, "control-categories")
+}
+
+func trustCenterChild(slug, name string) Collection {
+	return Collection(string(TrustCenters) + "/" + slug + "/" + name)
+}
+
+// idKey is the JSON field holding an item's ID.
+func (c Collection) idKey() string {
+	if c == RiskScenarios {
+		return "riskId"
+	}
+	return "id"
+}
+
+// object is an item as the server stores it: a decoded JSON object.
+type object = map[string]any
+
+type collection struct {
+	ids  []string
+	byID map[string]object
+}
+
+type store struct {
+	collections map[Collection]*collection
+	// documentLinks maps a control ID to the IDs of the documents mapped to it.
+	documentLinks map[string][]string
+	nextID        int
+}
+
+func newStore() *store {
+	return &store{
+		collections:   map[Collection]*collection{},
+		documentLinks: map[string][]string{},
+	}
+}
+
+func (st *store) collection(c Collection) *collection {
+	col, ok := st.collections[c]
+	if !ok {
+		col = &collection{byID: map[string]object{}}
+		st.collections[c] = col
+	}
+	return col
+}
+
+// newID returns an ID shaped like Vanta's 24 hex digit object IDs.
+func (st *store) newID() string {
+	st.nextID++
+	return fmt.Sprintf("%024x", st.nextID)
+}
+
+func (st *store) list(c Collection) []object {
+	col := st.collections[c]
+	if col == nil {
+		return nil
+	}
+	out := make([]object, 0, len(col.ids))
+	for _, id := range col.ids {
+		out = append(out, col.byID[id])
+	}
+	return out
+}
+
+func (st *store) get(c Collection, id string) (object, bool) {
+	col := st.collections[c]
+	if col == nil {
+		return nil, false
+	}
+	item, ok := col.byID[id]
+	return item, ok
+}
+
+// put stores item, assigning an ID when it has none. It replaces an existing
+// item with the same ID in place.
+func (st *store) put(c Collection, item object) object {
+	key := c.idKey()
+	id, _ := item[key].(string)
+	if id == "" {
+		id = st.newID()
+		item[key] = id
+	}
+	col := st.collection(c)
+	if _, ok := col.byID[id]; !ok {
+		col.ids = append(col.ids, id)
+	}
+	col.byID[id] = item
+	return item
+}
+
+func (st *store) delete(c Collection, id string) bool {
+	col := st.collections[c]
+	if col == nil {
+		return false
+	}
+	if _, ok := col.byID[id]; !ok {
+		return false
+	}
+	delete(col.byID, id)
+	col.ids = slices.DeleteFunc(col.ids, func(s string) bool { return s == id })
+	return true
+}
+
+// Seed adds items to c. Items are any values that marshal to JSON objects,
+// typically SDK models such as vanta.Control. Items without an ID get a
+// generated one; items with the ID of an existing item replace it.
+func (s *Server) Seed(c Collection, items ...any) error {
+	objects := make([]object, 0, len(items))
+	for i, item := range items {
+		obj, err := toObject(item)
+		if err != nil {
+			return fmt.Errorf("vantatest: seed %s item %d: %w", c, i, err)
+		}
+		objects = append(objects, obj)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, obj := range objects {
+		s.store.put(c, obj)
+	}
+	return nil
+}
+
+// LoadFixtures seeds the server from a JSON file whose top-level keys are
+// collection names and whose values are arrays of items:
+//
+//	{
+//	  "controls": [{"id": "c1", "name": "MFA"}],
+//	  "trust-centers/acme/faqs": [{"question": "...", "answer": "..."}]
+//	}
+//
+// Collections are seeded in key order.
+func (s *Server) LoadFixtures(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("vantatest: read fixtures: %w", err)
+	}
+	var fixtures map[Collection][]json.RawMessage
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		return fmt.Errorf("vantatest: decode fixtures %s: %w", path, err)
+	}
+	for _, c := range slices.Sorted(maps.Keys(fixtures)) {
+		items := make([]any, 0, len(fixtures[c]))
+		for _, raw := range fixtures[c] {
+			items = append(items, raw)
+		}
+		if err := s.Seed(c, items...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Item returns the item with the given ID in c, decoded into T.
+func Item[T any](s *Server, c Collection, id string) (T, error) {
+	var out T
+	s.mu.Lock()
+	obj, ok := s.store.get(c, id)
+	var data []byte
+	var err error
+	if ok {
+		data, err = json.Marshal(obj)
+	}
+	s.mu.Unlock()
+	if !ok {
+		return out, fmt.Errorf("%w: %s/%s", ErrNotFound, c, id)
+	}
+	if err != nil {
+		return out, err
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return out, fmt.Errorf("vantatest: decode %s/%s: %w", c, id, err)
+	}
+	return out, nil
+}
+
+// Count returns the number of items in c.
+func (s *Server) Count(c Collection) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.store.list(c))
+}
+
+// toObject converts v to a JSON object, keeping numbers as json.Number so
+// they survive the round trip unchanged.
+func toObject(v any) (object, error) {
+	data, ok := v.(json.RawMessage)
+	if !ok {
+		var err error
+		if data, err = json.Marshal(v); err != nil {
+			return nil, err
+		}
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var obj object
+	if err := dec.Decode(&obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, errors.New("item is not a JSON object")
+	}
+	return obj, nil
+}
+
+// merge applies a PATCH body to item: nested objects are merged key by key
+// and other values replace the existing ones.
+func merge(item, patch object) {
+	for k, v := range patch {
+		if sub, ok := v.(object); ok {
+			if cur, ok := item[k].(object); ok {
+				merge(cur, sub)
+				continue
+			}
+		}
+		item[k] = v
+	}
+}
@@ -0,0 +1,134 @@
+package v1
+
+// Vendor is a managed vendor.
+type Vendor struct {
+	ID                               VendorID              `json:"id"`
+	Name                             string                `json:"name"`
+	WebsiteURL                       string                `json:"websiteUrl"`
+	AccountManagerName               string                `json:"accountManagerName"`
+	AccountManagerEmail              string                `json:"accountManagerEmail"`
+	ServicesProvided                 string                `json:"servicesProvided"`
+	AdditionalNotes                  string                `json:"additionalNotes"`
+	AuthDetails                      *VendorAuthDetails    `json:"authDetails"`
+	SecurityOwnerUserID              string                `json:"securityOwnerUserId"`
+	BusinessOwnerUserID              string                `json:"businessOwnerUserId"`
+	ContractStartDate                *string               `json:"contractStartDate"`
+	ContractRenewalDate              *string               `json:"contractRenewalDate"`
+	ContractTerminationDate          *string               `json:"contractTerminationDate"`
+	LastSecurityReviewCompletionDate *string               `json:"lastSecurityReviewCompletionDate"`
+	NextSecurityReviewDueDate        *string               `json:"nextSecurityReviewDueDate"`
+	IsVisibleToAuditors              bool                  `json:"isVisibleToAuditors"`
+	IsRiskAutoScored                 bool                  `json:"isRiskAutoScored"`
+	Category                         *VendorCategory       `json:"category"`
+	RiskAttributeIDs                 []string              `json:"riskAttributeIds"`
+	Status                           VendorStatus          `json:"status"`
+	InherentRiskLevel                string                `json:"inherentRiskLevel"`
+	ResidualRiskLevel                string                `json:"residualRiskLevel"`
+	VendorHeadquarters               string                `json:"vendorHeadquarters"`
+	ContractAmount                   *VendorContractAmount `json:"contractAmount"`
+	CustomFields                     CustomFields          `json:"customFields"`
+	TagIdentifiers                   any                   `json:"tagIdentifiers"`
+	LatestDecision                   *VendorDecision       `json:"latestDecision"`
+}
+
+type VendorAuthDetails struct {
+	Method                 string `json:"method"`
+	PasswordMFA            bool   `json:"passwordMFA"`
+	PasswordRequiresNumber bool   `json:"passwordRequiresNumber"`
+	PasswordRequiresSymbol bool   `json:"passwordRequiresSymbol"`
+	PasswordMinimumLength  int    `json:"passwordMinimumLength"`
+}
+
+type VendorCategory struct {
+	DisplayName string `json:"displayName"`
+}
+
+type VendorContractAmount struct {
+	Amount   float64 `json:"amount"`
+	Currency string  `json:"currency"`
+}
+
+// VendorDecision is the outcome of a vendor security review.
+type VendorDecision struct {
+	Status        string `json:"status"`
+	LastUpdatedAt string `json:"lastUpdatedAt"`
+}
+
+// VendorDocument is a document attached to a vendor or security review.
+type VendorDocument struct {
+	ID           string             `json:"id"`
+	URL          string             `json:"url"`
+	Title        string             `json:"title"`
+	FileName     string             `json:"fileName"`
+	Type         string             `json:"type"`
+	MimeType     string             `json:"mimeType"`
+	Description  string             `json:"description"`
+	UploadedBy   *UploaderReference `json:"uploadedBy"`
+	CreationDate string             `json:"creationDate"`
+	UpdatedDate  string             `json:"updatedDate"`
+	DeletionDate *string            `json:"deletionDate"`
+}
+
+// VendorSecurityReview is a security review of a vendor.
+type VendorSecurityReview struct {
+	ID                VendorSecurityReviewID `json:"id"`
+	VendorID          VendorID               `json:"vendorId"`
+	DecisionNotes     string                 `json:"decisionNotes"`
+	Comments          string                 `json:"comments"`
+	CompletedByUserID string                 `json:"completedByUserId"`
+	StartDate         string                 `json:"startDate"`
+	DueDate           string                 `json:"dueDate"`
+	OverrideDueDate   *string                `json:"overrideDueDate"`
+	CompletionDate    *string                `json:"completionDate"`
+	Decision          *VendorDecision        `json:"decision"`
+}
+
+// VendorFinding is a risk finding recorded against a vendor.
+type VendorFinding struct {
+	ID               VendorFindingID           `json:"id"`
+	VendorID         VendorID                  `json:"vendorId"`
+	SecurityReviewID VendorSecurityReviewID    `json:"securityReviewId"`
+	DocumentID       *DocumentID               `json:"documentId"`
+	Content          string                    `json:"content"`
+	RiskStatus       string                    `json:"riskStatus"`
+	Remediation      *VendorFindingRemediation `json:"remediation"`
+}
+
+type VendorFindingRemediation struct {
+	RequirementNotes string `json:"requirementNotes"`
+	State            string `json:"state"`
+}
+
+// VendorRiskAttribute is a risk attribute that can be assigned to vendors.
+type VendorRiskAttribute struct {
+	ID               string   `json:"id"`
+	Name             string   `json:"name"`
+	Description      string   `json:"description"`
+	VendorCategories []string `json:"vendorCategories"`
+	Enabled          bool     `json:"enabled"`
+	RiskLevel        string   `json:"riskLevel"`
+}
+
+// VendorStatus is the lifecycle status of a vendor.
+type VendorStatus string
+
+// VendorStatus values.
+const (
+	VendorStatusManaged       VendorStatus = "MANAGED"
+	VendorStatusInProcurement VendorStatus = "IN_PROCUREMENT"
+	VendorStatusArchived      VendorStatus = "ARCHIVED"
+)
+
+// Valid reports whether v is a known VendorStatus.
+func (v VendorStatus) Valid() bool {
+	switch v {
+	case VendorStatusManaged, VendorStatusInProcurement, VendorStatusArchived:
+		return true
+	}
+	return false
+}
+
+// UnmarshalJSON accepts values the SDK does not know yet.
+func (v *VendorStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, v)
+}
@@ -0,0 +1,130 @@
+package v1
+
+// Vulnerability is a vulnerability detected by a scanner integration.
+type Vulnerability struct {
+	ID                 VulnerabilityID                  `json:"id"`
+	Name               string                           `json:"name"`
+	Description        string                           `json:"description"`
+	IntegrationID      IntegrationID                    `json:"integrationId"`
+	PackageIdentifier  string                           `json:"packageIdentifier"`
+	VulnerabilityType  string                           `json:"vulnerabilityType"`
+	TargetID           string                           `json:"targetId"`
+	FirstDetectedDate  string                           `json:"firstDetectedDate"`
+	SourceDetectedDate string                           `json:"sourceDetectedDate"`
+	LastDetectedDate   string                           `json:"lastDetectedDate"`
+	Severity           VulnerabilitySeverity            `json:"severity"`
+	CVSSSeverityScore  float64                          `json:"cvssSeverityScore"`
+	ScannerScore       float64                          `json:"scannerScore"`
+	IsFixable          bool                             `json:"isFixable"`
+	RemediateByDate    *string                          `json:"remediateByDate"`
+	RelatedVulns       []string                         `json:"relatedVulns"`
+	RelatedURLs        []string                         `json:"relatedUrls"`
+	ExternalURL        string                           `json:"externalURL"`
+	ScanSource         string                           `json:"scanSource"`
+	DeactivateMetadata *VulnerabilityDeactivateMetadata `json:"deactivateMetadata"`
+}
+
+type VulnerabilityDeactivateMetadata struct {
+	DeactivatedBy                 string  `json:"deactivatedBy"`
+	DeactivatedOnDate             string  `json:"deactivatedOnDate"`
+	DeactivationReason            string  `json:"deactivationReason"`
+	DeactivatedUntilDate          *string `json:"deactivatedUntilDate"`
+	IsVulnDeactivatedIndefinitely bool    `json:"isVulnDeactivatedIndefinitely"`
+}
+
+// VulnerabilityRemediation tracks remediation of one vulnerability on one asset.
+type VulnerabilityRemediation struct {
+	ID                string                `json:"id"`
+	VulnerabilityID   VulnerabilityID       `json:"vulnerabilityId"`
+	VulnerableAssetID VulnerableAssetID     `json:"vulnerableAssetId"`
+	Severity          VulnerabilitySeverity `json:"severity"`
+	DetectedDate      string                `json:"detectedDate"`
+	SLADeadlineDate   string                `json:"slaDeadlineDate"`
+	RemediationDate   *string               `json:"remediationDate"`
+}
+
+// VulnerableAsset is an asset with associated vulnerabilities.
+type VulnerableAsset struct {
+	ID             VulnerableAssetID        `json:"id"`
+	Name           string                   `json:"name"`
+	AssetType      VulnerableAssetType      `json:"assetType"`
+	HasBeenScanned bool                     `json:"hasBeenScanned"`
+	ImageScanTag   string                   `json:"imageScanTag"`
+	Scanners       []VulnerableAssetScanner `json:"scanners"`
+}
+
+type VulnerableAssetScanner struct {
+	ResourceID                  string               `json:"resourceId"`
+	IntegrationID               IntegrationID        `json:"integrationId"`
+	ImageDigest                 string               `json:"imageDigest"`
+	ImagePushedAtDate           string               `json:"imagePushedAtDate"`
+	ImageTags                   []string             `json:"imageTags"`
+	AssetTags                   []VulnerableAssetTag `json:"assetTags"`
+	ParentAccountOrOrganization string               `json:"parentAccountOrOrganization"`
+	BiosUUID                    string               `json:"biosUuid"`
+	IPv4s                       []string             `json:"ipv4s"`
+	IPv6s                       []string             `json:"ipv6s"`
+	MacAddresses                []string             `json:"macAddresses"`
+	Hostnames                   []string             `json:"hostnames"`
+	FQDNs                       []string             `json:"fqdns"`
+	OperatingSystems            []string             `json:"operatingSystems"`
+	TargetID                    string               `json:"targetId"`
+}
+
+type VulnerableAssetTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// VulnerabilitySeverity is the severity of a vulnerability or remediation.
+type VulnerabilitySeverity string
+
+// VulnerabilitySeverity values.
+const (
+	VulnerabilitySeverityCritical VulnerabilitySeverity = "CRITICAL"
+	VulnerabilitySeverityHigh     VulnerabilitySeverity = "HIGH"
+	VulnerabilitySeverityMedium   VulnerabilitySeverity = "MEDIUM"
+	VulnerabilitySeverityLow      VulnerabilitySeverity = "LOW"
+)
+
+// Valid reports whether v is a known VulnerabilitySeverity.
+func (v VulnerabilitySeverity) Valid() bool {
+	switch v {
+	case VulnerabilitySeverityCritical, VulnerabilitySeverityHigh, VulnerabilitySeverityMedium, VulnerabilitySeverityLow:
+		return true
+	}
+	return false
+}
+
+// UnmarshalJSON accepts values the SDK does not know yet.
+func (v *VulnerabilitySeverity) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, v)
+}
+
+// VulnerableAssetType is the kind of asset a vulnerability was found on.
+type VulnerableAssetType string
+
+// VulnerableAssetType values.
+const (
+	VulnerableAssetTypeCodeRepository           VulnerableAssetType = "CODE_REPOSITORY"
+	VulnerableAssetTypeContainerRepository      VulnerableAssetType = "CONTAINER_REPOSITORY"
+	VulnerableAssetTypeContainerRepositoryImage VulnerableAssetType = "CONTAINER_REPOSITORY_IMAGE"
+	VulnerableAssetTypeManifestFile             VulnerableAssetType = "MANIFEST_FILE"
+	VulnerableAssetTypeServer                   VulnerableAssetType = "SERVER"
+	VulnerableAssetTypeServerlessFunction       VulnerableAssetType = "SERVERLESS_FUNCTION"
+	VulnerableAssetTypeWorkstation              VulnerableAssetType = "WORKSTATION"
+)
+
+// Valid reports whether v is a known VulnerableAssetType.
+func (v VulnerableAssetType) Valid() bool {
+	switch v {
+	case VulnerableAssetTypeCodeRepository, VulnerableAssetTypeContainerRepository, VulnerableAssetTypeContainerRepositoryImage, VulnerableAssetTypeManifestFile, VulnerableAssetTypeServer, VulnerableAssetTypeServerlessFunction, VulnerableAssetTypeWorkstation:
+		return true
+	}
+	return false
+}
+
+// UnmarshalJSON accepts values the SDK does not know yet.
+func (v *VulnerableAssetType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, v)
+}